@@ -1,17 +1,24 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"strings"
+	"time"
 
 	"path/filepath"
 
 	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	logsupport "github.com/bytesparadise/libasciidoc/pkg/log"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -24,10 +31,16 @@ func NewRootCmd() *cobra.Command {
 	var logLevel string
 	var css string
 	var attributes []string
+	var safeMode string
+	var uriReadTimeout time.Duration
+	var uriCacheDir string
+	var backend string
+	var dump string
+	var templateDir string
 
 	rootCmd := &cobra.Command{
 		Use:   "libasciidoc [flags] FILE",
-		Short: `libasciidoc is a tool to convert from Asciidoc to HTML`,
+		Short: `libasciidoc is a tool to convert from Asciidoc to HTML (and to the other formats of the available backends)`,
 		Args:  cobra.ArbitraryArgs,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			lvl, err := log.ParseLevel(logLevel)
@@ -41,10 +54,31 @@ func NewRootCmd() *cobra.Command {
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if dump == "schema" {
+				// the JSON schema does not depend on the documents
+				out, close := getOut(cmd, "", outputName)
+				defer close()
+				return types.EncodeJSONSchema(out)
+			}
 			if len(args) == 0 {
 				return helpCommand.RunE(cmd, args)
 			}
 			attrs := parseAttributes(attributes)
+			mode, err := configuration.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			if _, err := renderer.Lookup(backend); err != nil {
+				return err
+			}
+			switch dump {
+			case "", "draft", "final":
+			default:
+				return errors.Errorf("invalid document to dump: '%s' (expected 'draft', 'final' or 'schema')", dump)
+			}
+			// the errors of the malformed blocks do not prevent the rendering of the documents,
+			// but they are reported once all the documents have been processed
+			var parseErrs parser.ParseErrors
 			for _, sourcePath := range args {
 				out, close := getOut(cmd, sourcePath, outputName)
 				if out != nil {
@@ -55,13 +89,44 @@ func NewRootCmd() *cobra.Command {
 						configuration.WithFilename(sourcePath),
 						configuration.WithAttributes(attrs),
 						configuration.WithCSS(css),
-						configuration.WithHeaderFooter(!noHeaderFooter))
-					_, err := libasciidoc.ConvertFileToHTML(out, config)
-					if err != nil {
+						configuration.WithHeaderFooter(!noHeaderFooter),
+						configuration.WithSafeMode(mode),
+						configuration.WithURIReadTimeout(uriReadTimeout),
+						configuration.WithURICacheDir(uriCacheDir),
+						configuration.WithOutputDir(getOutDir(sourcePath, outputName)),
+						configuration.WithBackend(backend),
+						configuration.WithTemplateDir(templateDir))
+					if dump != "" {
+						if err := dumpFile(out, config, dump); err != nil {
+							errs, ok := err.(parser.ParseErrors)
+							if !ok {
+								return err
+							}
+							parseErrs = append(parseErrs, errs...)
+						}
+						if f, ok := out.(*outputFile); ok {
+							if err := f.write("", ".json"); err != nil {
+								return err
+							}
+						}
+						continue
+					}
+					metadata, err := libasciidoc.ConvertFile(out, config)
+					if errs, ok := err.(parser.ParseErrors); ok {
+						parseErrs = append(parseErrs, errs...)
+					} else if err != nil {
 						return err
 					}
+					if f, ok := out.(*outputFile); ok {
+						if err := f.write(metadata.OutFileName, metadata.OutFileSuffix); err != nil {
+							return err
+						}
+					}
 				}
 			}
+			if len(parseErrs) > 0 {
+				return parseErrs
+			}
 			return nil
 		},
 	}
@@ -71,10 +136,29 @@ func NewRootCmd() *cobra.Command {
 	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT")
 	flags.StringVar(&logLevel, "log", "warning", "log level to set [debug|info|warning|error|fatal|panic]")
 	flags.StringVar(&css, "css", "", "the path to the CSS file to link to the document")
-	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name=value, name@ or name=value@ (soft set), name! (unset) or name!@ (soft unset)")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "the safe mode to apply [unsafe|safe|server|secure]")
+	flags.DurationVar(&uriReadTimeout, "uri-read-timeout", configuration.DefaultURIReadTimeout, "the timeout when reading remote content (with the 'allow-uri-read' attribute)")
+	flags.StringVar(&uriCacheDir, "uri-cache-dir", "", "the directory in which remote content is cached (no cache by default)")
+	flags.StringVarP(&backend, "backend", "b", "", fmt.Sprintf("the backend to use %v (default: the 'backend' attribute of the document, or %s)", renderer.Backends(), renderer.DefaultBackend))
+	flags.StringVarP(&templateDir, "template-dir", "T", "", "the directory of the templates named after the kind of element (eg: 'paragraph.tmpl') which override the built-in templates of the backend (html5, xhtml5 and epub3 only)")
+	flags.StringVar(&dump, "dump", "", "dump the parsed document in JSON instead of converting it [draft|final], or the JSON schema of the dumped documents [schema] (the draft document is the result of the file inclusions, before the substitutions)")
 	return rootCmd
 }
 
+// dumpFile writes the draft or the final document parsed from the file set in the configuration, in the JSON format
+func dumpFile(output io.Writer, config configuration.Configuration, dump string) error {
+	file, err := config.Open(config.Filename)
+	if err != nil {
+		return errors.Wrapf(err, "error opening %s", config.Filename)
+	}
+	defer file.Close()
+	if dump == "draft" {
+		return libasciidoc.ParseDraftToJSON(file, output, config)
+	}
+	return libasciidoc.ParseToJSON(file, output, config)
+}
+
 type closeFunc func() error
 
 func defaultCloseFunc() closeFunc {
@@ -99,29 +183,51 @@ func getOut(cmd *cobra.Command, sourcePath, outputName string) (io.Writer, close
 		}
 		return outfile, newCloseFileFunc(outfile)
 	} else if sourcePath != "" {
-		// outfile is based on sourcePath
+		// outfile is based on sourcePath, with the suffix of the backend (unless the backend sets its name)
 		path, _ := filepath.Abs(sourcePath)
-		outname := strings.TrimSuffix(path, filepath.Ext(path)) + ".html"
-		outfile, err := os.Create(outname)
-		if err != nil {
-			log.Warnf("Cannot create output file - %v, skipping", outname)
-			return nil, nil
-		}
-		return outfile, newCloseFileFunc(outfile)
+		return &outputFile{
+			path: strings.TrimSuffix(path, filepath.Ext(path)),
+		}, defaultCloseFunc()
 	}
 	return cmd.OutOrStdout(), defaultCloseFunc()
 }
 
-// converts the `name`, `!name` and `name=value` into a map
+// outputFile an output file whose content is buffered during the conversion,
+// so that its name can be set by the backend (eg: `<manname>.<manvolnum>` for a manpage)
+// and its suffix by the backend actually used (eg: the one specified in the document)
+type outputFile struct {
+	bytes.Buffer
+	path string // the path of the output file, without suffix
+}
+
+// write writes the buffered content in the output file with the given suffix, or in the file with the given name
+// (in the same directory) if it is not empty
+func (f *outputFile) write(name, suffix string) error {
+	path := f.path + suffix
+	if name != "" {
+		path = filepath.Join(filepath.Dir(f.path), name)
+	}
+	return ioutil.WriteFile(path, f.Bytes(), 0644)
+}
+
+// getOutDir returns the directory of the output file, or an empty string if the output is STDOUT
+func getOutDir(sourcePath, outputName string) string {
+	if outputName == "-" {
+		return ""
+	} else if outputName != "" {
+		return filepath.Dir(outputName)
+	}
+	path, _ := filepath.Abs(sourcePath)
+	return filepath.Dir(path)
+}
+
+// converts the `name`, `name=value`, `name@`, `name=value@`, `name!`, `!name`, `name!@` and `!name@` into a map
+// (see `types.AttributesWithOverrides` for the conventions on the keys and values)
 func parseAttributes(attributes []string) map[string]string {
 	result := make(map[string]string, len(attributes))
 	for _, attr := range attributes {
-		data := strings.Split(attr, "=")
-		if len(data) > 1 {
-			result[data[0]] = data[1]
-		} else {
-			result[data[0]] = ""
-		}
+		key, value := types.ParseAttributeOverride(attr)
+		result[key] = value
 	}
 	return result
 }
@@ -3,6 +3,8 @@ package main_test
 import (
 	"bytes"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
 
@@ -88,7 +90,7 @@ var _ = Describe("root cmd", func() {
 		root := main.NewRootCmd()
 		buf := new(bytes.Buffer)
 		root.SetOutput(buf)
-		root.SetArgs([]string{"-s", "-o", "-", "-afoo1=bar1", "-a!foo2", "test/doc_with_attributes.adoc"})
+		root.SetArgs([]string{"-s", "-o", "-", "-afoo1=bar1", "-a!foo2", "-aattribute-missing=warn", "test/doc_with_attributes.adoc"})
 		// when
 		err := root.Execute()
 		// then
@@ -96,7 +98,41 @@ var _ = Describe("root cmd", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(buf.String()).ToNot(BeEmpty())
 		// console output also includes a warning message
-		Expect(buf.String()).To(Equal(`level=warning msg="unable to find attribute 'foo2'"
+		Expect(buf.String()).To(Equal(`level=warning msg="unable to find attribute 'foo2'" position="test/doc_with_attributes.adoc:5:12"
+<div class="paragraph">
+<p>bar1 and {foo2}</p>
+</div>`))
+	})
+
+	It("render with soft set attributes and values containing '='", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-afoo1=bar1@", "-afoo2=a=b", "-aattribute-missing=warn", "test/doc_with_attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		GinkgoT().Logf("out: %v", buf.String())
+		Expect(err).ToNot(HaveOccurred())
+		// `foo1` is soft set, so it is reset in the document
+		Expect(buf.String()).To(Equal(`level=warning msg="unable to find attribute 'foo1'" position="test/doc_with_attributes.adoc:5:1"
+<div class="paragraph">
+<p>{foo1} and a=b</p>
+</div>`))
+	})
+
+	It("render with attribute reset with trailing '!'", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-afoo1=bar1", "-afoo2!", "-aattribute-missing=warn", "test/doc_with_attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`level=warning msg="unable to find attribute 'foo2'" position="test/doc_with_attributes.adoc:5:12"
 <div class="paragraph">
 <p>bar1 and {foo2}</p>
 </div>`))
@@ -126,6 +162,195 @@ var _ = Describe("root cmd", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("render with malformed blocks and return their errors", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "test/malformed.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError(ContainSubstring("test/malformed.adoc:4:1: failed to parse AsciidocDocument")))
+		Expect(buf.String()).To(ContainSubstring("<p>[[a]]</p>"))
+	})
+
+	It("render with the html5 backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "html5", "-s", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<div class="listingblock">`))
+	})
+
+	It("render with the docbook5 backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "docbook5", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<article xmlns="http://docbook.org/ns/docbook"`))
+	})
+
+	It("render with the manpage backend in a file named after the command", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		source := filepath.Join(dir, "eve.adoc")
+		err = ioutil.WriteFile(source, []byte(`= eve(1)
+
+== Name
+
+eve - analyzes an image to determine if it's a picture of a life form
+
+== Synopsis
+
+*eve* [_OPTION_]... _FILE_...`), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "manpage", source})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadFile(filepath.Join(dir, "eve.1"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`.TH "EVE" "1"`))
+	})
+
+	It("render in a file with the suffix of the backend set in the document", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		source := filepath.Join(dir, "doc.adoc")
+		err = ioutil.WriteFile(source, []byte(`= Document Title
+:backend: docbook5
+
+a paragraph`), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{source})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadFile(filepath.Join(dir, "doc.xml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`<article xmlns="http://docbook.org/ns/docbook"`))
+		Expect(filepath.Join(dir, "doc.html")).ToNot(BeAnExistingFile())
+	})
+
+	It("render with the templates of a directory", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		err = ioutil.WriteFile(filepath.Join(dir, "paragraph.tmpl"), []byte(`<p class="custom">{{ .Content }}</p>`), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-T", dir, "-o", "-", "test/doc_with_attributes.adoc"})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<p class="custom">`))
+	})
+
+	It("fail to render with the templates of a directory and the docbook5 backend", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "docbook5", "-T", dir, "-o", "-", "test/doc_with_attributes.adoc"})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).To(MatchError("the 'docbook5' backend does not support user-supplied templates"))
+	})
+
+	It("dump the final document in JSON", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--dump", "final", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`"type": "Document"`))
+	})
+
+	It("dump the draft document in JSON", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--dump", "draft", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`"type": "DraftDocument"`))
+	})
+
+	It("dump the JSON schema", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--dump", "schema"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`"$schema": "http://json-schema.org/draft-07/schema#"`))
+	})
+
+	It("fail to dump an unknown document", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--dump", "unknown", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fail to render with an unknown backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--backend", "unknown", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("show help when executed with no arg", func() {
 		// given
 		root := main.NewRootCmd()
@@ -1,6 +1,6 @@
 module github.com/bytesparadise/libasciidoc
 
-go 1.11
+go 1.16
 
 require (
 	github.com/alecthomas/chroma v0.7.1
@@ -20,7 +20,7 @@ require (
 	github.com/spf13/cobra v0.0.5
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/stretchr/testify v1.5.1
-	golang.org/x/text v0.3.2 // indirect
+	golang.org/x/text v0.3.2
 	golang.org/x/tools v0.0.0-20200502202811-ed308ab3e770 // indirect
 	gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f // indirect
 	gopkg.in/yaml.v2 v2.2.8
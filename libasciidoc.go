@@ -1,16 +1,23 @@
 // Package libasciidoc is an open source Go library that converts Asciidoc
-// content into HTML.
+// content into HTML (and into the other formats of the registered backends).
 package libasciidoc
 
 import (
+	"bytes"
 	"io"
-	"os"
+	"io/ioutil"
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
-	htmlrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	_ "github.com/bytesparadise/libasciidoc/pkg/renderer/docbook5" // registers the docbook5 backend
+	_ "github.com/bytesparadise/libasciidoc/pkg/renderer/epub3"    // registers the epub3 backend
+	_ "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"    // registers the html5 backend
+	_ "github.com/bytesparadise/libasciidoc/pkg/renderer/latex"    // registers the latex backend
+	_ "github.com/bytesparadise/libasciidoc/pkg/renderer/manpage"  // registers the manpage backend
+	_ "github.com/bytesparadise/libasciidoc/pkg/renderer/markdown" // registers the markdown backend
+	_ "github.com/bytesparadise/libasciidoc/pkg/renderer/text"     // registers the text backend
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/bytesparadise/libasciidoc/pkg/validator"
 	"github.com/pkg/errors"
@@ -28,52 +35,190 @@ var (
 )
 
 // ConvertFileToHTML converts the content of the given filename into an HTML document.
+// The file is read from the filesystem set in the configuration (the local disk by default).
 // The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
 // as the result of the function call.
 func ConvertFileToHTML(output io.Writer, config configuration.Configuration) (types.Metadata, error) {
-	file, err := os.Open(config.Filename)
+	config.Backend = "html5"
+	return ConvertFile(output, config)
+}
+
+// ConvertToHTML converts the content of the given reader `r` into a full HTML document, written in the given writer `output`.
+// The files to include are resolved relatively to the directory of the `Filename` in the configured filesystem.
+// Returns an error if a problem occurred
+func ConvertToHTML(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	config.Backend = "html5"
+	return Convert(r, output, config)
+}
+
+// ConvertFile converts the content of the given filename with the backend set in the configuration
+// (or in the `backend` attribute of the document, or `html5` by default).
+// The file is read from the filesystem set in the configuration (the local disk by default).
+// The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
+// as the result of the function call.
+func ConvertFile(output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	file, err := config.Open(config.Filename)
 	if err != nil {
 		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
 	}
 	defer file.Close()
 	// use the file mtime as the `last updated` value
-	stat, err := os.Stat(config.Filename)
+	stat, err := file.Stat()
 	if err != nil {
 		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
 	}
 	config.LastUpdated = stat.ModTime()
-	return ConvertToHTML(file, output, config)
+	// unless the `SOURCE_DATE_EPOCH` env var is set, for reproducible builds
+	if t, found := configuration.SourceDateEpoch(); found {
+		config.LastUpdated = t
+	}
+	return Convert(file, output, config)
 }
 
-// ConvertToHTML converts the content of the given reader `r` into a full HTML document, written in the given writer `output`.
-// Returns an error if a problem occurred
-func ConvertToHTML(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+// Convert converts the content of the given reader `r` with the backend set in the configuration
+// (or in the `backend` attribute of the document, or `html5` by default), and writes the result in the given writer `output`.
+// The files to include are resolved relatively to the directory of the `Filename` in the configured filesystem.
+// The `last updated` time defaults to the current time (or to the time set in the `SOURCE_DATE_EPOCH` env var) if it is not set in the configuration.
+// Returns an error if a problem occurred. In particular, if the document contains malformed blocks, they are rendered
+// as plain paragraphs and the metadata is returned along with a `parser.ParseErrors` error
+func Convert(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
 	start := time.Now()
 	defer func() {
 		duration := time.Since(start)
-		log.Debugf("rendered the HTML output in %v", duration)
+		log.Debugf("rendered the output in %v", duration)
 	}()
+	if config.GeneratorVersion == "" {
+		config.GeneratorVersion = version()
+	}
+	// use the current time as the `last updated` value when it is not set (eg: the content is not read from a file),
+	// unless the `SOURCE_DATE_EPOCH` env var is set, for reproducible builds
+	if config.LastUpdated.IsZero() {
+		config.LastUpdated = configuration.Now()
+	}
+	backend, err := renderer.Lookup(config.Backend)
+	if err != nil {
+		return types.Metadata{}, err
+	}
+	config.BackendAttributes = backend.Attributes
+	var source []byte
+	if config.Backend == "" {
+		// retain the source, in case it needs to be parsed again with the backend specified in the document
+		if source, err = ioutil.ReadAll(r); err != nil {
+			return types.Metadata{}, err
+		}
+		r = bytes.NewReader(source)
+	}
 	log.Debugf("parsing the asciidoc source...")
-	doc, err := parser.ParseDocument(r, config) //, parser.Debug(true))
+	doc, parseErrs, err := parseDocument(r, config)
 	if err != nil {
 		return types.Metadata{}, err
 	}
+	// if no backend was set in the configuration, use the one specified in the document (if any)
+	if name, found := doc.Attributes.GetAsString(types.AttrBackend); found && config.Backend == "" && name != backend.Attributes[types.AttrBackend] {
+		if backend, err = renderer.Lookup(name); err != nil {
+			return types.Metadata{}, err
+		}
+		// parse the document again, so that the attributes of this backend (eg: `outfilesuffix`)
+		// are used in the substitutions and to load the docinfo files
+		config.BackendAttributes = backend.Attributes
+		if doc, parseErrs, err = parseDocument(bytes.NewReader(source), config); err != nil {
+			return types.Metadata{}, err
+		}
+	}
+	// the user-supplied templates override the built-in templates of the html backends only
+	if config.Templates != nil && !backend.SupportsTemplates() {
+		return types.Metadata{}, errors.Errorf("the '%s' backend does not support user-supplied templates", backend.Attributes[types.AttrBackend])
+	}
 	// validate the document
 	problems := validator.Validate(&doc)
 	for _, problem := range problems {
 		switch problem.Severity {
 		case validator.Error:
-			log.Error(problem.Message)
+			log.WithFields(problem.Position.Fields()).Error(problem.Message)
 		case validator.Warning:
-			log.Warn(problem.Message)
+			log.WithFields(problem.Position.Fields()).Warn(problem.Message)
 		}
 	}
 	// render
 	ctx := renderer.NewContext(doc, config)
-	metadata, err := htmlrenderer.Render(ctx, doc, output)
+	metadata, err := backend.Render(ctx, doc, output)
 	if err != nil {
 		return types.Metadata{}, err
 	}
+	metadata.OutFileSuffix = doc.Attributes.GetAsStringWithDefault(types.AttrOutFileSuffix, backend.Attributes[types.AttrOutFileSuffix])
 	log.Debugf("Done processing document")
+	if len(parseErrs) > 0 {
+		return metadata, parseErrs
+	}
 	return metadata, nil
 }
+
+// parseDocument parses the content of the given reader `r`.
+// The malformed blocks are retained as plain paragraphs (so that the document can be rendered), and their errors are
+// returned separately
+func parseDocument(r io.Reader, config configuration.Configuration) (types.Document, parser.ParseErrors, error) {
+	doc, err := parser.ParseDocument(r, config) //, parser.Debug(true))
+	if parseErrs, recovered := err.(parser.ParseErrors); recovered {
+		return doc, parseErrs, nil
+	}
+	return doc, nil, err
+}
+
+// ParseToJSON parses the content of the given reader `r` and writes the final document (i.e., after the substitutions
+// and the rearrangement of the lists and sections) in the JSON format in the given writer `output`
+// (see `types.EncodeJSON` for the schema).
+// The files to include are resolved relatively to the directory of the `Filename` in the configured filesystem.
+// Returns an error if a problem occurred
+func ParseToJSON(r io.Reader, output io.Writer, config configuration.Configuration) error {
+	config, err := withBackendAttributes(config)
+	if err != nil {
+		return err
+	}
+	doc, err := parser.ParseDocument(r, config)
+	return encodeJSON(output, doc, err)
+}
+
+// ParseDraftToJSON parses the content of the given reader `r` and writes the draft document (i.e., after the file inclusions,
+// but before the substitutions and the rearrangement of the lists and sections) in the JSON format in the given writer `output`
+// (see `types.EncodeJSON` for the schema).
+// The files to include are resolved relatively to the directory of the `Filename` in the configured filesystem.
+// Returns an error if a problem occurred
+func ParseDraftToJSON(r io.Reader, output io.Writer, config configuration.Configuration) error {
+	config, err := withBackendAttributes(config)
+	if err != nil {
+		return err
+	}
+	doc, err := parser.ParseDraftDocument(r, config)
+	return encodeJSON(output, doc, err)
+}
+
+// encodeJSON writes the given document in the JSON format unless a (non-recovered) parsing error occurred.
+// Returns the `parser.ParseErrors` error if the document contains malformed blocks
+func encodeJSON(output io.Writer, doc interface{}, err error) error {
+	if _, recovered := err.(parser.ParseErrors); err != nil && !recovered {
+		return err
+	}
+	if err := types.EncodeJSON(output, doc); err != nil {
+		return err
+	}
+	return err
+}
+
+// withBackendAttributes sets the attributes of the backend set in the configuration (or of the default backend),
+// which are available in the substitutions
+func withBackendAttributes(config configuration.Configuration) (configuration.Configuration, error) {
+	backend, err := renderer.Lookup(config.Backend)
+	if err != nil {
+		return config, err
+	}
+	config.BackendAttributes = backend.Attributes
+	return config, nil
+}
+
+// version returns the version of the library: the build tag if available, otherwise the build commit
+func version() string {
+	if BuildTag != "" {
+		return BuildTag
+	}
+	return BuildCommit
+}
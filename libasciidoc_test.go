@@ -1,10 +1,19 @@
 package libasciidoc_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"strings"
+	"testing/fstest"
 	"time"
 
+	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -133,6 +142,7 @@ a paragraph`
 							},
 						},
 					},
+					OutFileSuffix: ".html",
 				}))
 			})
 
@@ -201,6 +211,7 @@ a paragraph with _italic content_`
 							},
 						},
 					},
+					OutFileSuffix: ".html",
 				}))
 			})
 
@@ -231,8 +242,36 @@ a paragraph with _italic content_`
 							},
 						},
 					},
+					OutFileSuffix: ".html",
 				}))
 			})
+			It("should include adoc file from a virtual filesystem", func() {
+				source := `include::chapters/chapter-a.adoc[]
+
+include::../shared/footer.adoc[]`
+				fsys := fstest.MapFS{
+					"book/chapters/chapter-a.adoc": &fstest.MapFile{
+						Data: []byte("== Chapter A\n\ncontent of chapter A"),
+					},
+					"shared/footer.adoc": &fstest.MapFile{
+						Data: []byte("the footer"),
+					},
+				}
+				expected := `<div class="sect1">
+<h2 id="_chapter_a">Chapter A</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>content of chapter A</p>
+</div>
+<div class="paragraph">
+<p>the footer</p>
+</div>
+</div>
+</div>`
+				Expect(RenderHTML(source,
+					configuration.WithFilename("book/index.adoc"),
+					configuration.WithFilesystem(fsys))).To(Equal(expected))
+			})
 		})
 
 		Context("complete Document ", func() {
@@ -269,6 +308,112 @@ Last updated {{.LastUpdated}}
 				Expect(err).NotTo(HaveOccurred())
 				Expect(RenderHTML5Document(filename, configuration.WithCSS("path/to/style.css"), configuration.WithHeaderFooter(true))).To(MatchHTMLTemplate(expectedContent, stat.ModTime()))
 			})
+
+			It("using existing file in a virtual filesystem", func() {
+				expectedContent := `<div class="paragraph">
+<p>content</p>
+</div>`
+				modTime := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+				fsys := fstest.MapFS{
+					"docs/index.adoc": &fstest.MapFile{
+						Data:    []byte("include::content.adoc[]"),
+						ModTime: modTime,
+					},
+					"docs/content.adoc": &fstest.MapFile{
+						Data: []byte("content"),
+					},
+				}
+				output := &strings.Builder{}
+				metadata, err := libasciidoc.ConvertFileToHTML(output, configuration.NewConfiguration(
+					configuration.WithFilename("docs/index.adoc"),
+					configuration.WithFilesystem(fsys)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.String()).To(Equal(expectedContent))
+				Expect(metadata.LastUpdated).To(Equal(modTime.Format(configuration.LastUpdatedFormat)))
+			})
+
+			It("with docinfo files in a virtual filesystem", func() {
+				expectedContent := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<style>
+` + html5.DefaultStylesheet + `</style>
+<title>Title</title>
+<meta name="description" content="Title">
+</head>
+<body class="article">
+<div id="header">
+<h1>Title</h1>
+</div>
+<div id="content">
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated 2020-05-01 10:00:00 +0000
+</div>
+</div>
+<script src="index.js"></script>
+</body>
+</html>`
+				fsys := fstest.MapFS{
+					"docs/index.adoc": &fstest.MapFile{
+						Data:    []byte("= Title\n:docinfo: shared-head,private-footer\n\ncontent"),
+						ModTime: time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC),
+					},
+					"docs/docinfo.html": &fstest.MapFile{
+						Data: []byte(`<meta name="description" content="{doctitle}">`),
+					},
+					"docs/index-docinfo-footer.html": &fstest.MapFile{
+						Data: []byte(`<script src="{docname}.js"></script>`),
+					},
+				}
+				output := &strings.Builder{}
+				_, err := libasciidoc.ConvertFileToHTML(output, configuration.NewConfiguration(
+					configuration.WithFilename("docs/index.adoc"),
+					configuration.WithFilesystem(fsys),
+					configuration.WithHeaderFooter(true)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.String()).To(Equal(expectedContent))
+			})
+
+			It("using the SOURCE_DATE_EPOCH env var instead of the file modification time", func() {
+				os.Setenv(configuration.SourceDateEpochEnv, "1584178013")
+				defer os.Unsetenv(configuration.SourceDateEpochEnv)
+				fsys := fstest.MapFS{
+					"index.adoc": &fstest.MapFile{
+						Data:    []byte("{docdate} {localdate}"),
+						ModTime: time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC),
+					},
+				}
+				output := &strings.Builder{}
+				metadata, err := libasciidoc.ConvertFileToHTML(output, configuration.NewConfiguration(
+					configuration.WithFilename("index.adoc"),
+					configuration.WithFilesystem(fsys)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.String()).To(Equal(`<div class="paragraph">
+<p>2020-03-14 2020-03-14</p>
+</div>`))
+				Expect(metadata.LastUpdated).To(Equal("2020-03-14 09:26:53 +0000"))
+			})
+
+			It("using the SOURCE_DATE_EPOCH env var when the last updated time is not set", func() {
+				os.Setenv(configuration.SourceDateEpochEnv, "1584178013")
+				defer os.Unsetenv(configuration.SourceDateEpochEnv)
+				output := &strings.Builder{}
+				metadata, err := libasciidoc.ConvertToHTML(strings.NewReader("{docdate}"), output, configuration.NewConfiguration())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.String()).To(Equal(`<div class="paragraph">
+<p>2020-03-14</p>
+</div>`))
+				Expect(metadata.LastUpdated).To(Equal("2020-03-14 09:26:53 +0000"))
+			})
 		})
 	})
 
@@ -475,3 +620,123 @@ Last updated {{.LastUpdated}}
 	})
 
 })
+
+var _ = Describe("backends", func() {
+
+	// a backend which renders the content of the paragraphs only
+	renderer.Register("test", renderer.RenderFunc(func(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+		for _, e := range doc.Elements {
+			if p, ok := e.(types.Paragraph); ok {
+				for _, l := range p.Lines {
+					for _, s := range l {
+						if s, ok := s.(types.StringElement); ok {
+							fmt.Fprint(output, s.Content)
+						}
+					}
+				}
+			}
+		}
+		return types.Metadata{}, nil
+	}), map[string]string{
+		types.AttrBaseBackend:   "test",
+		types.AttrOutFileSuffix: ".txt",
+		types.AttrFileType:      "txt",
+	})
+
+	It("should convert with the backend set in the configuration", func() {
+		output := &strings.Builder{}
+		metadata, err := libasciidoc.Convert(strings.NewReader("{backend} output in a {outfilesuffix} file"), output, configuration.NewConfiguration(configuration.WithBackend("test")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.String()).To(Equal("test output in a .txt file"))
+		Expect(metadata.OutFileSuffix).To(Equal(".txt"))
+	})
+
+	It("should convert with the backend set in the document", func() {
+		output := &strings.Builder{}
+		metadata, err := libasciidoc.Convert(strings.NewReader(":backend: test\n\n{backend} output in a {outfilesuffix} file"), output, configuration.NewConfiguration())
+		Expect(err).NotTo(HaveOccurred())
+		// the attributes of the backend set in the document are used in the substitutions
+		Expect(output.String()).To(Equal("test output in a .txt file"))
+		Expect(metadata.OutFileSuffix).To(Equal(".txt"))
+	})
+
+	It("should convert with the output file suffix set in the document", func() {
+		output := &strings.Builder{}
+		metadata, err := libasciidoc.Convert(strings.NewReader(":outfilesuffix: .htm\n\ncontent"), output, configuration.NewConfiguration())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata.OutFileSuffix).To(Equal(".htm"))
+	})
+
+	It("should convert to HTML regardless of the backend set in the document", func() {
+		output := &strings.Builder{}
+		_, err := libasciidoc.ConvertToHTML(strings.NewReader(":backend: test\n\ncontent"), output, configuration.NewConfiguration())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.String()).To(Equal(`<div class="paragraph">
+<p>content</p>
+</div>`))
+	})
+
+	It("should fail to convert with templates and a backend which does not support them", func() {
+		_, err := libasciidoc.Convert(strings.NewReader("content"), &strings.Builder{}, configuration.NewConfiguration(
+			configuration.WithBackend("docbook5"),
+			configuration.WithTemplates(fstest.MapFS{})))
+		Expect(err).To(MatchError("the 'docbook5' backend does not support user-supplied templates"))
+	})
+
+	It("should fail to convert with templates and a backend set in the document which does not support them", func() {
+		_, err := libasciidoc.Convert(strings.NewReader(":backend: test\n\ncontent"), &strings.Builder{}, configuration.NewConfiguration(
+			configuration.WithTemplates(fstest.MapFS{})))
+		Expect(err).To(MatchError("the 'test' backend does not support user-supplied templates"))
+	})
+
+	It("should fail to convert with an unknown backend", func() {
+		_, err := libasciidoc.Convert(strings.NewReader("content"), &strings.Builder{}, configuration.NewConfiguration(configuration.WithBackend("unknown")))
+		Expect(err).To(MatchError("unknown backend: 'unknown' (available backends: [docbook5 epub3 html5 latex manpage markdown test text xhtml5])"))
+	})
+})
+
+var _ = Describe("parse errors", func() {
+
+	It("should convert the document and return the errors of the malformed blocks", func() {
+		output := &strings.Builder{}
+		_, err := libasciidoc.Convert(strings.NewReader("a paragraph\n\n\xff malformed\n\nanother paragraph"), output, configuration.NewConfiguration(
+			configuration.WithFilename("test.adoc")))
+		Expect(err).To(BeAssignableToTypeOf(parser.ParseErrors{}))
+		errs := err.(parser.ParseErrors)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Position.File).To(Equal("test.adoc"))
+		Expect(errs[0].Position.Line).To(Equal(3))
+		// the malformed block is rendered as a plain paragraph, and the rest of the document is rendered, too
+		Expect(output.String()).To(Equal("<div class=\"paragraph\">\n<p>a paragraph</p>\n</div>\n" +
+			"<div class=\"paragraph\">\n<p>\xff malformed</p>\n</div>\n" +
+			"<div class=\"paragraph\">\n<p>another paragraph</p>\n</div>"))
+	})
+})
+
+var _ = Describe("json export", func() {
+
+	source := `= Title
+
+{backend} content`
+
+	It("should export the final document", func() {
+		output := &strings.Builder{}
+		err := libasciidoc.ParseToJSON(strings.NewReader(source), output, configuration.NewConfiguration())
+		Expect(err).NotTo(HaveOccurred())
+		result := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(output.String()), &result)).To(Succeed())
+		Expect(result["schemaVersion"]).To(Equal(float64(types.JSONSchemaVersion)))
+		Expect(output.String()).To(ContainSubstring(`"type": "Document"`))
+		Expect(output.String()).To(ContainSubstring(`"type": "Section"`))
+		Expect(output.String()).To(ContainSubstring(`"content": "html5 content"`))
+	})
+
+	It("should export the draft document", func() {
+		output := &strings.Builder{}
+		err := libasciidoc.ParseDraftToJSON(strings.NewReader(source), output, configuration.NewConfiguration())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.String()).To(ContainSubstring(`"type": "DraftDocument"`))
+		Expect(output.String()).To(ContainSubstring(`"type": "AttributeSubstitution"`))
+		Expect(output.String()).NotTo(ContainSubstring(`"html5 content"`))
+	})
+})
@@ -2,6 +2,11 @@ package configuration
 
 import (
 	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -9,6 +14,8 @@ import (
 func NewConfiguration(settings ...Setting) Configuration {
 	config := Configuration{
 		AttributeOverrides: make(map[string]string),
+		Filesystem:         OSFilesystem,
+		URIReadTimeout:     DefaultURIReadTimeout,
 		macros:             make(map[string]MacroTemplate),
 	}
 	for _, set := range settings {
@@ -24,6 +31,15 @@ type Configuration struct {
 	LastUpdated         time.Time
 	IncludeHeaderFooter bool
 	CSS                 string
+	Filesystem          fs.FS // the filesystem in which the document, the files to include, etc. are looked-up
+	SafeMode            SafeMode
+	URIReadTimeout      time.Duration     // the timeout when reading remote content (eg: files to include)
+	URICacheDir         string            // the directory in which remote content is cached (no cache if empty)
+	GeneratorVersion    string            // the version of the library, exposed in the `libasciidoc-version` attribute
+	OutputDir           string            // the directory of the output file, in which the stylesheets are copied (with the `copycss` attribute)
+	Backend             string            // the name of the backend to use (if empty, the `backend` attribute of the document or `html5`)
+	BackendAttributes   map[string]string // the intrinsic attributes of the backend (`backend`, `basebackend`, `outfilesuffix` and `filetype`)
+	Templates           fs.FS             // the filesystem of the user-supplied templates which override the built-in templates of the html backends (eg: `paragraph.tmpl`)
 	macros              map[string]MacroTemplate
 }
 
@@ -35,6 +51,15 @@ func (c Configuration) Clone() Configuration {
 		Filename:            c.Filename,
 		IncludeHeaderFooter: c.IncludeHeaderFooter,
 		LastUpdated:         c.LastUpdated,
+		Filesystem:          c.Filesystem,
+		SafeMode:            c.SafeMode,
+		URIReadTimeout:      c.URIReadTimeout,
+		URICacheDir:         c.URICacheDir,
+		GeneratorVersion:    c.GeneratorVersion,
+		OutputDir:           c.OutputDir,
+		Backend:             c.Backend,
+		BackendAttributes:   c.BackendAttributes,
+		Templates:           c.Templates,
 	}
 }
 
@@ -50,8 +75,50 @@ func (c Configuration) MacroTemplate(name string) (MacroTemplate, error) {
 const (
 	// LastUpdatedFormat key to the time format for the `last updated` document attribute
 	LastUpdatedFormat string = "2006-01-02 15:04:05 -0700"
+	// DefaultURIReadTimeout the default timeout when reading remote content
+	DefaultURIReadTimeout time.Duration = 30 * time.Second
 )
 
+// SafeMode the level of restrictions applied when accessing files and network resources during the processing of a document.
+// The levels are the same as in Asciidoctor
+type SafeMode int
+
+const (
+	// Unsafe the `unsafe` mode, in which no restriction applies (default)
+	Unsafe SafeMode = 0
+	// Safe the `safe` mode
+	Safe SafeMode = 1
+	// Server the `server` mode
+	Server SafeMode = 10
+	// Secure the `secure` mode, in which no network resource can be read, even if the `allow-uri-read` attribute is set
+	Secure SafeMode = 20
+)
+
+var safeModes = map[string]SafeMode{
+	"unsafe": Unsafe,
+	"safe":   Safe,
+	"server": Server,
+	"secure": Secure,
+}
+
+// ParseSafeMode returns the safe mode matching the given name (`unsafe`, `safe`, `server` or `secure`)
+func ParseSafeMode(name string) (SafeMode, error) {
+	if mode, found := safeModes[strings.ToLower(name)]; found {
+		return mode, nil
+	}
+	return Unsafe, fmt.Errorf("unknown safe mode: '%s'", name)
+}
+
+// String returns the name of the safe mode
+func (m SafeMode) String() string {
+	for name, mode := range safeModes {
+		if mode == m {
+			return name
+		}
+	}
+	return strconv.Itoa(int(m))
+}
+
 // Setting a setting to customize the configuration used during parsing and rendering of a document
 type Setting func(config *Configuration)
 
@@ -97,6 +164,69 @@ func WithFilename(filename string) Setting {
 	}
 }
 
+// WithOutputDir function to set the directory of the output file, in which the stylesheets are copied (with the `copycss` attribute)
+func WithOutputDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.OutputDir = dir
+	}
+}
+
+// WithBackend function to set the name of the backend to use (default is the `backend` attribute of the document, or `html5`)
+func WithBackend(name string) Setting {
+	return func(config *Configuration) {
+		config.Backend = name
+	}
+}
+
+// WithFilesystem function to set the `filesystem` setting in the config, i.e., the filesystem in which
+// the document and all the files it refers to (files to include, etc.) are looked-up (default is the local disk).
+// When using a custom filesystem, the `filename` setting is the path of the document in this filesystem.
+func WithFilesystem(fsys fs.FS) Setting {
+	return func(config *Configuration) {
+		config.Filesystem = fsys
+	}
+}
+
+// WithTemplates function to set the filesystem of the user-supplied templates, named after the kind of element
+// they render (eg: `paragraph.tmpl`), which override the built-in templates of the backend.
+// Only the `html5` and `xhtml5` backends (and the chapters of the `epub3` backend) support the user-supplied templates:
+// the conversion fails with the other backends
+func WithTemplates(fsys fs.FS) Setting {
+	return func(config *Configuration) {
+		config.Templates = fsys
+	}
+}
+
+// WithTemplateDir function to set the directory of the user-supplied templates (see `WithTemplates`)
+func WithTemplateDir(dir string) Setting {
+	return func(config *Configuration) {
+		if dir != "" {
+			config.Templates = os.DirFS(dir)
+		}
+	}
+}
+
+// WithSafeMode function to set the `safe mode` setting in the config (default is `Unsafe`)
+func WithSafeMode(mode SafeMode) Setting {
+	return func(config *Configuration) {
+		config.SafeMode = mode
+	}
+}
+
+// WithURIReadTimeout function to set the timeout when reading remote content (default is `DefaultURIReadTimeout`)
+func WithURIReadTimeout(timeout time.Duration) Setting {
+	return func(config *Configuration) {
+		config.URIReadTimeout = timeout
+	}
+}
+
+// WithURICacheDir function to set the directory in which the remote content is cached
+func WithURICacheDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.URICacheDir = dir
+	}
+}
+
 // WithMacroTemplate defines the given template to a user macro with the given name
 func WithMacroTemplate(name string, t MacroTemplate) Setting {
 	return func(config *Configuration) {
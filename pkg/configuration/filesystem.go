@@ -0,0 +1,79 @@
+package configuration
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// OSFilesystem the default filesystem, which gives access to the files of the local disk.
+// Contrary to the filesystems returned by `os.DirFS`, the names given to `Open` are native paths,
+// either absolute or relative to the current working directory
+var OSFilesystem fs.FS = osFilesystem{}
+
+type osFilesystem struct{}
+
+// Open opens the named file on the local disk
+func (osFilesystem) Open(name string) (fs.File, error) {
+	return os.Open(name)
+}
+
+// IsRemote returns true if the given location is an `http://` or `https://` URL,
+// i.e., if it does not refer to a file in the configured filesystem
+func IsRemote(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// Open opens the file with the given name in the configured filesystem
+// (or on the local disk if no filesystem was configured)
+func (c Configuration) Open(name string) (fs.File, error) {
+	return c.filesystem().Open(name)
+}
+
+// Stat returns the info of the file with the given name in the configured filesystem
+// (or on the local disk if no filesystem was configured)
+func (c Configuration) Stat(name string) (fs.FileInfo, error) {
+	return fs.Stat(c.filesystem(), name)
+}
+
+// ResolvePath returns the path of the given location, relative to the directory of the
+// document being processed (i.e., the `Filename` of this configuration).
+// When the document is read from the local disk, the resulting path is absolute. Otherwise, the
+// resulting path is a slash-separated path in the configured filesystem, in which absolute
+// locations are relative to the root of the filesystem.
+func (c Configuration) ResolvePath(location string) (string, error) {
+	if c.isOSFilesystem() {
+		if filepath.IsAbs(location) {
+			return location, nil
+		}
+		return filepath.Abs(filepath.Join(filepath.Dir(c.Filename), location))
+	}
+	var p string
+	if strings.HasPrefix(location, "/") {
+		p = path.Clean(location)
+	} else {
+		p = path.Join(path.Dir(filepath.ToSlash(c.Filename)), location)
+	}
+	p = strings.TrimPrefix(p, "/")
+	if p == "" {
+		p = "."
+	}
+	if !fs.ValidPath(p) {
+		return "", &fs.PathError{Op: "resolve", Path: location, Err: fs.ErrInvalid}
+	}
+	return p, nil
+}
+
+func (c Configuration) filesystem() fs.FS {
+	if c.Filesystem == nil {
+		return OSFilesystem
+	}
+	return c.Filesystem
+}
+
+func (c Configuration) isOSFilesystem() bool {
+	_, ok := c.filesystem().(osFilesystem)
+	return ok
+}
@@ -0,0 +1,37 @@
+package configuration
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SourceDateEpochEnv the environment variable which holds the time to use instead of the current time and
+// of the last modification time of the document, so that the output is reproducible
+// (see https://reproducible-builds.org/specs/source-date-epoch/)
+const SourceDateEpochEnv = "SOURCE_DATE_EPOCH"
+
+// SourceDateEpoch returns the time (in UTC) set in the `SOURCE_DATE_EPOCH` environment variable,
+// or `false` if the variable is not set or if its value is not a valid number of seconds since the Unix epoch
+func SourceDateEpoch() (time.Time, bool) {
+	value, found := os.LookupEnv(SourceDateEpochEnv)
+	if !found || value == "" {
+		return time.Time{}, false
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		log.Warnf("invalid value of '%s' environment variable: '%s'", SourceDateEpochEnv, value)
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0).UTC(), true
+}
+
+// Now returns the current time, unless the `SOURCE_DATE_EPOCH` environment variable is set
+func Now() time.Time {
+	if t, found := SourceDateEpoch(); found {
+		return t
+	}
+	return time.Now()
+}
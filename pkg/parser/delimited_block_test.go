@@ -1779,6 +1779,34 @@ type Foo struct{
 				}
 				Expect(ParseDraftDocument(source)).To(Equal(expected))
 			})
+
+			It("with language, line numbers, start and quoted highlighted lines", func() {
+				source := `[source,go,linenums,start=10,highlight="2,4..6"]
+----
+package foo
+
+// Foo
+type Foo struct{
+    Bar string
+}
+----`
+				expected := types.DraftDocument{
+					Blocks: []interface{}{
+						types.DelimitedBlock{
+							Attributes: types.Attributes{
+								types.AttrKind:      types.Source,
+								types.AttrLanguage:  "go",
+								types.AttrLineNums:  nil,
+								types.AttrStart:     "10",
+								types.AttrHighlight: "2,4..6",
+							},
+							Kind:     types.Source,
+							Elements: sourceCode,
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(Equal(expected))
+			})
 		})
 
 		Context("sidebar blocks", func() {
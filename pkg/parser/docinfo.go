@@ -0,0 +1,161 @@
+package parser
+
+import (
+	"errors"
+	"io/fs"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// loadDocInfo loads the content of the docinfo files to inject in the head and at the end of the body of the output,
+// as specified by the `docinfo` attribute (a comma-separated list of locations, `private` if empty):
+// - `shared-head` (or `shared`): the `docinfo.html` file
+// - `shared-footer` (or `shared`): the `docinfo-footer.html` file
+// - `private-head` (or `private`): the `<docname>-docinfo.html` file
+// - `private-footer` (or `private`): the `<docname>-docinfo-footer.html` file
+// The files are looked-up in the `docinfodir` directory (relatively to the document) if set, otherwise in the directory of the document.
+// The substitutions listed in the `docinfosubs` attribute are applied on the content (only `attributes` is supported, which is the default),
+// in the same way as in the document (including the `attribute-missing` attribute and its warnings, which are retained in the given context).
+// The docinfo files are not loaded in the `secure` safe mode.
+func loadDocInfo(ctx *substitutionContext, config configuration.Configuration) types.DocInfo {
+	attrs := ctx.attrs
+	docinfo, found := attrs.GetAsString(types.AttrDocInfo)
+	if !found {
+		return types.DocInfo{}
+	}
+	if config.SafeMode >= configuration.Secure {
+		log.Debugf("docinfo files are not loaded in '%s' safe mode", config.SafeMode)
+		return types.DocInfo{}
+	}
+	locations := map[string]bool{}
+	for _, l := range strings.Split(docinfo, ",") {
+		switch l = strings.TrimSpace(l); l {
+		case "": // same as `private`
+			locations["private-head"] = true
+			locations["private-footer"] = true
+		case "shared", "private":
+			locations[l+"-head"] = true
+			locations[l+"-footer"] = true
+		default:
+			locations[l] = true
+		}
+	}
+	suffix := attrs.GetAsStringWithDefault(types.AttrOutFileSuffix, ".html")
+	prefixes := map[string]string{
+		"shared": "",
+	}
+	if docname, found := attrs.GetAsString(types.AttrDocName); found {
+		prefixes["private"] = docname + "-"
+	}
+	result := types.DocInfo{}
+	for _, scope := range []string{"shared", "private"} {
+		prefix, found := prefixes[scope]
+		if !found {
+			continue
+		}
+		if locations[scope+"-head"] {
+			result.Head = join(result.Head, readDocInfo(prefix+"docinfo"+suffix, ctx, config))
+		}
+		if locations[scope+"-footer"] {
+			result.Footer = join(result.Footer, readDocInfo(prefix+"docinfo-footer"+suffix, ctx, config))
+		}
+	}
+	return result
+}
+
+// readDocInfo reads the docinfo file with the given name, and applies the `docinfosubs` substitutions on its content.
+// Returns an empty string if the file does not exist
+func readDocInfo(name string, ctx *substitutionContext, config configuration.Configuration) string {
+	attrs := ctx.attrs
+	if dir, found := attrs.GetAsString(types.AttrDocInfoDir); found && dir != "" {
+		name = path.Join(dir, name)
+	}
+	p, err := config.ResolvePath(name)
+	if err != nil {
+		log.WithError(err).Warnf("unable to resolve the path of docinfo file '%s'", name)
+		return ""
+	}
+	f, err := config.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Debugf("no docinfo file at '%s'", p)
+		return ""
+	} else if err != nil {
+		log.WithError(err).Warnf("unable to open docinfo file '%s'", p)
+		return ""
+	}
+	defer f.Close()
+	content, err := ioutil.ReadAll(f)
+	if err != nil {
+		log.WithError(err).Warnf("unable to read docinfo file '%s'", p)
+		return ""
+	}
+	result := strings.TrimRight(string(content), "\r\n")
+	for _, subs := range strings.Split(attrs.GetAsStringWithDefault(types.AttrDocInfoSubs, "attributes"), ",") {
+		if strings.TrimSpace(subs) == "attributes" {
+			if result, err = substituteAttributes(p, result, ctx); err != nil {
+				log.WithError(err).Warnf("unable to apply the substitutions on docinfo file '%s'", p)
+				return ""
+			}
+		}
+	}
+	return result
+}
+
+var attributeReference = regexp.MustCompile(`\{[\pL0-9_][\pL0-9-]*\}`)
+
+// substituteAttributes replaces the references to the attributes in the content of the given file with their value.
+// Since the content is not Asciidoc (eg: HTML), the references are not parsed with the grammar,
+// but they are substituted in the same way as in the document (eg: the lines with a reference to a missing attribute are
+// dropped if the `attribute-missing` attribute is `drop-line`)
+func substituteAttributes(filename, content string, ctx *substitutionContext) (string, error) {
+	lines := strings.Split(content, "\n")
+	result := make([]string, 0, len(lines))
+lines:
+	for i, line := range lines {
+		buf := &strings.Builder{}
+		start := 0
+		for _, ref := range attributeReference.FindAllStringIndex(line, -1) {
+			buf.WriteString(line[start:ref[0]])
+			start = ref[1]
+			r, _, err := applyAttributeSubstitutions(types.AttributeSubstitution{
+				Name: line[ref[0]+1 : ref[1]-1],
+				Position: types.Position{
+					File:      filename,
+					Line:      i + 1,
+					Column:    utf8.RuneCountInString(line[:ref[0]]) + 1,
+					EndLine:   i + 1,
+					EndColumn: utf8.RuneCountInString(line[:ref[1]]) + 1,
+				},
+			}, ctx)
+			if err != nil {
+				return "", err
+			}
+			switch r := r.(type) {
+			case droppedLine:
+				continue lines
+			case types.StringElement:
+				buf.WriteString(r.Content)
+			}
+		}
+		buf.WriteString(line[start:])
+		result = append(result, buf.String())
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+func join(content, addition string) string {
+	if content == "" {
+		return addition
+	}
+	if addition == "" {
+		return content
+	}
+	return content + "\n" + addition
+}
@@ -0,0 +1,129 @@
+package parser_test
+
+import (
+	"strings"
+	"testing/fstest"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("docinfo", func() {
+
+	fsys := fstest.MapFS{
+		"docs/docinfo.html": &fstest.MapFile{
+			Data: []byte("<meta name=\"description\" content=\"{description}\">\n"),
+		},
+		"docs/docinfo-footer.html": &fstest.MapFile{
+			Data: []byte("<script src=\"shared.js\"></script>\n"),
+		},
+		"docs/index-docinfo.html": &fstest.MapFile{
+			Data: []byte("<meta name=\"keywords\" content=\"{docname}\">\n"),
+		},
+		"docs/index-docinfo-footer.html": &fstest.MapFile{
+			Data: []byte("<script src=\"{docname}.js\"></script>\n"),
+		},
+		"docs/meta/docinfo.html": &fstest.MapFile{
+			Data: []byte("<meta name=\"robots\" content=\"{unknown}\">\n"),
+		},
+	}
+
+	parse := func(source string, settings ...configuration.Setting) types.DocInfo {
+		fsys["docs/index.adoc"] = &fstest.MapFile{
+			Data: []byte(source),
+		}
+		doc, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(append([]configuration.Setting{
+			configuration.WithFilename("docs/index.adoc"),
+			configuration.WithFilesystem(fsys),
+		}, settings...)...))
+		Expect(err).ToNot(HaveOccurred())
+		return doc.DocInfo
+	}
+
+	It("should not load any docinfo file by default", func() {
+		Expect(parse("content")).To(Equal(types.DocInfo{}))
+	})
+
+	It("should load the private docinfo files by default", func() {
+		source := `:docinfo:
+
+content`
+		Expect(parse(source)).To(Equal(types.DocInfo{
+			Head:   `<meta name="keywords" content="index">`,
+			Footer: `<script src="index.js"></script>`,
+		}))
+	})
+
+	It("should load the shared docinfo files followed by the private footer", func() {
+		source := `:description: a description
+:docinfo: shared,private-footer
+
+content`
+		Expect(parse(source)).To(Equal(types.DocInfo{
+			Head:   `<meta name="description" content="a description">`,
+			Footer: "<script src=\"shared.js\"></script>\n<script src=\"index.js\"></script>",
+		}))
+	})
+
+	It("should load the docinfo files from the docinfodir without substitutions", func() {
+		source := `:docinfo: shared-head
+:docinfodir: meta
+:docinfosubs: none
+
+content`
+		Expect(parse(source)).To(Equal(types.DocInfo{
+			Head: `<meta name="robots" content="{unknown}">`,
+		}))
+	})
+
+	It("should drop the line with a reference to a missing attribute", func() {
+		source := `:docinfo: shared-head
+:docinfodir: meta
+:attribute-missing: drop-line
+
+content`
+		Expect(parse(source)).To(Equal(types.DocInfo{}))
+	})
+
+	It("should warn about the reference to a missing attribute", func() {
+		source := `:docinfo: shared-head
+:docinfodir: meta
+:attribute-missing: warn
+
+content`
+		fsys["docs/index.adoc"] = &fstest.MapFile{
+			Data: []byte(source),
+		}
+		doc, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+			configuration.WithFilename("docs/index.adoc"),
+			configuration.WithFilesystem(fsys),
+		))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(doc.DocInfo).To(Equal(types.DocInfo{
+			Head: `<meta name="robots" content="{unknown}">`,
+		}))
+		Expect(doc.Warnings).To(Equal([]types.ProcessingWarning{
+			{
+				Message: "unable to find attribute 'unknown'",
+				Position: types.Position{
+					File:      "docs/meta/docinfo.html",
+					Line:      1,
+					Column:    30,
+					EndLine:   1,
+					EndColumn: 39,
+				},
+			},
+		}))
+	})
+
+	It("should not load the docinfo files in secure mode", func() {
+		source := `:docinfo: shared,private
+
+content`
+		Expect(parse(source, configuration.WithSafeMode(configuration.Secure))).To(Equal(types.DocInfo{}))
+	})
+})
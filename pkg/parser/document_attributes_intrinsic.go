@@ -0,0 +1,85 @@
+package parser
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// intrinsicAttributes returns the intrinsic document attributes, which are computed from the configuration:
+// the document file (`docname`, `docfile`, `docdir`, etc.), its last modification time (`docdate`, `doctime`, etc.),
+// the time of the conversion (`localdate`, `localtime`, etc., which honor the `SOURCE_DATE_EPOCH` env var), the backend (`backend`, `outfilesuffix`, etc.,
+// which are those of the `html5` backend unless other ones are set in the configuration)
+// and the version of the library.
+// In `server` (or higher) safe mode, the `docdir` is empty and the `docfile` is reduced to the file name,
+// so that the paths on the server are not revealed in the output.
+func intrinsicAttributes(config configuration.Configuration) map[string]string {
+	result := map[string]string{
+		types.AttrBackend:       "html5",
+		types.AttrBaseBackend:   "html",
+		types.AttrOutFileSuffix: ".html",
+		types.AttrFileType:      "html",
+	}
+	for k, v := range config.BackendAttributes {
+		result[k] = v
+	}
+	if config.GeneratorVersion != "" {
+		result[types.AttrGeneratorVersion] = config.GeneratorVersion
+	}
+	// document file
+	if config.Filename != "" {
+		docfile, err := config.ResolvePath(filepath.Base(config.Filename))
+		if err != nil {
+			log.Debugf("unable to resolve the path of '%s': %v", config.Filename, err)
+			docfile = config.Filename
+		}
+		base := filepath.Base(docfile)
+		ext := filepath.Ext(base)
+		result[types.AttrDocName] = strings.TrimSuffix(base, ext)
+		result[types.AttrDocFileSuffix] = ext
+		if config.SafeMode >= configuration.Server {
+			result[types.AttrDocFile] = base
+			result[types.AttrDocDir] = ""
+		} else {
+			result[types.AttrDocFile] = docfile
+			result[types.AttrDocDir] = filepath.Dir(docfile)
+		}
+	}
+	// dates and times
+	now := configuration.Now()
+	docTime := config.LastUpdated
+	if docTime.IsZero() {
+		docTime = now
+	}
+	for prefix, t := range map[string]time.Time{
+		"doc":   docTime,
+		"local": now,
+	} {
+		result[prefix+"date"] = t.Format("2006-01-02")
+		result[prefix+"time"] = t.Format("15:04:05 -0700")
+		result[prefix+"year"] = t.Format("2006")
+		result[prefix+"datetime"] = t.Format("2006-01-02 15:04:05 -0700")
+	}
+	return result
+}
+
+// plainText returns the plain text of the given elements (eg: the title of the document),
+// without any formatting
+func plainText(elements []interface{}) string {
+	result := &strings.Builder{}
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.QuotedText:
+			result.WriteString(plainText(e.Elements))
+		case types.AttributeSubstitution:
+			result.WriteString(e.String())
+		}
+	}
+	return result.String()
+}
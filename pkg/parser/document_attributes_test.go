@@ -1,7 +1,12 @@
 package parser_test
 
 import (
+	"strings"
+	"testing/fstest"
+	"time"
+
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -1121,13 +1126,20 @@ a paragraph`
 a paragraph`
 				expected := types.Document{
 					Attributes: types.Attributes{
-						"toc":        "",
-						"date":       "2017-01-01",
-						"author":     "Xavier",
-						"hardbreaks": "",
+						"toc":  "",
+						"date": "2017-01-01",
+						// `author` and `hardbreaks` are declared in the document body
 					},
 					Elements: []interface{}{
 						types.TableOfContentsPlaceHolder{},
+						// applied in order when rendering
+						types.AttributeDeclaration{
+							Name:  "author",
+							Value: "Xavier",
+						},
+						types.AttributeDeclaration{
+							Name: "hardbreaks",
+						},
 						types.Paragraph{
 							Lines: [][]interface{}{
 								{
@@ -1147,13 +1159,8 @@ a paragraph`
 :date: 2017-01-01
 :author: Xavier`
 				expected := types.Document{
-					Attributes: types.Attributes{
-						"toc":    "",
-						"date":   "2017-01-01",
-						"author": "Xavier",
-					},
+					// attributes declared in the document body are not document attributes
 					Elements: []interface{}{
-						types.TableOfContentsPlaceHolder{},
 						types.Paragraph{
 							Lines: [][]interface{}{
 								{
@@ -1161,6 +1168,17 @@ a paragraph`
 								},
 							},
 						},
+						types.AttributeDeclaration{
+							Name: "toc",
+						},
+						types.AttributeDeclaration{
+							Name:  "date",
+							Value: "2017-01-01",
+						},
+						types.AttributeDeclaration{
+							Name:  "author",
+							Value: "Xavier",
+						},
 					},
 				}
 				Expect(ParseDocument(source)).To(MatchDocument(expected))
@@ -1201,6 +1219,12 @@ a paragraph written by {author}.`
 						"author": "Xavier",
 					},
 					Elements: []interface{}{
+						types.AttributeReset{
+							Name: "author1",
+						},
+						types.AttributeReset{
+							Name: "author2",
+						},
 						types.Paragraph{
 							Lines: [][]interface{}{
 								{
@@ -1213,6 +1237,131 @@ a paragraph written by {author}.`
 				Expect(ParseDocument(source)).To(MatchDocument(expected))
 			})
 
+			It("paragraphs with attribute redefined in the document body", func() {
+				source := `:product: A
+
+{product} paragraph
+
+:product: B
+
+{product} paragraph`
+				expected := types.Document{
+					Attributes: types.Attributes{
+						"product": "A",
+					},
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "A paragraph"},
+								},
+							},
+						},
+						types.AttributeDeclaration{
+							Name:  "product",
+							Value: "B",
+						},
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "B paragraph"},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("paragraphs with attribute reset in the document body", func() {
+				source := `:product: A
+
+{product} paragraph
+
+:product!:
+
+{product} paragraph`
+				expected := types.Document{
+					Attributes: types.Attributes{
+						"product": "A",
+					},
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "A paragraph"},
+								},
+							},
+						},
+						types.AttributeReset{
+							Name: "product",
+						},
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "{product} paragraph"},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("labeled list term and table cell with attribute redefined in the document body", func() {
+				source := `:product: A
+
+{product}:: description
+
+:product: B
+
+|===
+| {product}
+|===`
+				expected := types.Document{
+					Attributes: types.Attributes{
+						"product": "A",
+					},
+					Elements: []interface{}{
+						types.LabeledList{
+							Items: []types.LabeledListItem{
+								{
+									Level: 1,
+									Term: []interface{}{
+										types.StringElement{Content: "A"},
+									},
+									Elements: []interface{}{
+										types.Paragraph{
+											Lines: [][]interface{}{
+												{
+													types.StringElement{Content: "description"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						types.AttributeDeclaration{
+							Name:  "product",
+							Value: "B",
+						},
+						types.Table{
+							Lines: []types.TableLine{
+								{
+									Cells: [][]interface{}{
+										{
+											types.StringElement{Content: "B"},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
 			It("header with 2 authors, revision and attributes", func() {
 				source := `= Document Title
 John Foo Doe <johndoe@example.com>; Jane the_Doe <jane@example.com>
@@ -1389,5 +1538,332 @@ a paragraph written by {author}.`
 			}
 			Expect(ParseDocument(source, configuration.WithAttributes(attrs))).To(Equal(expected))
 		})
+
+		It("hard set attributes cannot be redefined or unset in the document", func() {
+			attrs := map[string]string{
+				"product": "api",
+				"version": "1.0",
+			}
+			source := `:product: doc
+:version!:
+
+{product} {version}`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"product": "api",
+					"version": "1.0",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "api 1.0"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithAttributes(attrs))).To(MatchDocument(expected))
+		})
+
+		It("soft set attributes can be redefined or unset in the document", func() {
+			attrs := map[string]string{
+				"product": "api@",
+				"version": "1.0@",
+				"vendor":  "acme@",
+			}
+			source := `:product: doc
+:version!:
+
+{product} {version} {vendor}`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"product": "doc",
+					"vendor":  "acme",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "doc {version} acme"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithAttributes(attrs))).To(MatchDocument(expected))
+		})
+
+		It("hard unset attributes cannot be set in the document, unlike soft unset attributes", func() {
+			attrs := map[string]string{
+				"!product": "",
+				"!version": "@",
+			}
+			source := `:product: doc
+:version: 2.0
+
+{product} {version}`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"version": "2.0",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "{product} 2.0"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithAttributes(attrs))).To(MatchDocument(expected))
+		})
+	})
+
+	Context("missing and undefined attributes", func() {
+
+		It("should drop the reference to a missing attribute", func() {
+			source := `:attribute-missing: drop
+
+a {typo} reference`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					types.AttrAttributeMissing: types.AttributeMissingDrop,
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "a  reference"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("should drop the line containing a reference to a missing attribute", func() {
+			source := `:attribute-missing: drop-line
+
+a first line
+a {typo} reference in *a {typo} quoted text*
+a last line`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					types.AttrAttributeMissing: types.AttributeMissingDropLine,
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "a first line"},
+							},
+							{
+								types.StringElement{Content: "a last line"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("should report a warning for each reference to a missing attribute", func() {
+			source := `a {typo} reference
+
+another {typo}`
+			doc, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+				configuration.WithAttributes(map[string]string{
+					types.AttrAttributeMissing: types.AttributeMissingWarn,
+				}),
+			))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Warnings).To(Equal([]types.ProcessingWarning{
+				{
+					Message: "unable to find attribute 'typo'",
+					Position: types.Position{
+						Line:      1,
+						Column:    3,
+						EndLine:   1,
+						EndColumn: 9,
+					},
+				},
+				{
+					Message: "unable to find attribute 'typo'",
+					Position: types.Position{
+						Line:      3,
+						Column:    9,
+						EndLine:   3,
+						EndColumn: 15,
+					},
+				},
+			}))
+			// references are left as-is
+			Expect(WithoutPositions(doc.Elements)).To(Equal([]interface{}{
+				types.Paragraph{
+					Lines: [][]interface{}{
+						{
+							types.StringElement{Content: "a {typo} reference"},
+						},
+					},
+				},
+				types.Paragraph{
+					Lines: [][]interface{}{
+						{
+							types.StringElement{Content: "another {typo}"},
+						},
+					},
+				},
+			}))
+		})
+
+		It("should set and unset attributes with inline attribute entries", func() {
+			source := `{set:product:libasciidoc}{product} and {set:version}{version}
+{set:product!}{product}
+{product}`
+			expected := types.Document{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "libasciidoc and "},
+							},
+							{
+								types.StringElement{Content: "{product}"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("should drop the inline attribute entry which unsets an attribute", func() {
+			source := `:attribute-undefined: drop
+
+{set:product:libasciidoc}{product}
+{set:product!}{product}`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					types.AttrAttributeUndefined: types.AttributeUndefinedDrop,
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "libasciidoc"},
+							},
+							{
+								types.StringElement{Content: "{product}"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+	})
+
+	Context("intrinsic attributes", func() {
+
+		parse := func(source string, settings ...configuration.Setting) (types.Document, error) {
+			fsys := fstest.MapFS{
+				"docs/index.adoc": &fstest.MapFile{
+					Data: []byte(source),
+				},
+			}
+			return ParseDocument(source, append([]configuration.Setting{
+				configuration.WithFilename("docs/index.adoc"),
+				configuration.WithFilesystem(fsys),
+				configuration.WithLastUpdated(time.Date(2020, time.March, 14, 9, 26, 53, 0, time.UTC)),
+			}, settings...)...)
+		}
+
+		It("should substitute the intrinsic attributes without reporting them in the document attributes", func() {
+			source := `:lang: en
+
+{docname} {docfile} {docdir} {docfilesuffix}
+{docdate} {doctime} {docyear}
+{backend} {basebackend} {outfilesuffix} {filetype}`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"lang": "en",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "index docs/index.adoc docs .adoc"},
+							},
+							{
+								types.StringElement{Content: "2020-03-14 09:26:53 +0000 2020"},
+							},
+							{
+								types.StringElement{Content: "html5 html .html html"},
+							},
+						},
+					},
+				},
+			}
+			Expect(parse(source)).To(MatchDocument(expected))
+		})
+
+		It("should substitute the document title", func() {
+			source := `= The _Document_ Title
+
+{doctitle}`
+			doc, err := parse(source)
+			Expect(err).ToNot(HaveOccurred())
+			header, found := doc.Header()
+			Expect(found).To(BeTrue())
+			Expect(header.Elements).To(Equal([]interface{}{
+				types.Paragraph{
+					Lines: [][]interface{}{
+						{
+							types.StringElement{Content: "The Document Title"},
+						},
+					},
+				},
+			}))
+		})
+
+		It("should not reveal the paths in server safe mode", func() {
+			source := `{docname} {docfile} [{docdir}]`
+			expected := types.Document{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "index index.adoc []"},
+							},
+						},
+					},
+				},
+			}
+			Expect(parse(source, configuration.WithSafeMode(configuration.Server))).To(MatchDocument(expected))
+		})
+
+		It("should let the document redefine an intrinsic attribute", func() {
+			source := `:docname: custom
+
+{docname}`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"docname": "custom",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "custom"},
+							},
+						},
+					},
+				},
+			}
+			Expect(parse(source)).To(MatchDocument(expected))
+		})
 	})
 })
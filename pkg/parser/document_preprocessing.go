@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -18,24 +19,31 @@ type ContextKey string
 // LevelOffset the key for the level offset of the file to include
 const LevelOffset ContextKey = "leveloffset"
 
-// ParseDraftDocument parses a document's content and applies the preprocessing directives (file inclusions)
+// ParseDraftDocument parses a document's content and applies the preprocessing directives (file inclusions).
+// If the document or one of its included files contains malformed blocks, the draft document is returned
+// along with a `ParseErrors` error
 func ParseDraftDocument(r io.Reader, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
 	options = append(options, Entrypoint("AsciidocDocument"))
-	return parseDraftDocument(r, []levelOffset{}, config, options...)
+	return parseDraftDocument(r, fileSourceMap{file: config.Filename}, []levelOffset{}, intrinsicAttributes(config), config, options...)
 }
 
-func parseDraftDocument(r io.Reader, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
+// parseDraftDocument parses the content of the document or of a file to include, in which the intrinsic attributes
+// (`docdir`, `docname`, etc.) are those of the root document
+func parseDraftDocument(r io.Reader, sm sourceMap, levelOffsets []levelOffset, intrinsic map[string]string, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
 	log.Debugf("parsing draft document '%s'", config.Filename)
-	d, err := ParseReader(config.Filename, r, options...)
+	data, err := ioutil.ReadAll(r)
 	if err != nil {
 		return types.DraftDocument{}, err
 	}
-	doc := d.(types.DraftDocument)
-	attrs := types.AttributesWithOverrides{
-		Content:   map[string]interface{}{},
-		Overrides: map[string]string{},
+	// malformed blocks are logged and retained as plain paragraphs, so that the rest of the document can be processed
+	doc, errs, err := parseWithRecovery(config.Filename, data, sm, options...)
+	if err != nil {
+		return types.DraftDocument{}, err
 	}
-	doc.Blocks, err = processFileInclusions(doc.Blocks, attrs, levelOffsets, config, options...)
+	attrs := types.NewAttributesWithOverrides(config.AttributeOverrides)
+	attrs.Intrinsic = intrinsic
+	blocks, err := processFileInclusions(doc.Blocks, attrs, levelOffsets, config, options...)
+	inclErrs, err := recoveredErrors(err)
 	if err != nil {
 		return types.DraftDocument{
 			Blocks: []interface{}{
@@ -51,17 +59,23 @@ func parseDraftDocument(r io.Reader, levelOffsets []levelOffset, config configur
 			},
 		}, nil
 	}
+	doc.Blocks = blocks
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debug("draft document:")
 		spew.Dump(doc)
 	}
+	if errs = append(errs, inclErrs...); len(errs) > 0 {
+		return doc, ParseErrors(errs)
+	}
 	return doc, nil
 }
 
 // processFileInclusions resolves the file inclusions if any is found in the given elements
-// and applies level offset on sections when needed
+// and applies level offset on sections when needed.
+// Returns a `ParseErrors` error along with the elements if the included files contain malformed blocks
 func processFileInclusions(elements []interface{}, attrs types.AttributesWithOverrides, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) ([]interface{}, error) {
 	result := []interface{}{}
+	errs := ParseErrors{}
 	log.Debugf("processing file inclusions found in %d element(s)", len(elements))
 	for _, e := range elements {
 		switch e := e.(type) {
@@ -71,8 +85,10 @@ func processFileInclusions(elements []interface{}, attrs types.AttributesWithOve
 		case types.FileInclusion:
 			// read the file and include its content
 			embedded, err := parseFileToInclude(e, attrs, levelOffsets, config, options...)
+			inclErrs, err := recoveredErrors(err)
+			errs = append(errs, inclErrs...)
 			if errr, ok := err.(FileInclusionError); ok {
-				log.Errorf("failed to include content of '%s' in '%s'", e.Location, errr.Filename)
+				log.WithFields(e.Position.Fields()).Errorf("failed to include content of '%s' in '%s'", e.Location, errr.Filename)
 				return nil, err
 			} else if err != nil {
 				return nil, err
@@ -82,6 +98,8 @@ func processFileInclusions(elements []interface{}, attrs types.AttributesWithOve
 			elmts, err := processFileInclusions(e.Elements, attrs, levelOffsets, config,
 				// use a new var to avoid overridding the current one which needs to stay as-is for the rest of the doc parsing
 				append(options, Entrypoint("VerbatimDocument"))...)
+			inclErrs, err := recoveredErrors(err)
+			errs = append(errs, inclErrs...)
 			if err != nil {
 				// do not fail but retain the error message
 				elmts = []interface{}{
@@ -99,11 +117,8 @@ func processFileInclusions(elements []interface{}, attrs types.AttributesWithOve
 				e.Attributes = types.Attributes{}
 			}
 			e.Attributes.Add(extraAttrs)
-			result = append(result, types.DelimitedBlock{
-				Attributes: e.Attributes,
-				Kind:       e.Kind,
-				Elements:   elmts,
-			})
+			e.Elements = elmts
+			result = append(result, e)
 		case types.Section:
 			for _, offset := range levelOffsets {
 				oldLevel := e.Level
@@ -121,6 +136,9 @@ func processFileInclusions(elements []interface{}, attrs types.AttributesWithOve
 			result = append(result, e)
 		}
 	}
+	if len(errs) > 0 {
+		return result, errs
+	}
 	return result, nil
 }
 
@@ -147,9 +165,14 @@ func parseDelimitedBlockElements(filename string, elements []interface{}, option
 	if err != nil {
 		return nil, nil, err
 	}
-	e, err := ParseReader(filename, verbatim, options...)
+	// retain the position of the lines in their source document (which may be an included file)
+	lines := make(linesSourceMap, len(elements))
+	for i, e := range elements {
+		lines[i] = types.PositionOf(e)
+	}
+	e, err := Parse(filename, verbatim, append(options, withSourceMap(lines))...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, newParseError(err, verbatim, lines, options...)
 	}
 	if result, ok := e.([]interface{}); ok {
 		return types.Attributes{}, result, nil
@@ -184,7 +207,7 @@ func parseMarkdownQuoteBlockAttribution(filename string, elements []interface{})
 	return ""
 }
 
-func serialize(elements []interface{}) (io.Reader, error) {
+func serialize(elements []interface{}) ([]byte, error) {
 	buf := bytes.NewBuffer(nil)
 	for _, e := range elements {
 		if r, ok := e.(types.VerbatimLine); ok {
@@ -199,5 +222,5 @@ func serialize(elements []interface{}) (io.Reader, error) {
 		}
 	}
 	log.Debugf("verbatim content: '%s'", buf.String())
-	return buf, nil
+	return buf.Bytes(), nil
 }
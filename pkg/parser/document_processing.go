@@ -9,23 +9,36 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-// ParseDocument parses the content of the reader identitied by the filename
+// ParseDocument parses the content of the reader identitied by the filename.
+// If the document or one of its included files contains malformed blocks, the document is returned
+// along with a `ParseErrors` error
 func ParseDocument(r io.Reader, config configuration.Configuration) (types.Document, error) {
 	draftDoc, err := ParseDraftDocument(r, config)
+	// malformed blocks were retained as plain paragraphs, and their errors are returned along with the document
+	parseErrs, err := recoveredErrors(err)
 	if err != nil {
 		return types.Document{}, err
 	}
-	attrs := types.AttributesWithOverrides{
-		Content:   types.Attributes{},
-		Overrides: config.AttributeOverrides,
+	// the document attributes are the front-matter key/values and the attributes declared in the document header,
+	// along with the overrides (the attributes which were hard-set or hard-unset by the overrides cannot be changed)
+	docAttrs := types.NewAttributesWithOverrides(config.AttributeOverrides)
+	docAttrs.Add(draftDoc.FrontMatter.Content)
+	headerLen := draftDoc.ApplyHeaderAttributes(docAttrs)
+	// the intrinsic attributes are available in the substitutions, but they are not reported in the document attributes
+	intrinsic := intrinsicAttributes(config)
+	if header, found := draftDoc.Header(); found {
+		intrinsic[types.AttrDocTitle] = plainText(header.Title)
 	}
-	// also, add all front-matter key/values
-	attrs.Add(draftDoc.FrontMatter.Content)
-	// also, add all AttributeDeclaration at the top of the document
-	attrs.Add(draftDoc.Attributes())
 
-	// apply document attribute substitutions and re-parse paragraphs that were affected
-	blocks, _, err := applyAttributeSubstitutions(draftDoc.Blocks, attrs)
+	// apply document attribute substitutions and re-parse paragraphs that were affected.
+	// The attribute declarations and resets in the document body are applied in the order in which they appear,
+	// so the substitutions use a separate set of attributes, which starts with the document attributes
+	ctx := newSubstitutionContext(types.AttributesWithOverrides{
+		Content:   docAttrs.All(),
+		Overrides: config.AttributeOverrides,
+		Intrinsic: intrinsic,
+	})
+	blocks, _, err := applyAttributeSubstitutions(withoutHeaderAttributes(draftDoc.Blocks, headerLen), ctx)
 	if err != nil {
 		return types.Document{}, err
 	}
@@ -43,10 +56,19 @@ func ParseDocument(r io.Reader, config configuration.Configuration) (types.Docum
 	doc := rearrangeSections(blocks.([]interface{}))
 	// also, set the footnotes
 	doc.Footnotes = footnotes
+	// also, load the docinfo files, on which the substitutions are applied with the document attributes
+	docInfoCtx := newSubstitutionContext(types.AttributesWithOverrides{
+		Content:   docAttrs.All(),
+		Overrides: config.AttributeOverrides,
+		Intrinsic: intrinsic,
+	})
+	doc.DocInfo = loadDocInfo(docInfoCtx, config)
+	// and the warnings raised while applying the substitutions
+	doc.Warnings = append(ctx.warnings, docInfoCtx.warnings...)
 	// insert the preamble at the right location
 	doc = includePreamble(doc)
-	// and add all remaining attributes, too
-	extraAttrs := attrs.All()
+	// and add the document attributes, too
+	extraAttrs := docAttrs.All()
 	if doc.Attributes == nil && len(extraAttrs) > 0 {
 		doc.Attributes = types.Attributes{}
 	}
@@ -58,5 +80,26 @@ func ParseDocument(r io.Reader, config configuration.Configuration) (types.Docum
 		log.Debug("final document:")
 		spew.Dump(doc)
 	}
+	if len(parseErrs) > 0 {
+		return doc, parseErrs
+	}
 	return doc, nil
 }
+
+// withoutHeaderAttributes returns the given blocks without the attribute declarations and resets of the header
+// (ie, in the first `headerLen` blocks), which are reported in the document attributes.
+// The attribute declarations and resets of the document body are retained, so that the renderers apply them
+// in the order in which they appear in the document (eg: `:icons: font` after the preamble)
+func withoutHeaderAttributes(blocks []interface{}, headerLen int) []interface{} {
+	result := make([]interface{}, 0, len(blocks))
+	for i, b := range blocks {
+		switch b.(type) {
+		case types.AttributeDeclaration, types.AttributeReset:
+			if i < headerLen {
+				continue
+			}
+		}
+		result = append(result, b)
+	}
+	return result
+}
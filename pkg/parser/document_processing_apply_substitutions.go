@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -8,24 +9,69 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// substitutionContext the context in which the document attribute substitutions are applied:
+// the attributes, as they are resolved while processing the blocks, and the warnings to report to the caller
+type substitutionContext struct {
+	attrs    types.AttributesWithOverrides
+	warnings []types.ProcessingWarning
+}
+
+func newSubstitutionContext(attrs types.AttributesWithOverrides) *substitutionContext {
+	return &substitutionContext{
+		attrs: attrs,
+	}
+}
+
+// droppedLine a marker for a line that must be dropped, because it contains a reference to a missing attribute
+// (with `attribute-missing=drop-line`) or an inline attribute entry which unsets an attribute (with `attribute-undefined=drop-line`)
+type droppedLine struct{}
+
+func isDroppedLine(line []interface{}) bool {
+	if len(line) != 1 {
+		return false
+	}
+	_, ok := line[0].(droppedLine)
+	return ok
+}
+
+// withoutDroppedLine returns an empty line if the given line must be dropped
+// (used for the section titles, labeled list terms and table cells, which cannot be removed)
+func withoutDroppedLine(line []interface{}) []interface{} {
+	if isDroppedLine(line) {
+		return []interface{}{}
+	}
+	return line
+}
+
 // applyAttributeSubstitutions(elements applies the document attribute substitutions
 // and re-parse the paragraphs that were affected
 // nolint: gocyclo
-func applyAttributeSubstitutions(element interface{}, attrs types.AttributesWithOverrides) (interface{}, bool, error) {
+func applyAttributeSubstitutions(element interface{}, ctx *substitutionContext) (interface{}, bool, error) {
 	// the document attributes, as they are resolved while processing the blocks
 	// log.Debugf("applying document substitutions on block of type %T", element)
 	switch e := element.(type) {
 	case []interface{}:
 		elements := make([]interface{}, 0, len(e)) // maximum capacity cannot exceed initial input
 		applied := false
+		dropLine := false
 		for _, element := range e {
-			r, a, err := applyAttributeSubstitutions(element, attrs)
+			r, a, err := applyAttributeSubstitutions(element, ctx)
 			if err != nil {
 				return []interface{}{}, false, err
 			}
-			elements = append(elements, r)
+			switch r.(type) {
+			case nil: // element was dropped
+			case droppedLine:
+				dropLine = true
+			default:
+				elements = append(elements, r)
+			}
 			applied = applied || a
 		}
+		if dropLine {
+			// the whole line will be dropped by the enclosing paragraph
+			return []interface{}{droppedLine{}}, true, nil
+		}
 		elements = types.Merge(elements)
 		if applied {
 			elements, err := parseInlineLinks(elements)
@@ -33,95 +79,180 @@ func applyAttributeSubstitutions(element interface{}, attrs types.AttributesWith
 		}
 		return elements, false, nil
 	case types.AttributeDeclaration:
-		attrs.Set(e.Name, e.Value)
+		if ctx.attrs.Locked(e.Name) {
+			// the declaration has no effect, neither in the substitutions nor in the rendering
+			return nil, false, nil
+		}
+		ctx.attrs.Set(e.Name, e.Value)
 		return e, false, nil
 	case types.AttributeReset:
-		attrs.Delete(e.Name)
+		if ctx.attrs.Locked(e.Name) {
+			return nil, false, nil
+		}
+		ctx.attrs.Delete(e.Name)
 		return e, false, nil
+	case types.InlineAttributeEntry:
+		if !e.Unset {
+			ctx.attrs.Set(e.Name, e.Value)
+			return nil, true, nil
+		}
+		ctx.attrs.Delete(e.Name)
+		if ctx.attrs.GetAsStringWithDefault(types.AttrAttributeUndefined, types.AttributeUndefinedDropLine) == types.AttributeUndefinedDrop {
+			return nil, true, nil
+		}
+		return droppedLine{}, true, nil
 	case types.AttributeSubstitution:
-		if value, ok := attrs.GetAsString(e.Name); ok {
+		if value, ok := ctx.attrs.GetAsString(e.Name); ok {
 			return types.StringElement{
 				Content: value,
 			}, true, nil
 		}
-		log.Warnf("unable to find attribute '%s'", e.Name)
+		switch ctx.attrs.GetAsStringWithDefault(types.AttrAttributeMissing, types.AttributeMissingSkip) {
+		case types.AttributeMissingDrop:
+			log.WithFields(e.Position.Fields()).Debugf("dropping reference to missing attribute '%s'", e.Name)
+			return nil, true, nil
+		case types.AttributeMissingDropLine:
+			log.WithFields(e.Position.Fields()).Debugf("dropping line with reference to missing attribute '%s'", e.Name)
+			return droppedLine{}, true, nil
+		case types.AttributeMissingWarn:
+			log.WithFields(e.Position.Fields()).Warnf("unable to find attribute '%s'", e.Name)
+			ctx.warnings = append(ctx.warnings, types.ProcessingWarning{
+				Message:  fmt.Sprintf("unable to find attribute '%s'", e.Name),
+				Position: e.Position,
+			})
+		default:
+			log.WithFields(e.Position.Fields()).Debugf("unable to find attribute '%s'", e.Name)
+		}
 		return types.StringElement{
 			Content: "{" + e.Name + "}",
 		}, false, nil
 	case types.ImageBlock:
-		return e.ResolveLocation(attrs), false, nil
+		return e.ResolveLocation(ctx.attrs), false, nil
 	case types.InlineImage:
-		return e.ResolveLocation(attrs), false, nil
+		return e.ResolveLocation(ctx.attrs), false, nil
 	case types.ExternalCrossReference:
-		return e.ResolveLocation(attrs), false, nil
+		return e.ResolveLocation(ctx.attrs), false, nil
 	case types.Section:
-		title, applied, err := applyAttributeSubstitutions(e.Title, attrs)
+		title, applied, err := applyAttributeSubstitutions(e.Title, ctx)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		if title, ok := title.([]interface{}); ok {
-			e.Title = title
+			e.Title = withoutDroppedLine(title)
 		}
-		e, err = e.ResolveID(attrs)
+		e, err = e.ResolveID(ctx.attrs)
 		return e, applied, err
 	case types.OrderedListItem:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, ctx)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.UnorderedListItem:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, ctx)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.LabeledListItem:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		termApplied := false
+		// the term is parsed here (instead of when the lists are rearranged), since it may contain attribute substitutions
+		if len(e.Term) == 1 {
+			if term, ok := e.Term[0].(types.StringElement); ok {
+				var err error
+				if e.Term, err = parseLabeledListItemTerm(term.Content); err != nil {
+					return struct{}{}, false, err
+				}
+			}
+		}
+		if len(e.Term) > 0 {
+			term, a, err := applyAttributeSubstitutions(e.Term, ctx)
+			if err != nil {
+				return struct{}{}, false, err
+			}
+			e.Term = withoutDroppedLine(term.([]interface{}))
+			termApplied = a
+		}
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, ctx)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
-		return e, applied, nil
+		return e, termApplied || applied, nil
 	case types.QuotedText:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, ctx)
 		if err != nil {
 			return struct{}{}, false, err
 		}
+		if isDroppedLine(elements.([]interface{})) {
+			return droppedLine{}, true, nil
+		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.ContinuedListItemElement:
-		element, applied, err := applyAttributeSubstitutions(e.Element, attrs)
+		element, applied, err := applyAttributeSubstitutions(e.Element, ctx)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Element = element
 		return e, applied, nil
 	case types.DelimitedBlock:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, ctx)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
+	case types.Table:
+		header, applied, err := applyAttributeSubstitutionsOnTableLine(e.Header, ctx)
+		if err != nil {
+			return struct{}{}, false, err
+		}
+		e.Header = header
+		for i, line := range e.Lines {
+			line, a, err := applyAttributeSubstitutionsOnTableLine(line, ctx)
+			if err != nil {
+				return struct{}{}, false, err
+			}
+			e.Lines[i] = line
+			applied = applied || a
+		}
+		return e, applied, nil
 	case types.Paragraph:
 		applied := false
-		for i, line := range e.Lines {
-			line, a, err := applyAttributeSubstitutions(line, attrs)
+		lines := make([][]interface{}, 0, len(e.Lines))
+		for _, line := range e.Lines {
+			line, a, err := applyAttributeSubstitutions(line, ctx)
 			if err != nil {
 				return struct{}{}, false, err
 			}
-			e.Lines[i] = line.([]interface{})
+			if !isDroppedLine(line.([]interface{})) {
+				lines = append(lines, line.([]interface{}))
+			}
 			applied = applied || a
 		}
+		e.Lines = lines
 		return e, applied, nil
 	default:
 		return e, false, nil
 	}
 }
 
+func applyAttributeSubstitutionsOnTableLine(line types.TableLine, ctx *substitutionContext) (types.TableLine, bool, error) {
+	applied := false
+	for i, cell := range line.Cells {
+		cell, a, err := applyAttributeSubstitutions(cell, ctx)
+		if err != nil {
+			return types.TableLine{}, false, err
+		}
+		line.Cells[i] = withoutDroppedLine(cell.([]interface{}))
+		applied = applied || a
+	}
+	return line, applied, nil
+}
+
 // if a document attribute substitution happened, we need to parse the string element in search
 // for a potentially new link. Eg `{url}` giving `https://foo.com`
 func parseInlineLinks(elements []interface{}) ([]interface{}, error) {
@@ -130,7 +261,7 @@ func parseInlineLinks(elements []interface{}) ([]interface{}, error) {
 		switch element := element.(type) {
 		case types.StringElement:
 			log.Debugf("looking for links in line element of type %[1]T (%[1]v)", element)
-			elements, err := ParseReader("", strings.NewReader(element.Content), Entrypoint("InlineLinks"))
+			elements, err := ParseReader("", strings.NewReader(element.Content), Entrypoint("InlineLinks"), withSourceMap(noSourceMap{}))
 			if err != nil {
 				return []interface{}{}, errors.Wrap(err, "error while parsing content for inline links")
 			}
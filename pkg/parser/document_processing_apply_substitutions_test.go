@@ -26,12 +26,12 @@ var _ = Describe("document attribute subsititutions", func() {
 			},
 		}
 		// when
-		result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+		result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 			Content: map[string]interface{}{
 				"foo": "bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}))
 		// then
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(applied).To(BeTrue())
@@ -65,12 +65,12 @@ var _ = Describe("document attribute subsititutions", func() {
 			},
 		}
 		// when
-		result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+		result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 			Content: map[string]interface{}{
 				"foo": "bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}))
 		// then
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(applied).To(BeTrue())
@@ -107,12 +107,12 @@ var _ = Describe("document attribute subsititutions", func() {
 			},
 		}
 		// when
-		result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+		result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 			Content: map[string]interface{}{
 				"foo": "bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}))
 		// then
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(applied).To(BeTrue())
@@ -149,10 +149,10 @@ var _ = Describe("document attribute subsititutions", func() {
 			},
 		}
 		// when
-		result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+		result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 			Content:   map[string]interface{}{},
 			Overrides: map[string]string{},
-		})
+		}))
 
 		// then
 		Expect(err).To(Not(HaveOccurred()))
@@ -190,10 +190,10 @@ var _ = Describe("document attribute subsititutions", func() {
 			},
 		}
 		// when
-		result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+		result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 			Content:   map[string]interface{}{},
 			Overrides: map[string]string{},
-		})
+		}))
 
 		// then
 		Expect(err).To(Not(HaveOccurred()))
@@ -237,14 +237,14 @@ var _ = Describe("document attribute subsititutions", func() {
 			},
 		}
 		// when
-		result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+		result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 			Content: map[string]interface{}{
 				"foo":    "bar",
 				"scheme": "https",
 				"host":   "foo.bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}))
 
 		// then
 		Expect(err).To(Not(HaveOccurred()))
@@ -298,12 +298,12 @@ var _ = Describe("document attribute subsititutions", func() {
 				},
 			}
 			// when
-			result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+			result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 				Content: map[string]interface{}{
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}))
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -345,12 +345,12 @@ var _ = Describe("document attribute subsititutions", func() {
 				},
 			}
 			// when
-			result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+			result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 				Content: map[string]interface{}{
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}))
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -392,12 +392,12 @@ var _ = Describe("document attribute subsititutions", func() {
 				},
 			}
 			// when
-			result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+			result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 				Content: map[string]interface{}{
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}))
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -442,12 +442,12 @@ var _ = Describe("document attribute subsititutions", func() {
 				},
 			}
 			// when
-			result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+			result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 				Content: map[string]interface{}{
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}))
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -500,12 +500,12 @@ var _ = Describe("document attribute subsititutions", func() {
 				},
 			}
 			// when
-			result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+			result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 				Content: map[string]interface{}{
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}))
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -552,12 +552,12 @@ var _ = Describe("document attribute subsititutions", func() {
 				},
 			}
 			// when
-			result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+			result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 				Content: map[string]interface{}{
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}))
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -599,25 +599,18 @@ var _ = Describe("document attribute subsititutions", func() {
 				},
 			}
 			// when
-			result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
+			result, applied, err := applyAttributeSubstitutions(elements, newSubstitutionContext(types.AttributesWithOverrides{
 				Content: map[string]interface{}{
 					"foo": "bar",
 				},
 				Overrides: map[string]string{
 					"foo": "BAR",
 				},
-			})
+			}))
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
-			Expect(result).To(Equal([]interface{}{ // at this stage, AttributeDeclaration and AttributeReset are still present
-				types.AttributeDeclaration{
-					Name:  "foo",
-					Value: "foo",
-				},
-				types.AttributeReset{
-					Name: "foo",
-				},
+			Expect(result).To(Equal([]interface{}{ // the AttributeDeclaration and AttributeReset of the locked attribute have no effect, and are dropped
 				types.Paragraph{
 					Lines: [][]interface{}{
 						{
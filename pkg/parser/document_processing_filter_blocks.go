@@ -85,11 +85,11 @@ var emptyPreambleMatcher filterMatcher = func(element interface{}) bool {
 	return result
 }
 
-// documentAttributeMatcher filters the element if it is a AttributeDeclaration,
-// a AttributeSubstitution or a AttributeReset
+// documentAttributeMatcher filters the element if it is a AttributeSubstitution
+// (the AttributeDeclarations and AttributeResets of the document body are applied by the renderers)
 var documentAttributeMatcher filterMatcher = func(element interface{}) bool {
 	switch element.(type) {
-	case types.AttributeDeclaration, types.AttributeSubstitution, types.AttributeReset:
+	case types.AttributeSubstitution:
 		return true
 	default:
 		return false
@@ -43,7 +43,8 @@ var _ = Describe("block filters", func() {
 		Expect(filter(actual, allMatchers...)).To(Equal(expected))
 	})
 
-	It("should remove document attribute declaration", func() {
+	It("should retain document attribute declaration", func() {
+		// applied by the renderers, in the order in which they appear in the document
 		actual := []interface{}{
 			types.AttributeDeclaration{},
 			types.Paragraph{
@@ -55,6 +56,7 @@ var _ = Describe("block filters", func() {
 			},
 		}
 		expected := []interface{}{
+			types.AttributeDeclaration{},
 			types.Paragraph{
 				Lines: [][]interface{}{
 					{
@@ -89,7 +91,8 @@ var _ = Describe("block filters", func() {
 		Expect(filter(actual, allMatchers...)).To(Equal(expected))
 	})
 
-	It("should remove document attribute reset", func() {
+	It("should retain document attribute reset", func() {
+		// applied by the renderers, in the order in which they appear in the document
 		actual := []interface{}{
 			types.AttributeReset{},
 			types.Paragraph{
@@ -101,6 +104,7 @@ var _ = Describe("block filters", func() {
 			},
 		}
 		expected := []interface{}{
+			types.AttributeReset{},
 			types.Paragraph{
 				Lines: [][]interface{}{
 					{
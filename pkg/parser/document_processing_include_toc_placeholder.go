@@ -6,9 +6,13 @@ import (
 )
 
 // IncludeTableOfContentsPlaceHolder includes a `TableOfContentsPlaceHolder` block in the document
-// if the `toc` attribute is present
+// if the `toc` attribute is present. The `toc::[]` macros are retained only if the `toc` attribute is `macro`
 func includeTableOfContentsPlaceHolder(doc types.Document) types.Document {
-	if t, found := doc.Attributes.GetAsString(types.AttrTableOfContents); found {
+	t, found := doc.Attributes.GetAsString(types.AttrTableOfContents)
+	if !found || t != "macro" {
+		doc.Elements = removeTableOfContentsMacros(doc.Elements)
+	}
+	if found {
 		doc = doInsertTableOfContentsPlaceHolder(doc, t)
 	}
 	return doc
@@ -18,11 +22,12 @@ func doInsertTableOfContentsPlaceHolder(doc types.Document, location string) typ
 	log.Debugf("inserting a table of contents at location `%s`", location)
 	// insert a TableOfContentsPlaceHolder element if `toc` value is:
 	// - "auto" (or empty)
+	// - "left" or "right" (the renderer moves the table of contents in the header of the document, if applicable)
 	// - "preamble"
 	log.Debugf("inserting ToC macro with placement: '%s'", location)
 	toc := types.TableOfContentsPlaceHolder{}
 	switch location {
-	case "", "auto":
+	case "", "auto", "left", "right":
 		// insert TableOfContentsPlaceHolder at first position (in section '0' if it exists)
 		if header, ok := doc.Header(); ok {
 			header.Elements = append([]interface{}{toc}, header.Elements...)
@@ -41,13 +46,39 @@ func doInsertTableOfContentsPlaceHolder(doc types.Document, location string) typ
 		} else if preambleIndex, ok := lookupPreamble(doc.Elements); ok {
 			doc.Elements = insertAt(doc.Elements, toc, preambleIndex)
 		}
-	// case "macro":
+	case "macro":
+		// the table of contents is rendered where the `toc::[]` macro(s) are
 	default:
 		log.Warnf("invalid or unsupported value for 'toc' attribute: '%s'", location)
 	}
 	return doc
 }
 
+// removeTableOfContentsMacros removes the `toc::[]` macros from the given elements (and their sections and preamble)
+func removeTableOfContentsMacros(elements []interface{}) []interface{} {
+	if elements == nil {
+		return nil
+	}
+	result := make([]interface{}, 0, len(elements))
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.TableOfContentsPlaceHolder:
+			log.Debug("ignoring 'toc::[]' macro since the 'toc' attribute is not 'macro'")
+		case types.Section:
+			e.Elements = removeTableOfContentsMacros(e.Elements)
+			result = append(result, e)
+		case types.Preamble:
+			e.Elements = removeTableOfContentsMacros(e.Elements)
+			if e.HasContent() {
+				result = append(result, e)
+			}
+		default:
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
 // returns the index of the preamble if it was found in the given elements
 func lookupPreamble(elements []interface{}) (int, bool) {
 	for i, e := range elements {
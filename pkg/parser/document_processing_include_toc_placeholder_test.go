@@ -248,4 +248,62 @@ var _ = Describe("include table of contents", func() {
 		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
 	})
 
+	It("table of contents with macro placement", func() {
+		source := types.Document{
+			Attributes: types.Attributes{
+				types.AttrTableOfContents: "macro",
+			},
+			Elements: []interface{}{
+				preamble,
+				tocPlaceHolder,
+				section,
+			},
+		}
+		expected := types.Document{
+			Attributes: types.Attributes{
+				types.AttrTableOfContents: "macro",
+			},
+			Elements: []interface{}{
+				preamble,
+				tocPlaceHolder,
+				section,
+			},
+		}
+		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+	})
+
+	It("table of contents with left placement and a macro", func() {
+		source := types.Document{
+			Attributes: types.Attributes{
+				types.AttrTableOfContents: "left",
+			},
+			Elements: []interface{}{
+				preamble,
+				types.Section{
+					Level: 1,
+					Attributes: types.Attributes{
+						types.AttrID: "_section_1",
+					},
+					Title: []interface{}{
+						types.StringElement{Content: "section 1"},
+					},
+					Elements: []interface{}{
+						tocPlaceHolder,
+					},
+				},
+			},
+		}
+		expected := types.Document{
+			Attributes: types.Attributes{
+				types.AttrTableOfContents: "left",
+			},
+			Elements: []interface{}{
+				tocPlaceHolder,
+				preamble,
+				section,
+			},
+		}
+		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+	})
+
 })
@@ -31,8 +31,9 @@ func doInsertPreamble(blocks []interface{}) []interface{} {
 			preamble.Elements = append(preamble.Elements, block)
 		}
 	}
-	// no element in the preamble, or no section in the document, so no preamble to generate
-	if len(preamble.Elements) == 0 || len(preamble.Elements) == len(blocks) {
+	// no element in the preamble (or only attribute declarations and resets), or no section in the document,
+	// so no preamble to generate
+	if onlyAttributeEntries(preamble.Elements) || len(preamble.Elements) == len(blocks) {
 		log.Debugf("skipping preamble (%d vs %d)", len(preamble.Elements), len(blocks))
 		return blocks
 	}
@@ -44,3 +45,15 @@ func doInsertPreamble(blocks []interface{}) []interface{} {
 	log.Debugf("generated preamble with %d blocks", len(preamble.Elements))
 	return result
 }
+
+// onlyAttributeEntries returns true if the given blocks are all attribute declarations or resets (or if there is no block)
+func onlyAttributeEntries(blocks []interface{}) bool {
+	for _, b := range blocks {
+		switch b.(type) {
+		case types.AttributeDeclaration, types.AttributeReset:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -28,16 +28,7 @@ func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]inte
 			}
 			block.Elements = elements
 			if len(lists) > 0 {
-				switch list := lists[0].(type) { // just add the top-level list
-				case *types.OrderedList:
-					result = append(result, *list)
-				case *types.UnorderedList:
-					result = append(result, *list)
-				case *types.LabeledList:
-					result = append(result, *list)
-				case *types.CalloutList:
-					result = append(result, *list)
-				}
+				result = append(result, completeList(lists[0])) // just add the top-level list
 				// reset the list for further usage while processing the rest of the document
 				lists = []types.List{}
 			}
@@ -48,7 +39,7 @@ func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]inte
 			if blanklineCount > 0 && len(block.(types.DocumentElement).GetAttributes()) > 0 {
 				if len(lists) > 0 {
 					for _, list := range pruneLists(lists, 0) {
-						result = append(result, unPtr(list))
+						result = append(result, completeList(list))
 					}
 					// reset the list for further usage while processing the rest of the document
 					lists = []types.List{}
@@ -80,7 +71,7 @@ func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]inte
 			if len(lists) > 0 {
 				log.Debugf("appending %d lists before processing element of type %T", len(lists), block)
 				for _, list := range pruneLists(lists, 0) {
-					result = append(result, unPtr(list))
+					result = append(result, completeList(list))
 				}
 				// reset the list for further usage while processing the rest of the document
 				lists = []types.List{}
@@ -92,7 +83,7 @@ func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]inte
 	if len(lists) > 0 {
 		log.Debugf("processing the remaining %d lists...", len(lists))
 		for _, list := range pruneLists(lists, 0) {
-			result = append(result, unPtr(list))
+			result = append(result, completeList(list))
 		}
 	}
 	return result, nil
@@ -107,6 +98,23 @@ func unPtr(value interface{}) interface{} {
 	return value
 }
 
+// completeList returns the given list (by value), with a position which ends at the end of its last item
+// (since elements may have been added to the last item after it was added to the list)
+func completeList(list types.List) interface{} {
+	last := types.PositionOf(unPtr(list.LastItem()))
+	switch l := list.(type) {
+	case *types.OrderedList:
+		l.Position = l.Position.Extend(last)
+	case *types.UnorderedList:
+		l.Position = l.Position.Extend(last)
+	case *types.LabeledList:
+		l.Position = l.Position.Extend(last)
+	case *types.CalloutList:
+		l.Position = l.Position.Extend(last)
+	}
+	return unPtr(list)
+}
+
 func appendListItem(lists []types.List, item interface{}) ([]types.List, error) {
 	switch item := item.(type) {
 	case types.OrderedListItem:
@@ -244,7 +252,7 @@ func appendLabeledListItem(lists []types.List, item types.LabeledListItem) ([]ty
 // a labeled list item term may contain links, images, quoted text, footnotes, etc.
 func parseLabeledListItemTerm(term string) ([]interface{}, error) {
 	result := []interface{}{}
-	elements, err := ParseReader("", strings.NewReader(term), Entrypoint("LabeledListItemTerm"))
+	elements, err := ParseReader("", strings.NewReader(term), Entrypoint("LabeledListItemTerm"), withSourceMap(noSourceMap{}))
 	if err != nil {
 		return []interface{}{}, errors.Wrap(err, "error while parsing content for inline links")
 	}
@@ -272,13 +280,9 @@ func pruneLists(lists []types.List, level int) []types.List {
 			log.Debugf("appending list at depth %d to the last item of the parent list...", (i + 1))
 			parentList := &(lists[i-1])
 			parentItem := (*parentList).LastItem()
-			switch childList := lists[i].(type) {
-			case *types.OrderedList:
-				parentItem.AddElement(*childList)
-			case *types.UnorderedList:
-				parentItem.AddElement(*childList)
-			case *types.LabeledList:
-				parentItem.AddElement(*childList)
+			switch lists[i].(type) {
+			case *types.OrderedList, *types.UnorderedList, *types.LabeledList:
+				parentItem.AddElement(completeList(lists[i]))
 			}
 		}
 		// also, prune the pointers to the remaining sublists
@@ -347,4 +347,88 @@ a paragraph`
 			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 		})
 	})
+
+	Context("element attributes with quoted values", func() {
+
+		It("quoted value with commas in a block attribute group", func() {
+			source := `[foo="a, b", bar=c]
+a paragraph`
+			expected := types.Paragraph{
+				Attributes: types.Attributes{
+					"foo": "a, b",
+					"bar": "c",
+				},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{
+							Content: "a paragraph",
+						},
+					},
+				},
+			}
+			Expect(ParseDocumentBlock(source)).To(Equal(expected))
+		})
+
+		It("unquoted value with commas in a block attribute group", func() {
+			source := `[foo=a, b]
+a paragraph`
+			expected := types.Paragraph{
+				Attributes: types.Attributes{
+					"foo": "a",
+					"b":   nil,
+				},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{
+							Content: "a paragraph",
+						},
+					},
+				},
+			}
+			Expect(ParseDocumentBlock(source)).To(Equal(expected))
+		})
+
+		It("quoted value with commas in block image attributes", func() {
+			source := `image::foo.png[alt,title="a, b"]`
+			expected := types.ImageBlock{
+				Location: types.Location{
+					Path: []interface{}{
+						types.StringElement{Content: "foo.png"},
+					},
+				},
+				Attributes: types.Attributes{
+					types.AttrImageAlt:   "alt",
+					types.AttrImageTitle: "a, b",
+				},
+			}
+			Expect(ParseDocumentBlock(source)).To(Equal(expected))
+		})
+
+		It("quoted value with commas in inline link attributes", func() {
+			source := `link:https://example.com[text,foo="c, d"]`
+			expected := types.Paragraph{
+				Lines: [][]interface{}{
+					{
+						types.InlineLink{
+							Location: types.Location{
+								Scheme: "https://",
+								Path: []interface{}{
+									types.StringElement{Content: "example.com"},
+								},
+							},
+							Attributes: types.Attributes{
+								"positional-1": []interface{}{
+									types.StringElement{
+										Content: "text",
+									},
+								},
+								"foo": "c, d",
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocumentBlock(source)).To(Equal(expected))
+		})
+	})
 })
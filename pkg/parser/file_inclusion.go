@@ -3,8 +3,11 @@ package parser
 import (
 	"bufio"
 	"bytes"
+	stderrors "errors"
 	"fmt"
-	"os"
+	"io"
+	"io/fs"
+	"net/url"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -12,6 +15,9 @@ import (
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -47,27 +53,42 @@ func absoluteOffset(offset int) levelOffset {
 
 func parseFileToInclude(incl types.FileInclusion, attrs types.AttributesWithOverrides, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
 	path := incl.Location.Resolve(attrs).String()
-	currentDir := filepath.Dir(config.Filename)
-	log.Debugf("parsing '%s' from current dir '%s' (%s)", path, currentDir, config.Filename)
-	f, absPath, done, err := open(filepath.Join(currentDir, path))
-	defer done()
+	log.Debugf("parsing '%s' from '%s'", path, config.Filename)
+	f, absPath, err := open(path, attrs, config)
 	if err != nil {
+		if incl.IsOptional() && stderrors.Is(err, fs.ErrNotExist) {
+			log.Debugf("skipping missing optional file to include: '%s'", path)
+			return types.DraftDocument{}, nil
+		} else if incl.IsOptional() {
+			// the file may exist, but cannot be read (eg: in secure mode)
+			log.WithError(err).Warnf("unable to open optional file to include: '%s'", path)
+		} else {
+			log.WithError(err).Debugf("unable to open file to include: '%s'", path)
+		}
 		return types.DraftDocument{}, FileInclusionError{
 			Filename: config.Filename,
 			rawText:  incl.RawText,
 		}
 	}
-	content := bytes.NewBuffer(nil)
-	scanner := bufio.NewScanner(bufio.NewReader(f))
-	if lineRanges, ok := incl.LineRanges(); ok {
-		if err := readWithinLines(scanner, content, lineRanges); err != nil {
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("failed to close file '%s'", absPath)
+		}
+	}()
+	content := &includedContent{
+		file: absPath,
+	}
+	scanner := bufio.NewScanner(bufio.NewReader(decode(f, incl)))
+	lineRanges, withinLines := incl.LineRanges()
+	if tagRanges, ok := incl.TagRanges(); ok {
+		if err := readWithinTags(path, scanner, content, tagRanges, lineRanges); err != nil {
 			return types.DraftDocument{}, FileInclusionError{
 				Filename: config.Filename,
 				rawText:  incl.RawText,
 			}
 		}
-	} else if tagRanges, ok := incl.TagRanges(); ok {
-		if err := readWithinTags(path, scanner, content, tagRanges); err != nil {
+	} else if withinLines {
+		if err := readWithinLines(scanner, content, lineRanges); err != nil {
 			return types.DraftDocument{}, FileInclusionError{
 				Filename: config.Filename,
 				rawText:  incl.RawText,
@@ -87,6 +108,9 @@ func parseFileToInclude(incl types.FileInclusion, attrs types.AttributesWithOver
 			rawText:  incl.RawText,
 		}
 	}
+	if indent, ok := incl.Indent(); ok {
+		content.Buffer = *bytes.NewBuffer(reindent(content.Bytes(), indent, tabSize(incl, attrs)))
+	}
 	// parse the content, and returns the corresponding elements
 	if l, found := incl.Attributes.GetAsString(types.AttrLevelOffset); found {
 		offset, err := strconv.Atoi(l)
@@ -100,13 +124,14 @@ func parseFileToInclude(incl types.FileInclusion, attrs types.AttributesWithOver
 
 		}
 	}
-	// use a simpler/different grammar for non-asciidoc files.
-	if !IsAsciidoc(absPath) {
+	// use a simpler/different grammar for non-asciidoc files (unless the file is included in a delimited block,
+	// in which case its content is verbatim).
+	if !IsAsciidoc(absPath) && entrypoint(options...) != "VerbatimDocument" {
 		options = append(options, Entrypoint("TextDocument")) // TODO: delete rule and use VerbatimDocument?
 	}
 	inclConfig := config.Clone()
 	inclConfig.Filename = absPath
-	return parseDraftDocument(content, levelOffsets, inclConfig, options...)
+	return parseDraftDocument(&content.Buffer, content.lines, levelOffsets, attrs.Intrinsic, inclConfig, options...)
 }
 
 // FileInclusionError an error which may happen during a file inclusion
@@ -121,7 +146,31 @@ func (e FileInclusionError) Error() string {
 
 var _ error = FileInclusionError{}
 
-func readWithinLines(scanner *bufio.Scanner, content *bytes.Buffer, lineRanges types.LineRanges) error {
+// includedContent the content of a file to include, along with the position
+// of each line in the file (which may differ when only some lines or tags are included)
+type includedContent struct {
+	bytes.Buffer
+	file  string
+	lines linesSourceMap
+}
+
+func (c *includedContent) writeLine(line []byte, lineNumber int) error {
+	if _, err := c.Write(line); err != nil {
+		return err
+	}
+	if _, err := c.WriteString("\n"); err != nil {
+		return err
+	}
+	c.lines = append(c.lines, types.Position{
+		File:   c.file,
+		Line:   lineNumber,
+		Column: 1,
+	})
+	return nil
+}
+
+// readWithinLines reads the lines which are within the given line ranges
+func readWithinLines(scanner *bufio.Scanner, content *includedContent, lineRanges types.LineRanges) error {
 	log.Debugf("limiting to line ranges: %v", lineRanges)
 	line := 0
 	for scanner.Scan() {
@@ -142,12 +191,7 @@ func readWithinLines(scanner *bufio.Scanner, content *bytes.Buffer, lineRanges t
 		}
 		// TODO: stop reading if current line above highest range
 		if lineRanges.Match(line) {
-			_, err := content.Write(scanner.Bytes())
-			if err != nil {
-				return err
-			}
-			_, err = content.WriteString("\n")
-			if err != nil {
+			if err := content.writeLine(scanner.Bytes(), line); err != nil {
 				return err
 			}
 		}
@@ -155,8 +199,9 @@ func readWithinLines(scanner *bufio.Scanner, content *bytes.Buffer, lineRanges t
 	return nil
 }
 
-func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer, expectedRanges types.TagRanges) error {
-	log.Debugf("limiting to tag ranges: %v", expectedRanges)
+// readWithinTags reads the lines which are within the expected tag ranges AND within the given line ranges
+func readWithinTags(path string, scanner *bufio.Scanner, content *includedContent, expectedRanges types.TagRanges, lineRanges types.LineRanges) error {
+	log.Debugf("limiting to tag ranges: %v and line ranges: %v", expectedRanges, lineRanges)
 	currentRanges := make(map[string]*types.CurrentTagRange, len(expectedRanges)) // ensure capacity
 	lineNumber := 0
 	for scanner.Scan() {
@@ -181,13 +226,8 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 		if endTag, ok := fl.GetEndTag(); ok {
 			currentRanges[endTag.Value].EndLine = lineNumber
 		}
-		if expectedRanges.Match(lineNumber, currentRanges) && !fl.HasTag() {
-			_, err := content.Write(scanner.Bytes())
-			if err != nil {
-				return err
-			}
-			_, err = content.WriteString("\n")
-			if err != nil {
+		if expectedRanges.Match(lineNumber, currentRanges) && lineRanges.Match(lineNumber) && !fl.HasTag() {
+			if err := content.writeLine(scanner.Bytes(), lineNumber); err != nil {
 				return err
 			}
 		}
@@ -209,8 +249,10 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 	return nil
 }
 
-func readAll(scanner *bufio.Scanner, content *bytes.Buffer) error {
+func readAll(scanner *bufio.Scanner, content *includedContent) error {
+	line := 0
 	for scanner.Scan() {
+		line++
 		// parse the line in search for the `tag::<tag>[]` or `end:<tag>[]` macros
 		l, err := Parse("", scanner.Bytes(), Entrypoint("IncludedFileLine"))
 		if err != nil {
@@ -224,66 +266,134 @@ func readAll(scanner *bufio.Scanner, content *bytes.Buffer) error {
 		if fl.HasTag() {
 			continue
 		}
-		_, err = content.Write(scanner.Bytes())
-		if err != nil {
-			return err
-		}
-		_, err = content.WriteString("\n")
-		if err != nil {
+		if err := content.writeLine(scanner.Bytes(), line); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func open(path string) (*os.File, string, func(), error) {
-	wd, err := os.Getwd()
-	if err != nil {
-		return nil, "", func() {}, err
+// open opens the file to include, which can be a remote file (`http://` or `https://` URL), or
+// a file in the configured filesystem. Returns the reader, and the absolute path (or URL) of the file
+func open(path string, attrs types.AttributesWithOverrides, config configuration.Configuration) (io.ReadCloser, string, error) {
+	if configuration.IsRemote(config.Filename) {
+		// resolve relatively to the URL of the current (remote) document
+		var err error
+		if path, err = resolveRemoteLocation(config.Filename, path); err != nil {
+			return nil, "", err
+		}
 	}
-	absPath, err := filepath.Abs(path)
-	if err != nil {
-		return nil, "", func() {
-			log.Debugf("restoring current working dir to: %s", wd)
-			if err := os.Chdir(wd); err != nil { // restore the previous working directory
-				log.WithError(err).Error("failed to restore previous working directory")
-			}
-		}, err
+	if configuration.IsRemote(path) {
+		f, err := openRemote(path, attrs, config)
+		return f, path, err
 	}
-	dir := filepath.Dir(absPath)
-	err = os.Chdir(dir)
+	absPath, err := config.ResolvePath(path)
 	if err != nil {
-		return nil, "", func() {
-			log.Debugf("restoring current working dir to: %s", wd)
-			if err := os.Chdir(wd); err != nil { // restore the previous working directory
-				log.WithError(err).Error("failed to restore previous working directory")
-			}
-		}, err
+		return nil, "", err
 	}
 	// read the file per-se
 	log.Debugf("opening '%s'", absPath)
-	f, err := os.Open(absPath)
+	f, err := config.Open(absPath)
 	if err != nil {
-		return nil, absPath, func() {
-			log.Debugf("restoring current working dir to: %s", wd)
-			if err := os.Chdir(wd); err != nil { // restore the previous working directory
-				log.WithError(err).Error("failed to restore previous working directory")
-			}
-		}, err
+		return nil, absPath, err
+	}
+	return f, absPath, nil
+}
+
+// decode returns a reader which decodes the content of the file to include from the encoding
+// specified in the `encoding` attribute (if any) into UTF-8
+func decode(r io.Reader, incl types.FileInclusion) io.Reader {
+	name, ok := incl.Encoding()
+	if !ok {
+		return r
 	}
-	return f, absPath, func() {
-		log.Debugf("restoring current working dir to: %s", wd)
-		if err := os.Chdir(wd); err != nil { // restore the previous working directory
-			log.WithError(err).Error("failed to restore previous working directory")
+	enc, err := htmlindex.Get(name)
+	if err != nil {
+		log.WithError(err).Warnf("unsupported encoding '%s' in '%s', reading as UTF-8 instead", name, incl.RawText)
+		return r
+	}
+	// a byte order mark (if any) takes precedence over the specified encoding (eg: UTF-16BE vs UTF-16LE)
+	return transform.NewReader(r, unicode.BOMOverride(enc.NewDecoder()))
+}
+
+// defaultTabSize the number of columns of a tab in the indentation of the included lines,
+// unless the `tabsize` attribute is set
+const defaultTabSize = 4
+
+// tabSize returns the value of the `tabsize` attribute of the file inclusion or of the document,
+// or the default tab size if none is set (or if the value is not a positive number)
+func tabSize(incl types.FileInclusion, attrs types.AttributesWithOverrides) int {
+	s, found := incl.Attributes.GetAsString(types.AttrTabSize)
+	if !found {
+		s, found = attrs.GetAsString(types.AttrTabSize)
+	}
+	if found {
+		if size, err := strconv.Atoi(s); err == nil && size > 0 {
+			return size
 		}
-		if err := f.Close(); err != nil {
-			log.WithError(err).Errorf("failed to close file '%s'", absPath)
+		log.Warnf("invalid 'tabsize' value: '%s'", s)
+	}
+	return defaultTabSize
+}
+
+// reindent removes the common leading indentation of the given lines, then
+// indents them with the given number of spaces.
+// The tabs in the leading indentation are first expanded into spaces, up to the next multiple of the given tab size,
+// so that the lines indented with tabs and the lines indented with spaces are aligned.
+// Blank lines are left empty.
+func reindent(content []byte, indent, tabSize int) []byte {
+	lines := strings.SplitAfter(string(content), "\n")
+	common := -1
+	for i, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		lines[i] = expandLeadingTabs(l, tabSize)
+		if n := len(lines[i]) - len(strings.TrimLeft(lines[i], " ")); common == -1 || n < common {
+			common = n
+		}
+	}
+	result := &strings.Builder{}
+	prefix := strings.Repeat(" ", indent)
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			result.WriteString(strings.TrimLeft(l, " \t"))
+			continue
 		}
-	}, nil
+		result.WriteString(prefix)
+		result.WriteString(l[common:])
+	}
+	return []byte(result.String())
+}
+
+// expandLeadingTabs replaces the tabs in the leading indentation of the given (non-blank) line with spaces
+func expandLeadingTabs(line string, tabSize int) string {
+	column := 0
+	for i, c := range line {
+		switch c {
+		case ' ':
+			column++
+		case '\t':
+			column += tabSize - column%tabSize
+		default:
+			return strings.Repeat(" ", column) + line[i:]
+		}
+	}
+	return line
+}
+
+// entrypoint returns the name of the grammar rule used as the entrypoint with the given options
+func entrypoint(options ...Option) string {
+	return newParser("", nil, options...).entrypoint
 }
 
 // IsAsciidoc returns true if the file to include is an asciidoc file (based on the file location extension)
 func IsAsciidoc(path string) bool {
+	if configuration.IsRemote(path) {
+		if u, err := url.Parse(path); err == nil {
+			path = u.Path
+		}
+	}
 	ext := filepath.Ext(path)
 	return ext == ".asciidoc" || ext == ".adoc" || ext == ".ad" || ext == ".asc" || ext == ".txt"
 }
@@ -0,0 +1,159 @@
+package parser
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// resolveRemoteLocation resolves the location of a file to include relatively to the given (remote) base URL,
+// so that an included remote file can include its siblings.
+func resolveRemoteLocation(base, location string) (string, error) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	l, err := url.Parse(location)
+	if err != nil {
+		return "", err
+	}
+	return b.ResolveReference(l).String(), nil
+}
+
+// openRemote reads the content at the given URL.
+// Remote content can only be read when the `allow-uri-read` attribute was set via the API or the CLI
+// and when the safe mode is below `Secure`.
+func openRemote(location string, attrs types.AttributesWithOverrides, config configuration.Configuration) (io.ReadCloser, error) {
+	if config.SafeMode >= configuration.Secure {
+		return nil, errors.Errorf("unable to read '%s' in secure mode", location)
+	}
+	if _, found := attrs.Overrides[types.AttrAllowURIRead]; !found {
+		return nil, errors.Errorf("unable to read '%s' since the '%s' attribute is not set", location, types.AttrAllowURIRead)
+	}
+	cache := uriCache{
+		dir: config.URICacheDir,
+	}
+	cached, found := cache.get(location)
+	req, err := http.NewRequest(http.MethodGet, location, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read '%s'", location)
+	}
+	if found {
+		// conditional request to check if the cached content is still valid
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+	client := &http.Client{
+		Timeout: config.URIReadTimeout,
+	}
+	log.Debugf("reading '%s'", location)
+	resp, err := client.Do(req)
+	if err != nil {
+		if found {
+			log.WithError(err).Warnf("unable to read '%s', using cached content instead", location)
+			return ioutil.NopCloser(bytes.NewReader(cached.content)), nil
+		}
+		return nil, errors.Wrapf(err, "unable to read '%s'", location)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotModified && found:
+		log.Debugf("using cached content of '%s'", location)
+		return ioutil.NopCloser(bytes.NewReader(cached.content)), nil
+	case resp.StatusCode == http.StatusNotFound:
+		// so that missing optional remote files are skipped, as are missing local files
+		return nil, &fs.PathError{Op: "read", Path: location, Err: fs.ErrNotExist}
+	case resp.StatusCode != http.StatusOK:
+		return nil, errors.Errorf("unable to read '%s': %s", location, resp.Status)
+	}
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read '%s'", location)
+	}
+	cache.put(location, uriCacheEntry{
+		URL:          location,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		content:      content,
+	})
+	return ioutil.NopCloser(bytes.NewReader(content)), nil
+}
+
+// uriCache an on-disk cache of remote content, keyed by URL.
+// Each entry is stored in 2 files: the content itself, and its metadata (in JSON),
+// which is used to perform conditional requests when the content is read again.
+type uriCache struct {
+	dir string // cache is disabled if empty
+}
+
+type uriCacheEntry struct {
+	URL          string `json:"url"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last-modified,omitempty"`
+	content      []byte
+}
+
+func (c uriCache) path(location string) string {
+	key := sha256.Sum256([]byte(location))
+	return filepath.Join(c.dir, hex.EncodeToString(key[:]))
+}
+
+func (c uriCache) get(location string) (uriCacheEntry, bool) {
+	if c.dir == "" {
+		return uriCacheEntry{}, false
+	}
+	p := c.path(location)
+	metadata, err := ioutil.ReadFile(p + ".json")
+	if err != nil {
+		return uriCacheEntry{}, false
+	}
+	entry := uriCacheEntry{}
+	if err := json.Unmarshal(metadata, &entry); err != nil || entry.URL != location {
+		return uriCacheEntry{}, false
+	}
+	if entry.content, err = ioutil.ReadFile(p); err != nil {
+		return uriCacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (c uriCache) put(location string, entry uriCacheEntry) {
+	if c.dir == "" {
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		log.WithError(err).Warnf("unable to create cache directory '%s'", c.dir)
+		return
+	}
+	p := c.path(location)
+	metadata, err := json.Marshal(entry)
+	if err != nil {
+		log.WithError(err).Warnf("unable to cache content of '%s'", location)
+		return
+	}
+	if err := ioutil.WriteFile(p, entry.content, 0644); err != nil {
+		log.WithError(err).Warnf("unable to cache content of '%s'", location)
+		return
+	}
+	if err := ioutil.WriteFile(p+".json", metadata, 0644); err != nil {
+		log.WithError(err).Warnf("unable to cache content of '%s'", location)
+	}
+}
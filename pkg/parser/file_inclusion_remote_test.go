@@ -0,0 +1,275 @@
+package parser_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("remote file inclusions", func() {
+
+	const snippet = `package main
+
+// tag::main[]
+func main() {
+	fmt.Println("hello, world!")
+}
+// end::main[]
+`
+
+	var server *httptest.Server
+	var requests, conditionalRequests int
+
+	BeforeEach(func() {
+		requests = 0
+		conditionalRequests = 0
+		mux := http.NewServeMux()
+		mux.HandleFunc("/snippets/hello.go", func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				conditionalRequests++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			fmt.Fprint(w, snippet)
+		})
+		mux.HandleFunc("/docs/chapter.adoc", func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Last-Modified", "Mon, 04 May 2020 10:00:00 GMT")
+			fmt.Fprint(w, "remote content\n\ninclude::section.adoc[]\n")
+		})
+		mux.HandleFunc("/docs/section.adoc", func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			fmt.Fprint(w, "sibling content\n")
+		})
+		mux.HandleFunc("/slow.adoc", func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+			fmt.Fprint(w, "slow content\n")
+		})
+		server = httptest.NewServer(mux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	unresolved := func(source string) types.Document {
+		return types.Document{
+			Attributes: types.Attributes{
+				types.AttrAllowURIRead: "",
+			},
+			Elements: []interface{}{
+				types.Paragraph{
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "Unresolved directive in test.adoc - " + source,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("should include remote file with tag", func() {
+		source := fmt.Sprintf("----\ninclude::%s/snippets/hello.go[tag=main]\n----", server.URL)
+		expected := types.Document{
+			Attributes: types.Attributes{
+				types.AttrAllowURIRead: "",
+			},
+			Elements: []interface{}{
+				types.DelimitedBlock{
+					Kind: types.Listing,
+					Elements: []interface{}{
+						types.VerbatimLine{
+							Content: "func main() {",
+						},
+						types.VerbatimLine{
+							Content: `	fmt.Println("hello, world!")`,
+						},
+						types.VerbatimLine{
+							Content: "}",
+						},
+					},
+				},
+			},
+		}
+		Expect(ParseDocument(source,
+			configuration.WithFilename("test.adoc"),
+			configuration.WithAttribute(types.AttrAllowURIRead, ""))).To(MatchDocument(expected))
+	})
+
+	It("should include remote file and its sibling", func() {
+		source := fmt.Sprintf("include::%s/docs/chapter.adoc[]", server.URL)
+		expected := types.Document{
+			Attributes: types.Attributes{
+				types.AttrAllowURIRead: "",
+			},
+			Elements: []interface{}{
+				types.Paragraph{
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "remote content",
+							},
+						},
+					},
+				},
+				types.Paragraph{
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "sibling content",
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(ParseDocument(source,
+			configuration.WithFilename("test.adoc"),
+			configuration.WithAttribute(types.AttrAllowURIRead, ""))).To(MatchDocument(expected))
+		Expect(requests).To(Equal(2))
+	})
+
+	It("should not include remote file without allow-uri-read attribute", func() {
+		source := fmt.Sprintf("include::%s/snippets/hello.go[]", server.URL)
+		expected := unresolved(source)
+		expected.Attributes = nil
+		Expect(ParseDocument(source,
+			configuration.WithFilename("test.adoc"))).To(MatchDocument(expected))
+		Expect(requests).To(Equal(0))
+	})
+
+	It("should not include remote file with allow-uri-read attribute set in the document", func() {
+		source := fmt.Sprintf(":allow-uri-read:\n\ninclude::%s/snippets/hello.go[]", server.URL)
+		doc, err := ParseDocument(source, configuration.WithFilename("test.adoc"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(ContainElement(unresolved(fmt.Sprintf("include::%s/snippets/hello.go[]", server.URL)).Elements[0]))
+		Expect(requests).To(Equal(0))
+	})
+
+	It("should not include remote file in secure mode", func() {
+		source := fmt.Sprintf("include::%s/snippets/hello.go[]", server.URL)
+		Expect(ParseDocument(source,
+			configuration.WithFilename("test.adoc"),
+			configuration.WithAttribute(types.AttrAllowURIRead, ""),
+			configuration.WithSafeMode(configuration.Secure))).To(MatchDocument(unresolved(source)))
+		Expect(requests).To(Equal(0))
+	})
+
+	It("should not include remote file after timeout", func() {
+		source := fmt.Sprintf("include::%s/slow.adoc[]", server.URL)
+		Expect(ParseDocument(source,
+			configuration.WithFilename("test.adoc"),
+			configuration.WithAttribute(types.AttrAllowURIRead, ""),
+			configuration.WithURIReadTimeout(50*time.Millisecond))).To(MatchDocument(unresolved(source)))
+	})
+
+	It("should skip missing optional remote file", func() {
+		source := fmt.Sprintf("include::%s/unknown.adoc[opts=optional]", server.URL)
+		Expect(ParseDocument(source,
+			configuration.WithFilename("test.adoc"),
+			configuration.WithAttribute(types.AttrAllowURIRead, ""))).To(MatchDocument(types.Document{
+			Attributes: types.Attributes{
+				types.AttrAllowURIRead: "",
+			},
+			Elements: []interface{}{},
+		}))
+	})
+
+	It("should not skip optional remote file without allow-uri-read attribute", func() {
+		source := fmt.Sprintf("include::%s/snippets/hello.go[opts=optional]", server.URL)
+		expected := unresolved(source)
+		expected.Attributes = nil
+		Expect(ParseDocument(source,
+			configuration.WithFilename("test.adoc"))).To(MatchDocument(expected))
+		Expect(requests).To(Equal(0))
+	})
+
+	Context("with cache", func() {
+
+		var cacheDir string
+
+		BeforeEach(func() {
+			var err error
+			cacheDir, err = ioutil.TempDir("", "libasciidoc-cache")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(cacheDir)
+		})
+
+		It("should revalidate cached content", func() {
+			source := fmt.Sprintf("----\ninclude::%s/snippets/hello.go[lines=1]\n----", server.URL)
+			expected := types.Document{
+				Attributes: types.Attributes{
+					types.AttrAllowURIRead: "",
+				},
+				Elements: []interface{}{
+					types.DelimitedBlock{
+						Kind: types.Listing,
+						Elements: []interface{}{
+							types.VerbatimLine{
+								Content: "package main",
+							},
+						},
+					},
+				},
+			}
+			settings := []configuration.Setting{
+				configuration.WithFilename("test.adoc"),
+				configuration.WithAttribute(types.AttrAllowURIRead, ""),
+				configuration.WithURICacheDir(cacheDir),
+			}
+			// first time: content is fetched
+			Expect(ParseDocument(source, settings...)).To(MatchDocument(expected))
+			Expect(requests).To(Equal(1))
+			Expect(conditionalRequests).To(Equal(0))
+			// second time: content is revalidated and read from the cache
+			Expect(ParseDocument(source, settings...)).To(MatchDocument(expected))
+			Expect(requests).To(Equal(2))
+			Expect(conditionalRequests).To(Equal(1))
+		})
+
+		It("should use cached content when server is unavailable", func() {
+			source := fmt.Sprintf("----\ninclude::%s/snippets/hello.go[lines=1]\n----", server.URL)
+			expected := types.Document{
+				Attributes: types.Attributes{
+					types.AttrAllowURIRead: "",
+				},
+				Elements: []interface{}{
+					types.DelimitedBlock{
+						Kind: types.Listing,
+						Elements: []interface{}{
+							types.VerbatimLine{
+								Content: "package main",
+							},
+						},
+					},
+				},
+			}
+			settings := []configuration.Setting{
+				configuration.WithFilename("test.adoc"),
+				configuration.WithAttribute(types.AttrAllowURIRead, ""),
+				configuration.WithURICacheDir(cacheDir),
+			}
+			Expect(ParseDocument(source, settings...)).To(MatchDocument(expected))
+			server.Close()
+			Expect(ParseDocument(source, settings...)).To(MatchDocument(expected))
+		})
+	})
+})
@@ -2,7 +2,9 @@ package parser_test
 
 import (
 	"strings"
+	"testing/fstest"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
@@ -21,7 +23,7 @@ var _ = DescribeTable("'FileLocation' pattern",
 		Expect(err).ToNot(HaveOccurred())
 		GinkgoT().Log("actual result: %s", spew.Sdump(actual))
 		GinkgoT().Log("expected result: %s", spew.Sdump(expected))
-		Expect(actual).To(Equal(expected))
+		Expect(WithoutPositions(actual)).To(Equal(expected))
 	},
 	Entry("'chapter'", "chapter", types.Location{
 		Path: []interface{}{
@@ -113,6 +115,8 @@ var _ = DescribeTable("check asciidoc file",
 	Entry("foo.txt", "foo.txt", true),
 	Entry("foo.csv", "foo.csv", false),
 	Entry("foo.go", "foo.go", false),
+	Entry("https://example.com/foo.adoc?ref=master", "https://example.com/foo.adoc?ref=master", true),
+	Entry("https://example.com/foo.go?ref=master", "https://example.com/foo.go?ref=master", false),
 )
 
 var _ = Describe("file inclusions", func() {
@@ -2476,6 +2480,243 @@ include::../../test/includes/hello_world.go.txt[lines=1]
 					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 				})
 			})
+
+			Context("with include options", func() {
+
+				It("should strip indentation with indent=0", func() {
+					source := `----
+include::../../test/includes/indented-include.go.txt[tag=body,indent=0]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `fmt.Println("hello")`,
+									},
+									types.VerbatimLine{
+										Content: `fmt.Println("world")`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should re-indent with indent=2", func() {
+					source := `----
+include::../../test/includes/indented-include.go.txt[tag=main,indent=2]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `  func main() {`,
+									},
+									types.VerbatimLine{
+										Content: `      fmt.Println("hello")`,
+									},
+									types.VerbatimLine{
+										Content: `      fmt.Println("world")`,
+									},
+									types.VerbatimLine{
+										Content: `  }`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should re-indent with indent=2 and tabs expanded with the default tab size", func() {
+					source := `----
+include::../../test/includes/hello_world.go.txt[lines=5..7,indent=2]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `  func helloworld() {`,
+									},
+									types.VerbatimLine{
+										Content: `      fmt.Println("hello, world!")`,
+									},
+									types.VerbatimLine{
+										Content: `  }`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should re-indent with indent=0 and tabs expanded with the tabsize attribute", func() {
+					source := `:tabsize: 2
+
+----
+include::../../test/includes/hello_world.go.txt[lines=5..7,indent=0]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.AttributeDeclaration{
+								Name:  "tabsize",
+								Value: "2",
+							},
+							types.BlankLine{},
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `func helloworld() {`,
+									},
+									types.VerbatimLine{
+										Content: `  fmt.Println("hello, world!")`,
+									},
+									types.VerbatimLine{
+										Content: `}`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should include lines within tags", func() {
+					source := `----
+include::../../test/includes/indented-include.go.txt[lines=1..6,tags=main]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `func main() {`,
+									},
+									types.VerbatimLine{
+										Content: `    fmt.Println("hello")`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should include lines within tags with quoted line ranges", func() {
+					source := `----
+include::../../test/includes/indented-include.go.txt[tags=main;body,lines="4,7..20"]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `func main() {`,
+									},
+									types.VerbatimLine{
+										Content: `    fmt.Println("world")`,
+									},
+									types.VerbatimLine{
+										Content: `}`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should read Latin-1 content", func() {
+					source := `----
+include::../../test/includes/latin1-include.adoc[encoding=iso-8859-1]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `café crème brûlée`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should read UTF-16 content", func() {
+					source := `----
+include::../../test/includes/utf16-include.adoc[encoding=utf-16]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `héllo wörld`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should skip missing optional file", func() {
+					console, reset := ConfigureLogger()
+					defer reset()
+					source := `include::../../test/includes/unknown.adoc[opts=optional]
+
+a paragraph`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.BlankLine{},
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: "a paragraph",
+										},
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+					Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+				})
+
+				It("should include existing optional file", func() {
+					source := `include::../../test/includes/latin1-include.adoc[opts=optional,encoding=latin1]`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: "café crème brûlée",
+										},
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+			})
 		})
 
 	})
@@ -2773,5 +3014,106 @@ include::../../test/includes/hello_world.go.txt[lines=1]
 			}
 			Expect(ParseDocument(source)).To(MatchDocument(expected))
 		})
+
+		It("should include child and grandchild content from a custom filesystem", func() {
+			source := `include::parent.adoc[]`
+			fsys := fstest.MapFS{
+				"docs/parent.adoc": &fstest.MapFile{
+					Data: []byte("first line of parent\n\ninclude::includes/child.adoc[]"),
+				},
+				"docs/includes/child.adoc": &fstest.MapFile{
+					Data: []byte("first line of child\n\ninclude::../../grandchild.adoc[]"),
+				},
+				"grandchild.adoc": &fstest.MapFile{
+					Data: []byte("first line of grandchild"),
+				},
+			}
+			expected := types.Document{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "first line of parent",
+								},
+							},
+						},
+					},
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "first line of child",
+								},
+							},
+						},
+					},
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "first line of grandchild",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source,
+				configuration.WithFilename("docs/index.adoc"),
+				configuration.WithFilesystem(fsys))).To(MatchDocument(expected))
+		})
+
+		It("should resolve the intrinsic attributes of the root document in the nested files to include", func() {
+			source := `include::includes/child.adoc[]`
+			fsys := fstest.MapFS{
+				"docs/includes/child.adoc": &fstest.MapFile{
+					Data: []byte("include::{docname}-grandchild.adoc[]"),
+				},
+				"docs/includes/index-grandchild.adoc": &fstest.MapFile{
+					Data: []byte("grandchild of index"),
+				},
+				"docs/includes/child-grandchild.adoc": &fstest.MapFile{
+					Data: []byte("grandchild of child"),
+				},
+			}
+			expected := types.Document{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "grandchild of index",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source,
+				configuration.WithFilename("docs/index.adoc"),
+				configuration.WithFilesystem(fsys))).To(MatchDocument(expected))
+		})
+
+		It("should not include file outside of the custom filesystem", func() {
+			source := `include::../../secret.adoc[]`
+			fsys := fstest.MapFS{}
+			expected := types.Document{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "Unresolved directive in docs/index.adoc - include::../../secret.adoc[]",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source,
+				configuration.WithFilename("docs/index.adoc"),
+				configuration.WithFilesystem(fsys))).To(MatchDocument(expected))
+		})
 	})
 })
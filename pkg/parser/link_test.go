@@ -785,8 +785,10 @@ a link to {scheme}:{path}[] and https://foo.baz`
 				expected := types.Document{
 					Attributes: types.Attributes{
 						"scheme": "link",
+						"path":   "foo.bar", // only reset in the document body
 					},
 					Elements: []interface{}{
+						types.AttributeReset{Name: "path"}, // applied in order when rendering
 						types.Paragraph{
 							Lines: [][]interface{}{
 								{
@@ -1494,8 +1496,10 @@ a link to {scheme}://{path} and https://foo.baz`
 				expected := types.Document{
 					Attributes: types.Attributes{
 						"scheme": "https",
+						"path":   "foo.bar", // only reset in the document body
 					},
 					Elements: []interface{}{
+						types.AttributeReset{Name: "path"}, // applied in order when rendering
 						types.Paragraph{
 							Lines: [][]interface{}{
 								{
@@ -0,0 +1,280 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ParseError an error which occurred while parsing a document or a file to include
+type ParseError struct {
+	Position types.Position // the position of the error in the source document (which can be an included file)
+	Source   string         // the source line in which the error occurred
+	Rule     string         // the grammar rule that could not be matched
+	Expected []string       // the tokens that were expected by the grammar at the position of the error
+	Inner    error          // the underlying error
+}
+
+var _ error = ParseError{}
+
+// Error returns the error message, followed by the offending source line and a caret at the position of the error
+func (e ParseError) Error() string {
+	buf := &strings.Builder{}
+	if e.Position.IsSet() {
+		buf.WriteString(e.Position.String())
+		buf.WriteString(": ")
+	}
+	fmt.Fprintf(buf, "failed to parse %s", e.Rule)
+	if len(e.Expected) > 0 {
+		fmt.Fprintf(buf, ", expected %s", strings.Join(e.Expected, ", "))
+	} else if e.Inner != nil {
+		fmt.Fprintf(buf, ": %s", e.Inner.Error())
+	}
+	if e.Source != "" || e.Position.IsSet() {
+		buf.WriteString("\n")
+		buf.WriteString(e.Source)
+		buf.WriteString("\n")
+		if e.Position.Column > 1 {
+			buf.WriteString(strings.Repeat(" ", e.Position.Column-1))
+		}
+		buf.WriteString("^")
+	}
+	return buf.String()
+}
+
+// ParseErrors the errors which occurred while parsing a document and its included files, and from which the parser recovered
+// by retaining the malformed blocks as plain paragraphs. This error is returned along with the parsed document,
+// so that the caller can decide whether the document should be rendered anyway.
+type ParseErrors []ParseError
+
+var _ error = ParseErrors{}
+
+// Error returns the messages of all the errors, separated by a newline
+func (e ParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// recoveredErrors returns the errors from which the parser recovered if the given error is a `ParseErrors`,
+// or the given error otherwise
+func recoveredErrors(err error) (ParseErrors, error) {
+	if errs, ok := err.(ParseErrors); ok {
+		return errs, nil
+	}
+	return nil, err
+}
+
+// newParseError converts the (first) error returned by the parser into a `ParseError`,
+// in which the position is resolved with the given source map
+func newParseError(err error, data []byte, sm sourceMap, options ...Option) error {
+	var perr *parserError
+	if errs, ok := err.(errList); ok && len(errs) > 0 {
+		perr, _ = errs[0].(*parserError)
+	} else {
+		perr, _ = err.(*parserError)
+	}
+	if perr == nil {
+		return errors.Wrap(err, "failed to parse content")
+	}
+	result := ParseError{
+		Source:   sourceLine(data, perr.pos.line),
+		Rule:     entrypoint(options...),
+		Expected: perr.expected,
+		Inner:    perr.Inner,
+	}
+	// errors returned by an action in the grammar are reported with the rule in which they occurred
+	if i := strings.LastIndex(perr.prefix, "rule "); i >= 0 {
+		result.Rule = perr.prefix[i+len("rule "):]
+	}
+	if file, line, col, ok := sm.resolve(perr.pos.line, perr.pos.col); ok {
+		result.Position = types.Position{
+			File:      file,
+			Line:      line,
+			Column:    col,
+			EndLine:   line,
+			EndColumn: col,
+		}
+	}
+	return result
+}
+
+// sourceLine returns the line at the given (1-based) index in the given data
+func sourceLine(data []byte, line int) string {
+	lines := bytes.Split(data, []byte("\n"))
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	return string(bytes.TrimRight(lines[line-1], "\r"))
+}
+
+// parseWithRecovery parses the given data with the given options. If a parsing error occurs,
+// the malformed block (ie, the lines between the blank lines surrounding the position of the error, ignoring the blank lines
+// within the delimited blocks) is retained as a plain paragraph, the error is logged and the parsing resumes after the malformed block,
+// so that the rest of the document can be rendered.
+// Returns the parsed document along with the parsing errors which occurred in it.
+func parseWithRecovery(filename string, data []byte, sm sourceMap, options ...Option) (types.DraftDocument, []ParseError, error) {
+	d, err := Parse(filename, data, append(options, withSourceMap(sm))...)
+	if err == nil {
+		return d.(types.DraftDocument), nil, nil
+	}
+	perr, ok := newParseError(err, data, sm, options...).(ParseError)
+	if !ok {
+		return types.DraftDocument{}, nil, err
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	start, end, ok := malformedBlock(lines, parserErrorLine(err))
+	if !ok {
+		return types.DraftDocument{}, nil, perr
+	}
+	malformed := malformedParagraph(lines[start:end], start, sm)
+	if !perr.Position.IsSet() {
+		// the error occurred at the end of the content, which is not mapped in the source document
+		perr.Position = malformed.Position
+	}
+	log.WithFields(perr.Position.Fields()).Error(perr.Error())
+	errs := []ParseError{perr}
+	// parse the content before the malformed block
+	doc := types.DraftDocument{
+		Blocks: []interface{}{},
+	}
+	if start > 0 {
+		before, beforeErrs, err := parseWithRecovery(filename, bytes.Join(lines[:start], nil), sm, options...)
+		if err != nil {
+			return types.DraftDocument{}, nil, err
+		}
+		doc.FrontMatter = before.FrontMatter
+		doc.Blocks = append(doc.Blocks, before.Blocks...)
+		errs = append(errs, beforeErrs...)
+	}
+	// retain the malformed block as-is
+	doc.Blocks = append(doc.Blocks, malformed)
+	// parse the content after the malformed block
+	if end < len(lines) {
+		after, afterErrs, err := parseWithRecovery(filename, bytes.Join(lines[end:], nil), offsetSourceMap{sourceMap: sm, offset: end}, options...)
+		if err != nil {
+			return types.DraftDocument{}, nil, err
+		}
+		doc.Blocks = append(doc.Blocks, after.Blocks...)
+		errs = append(errs, afterErrs...)
+	}
+	return doc, errs, nil
+}
+
+// parserErrorLine returns the (1-based) line of the first error returned by the parser
+func parserErrorLine(err error) int {
+	if errs, ok := err.(errList); ok && len(errs) > 0 {
+		err = errs[0]
+	}
+	if perr, ok := err.(*parserError); ok {
+		return perr.pos.line
+	}
+	return 0
+}
+
+// malformedBlock returns the (0-based) range of lines of the block in which the error occurred at the given (1-based) line.
+// The block is delimited by the blank lines that precede and follow the error line, except the blank lines within
+// a delimited block (eg: a listing block), so that the malformed block never ends in the middle of a delimited block.
+// Returns `false` if the block could not be determined
+func malformedBlock(lines [][]byte, errLine int) (int, int, bool) {
+	boundaries := blockBoundaries(lines)
+	i := errLine - 1
+	if i >= len(lines) {
+		i = len(lines) - 1
+	}
+	// if the error occurred at the beginning of a blank line (or at the end of the content),
+	// then the malformed block is the one before
+	for i >= 0 && boundaries[i] {
+		i--
+	}
+	if i < 0 {
+		return 0, 0, false
+	}
+	start := i
+	for start > 0 && !boundaries[start-1] {
+		start--
+	}
+	end := i + 1
+	for end < len(lines) && !boundaries[end] {
+		end++
+	}
+	return start, end, true
+}
+
+// blockDelimiters the delimiters of the blocks which may contain blank lines
+var blockDelimiters = []string{"```", "----", "....", "====", "____", "****", "++++", "|===", "////"}
+
+// blockBoundaries returns, for each of the given lines, `true` if it is a blank line which separates two blocks,
+// i.e., a blank line which is not within a delimited block. A delimited block which is not closed
+// extends to the end of the content
+func blockBoundaries(lines [][]byte) []bool {
+	result := make([]bool, len(lines))
+	delimiter := "" // the delimiter of the current delimited block, if any
+	for i, l := range lines {
+		line := string(bytes.TrimRight(l, " \t\r\n"))
+		switch {
+		case delimiter != "":
+			if line == delimiter {
+				delimiter = ""
+			}
+		case isBlockDelimiter(line):
+			delimiter = line
+		default:
+			result[i] = isBlankLine(l)
+		}
+	}
+	return result
+}
+
+func isBlockDelimiter(line string) bool {
+	for _, d := range blockDelimiters {
+		if line == d {
+			return true
+		}
+	}
+	return false
+}
+
+func isBlankLine(line []byte) bool {
+	return len(bytes.TrimSpace(line)) == 0
+}
+
+// malformedParagraph returns a paragraph with the raw content of the given lines, which start at the given (0-based) index
+func malformedParagraph(lines [][]byte, start int, sm sourceMap) types.Paragraph {
+	p := types.Paragraph{
+		Lines: make([][]interface{}, len(lines)),
+	}
+	for i, l := range lines {
+		p.Lines[i] = []interface{}{
+			types.StringElement{
+				Content: string(bytes.TrimRight(l, "\r\n")),
+			},
+		}
+	}
+	if file, line, col, ok := sm.resolve(start+1, 1); ok {
+		last := bytes.TrimRight(lines[len(lines)-1], "\r\n")
+		_, endLine, endCol, ok := sm.resolve(start+len(lines), 1+utf8.RuneCount(last))
+		if !ok {
+			endLine, endCol = line, col
+		}
+		p.Position = types.Position{
+			File:      file,
+			Line:      line,
+			Column:    col,
+			EndLine:   endLine,
+			EndColumn: endCol,
+		}
+	}
+	return p
+}
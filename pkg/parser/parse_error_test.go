@@ -0,0 +1,170 @@
+package parser_test
+
+import (
+	"errors"
+	"strings"
+	"testing/fstest"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("parse errors", func() {
+
+	Context("error messages", func() {
+
+		It("should report the position, the expected tokens and the source line", func() {
+			err := parser.ParseError{
+				Position: types.Position{
+					File:   "chapter.adoc",
+					Line:   3,
+					Column: 6,
+				},
+				Source:   "[[a]]",
+				Rule:     "AsciidocDocument",
+				Expected: []string{`"\n"`, `"["`},
+			}
+			Expect(err.Error()).To(Equal(`chapter.adoc:3:6: failed to parse AsciidocDocument, expected "\n", "["
+[[a]]
+     ^`))
+		})
+
+		It("should report the underlying error when no token was expected", func() {
+			err := parser.ParseError{
+				Position: types.Position{
+					Line:   1,
+					Column: 1,
+				},
+				Source: "foo",
+				Rule:   "AsciidocDocument",
+				Inner:  errors.New("invalid encoding"),
+			}
+			Expect(err.Error()).To(Equal(`1:1: failed to parse AsciidocDocument: invalid encoding
+foo
+^`))
+		})
+	})
+
+	Context("recovery", func() {
+
+		parse := func(source string, fsys fstest.MapFS) (types.DraftDocument, parser.ParseErrors) {
+			fsys["index.adoc"] = &fstest.MapFile{
+				Data: []byte(source),
+			}
+			doc, err := parser.ParseDraftDocument(strings.NewReader(source), configuration.NewConfiguration(
+				configuration.WithFilename("index.adoc"),
+				configuration.WithFilesystem(fsys),
+			))
+			Expect(err).To(BeAssignableToTypeOf(parser.ParseErrors{}))
+			return doc, err.(parser.ParseErrors)
+		}
+
+		It("should retain the malformed block and parse the rest of the document", func() {
+			source := "a paragraph\n\n\xff malformed\n\nanother *paragraph*"
+			doc, errs := parse(source, fstest.MapFS{})
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Position.Line).To(Equal(3))
+			Expect(doc.Blocks).To(HaveLen(5))
+			Expect(doc.Blocks[2]).To(Equal(types.Paragraph{
+				Lines: [][]interface{}{
+					{
+						types.StringElement{Content: "\xff malformed"},
+					},
+				},
+				Position: types.Position{
+					File:      "index.adoc",
+					Line:      3,
+					Column:    1,
+					EndLine:   3,
+					EndColumn: 12,
+				},
+			}))
+			p := doc.Blocks[4].(types.Paragraph)
+			Expect(p.Lines[0][1]).To(BeAssignableToTypeOf(types.QuotedText{}))
+			Expect(p.Position).To(Equal(types.Position{
+				File:      "index.adoc",
+				Line:      5,
+				Column:    1,
+				EndLine:   5,
+				EndColumn: 20,
+			}))
+		})
+
+		It("should retain the whole delimited block containing a blank line and a malformed table", func() {
+			source := "a paragraph\n\n====\nsome text\n\n|===\n| \xff cell\n|===\n====\n\nanother paragraph"
+			doc, errs := parse(source, fstest.MapFS{})
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Position.Line).To(Equal(7))
+			Expect(doc.Blocks).To(HaveLen(5))
+			Expect(doc.Blocks[2]).To(Equal(types.Paragraph{
+				Lines: [][]interface{}{
+					{
+						types.StringElement{Content: "===="},
+					},
+					{
+						types.StringElement{Content: "some text"},
+					},
+					{
+						types.StringElement{Content: ""},
+					},
+					{
+						types.StringElement{Content: "|==="},
+					},
+					{
+						types.StringElement{Content: "| \xff cell"},
+					},
+					{
+						types.StringElement{Content: "|==="},
+					},
+					{
+						types.StringElement{Content: "===="},
+					},
+				},
+				Position: types.Position{
+					File:      "index.adoc",
+					Line:      3,
+					Column:    1,
+					EndLine:   9,
+					EndColumn: 5,
+				},
+			}))
+			Expect(doc.Blocks[4].(types.Paragraph).Position.Line).To(Equal(11))
+		})
+
+		It("should retain the malformed block at the end of the document", func() {
+			source := "a paragraph\n\n[[a]]"
+			doc, errs := parse(source, fstest.MapFS{})
+			Expect(errs).To(HaveLen(1))
+			Expect(doc.Blocks).To(HaveLen(3))
+			Expect(doc.Blocks[2].(types.Paragraph).Lines).To(Equal([][]interface{}{
+				{
+					types.StringElement{Content: "[[a]]"},
+				},
+			}))
+		})
+
+		It("should retain the malformed block in an included file", func() {
+			source := "include::chapter.adoc[]\n\nlast paragraph"
+			doc, errs := parse(source, fstest.MapFS{
+				"chapter.adoc": &fstest.MapFile{
+					Data: []byte("== Chapter\n\n[[a]]\n"),
+				},
+			})
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Position.File).To(Equal("chapter.adoc"))
+			Expect(doc.Blocks).To(HaveLen(5))
+			Expect(doc.Blocks[2].(types.Paragraph).Position).To(Equal(types.Position{
+				File:      "chapter.adoc",
+				Line:      3,
+				Column:    1,
+				EndLine:   3,
+				EndColumn: 6,
+			}))
+			Expect(doc.Blocks[4].(types.Paragraph).Position.File).To(Equal("index.adoc"))
+		})
+	})
+})
@@ -154,70 +154,70 @@ var g = &grammar{
 					},
 					&ruleRefExpr{
 						pos:  position{line: 44, col: 11, offset: 1253},
+						name: "TableOfContentsPlaceHolder",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 45, col: 11, offset: 1329},
 						name: "SimpleParagraph",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 45, col: 11, offset: 1279},
+						pos:  position{line: 46, col: 11, offset: 1355},
 						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 46, col: 11, offset: 1331},
+						pos:  position{line: 47, col: 11, offset: 1407},
 						name: "Section",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 47, col: 11, offset: 1349},
+						pos:  position{line: 48, col: 11, offset: 1425},
 						name: "DelimitedBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 48, col: 11, offset: 1374},
+						pos:  position{line: 49, col: 11, offset: 1450},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 49, col: 11, offset: 1398},
+						pos:  position{line: 50, col: 11, offset: 1474},
 						name: "VerseParagraph",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 50, col: 11, offset: 1452},
+						pos:  position{line: 51, col: 11, offset: 1528},
 						name: "ImageBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 51, col: 11, offset: 1474},
+						pos:  position{line: 52, col: 11, offset: 1550},
 						name: "OrderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 52, col: 11, offset: 1501},
+						pos:  position{line: 53, col: 11, offset: 1577},
 						name: "UnorderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 53, col: 11, offset: 1530},
+						pos:  position{line: 54, col: 11, offset: 1606},
 						name: "CalloutListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 54, col: 11, offset: 1556},
+						pos:  position{line: 55, col: 11, offset: 1632},
 						name: "ContinuedListItemElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 55, col: 11, offset: 1591},
+						pos:  position{line: 56, col: 11, offset: 1667},
 						name: "LiteralBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 56, col: 11, offset: 1615},
+						pos:  position{line: 57, col: 11, offset: 1691},
 						name: "AttributeDeclaration",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 57, col: 11, offset: 1647},
+						pos:  position{line: 58, col: 11, offset: 1723},
 						name: "AttributeReset",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 11, offset: 1673},
-						name: "TableOfContentsPlaceHolder",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 59, col: 11, offset: 1710},
+						pos:  position{line: 59, col: 11, offset: 1749},
 						name: "UserMacroBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 60, col: 11, offset: 1735},
+						pos:  position{line: 60, col: 11, offset: 1774},
 						name: "Paragraph",
 					},
 				},
@@ -225,14 +225,14 @@ var g = &grammar{
 		},
 		{
 			name: "AsciidocDocumentBlocksWithinDelimitedBlock",
-			pos:  position{line: 62, col: 1, offset: 1746},
+			pos:  position{line: 62, col: 1, offset: 1785},
 			expr: &labeledExpr{
-				pos:   position{line: 62, col: 47, offset: 1792},
+				pos:   position{line: 62, col: 47, offset: 1831},
 				label: "blocks",
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 62, col: 54, offset: 1799},
+					pos: position{line: 62, col: 54, offset: 1838},
 					expr: &ruleRefExpr{
-						pos:  position{line: 62, col: 55, offset: 1800},
+						pos:  position{line: 62, col: 55, offset: 1839},
 						name: "DocumentBlockWithinDelimitedBlock",
 					},
 				},
@@ -240,84 +240,84 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentBlockWithinDelimitedBlock",
-			pos:  position{line: 64, col: 1, offset: 1837},
+			pos:  position{line: 64, col: 1, offset: 1876},
 			expr: &actionExpr{
-				pos: position{line: 64, col: 38, offset: 1874},
+				pos: position{line: 64, col: 38, offset: 1913},
 				run: (*parser).callonDocumentBlockWithinDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 64, col: 38, offset: 1874},
+					pos: position{line: 64, col: 38, offset: 1913},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 64, col: 38, offset: 1874},
+							pos: position{line: 64, col: 38, offset: 1913},
 							expr: &ruleRefExpr{
-								pos:  position{line: 64, col: 39, offset: 1875},
+								pos:  position{line: 64, col: 39, offset: 1914},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 65, col: 5, offset: 1884},
+							pos:   position{line: 65, col: 5, offset: 1923},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 65, col: 12, offset: 1891},
+								pos: position{line: 65, col: 12, offset: 1930},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 65, col: 12, offset: 1891},
+										pos:  position{line: 65, col: 12, offset: 1930},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 66, col: 11, offset: 1916},
+										pos:  position{line: 66, col: 11, offset: 1955},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 67, col: 11, offset: 1968},
+										pos:  position{line: 67, col: 11, offset: 2007},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 68, col: 11, offset: 1992},
+										pos:  position{line: 68, col: 11, offset: 2031},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 69, col: 11, offset: 2017},
+										pos:  position{line: 69, col: 11, offset: 2056},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 70, col: 11, offset: 2039},
+										pos:  position{line: 70, col: 11, offset: 2078},
 										name: "OrderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 71, col: 11, offset: 2066},
+										pos:  position{line: 71, col: 11, offset: 2105},
 										name: "UnorderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 72, col: 11, offset: 2095},
+										pos:  position{line: 72, col: 11, offset: 2134},
 										name: "LabeledListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 73, col: 11, offset: 2122},
+										pos:  position{line: 73, col: 11, offset: 2161},
 										name: "ContinuedListItemElement",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 74, col: 11, offset: 2157},
+										pos:  position{line: 74, col: 11, offset: 2196},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 75, col: 11, offset: 2181},
+										pos:  position{line: 75, col: 11, offset: 2220},
 										name: "AttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 11, offset: 2213},
+										pos:  position{line: 76, col: 11, offset: 2252},
 										name: "AttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 77, col: 11, offset: 2239},
+										pos:  position{line: 77, col: 11, offset: 2278},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 78, col: 11, offset: 2276},
+										pos:  position{line: 78, col: 11, offset: 2315},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 79, col: 11, offset: 2301},
+										pos:  position{line: 79, col: 11, offset: 2340},
 										name: "Paragraph",
 									},
 								},
@@ -329,14 +329,14 @@ var g = &grammar{
 		},
 		{
 			name: "TextDocumentBlocks",
-			pos:  position{line: 83, col: 1, offset: 2339},
+			pos:  position{line: 83, col: 1, offset: 2378},
 			expr: &labeledExpr{
-				pos:   position{line: 83, col: 23, offset: 2361},
+				pos:   position{line: 83, col: 23, offset: 2400},
 				label: "blocks",
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 83, col: 30, offset: 2368},
+					pos: position{line: 83, col: 30, offset: 2407},
 					expr: &ruleRefExpr{
-						pos:  position{line: 83, col: 31, offset: 2369},
+						pos:  position{line: 83, col: 31, offset: 2408},
 						name: "TextDocumentBlock",
 					},
 				},
@@ -344,32 +344,32 @@ var g = &grammar{
 		},
 		{
 			name: "TextDocumentBlock",
-			pos:  position{line: 85, col: 1, offset: 2390},
+			pos:  position{line: 85, col: 1, offset: 2429},
 			expr: &actionExpr{
-				pos: position{line: 85, col: 22, offset: 2411},
+				pos: position{line: 85, col: 22, offset: 2450},
 				run: (*parser).callonTextDocumentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 85, col: 22, offset: 2411},
+					pos: position{line: 85, col: 22, offset: 2450},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 85, col: 22, offset: 2411},
+							pos: position{line: 85, col: 22, offset: 2450},
 							expr: &ruleRefExpr{
-								pos:  position{line: 85, col: 23, offset: 2412},
+								pos:  position{line: 85, col: 23, offset: 2451},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 86, col: 5, offset: 2421},
+							pos:   position{line: 86, col: 5, offset: 2460},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 86, col: 12, offset: 2428},
+								pos: position{line: 86, col: 12, offset: 2467},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 86, col: 12, offset: 2428},
+										pos:  position{line: 86, col: 12, offset: 2467},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 86, col: 24, offset: 2440},
+										pos:  position{line: 86, col: 24, offset: 2479},
 										name: "Paragraph",
 									},
 								},
@@ -381,38 +381,38 @@ var g = &grammar{
 		},
 		{
 			name: "FrontMatter",
-			pos:  position{line: 93, col: 1, offset: 2586},
+			pos:  position{line: 93, col: 1, offset: 2625},
 			expr: &ruleRefExpr{
-				pos:  position{line: 93, col: 16, offset: 2601},
+				pos:  position{line: 93, col: 16, offset: 2640},
 				name: "YamlFrontMatter",
 			},
 		},
 		{
 			name: "YamlFrontMatter",
-			pos:  position{line: 95, col: 1, offset: 2619},
+			pos:  position{line: 95, col: 1, offset: 2658},
 			expr: &actionExpr{
-				pos: position{line: 95, col: 20, offset: 2638},
+				pos: position{line: 95, col: 20, offset: 2677},
 				run: (*parser).callonYamlFrontMatter1,
 				expr: &seqExpr{
-					pos: position{line: 95, col: 20, offset: 2638},
+					pos: position{line: 95, col: 20, offset: 2677},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 95, col: 20, offset: 2638},
+							pos:  position{line: 95, col: 20, offset: 2677},
 							name: "YamlFrontMatterToken",
 						},
 						&labeledExpr{
-							pos:   position{line: 95, col: 41, offset: 2659},
+							pos:   position{line: 95, col: 41, offset: 2698},
 							label: "content",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 95, col: 49, offset: 2667},
+								pos: position{line: 95, col: 49, offset: 2706},
 								expr: &ruleRefExpr{
-									pos:  position{line: 95, col: 50, offset: 2668},
+									pos:  position{line: 95, col: 50, offset: 2707},
 									name: "YamlFrontMatterContent",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 95, col: 75, offset: 2693},
+							pos:  position{line: 95, col: 75, offset: 2732},
 							name: "YamlFrontMatterToken",
 						},
 					},
@@ -421,25 +421,25 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterToken",
-			pos:  position{line: 99, col: 1, offset: 2773},
+			pos:  position{line: 99, col: 1, offset: 2812},
 			expr: &seqExpr{
-				pos: position{line: 99, col: 26, offset: 2798},
+				pos: position{line: 99, col: 26, offset: 2837},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 99, col: 26, offset: 2798},
+						pos:        position{line: 99, col: 26, offset: 2837},
 						val:        "---",
 						ignoreCase: false,
 						want:       "\"---\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 99, col: 32, offset: 2804},
+						pos: position{line: 99, col: 32, offset: 2843},
 						expr: &ruleRefExpr{
-							pos:  position{line: 99, col: 32, offset: 2804},
+							pos:  position{line: 99, col: 32, offset: 2843},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 99, col: 39, offset: 2811},
+						pos:  position{line: 99, col: 39, offset: 2850},
 						name: "EOL",
 					},
 				},
@@ -447,26 +447,26 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterContent",
-			pos:  position{line: 101, col: 1, offset: 2816},
+			pos:  position{line: 101, col: 1, offset: 2855},
 			expr: &actionExpr{
-				pos: position{line: 101, col: 27, offset: 2842},
+				pos: position{line: 101, col: 27, offset: 2881},
 				run: (*parser).callonYamlFrontMatterContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 101, col: 27, offset: 2842},
+					pos: position{line: 101, col: 27, offset: 2881},
 					expr: &oneOrMoreExpr{
-						pos: position{line: 101, col: 28, offset: 2843},
+						pos: position{line: 101, col: 28, offset: 2882},
 						expr: &seqExpr{
-							pos: position{line: 101, col: 29, offset: 2844},
+							pos: position{line: 101, col: 29, offset: 2883},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 101, col: 29, offset: 2844},
+									pos: position{line: 101, col: 29, offset: 2883},
 									expr: &ruleRefExpr{
-										pos:  position{line: 101, col: 30, offset: 2845},
+										pos:  position{line: 101, col: 30, offset: 2884},
 										name: "YamlFrontMatterToken",
 									},
 								},
 								&anyMatcher{
-									line: 101, col: 51, offset: 2866,
+									line: 101, col: 51, offset: 2905,
 								},
 							},
 						},
@@ -476,99 +476,99 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentHeader",
-			pos:  position{line: 108, col: 1, offset: 3032},
+			pos:  position{line: 108, col: 1, offset: 3071},
 			expr: &actionExpr{
-				pos: position{line: 108, col: 19, offset: 3050},
+				pos: position{line: 108, col: 19, offset: 3089},
 				run: (*parser).callonDocumentHeader1,
 				expr: &seqExpr{
-					pos: position{line: 108, col: 19, offset: 3050},
+					pos: position{line: 108, col: 19, offset: 3089},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 108, col: 19, offset: 3050},
+							pos:        position{line: 108, col: 19, offset: 3089},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 108, col: 23, offset: 3054},
+							pos: position{line: 108, col: 23, offset: 3093},
 							expr: &ruleRefExpr{
-								pos:  position{line: 108, col: 23, offset: 3054},
+								pos:  position{line: 108, col: 23, offset: 3093},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 108, col: 30, offset: 3061},
+							pos:   position{line: 108, col: 30, offset: 3100},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 108, col: 37, offset: 3068},
+								pos:  position{line: 108, col: 37, offset: 3107},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 108, col: 52, offset: 3083},
+							pos:   position{line: 108, col: 52, offset: 3122},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 108, col: 56, offset: 3087},
+								pos: position{line: 108, col: 56, offset: 3126},
 								expr: &ruleRefExpr{
-									pos:  position{line: 108, col: 56, offset: 3087},
+									pos:  position{line: 108, col: 56, offset: 3126},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 108, col: 74, offset: 3105},
+							pos:  position{line: 108, col: 74, offset: 3144},
 							name: "EOL",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 109, col: 9, offset: 3117},
+							pos: position{line: 109, col: 9, offset: 3156},
 							expr: &choiceExpr{
-								pos: position{line: 109, col: 10, offset: 3118},
+								pos: position{line: 109, col: 10, offset: 3157},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 109, col: 10, offset: 3118},
+										pos:  position{line: 109, col: 10, offset: 3157},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 109, col: 30, offset: 3138},
+										pos:  position{line: 109, col: 30, offset: 3177},
 										name: "CommentBlock",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 110, col: 9, offset: 3161},
+							pos:   position{line: 110, col: 9, offset: 3200},
 							label: "authors",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 110, col: 18, offset: 3170},
+								pos: position{line: 110, col: 18, offset: 3209},
 								expr: &ruleRefExpr{
-									pos:  position{line: 110, col: 18, offset: 3170},
+									pos:  position{line: 110, col: 18, offset: 3209},
 									name: "DocumentAuthors",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 111, col: 9, offset: 3197},
+							pos: position{line: 111, col: 9, offset: 3236},
 							expr: &choiceExpr{
-								pos: position{line: 111, col: 10, offset: 3198},
+								pos: position{line: 111, col: 10, offset: 3237},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 111, col: 10, offset: 3198},
+										pos:  position{line: 111, col: 10, offset: 3237},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 111, col: 30, offset: 3218},
+										pos:  position{line: 111, col: 30, offset: 3257},
 										name: "CommentBlock",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 112, col: 9, offset: 3241},
+							pos:   position{line: 112, col: 9, offset: 3280},
 							label: "revision",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 112, col: 19, offset: 3251},
+								pos: position{line: 112, col: 19, offset: 3290},
 								expr: &ruleRefExpr{
-									pos:  position{line: 112, col: 19, offset: 3251},
+									pos:  position{line: 112, col: 19, offset: 3290},
 									name: "DocumentRevision",
 								},
 							},
@@ -579,16 +579,16 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthors",
-			pos:  position{line: 116, col: 1, offset: 3352},
+			pos:  position{line: 116, col: 1, offset: 3411},
 			expr: &choiceExpr{
-				pos: position{line: 116, col: 20, offset: 3371},
+				pos: position{line: 116, col: 20, offset: 3430},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 116, col: 20, offset: 3371},
+						pos:  position{line: 116, col: 20, offset: 3430},
 						name: "DocumentAuthorsInlineForm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 116, col: 48, offset: 3399},
+						pos:  position{line: 116, col: 48, offset: 3458},
 						name: "DocumentAuthorsAttributeForm",
 					},
 				},
@@ -596,42 +596,42 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsInlineForm",
-			pos:  position{line: 118, col: 1, offset: 3429},
+			pos:  position{line: 118, col: 1, offset: 3488},
 			expr: &actionExpr{
-				pos: position{line: 118, col: 30, offset: 3458},
+				pos: position{line: 118, col: 30, offset: 3517},
 				run: (*parser).callonDocumentAuthorsInlineForm1,
 				expr: &seqExpr{
-					pos: position{line: 118, col: 30, offset: 3458},
+					pos: position{line: 118, col: 30, offset: 3517},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 118, col: 30, offset: 3458},
+							pos: position{line: 118, col: 30, offset: 3517},
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 30, offset: 3458},
+								pos:  position{line: 118, col: 30, offset: 3517},
 								name: "Space",
 							},
 						},
 						&notExpr{
-							pos: position{line: 118, col: 37, offset: 3465},
+							pos: position{line: 118, col: 37, offset: 3524},
 							expr: &litMatcher{
-								pos:        position{line: 118, col: 38, offset: 3466},
+								pos:        position{line: 118, col: 38, offset: 3525},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 118, col: 42, offset: 3470},
+							pos:   position{line: 118, col: 42, offset: 3529},
 							label: "authors",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 118, col: 51, offset: 3479},
+								pos: position{line: 118, col: 51, offset: 3538},
 								expr: &ruleRefExpr{
-									pos:  position{line: 118, col: 51, offset: 3479},
+									pos:  position{line: 118, col: 51, offset: 3538},
 									name: "DocumentAuthor",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 118, col: 68, offset: 3496},
+							pos:  position{line: 118, col: 68, offset: 3555},
 							name: "EOL",
 						},
 					},
@@ -640,36 +640,36 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsAttributeForm",
-			pos:  position{line: 122, col: 1, offset: 3566},
+			pos:  position{line: 122, col: 1, offset: 3625},
 			expr: &actionExpr{
-				pos: position{line: 122, col: 33, offset: 3598},
+				pos: position{line: 122, col: 33, offset: 3657},
 				run: (*parser).callonDocumentAuthorsAttributeForm1,
 				expr: &seqExpr{
-					pos: position{line: 122, col: 33, offset: 3598},
+					pos: position{line: 122, col: 33, offset: 3657},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 122, col: 33, offset: 3598},
+							pos: position{line: 122, col: 33, offset: 3657},
 							expr: &ruleRefExpr{
-								pos:  position{line: 122, col: 33, offset: 3598},
+								pos:  position{line: 122, col: 33, offset: 3657},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 122, col: 40, offset: 3605},
+							pos:        position{line: 122, col: 40, offset: 3664},
 							val:        ":author:",
 							ignoreCase: false,
 							want:       "\":author:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 122, col: 51, offset: 3616},
+							pos:   position{line: 122, col: 51, offset: 3675},
 							label: "author",
 							expr: &ruleRefExpr{
-								pos:  position{line: 122, col: 59, offset: 3624},
+								pos:  position{line: 122, col: 59, offset: 3683},
 								name: "DocumentAuthor",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 122, col: 75, offset: 3640},
+							pos:  position{line: 122, col: 75, offset: 3699},
 							name: "EOL",
 						},
 					},
@@ -678,59 +678,59 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthor",
-			pos:  position{line: 126, col: 1, offset: 3719},
+			pos:  position{line: 126, col: 1, offset: 3778},
 			expr: &actionExpr{
-				pos: position{line: 126, col: 19, offset: 3737},
+				pos: position{line: 126, col: 19, offset: 3796},
 				run: (*parser).callonDocumentAuthor1,
 				expr: &seqExpr{
-					pos: position{line: 126, col: 19, offset: 3737},
+					pos: position{line: 126, col: 19, offset: 3796},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 126, col: 19, offset: 3737},
+							pos: position{line: 126, col: 19, offset: 3796},
 							expr: &ruleRefExpr{
-								pos:  position{line: 126, col: 19, offset: 3737},
+								pos:  position{line: 126, col: 19, offset: 3796},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 126, col: 26, offset: 3744},
+							pos:   position{line: 126, col: 26, offset: 3803},
 							label: "fullname",
 							expr: &ruleRefExpr{
-								pos:  position{line: 126, col: 36, offset: 3754},
+								pos:  position{line: 126, col: 36, offset: 3813},
 								name: "DocumentAuthorName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 126, col: 56, offset: 3774},
+							pos:   position{line: 126, col: 56, offset: 3833},
 							label: "email",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 126, col: 62, offset: 3780},
+								pos: position{line: 126, col: 62, offset: 3839},
 								expr: &ruleRefExpr{
-									pos:  position{line: 126, col: 63, offset: 3781},
+									pos:  position{line: 126, col: 63, offset: 3840},
 									name: "DocumentAuthorEmail",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 126, col: 85, offset: 3803},
+							pos: position{line: 126, col: 85, offset: 3862},
 							expr: &ruleRefExpr{
-								pos:  position{line: 126, col: 85, offset: 3803},
+								pos:  position{line: 126, col: 85, offset: 3862},
 								name: "Space",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 126, col: 92, offset: 3810},
+							pos: position{line: 126, col: 92, offset: 3869},
 							expr: &litMatcher{
-								pos:        position{line: 126, col: 92, offset: 3810},
+								pos:        position{line: 126, col: 92, offset: 3869},
 								val:        ";",
 								ignoreCase: false,
 								want:       "\";\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 126, col: 97, offset: 3815},
+							pos: position{line: 126, col: 97, offset: 3874},
 							expr: &ruleRefExpr{
-								pos:  position{line: 126, col: 97, offset: 3815},
+								pos:  position{line: 126, col: 97, offset: 3874},
 								name: "Space",
 							},
 						},
@@ -740,14 +740,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorName",
-			pos:  position{line: 131, col: 1, offset: 3960},
+			pos:  position{line: 131, col: 1, offset: 4019},
 			expr: &actionExpr{
-				pos: position{line: 131, col: 23, offset: 3982},
+				pos: position{line: 131, col: 23, offset: 4041},
 				run: (*parser).callonDocumentAuthorName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 131, col: 23, offset: 3982},
+					pos: position{line: 131, col: 23, offset: 4041},
 					expr: &charClassMatcher{
-						pos:        position{line: 131, col: 23, offset: 3982},
+						pos:        position{line: 131, col: 23, offset: 4041},
 						val:        "[^<;\\r\\n]",
 						chars:      []rune{'<', ';', '\r', '\n'},
 						ignoreCase: false,
@@ -758,29 +758,29 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorEmail",
-			pos:  position{line: 135, col: 1, offset: 4029},
+			pos:  position{line: 135, col: 1, offset: 4088},
 			expr: &actionExpr{
-				pos: position{line: 135, col: 24, offset: 4052},
+				pos: position{line: 135, col: 24, offset: 4111},
 				run: (*parser).callonDocumentAuthorEmail1,
 				expr: &seqExpr{
-					pos: position{line: 135, col: 24, offset: 4052},
+					pos: position{line: 135, col: 24, offset: 4111},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 135, col: 24, offset: 4052},
+							pos:        position{line: 135, col: 24, offset: 4111},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 135, col: 28, offset: 4056},
+							pos:   position{line: 135, col: 28, offset: 4115},
 							label: "email",
 							expr: &actionExpr{
-								pos: position{line: 135, col: 35, offset: 4063},
+								pos: position{line: 135, col: 35, offset: 4122},
 								run: (*parser).callonDocumentAuthorEmail5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 135, col: 36, offset: 4064},
+									pos: position{line: 135, col: 36, offset: 4123},
 									expr: &charClassMatcher{
-										pos:        position{line: 135, col: 36, offset: 4064},
+										pos:        position{line: 135, col: 36, offset: 4123},
 										val:        "[^>\\r\\n]",
 										chars:      []rune{'>', '\r', '\n'},
 										ignoreCase: false,
@@ -790,7 +790,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 137, col: 4, offset: 4111},
+							pos:        position{line: 137, col: 4, offset: 4170},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
@@ -801,85 +801,85 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevision",
-			pos:  position{line: 143, col: 1, offset: 4272},
+			pos:  position{line: 143, col: 1, offset: 4331},
 			expr: &actionExpr{
-				pos: position{line: 143, col: 21, offset: 4292},
+				pos: position{line: 143, col: 21, offset: 4351},
 				run: (*parser).callonDocumentRevision1,
 				expr: &seqExpr{
-					pos: position{line: 143, col: 21, offset: 4292},
+					pos: position{line: 143, col: 21, offset: 4351},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 143, col: 21, offset: 4292},
+							pos: position{line: 143, col: 21, offset: 4351},
 							expr: &ruleRefExpr{
-								pos:  position{line: 143, col: 21, offset: 4292},
+								pos:  position{line: 143, col: 21, offset: 4351},
 								name: "Space",
 							},
 						},
 						&notExpr{
-							pos: position{line: 143, col: 28, offset: 4299},
+							pos: position{line: 143, col: 28, offset: 4358},
 							expr: &litMatcher{
-								pos:        position{line: 143, col: 29, offset: 4300},
+								pos:        position{line: 143, col: 29, offset: 4359},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 143, col: 33, offset: 4304},
+							pos:   position{line: 143, col: 33, offset: 4363},
 							label: "revision",
 							expr: &choiceExpr{
-								pos: position{line: 144, col: 9, offset: 4323},
+								pos: position{line: 144, col: 9, offset: 4382},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 144, col: 10, offset: 4324},
+										pos: position{line: 144, col: 10, offset: 4383},
 										run: (*parser).callonDocumentRevision9,
 										expr: &seqExpr{
-											pos: position{line: 144, col: 10, offset: 4324},
+											pos: position{line: 144, col: 10, offset: 4383},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 144, col: 10, offset: 4324},
+													pos:   position{line: 144, col: 10, offset: 4383},
 													label: "revnumber",
 													expr: &ruleRefExpr{
-														pos:  position{line: 144, col: 21, offset: 4335},
+														pos:  position{line: 144, col: 21, offset: 4394},
 														name: "DocumentRevisionNumber",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 144, col: 45, offset: 4359},
+													pos: position{line: 144, col: 45, offset: 4418},
 													expr: &litMatcher{
-														pos:        position{line: 144, col: 45, offset: 4359},
+														pos:        position{line: 144, col: 45, offset: 4418},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 144, col: 50, offset: 4364},
+													pos:   position{line: 144, col: 50, offset: 4423},
 													label: "revdate",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 144, col: 58, offset: 4372},
+														pos: position{line: 144, col: 58, offset: 4431},
 														expr: &ruleRefExpr{
-															pos:  position{line: 144, col: 59, offset: 4373},
+															pos:  position{line: 144, col: 59, offset: 4432},
 															name: "DocumentRevisionDate",
 														},
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 144, col: 82, offset: 4396},
+													pos: position{line: 144, col: 82, offset: 4455},
 													expr: &litMatcher{
-														pos:        position{line: 144, col: 82, offset: 4396},
+														pos:        position{line: 144, col: 82, offset: 4455},
 														val:        ":",
 														ignoreCase: false,
 														want:       "\":\"",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 144, col: 87, offset: 4401},
+													pos:   position{line: 144, col: 87, offset: 4460},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 144, col: 97, offset: 4411},
+														pos: position{line: 144, col: 97, offset: 4470},
 														expr: &ruleRefExpr{
-															pos:  position{line: 144, col: 98, offset: 4412},
+															pos:  position{line: 144, col: 98, offset: 4471},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -888,35 +888,35 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 146, col: 15, offset: 4529},
+										pos: position{line: 146, col: 15, offset: 4588},
 										run: (*parser).callonDocumentRevision23,
 										expr: &seqExpr{
-											pos: position{line: 146, col: 15, offset: 4529},
+											pos: position{line: 146, col: 15, offset: 4588},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 146, col: 15, offset: 4529},
+													pos:   position{line: 146, col: 15, offset: 4588},
 													label: "revdate",
 													expr: &ruleRefExpr{
-														pos:  position{line: 146, col: 24, offset: 4538},
+														pos:  position{line: 146, col: 24, offset: 4597},
 														name: "DocumentRevisionDate",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 146, col: 46, offset: 4560},
+													pos: position{line: 146, col: 46, offset: 4619},
 													expr: &litMatcher{
-														pos:        position{line: 146, col: 46, offset: 4560},
+														pos:        position{line: 146, col: 46, offset: 4619},
 														val:        ":",
 														ignoreCase: false,
 														want:       "\":\"",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 146, col: 51, offset: 4565},
+													pos:   position{line: 146, col: 51, offset: 4624},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 146, col: 61, offset: 4575},
+														pos: position{line: 146, col: 61, offset: 4634},
 														expr: &ruleRefExpr{
-															pos:  position{line: 146, col: 62, offset: 4576},
+															pos:  position{line: 146, col: 62, offset: 4635},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -928,7 +928,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 148, col: 13, offset: 4685},
+							pos:  position{line: 148, col: 13, offset: 4744},
 							name: "EOL",
 						},
 					},
@@ -937,30 +937,30 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionNumber",
-			pos:  position{line: 153, col: 1, offset: 4815},
+			pos:  position{line: 153, col: 1, offset: 4874},
 			expr: &choiceExpr{
-				pos: position{line: 153, col: 27, offset: 4841},
+				pos: position{line: 153, col: 27, offset: 4900},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 153, col: 27, offset: 4841},
+						pos: position{line: 153, col: 27, offset: 4900},
 						run: (*parser).callonDocumentRevisionNumber2,
 						expr: &seqExpr{
-							pos: position{line: 153, col: 27, offset: 4841},
+							pos: position{line: 153, col: 27, offset: 4900},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 153, col: 27, offset: 4841},
+									pos:        position{line: 153, col: 27, offset: 4900},
 									val:        "v",
 									ignoreCase: true,
 									want:       "\"v\"i",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 153, col: 32, offset: 4846},
+									pos:  position{line: 153, col: 32, offset: 4905},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 153, col: 39, offset: 4853},
+									pos: position{line: 153, col: 39, offset: 4912},
 									expr: &charClassMatcher{
-										pos:        position{line: 153, col: 39, offset: 4853},
+										pos:        position{line: 153, col: 39, offset: 4912},
 										val:        "[^:,\\r\\n]",
 										chars:      []rune{':', ',', '\r', '\n'},
 										ignoreCase: false,
@@ -971,28 +971,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 155, col: 5, offset: 4901},
+						pos: position{line: 155, col: 5, offset: 4960},
 						run: (*parser).callonDocumentRevisionNumber8,
 						expr: &seqExpr{
-							pos: position{line: 155, col: 5, offset: 4901},
+							pos: position{line: 155, col: 5, offset: 4960},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 155, col: 5, offset: 4901},
+									pos: position{line: 155, col: 5, offset: 4960},
 									expr: &litMatcher{
-										pos:        position{line: 155, col: 5, offset: 4901},
+										pos:        position{line: 155, col: 5, offset: 4960},
 										val:        "v",
 										ignoreCase: true,
 										want:       "\"v\"i",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 155, col: 11, offset: 4907},
+									pos:  position{line: 155, col: 11, offset: 4966},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 155, col: 18, offset: 4914},
+									pos: position{line: 155, col: 18, offset: 4973},
 									expr: &charClassMatcher{
-										pos:        position{line: 155, col: 18, offset: 4914},
+										pos:        position{line: 155, col: 18, offset: 4973},
 										val:        "[^:,\\r\\n]",
 										chars:      []rune{':', ',', '\r', '\n'},
 										ignoreCase: false,
@@ -1000,16 +1000,16 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 155, col: 29, offset: 4925},
+									pos: position{line: 155, col: 29, offset: 4984},
 									expr: &ruleRefExpr{
-										pos:  position{line: 155, col: 29, offset: 4925},
+										pos:  position{line: 155, col: 29, offset: 4984},
 										name: "Space",
 									},
 								},
 								&andExpr{
-									pos: position{line: 155, col: 36, offset: 4932},
+									pos: position{line: 155, col: 36, offset: 4991},
 									expr: &litMatcher{
-										pos:        position{line: 155, col: 37, offset: 4933},
+										pos:        position{line: 155, col: 37, offset: 4992},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
@@ -1023,14 +1023,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionDate",
-			pos:  position{line: 159, col: 1, offset: 4973},
+			pos:  position{line: 159, col: 1, offset: 5032},
 			expr: &actionExpr{
-				pos: position{line: 159, col: 25, offset: 4997},
+				pos: position{line: 159, col: 25, offset: 5056},
 				run: (*parser).callonDocumentRevisionDate1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 159, col: 25, offset: 4997},
+					pos: position{line: 159, col: 25, offset: 5056},
 					expr: &charClassMatcher{
-						pos:        position{line: 159, col: 25, offset: 4997},
+						pos:        position{line: 159, col: 25, offset: 5056},
 						val:        "[^:\\r\\n]",
 						chars:      []rune{':', '\r', '\n'},
 						ignoreCase: false,
@@ -1041,14 +1041,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionRemark",
-			pos:  position{line: 163, col: 1, offset: 5043},
+			pos:  position{line: 163, col: 1, offset: 5102},
 			expr: &actionExpr{
-				pos: position{line: 163, col: 27, offset: 5069},
+				pos: position{line: 163, col: 27, offset: 5128},
 				run: (*parser).callonDocumentRevisionRemark1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 163, col: 27, offset: 5069},
+					pos: position{line: 163, col: 27, offset: 5128},
 					expr: &charClassMatcher{
-						pos:        position{line: 163, col: 27, offset: 5069},
+						pos:        position{line: 163, col: 27, offset: 5128},
 						val:        "[^\\r\\r\\n]",
 						chars:      []rune{'\r', '\r', '\n'},
 						ignoreCase: false,
@@ -1059,56 +1059,56 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeDeclaration",
-			pos:  position{line: 170, col: 1, offset: 5222},
+			pos:  position{line: 170, col: 1, offset: 5281},
 			expr: &actionExpr{
-				pos: position{line: 170, col: 25, offset: 5246},
+				pos: position{line: 170, col: 25, offset: 5305},
 				run: (*parser).callonAttributeDeclaration1,
 				expr: &seqExpr{
-					pos: position{line: 170, col: 25, offset: 5246},
+					pos: position{line: 170, col: 25, offset: 5305},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 170, col: 25, offset: 5246},
+							pos:        position{line: 170, col: 25, offset: 5305},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 170, col: 29, offset: 5250},
+							pos:   position{line: 170, col: 29, offset: 5309},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 170, col: 35, offset: 5256},
+								pos:  position{line: 170, col: 35, offset: 5315},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 170, col: 50, offset: 5271},
+							pos:        position{line: 170, col: 50, offset: 5330},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 171, col: 9, offset: 5284},
+							pos:   position{line: 171, col: 9, offset: 5343},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 171, col: 15, offset: 5290},
+								pos: position{line: 171, col: 15, offset: 5349},
 								expr: &actionExpr{
-									pos: position{line: 171, col: 16, offset: 5291},
+									pos: position{line: 171, col: 16, offset: 5350},
 									run: (*parser).callonAttributeDeclaration9,
 									expr: &seqExpr{
-										pos: position{line: 171, col: 17, offset: 5292},
+										pos: position{line: 171, col: 17, offset: 5351},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 171, col: 17, offset: 5292},
+												pos: position{line: 171, col: 17, offset: 5351},
 												expr: &ruleRefExpr{
-													pos:  position{line: 171, col: 17, offset: 5292},
+													pos:  position{line: 171, col: 17, offset: 5351},
 													name: "Space",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 171, col: 24, offset: 5299},
+												pos:   position{line: 171, col: 24, offset: 5358},
 												label: "value",
 												expr: &ruleRefExpr{
-													pos:  position{line: 171, col: 31, offset: 5306},
+													pos:  position{line: 171, col: 31, offset: 5365},
 													name: "AttributeDeclarationValue",
 												},
 											},
@@ -1118,14 +1118,14 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 173, col: 13, offset: 5380},
+							pos: position{line: 173, col: 13, offset: 5439},
 							expr: &ruleRefExpr{
-								pos:  position{line: 173, col: 13, offset: 5380},
+								pos:  position{line: 173, col: 13, offset: 5439},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 173, col: 20, offset: 5387},
+							pos:  position{line: 173, col: 20, offset: 5446},
 							name: "EOL",
 						},
 					},
@@ -1134,15 +1134,15 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeName",
-			pos:  position{line: 180, col: 1, offset: 5627},
+			pos:  position{line: 180, col: 1, offset: 5706},
 			expr: &actionExpr{
-				pos: position{line: 180, col: 18, offset: 5644},
+				pos: position{line: 180, col: 18, offset: 5723},
 				run: (*parser).callonAttributeName1,
 				expr: &seqExpr{
-					pos: position{line: 180, col: 18, offset: 5644},
+					pos: position{line: 180, col: 18, offset: 5723},
 					exprs: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 180, col: 18, offset: 5644},
+							pos:        position{line: 180, col: 18, offset: 5723},
 							val:        "[\\pL0-9_]",
 							chars:      []rune{'_'},
 							ranges:     []rune{'0', '9'},
@@ -1151,9 +1151,9 @@ var g = &grammar{
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 180, col: 28, offset: 5654},
+							pos: position{line: 180, col: 28, offset: 5733},
 							expr: &charClassMatcher{
-								pos:        position{line: 180, col: 29, offset: 5655},
+								pos:        position{line: 180, col: 29, offset: 5734},
 								val:        "[\\pL0-9-]",
 								chars:      []rune{'-'},
 								ranges:     []rune{'0', '9'},
@@ -1168,14 +1168,14 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeDeclarationValue",
-			pos:  position{line: 184, col: 1, offset: 5703},
+			pos:  position{line: 184, col: 1, offset: 5782},
 			expr: &actionExpr{
-				pos: position{line: 184, col: 30, offset: 5732},
+				pos: position{line: 184, col: 30, offset: 5811},
 				run: (*parser).callonAttributeDeclarationValue1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 184, col: 30, offset: 5732},
+					pos: position{line: 184, col: 30, offset: 5811},
 					expr: &charClassMatcher{
-						pos:        position{line: 184, col: 30, offset: 5732},
+						pos:        position{line: 184, col: 30, offset: 5811},
 						val:        "[^\\r\\n]",
 						chars:      []rune{'\r', '\n'},
 						ignoreCase: false,
@@ -1186,85 +1186,85 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeReset",
-			pos:  position{line: 188, col: 1, offset: 5777},
+			pos:  position{line: 188, col: 1, offset: 5856},
 			expr: &choiceExpr{
-				pos: position{line: 188, col: 19, offset: 5795},
+				pos: position{line: 188, col: 19, offset: 5874},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 188, col: 19, offset: 5795},
+						pos: position{line: 188, col: 19, offset: 5874},
 						run: (*parser).callonAttributeReset2,
 						expr: &seqExpr{
-							pos: position{line: 188, col: 19, offset: 5795},
+							pos: position{line: 188, col: 19, offset: 5874},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 188, col: 19, offset: 5795},
+									pos:        position{line: 188, col: 19, offset: 5874},
 									val:        ":!",
 									ignoreCase: false,
 									want:       "\":!\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 188, col: 24, offset: 5800},
+									pos:   position{line: 188, col: 24, offset: 5879},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 188, col: 30, offset: 5806},
+										pos:  position{line: 188, col: 30, offset: 5885},
 										name: "AttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 188, col: 45, offset: 5821},
+									pos:        position{line: 188, col: 45, offset: 5900},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 188, col: 49, offset: 5825},
+									pos: position{line: 188, col: 49, offset: 5904},
 									expr: &ruleRefExpr{
-										pos:  position{line: 188, col: 49, offset: 5825},
+										pos:  position{line: 188, col: 49, offset: 5904},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 188, col: 56, offset: 5832},
+									pos:  position{line: 188, col: 56, offset: 5911},
 									name: "EOL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 190, col: 5, offset: 5892},
+						pos: position{line: 190, col: 5, offset: 5991},
 						run: (*parser).callonAttributeReset11,
 						expr: &seqExpr{
-							pos: position{line: 190, col: 5, offset: 5892},
+							pos: position{line: 190, col: 5, offset: 5991},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 190, col: 5, offset: 5892},
+									pos:        position{line: 190, col: 5, offset: 5991},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 190, col: 9, offset: 5896},
+									pos:   position{line: 190, col: 9, offset: 5995},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 190, col: 15, offset: 5902},
+										pos:  position{line: 190, col: 15, offset: 6001},
 										name: "AttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 190, col: 30, offset: 5917},
+									pos:        position{line: 190, col: 30, offset: 6016},
 									val:        "!:",
 									ignoreCase: false,
 									want:       "\"!:\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 190, col: 35, offset: 5922},
+									pos: position{line: 190, col: 35, offset: 6021},
 									expr: &ruleRefExpr{
-										pos:  position{line: 190, col: 35, offset: 5922},
+										pos:  position{line: 190, col: 35, offset: 6021},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 190, col: 42, offset: 5929},
+									pos:  position{line: 190, col: 42, offset: 6028},
 									name: "EOL",
 								},
 							},
@@ -1275,61 +1275,185 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeSubstitution",
-			pos:  position{line: 194, col: 1, offset: 5988},
+			pos:  position{line: 194, col: 1, offset: 6107},
+			expr: &choiceExpr{
+				pos: position{line: 194, col: 26, offset: 6132},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 194, col: 26, offset: 6132},
+						name: "InlineAttributeEntry",
+					},
+					&actionExpr{
+						pos: position{line: 194, col: 49, offset: 6155},
+						run: (*parser).callonAttributeSubstitution3,
+						expr: &seqExpr{
+							pos: position{line: 194, col: 49, offset: 6155},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 194, col: 49, offset: 6155},
+									val:        "{",
+									ignoreCase: false,
+									want:       "\"{\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 194, col: 53, offset: 6159},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 194, col: 59, offset: 6165},
+										name: "AttributeName",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 194, col: 74, offset: 6180},
+									val:        "}",
+									ignoreCase: false,
+									want:       "\"}\"",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineAttributeEntry",
+			pos:  position{line: 199, col: 1, offset: 6346},
+			expr: &choiceExpr{
+				pos: position{line: 199, col: 25, offset: 6370},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 199, col: 25, offset: 6370},
+						run: (*parser).callonInlineAttributeEntry2,
+						expr: &seqExpr{
+							pos: position{line: 199, col: 25, offset: 6370},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 199, col: 25, offset: 6370},
+									val:        "{set:",
+									ignoreCase: false,
+									want:       "\"{set:\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 199, col: 33, offset: 6378},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 199, col: 39, offset: 6384},
+										name: "AttributeName",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 199, col: 54, offset: 6399},
+									val:        "!}",
+									ignoreCase: false,
+									want:       "\"!}\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 201, col: 5, offset: 6497},
+						run: (*parser).callonInlineAttributeEntry8,
+						expr: &seqExpr{
+							pos: position{line: 201, col: 5, offset: 6497},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 201, col: 5, offset: 6497},
+									val:        "{set:",
+									ignoreCase: false,
+									want:       "\"{set:\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 201, col: 13, offset: 6505},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 201, col: 19, offset: 6511},
+										name: "AttributeName",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 201, col: 34, offset: 6526},
+									label: "value",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 201, col: 40, offset: 6532},
+										expr: &ruleRefExpr{
+											pos:  position{line: 201, col: 41, offset: 6533},
+											name: "InlineAttributeEntryValue",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 201, col: 69, offset: 6561},
+									val:        "}",
+									ignoreCase: false,
+									want:       "\"}\"",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineAttributeEntryValue",
+			pos:  position{line: 205, col: 1, offset: 6660},
 			expr: &actionExpr{
-				pos: position{line: 194, col: 26, offset: 6013},
-				run: (*parser).callonAttributeSubstitution1,
+				pos: position{line: 205, col: 30, offset: 6689},
+				run: (*parser).callonInlineAttributeEntryValue1,
 				expr: &seqExpr{
-					pos: position{line: 194, col: 26, offset: 6013},
+					pos: position{line: 205, col: 30, offset: 6689},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 194, col: 26, offset: 6013},
-							val:        "{",
+							pos:        position{line: 205, col: 30, offset: 6689},
+							val:        ":",
 							ignoreCase: false,
-							want:       "\"{\"",
+							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 194, col: 30, offset: 6017},
-							label: "name",
-							expr: &ruleRefExpr{
-								pos:  position{line: 194, col: 36, offset: 6023},
-								name: "AttributeName",
+							pos:   position{line: 205, col: 34, offset: 6693},
+							label: "value",
+							expr: &actionExpr{
+								pos: position{line: 205, col: 41, offset: 6700},
+								run: (*parser).callonInlineAttributeEntryValue5,
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 205, col: 41, offset: 6700},
+									expr: &charClassMatcher{
+										pos:        position{line: 205, col: 41, offset: 6700},
+										val:        "[^\\r\\n}]",
+										chars:      []rune{'\r', '\n', '}'},
+										ignoreCase: false,
+										inverted:   true,
+									},
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 194, col: 51, offset: 6038},
-							val:        "}",
-							ignoreCase: false,
-							want:       "\"}\"",
-						},
 					},
 				},
 			},
 		},
 		{
 			name: "Attributes",
-			pos:  position{line: 198, col: 1, offset: 6104},
+			pos:  position{line: 211, col: 1, offset: 6773},
 			expr: &actionExpr{
-				pos: position{line: 198, col: 15, offset: 6118},
+				pos: position{line: 211, col: 15, offset: 6787},
 				run: (*parser).callonAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 198, col: 15, offset: 6118},
+					pos: position{line: 211, col: 15, offset: 6787},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 198, col: 15, offset: 6118},
+							pos:   position{line: 211, col: 15, offset: 6787},
 							label: "attrs",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 198, col: 21, offset: 6124},
+								pos: position{line: 211, col: 21, offset: 6793},
 								expr: &ruleRefExpr{
-									pos:  position{line: 198, col: 22, offset: 6125},
+									pos:  position{line: 211, col: 22, offset: 6794},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 198, col: 41, offset: 6144},
+							pos: position{line: 211, col: 41, offset: 6813},
 							expr: &ruleRefExpr{
-								pos:  position{line: 198, col: 41, offset: 6144},
+								pos:  position{line: 211, col: 41, offset: 6813},
 								name: "BlankLine",
 							},
 						},
@@ -1339,32 +1463,32 @@ var g = &grammar{
 		},
 		{
 			name: "ElementAttribute",
-			pos:  position{line: 202, col: 1, offset: 6214},
+			pos:  position{line: 215, col: 1, offset: 6883},
 			expr: &actionExpr{
-				pos: position{line: 202, col: 21, offset: 6234},
+				pos: position{line: 215, col: 21, offset: 6903},
 				run: (*parser).callonElementAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 202, col: 21, offset: 6234},
+					pos: position{line: 215, col: 21, offset: 6903},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 202, col: 21, offset: 6234},
+							pos: position{line: 215, col: 21, offset: 6903},
 							expr: &choiceExpr{
-								pos: position{line: 202, col: 23, offset: 6236},
+								pos: position{line: 215, col: 23, offset: 6905},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 202, col: 23, offset: 6236},
+										pos:        position{line: 215, col: 23, offset: 6905},
 										val:        "[",
 										ignoreCase: false,
 										want:       "\"[\"",
 									},
 									&litMatcher{
-										pos:        position{line: 202, col: 29, offset: 6242},
+										pos:        position{line: 215, col: 29, offset: 6911},
 										val:        ".",
 										ignoreCase: false,
 										want:       "\".\"",
 									},
 									&litMatcher{
-										pos:        position{line: 202, col: 35, offset: 6248},
+										pos:        position{line: 215, col: 35, offset: 6917},
 										val:        "#",
 										ignoreCase: false,
 										want:       "\"#\"",
@@ -1373,53 +1497,53 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 203, col: 5, offset: 6324},
+							pos:   position{line: 216, col: 5, offset: 6993},
 							label: "attr",
 							expr: &choiceExpr{
-								pos: position{line: 203, col: 11, offset: 6330},
+								pos: position{line: 216, col: 11, offset: 6999},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 203, col: 11, offset: 6330},
+										pos:  position{line: 216, col: 11, offset: 6999},
 										name: "ElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 204, col: 9, offset: 6351},
+										pos:  position{line: 217, col: 9, offset: 7020},
 										name: "ElementTitle",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 205, col: 9, offset: 6375},
+										pos:  position{line: 218, col: 9, offset: 7044},
 										name: "ElementRole",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 206, col: 9, offset: 6398},
+										pos:  position{line: 219, col: 9, offset: 7067},
 										name: "LiteralAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 207, col: 9, offset: 6426},
+										pos:  position{line: 220, col: 9, offset: 7095},
 										name: "SourceAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 208, col: 9, offset: 6454},
+										pos:  position{line: 221, col: 9, offset: 7123},
 										name: "QuoteAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 209, col: 9, offset: 6481},
+										pos:  position{line: 222, col: 9, offset: 7150},
 										name: "VerseAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 210, col: 9, offset: 6508},
+										pos:  position{line: 223, col: 9, offset: 7177},
 										name: "AdmonitionMarkerAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 211, col: 9, offset: 6545},
+										pos:  position{line: 224, col: 9, offset: 7214},
 										name: "HorizontalLayout",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 212, col: 9, offset: 6573},
+										pos:  position{line: 225, col: 9, offset: 7242},
 										name: "PassthroughBlockAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 213, col: 9, offset: 6610},
+										pos:  position{line: 226, col: 9, offset: 7279},
 										name: "AttributeGroup",
 									},
 								},
@@ -1431,16 +1555,16 @@ var g = &grammar{
 		},
 		{
 			name: "MasqueradeAttribute",
-			pos:  position{line: 218, col: 1, offset: 6793},
+			pos:  position{line: 231, col: 1, offset: 7462},
 			expr: &choiceExpr{
-				pos: position{line: 218, col: 24, offset: 6816},
+				pos: position{line: 231, col: 24, offset: 7485},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 218, col: 24, offset: 6816},
+						pos:  position{line: 231, col: 24, offset: 7485},
 						name: "QuoteAttributes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 218, col: 42, offset: 6834},
+						pos:  position{line: 231, col: 42, offset: 7503},
 						name: "VerseAttributes",
 					},
 				},
@@ -1448,85 +1572,85 @@ var g = &grammar{
 		},
 		{
 			name: "ElementID",
-			pos:  position{line: 220, col: 1, offset: 6851},
+			pos:  position{line: 233, col: 1, offset: 7520},
 			expr: &choiceExpr{
-				pos: position{line: 220, col: 14, offset: 6864},
+				pos: position{line: 233, col: 14, offset: 7533},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 220, col: 14, offset: 6864},
+						pos: position{line: 233, col: 14, offset: 7533},
 						run: (*parser).callonElementID2,
 						expr: &seqExpr{
-							pos: position{line: 220, col: 14, offset: 6864},
+							pos: position{line: 233, col: 14, offset: 7533},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 220, col: 14, offset: 6864},
+									pos:        position{line: 233, col: 14, offset: 7533},
 									val:        "[[",
 									ignoreCase: false,
 									want:       "\"[[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 220, col: 19, offset: 6869},
+									pos:   position{line: 233, col: 19, offset: 7538},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 220, col: 23, offset: 6873},
+										pos:  position{line: 233, col: 23, offset: 7542},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 220, col: 27, offset: 6877},
+									pos:        position{line: 233, col: 27, offset: 7546},
 									val:        "]]",
 									ignoreCase: false,
 									want:       "\"]]\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 220, col: 32, offset: 6882},
+									pos: position{line: 233, col: 32, offset: 7551},
 									expr: &ruleRefExpr{
-										pos:  position{line: 220, col: 32, offset: 6882},
+										pos:  position{line: 233, col: 32, offset: 7551},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 220, col: 39, offset: 6889},
+									pos:  position{line: 233, col: 39, offset: 7558},
 									name: "EOL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 222, col: 5, offset: 6942},
+						pos: position{line: 235, col: 5, offset: 7611},
 						run: (*parser).callonElementID11,
 						expr: &seqExpr{
-							pos: position{line: 222, col: 5, offset: 6942},
+							pos: position{line: 235, col: 5, offset: 7611},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 222, col: 5, offset: 6942},
+									pos:        position{line: 235, col: 5, offset: 7611},
 									val:        "[#",
 									ignoreCase: false,
 									want:       "\"[#\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 222, col: 10, offset: 6947},
+									pos:   position{line: 235, col: 10, offset: 7616},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 222, col: 14, offset: 6951},
+										pos:  position{line: 235, col: 14, offset: 7620},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 222, col: 18, offset: 6955},
+									pos:        position{line: 235, col: 18, offset: 7624},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 222, col: 23, offset: 6960},
+									pos: position{line: 235, col: 23, offset: 7629},
 									expr: &ruleRefExpr{
-										pos:  position{line: 222, col: 23, offset: 6960},
+										pos:  position{line: 235, col: 23, offset: 7629},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 222, col: 30, offset: 6967},
+									pos:  position{line: 235, col: 30, offset: 7636},
 									name: "EOL",
 								},
 							},
@@ -1537,37 +1661,37 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElementID",
-			pos:  position{line: 226, col: 1, offset: 7019},
+			pos:  position{line: 239, col: 1, offset: 7688},
 			expr: &actionExpr{
-				pos: position{line: 226, col: 20, offset: 7038},
+				pos: position{line: 239, col: 20, offset: 7707},
 				run: (*parser).callonInlineElementID1,
 				expr: &seqExpr{
-					pos: position{line: 226, col: 20, offset: 7038},
+					pos: position{line: 239, col: 20, offset: 7707},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 226, col: 20, offset: 7038},
+							pos:        position{line: 239, col: 20, offset: 7707},
 							val:        "[[",
 							ignoreCase: false,
 							want:       "\"[[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 226, col: 25, offset: 7043},
+							pos:   position{line: 239, col: 25, offset: 7712},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 226, col: 29, offset: 7047},
+								pos:  position{line: 239, col: 29, offset: 7716},
 								name: "ID",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 226, col: 33, offset: 7051},
+							pos:        position{line: 239, col: 33, offset: 7720},
 							val:        "]]",
 							ignoreCase: false,
 							want:       "\"]]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 226, col: 38, offset: 7056},
+							pos: position{line: 239, col: 38, offset: 7725},
 							expr: &ruleRefExpr{
-								pos:  position{line: 226, col: 38, offset: 7056},
+								pos:  position{line: 239, col: 38, offset: 7725},
 								name: "Space",
 							},
 						},
@@ -1577,29 +1701,29 @@ var g = &grammar{
 		},
 		{
 			name: "ElementTitle",
-			pos:  position{line: 232, col: 1, offset: 7333},
+			pos:  position{line: 245, col: 1, offset: 8002},
 			expr: &actionExpr{
-				pos: position{line: 232, col: 17, offset: 7349},
+				pos: position{line: 245, col: 17, offset: 8018},
 				run: (*parser).callonElementTitle1,
 				expr: &seqExpr{
-					pos: position{line: 232, col: 17, offset: 7349},
+					pos: position{line: 245, col: 17, offset: 8018},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 232, col: 17, offset: 7349},
+							pos:        position{line: 245, col: 17, offset: 8018},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 232, col: 21, offset: 7353},
+							pos:   position{line: 245, col: 21, offset: 8022},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 232, col: 28, offset: 7360},
+								pos:  position{line: 245, col: 28, offset: 8029},
 								name: "ElementTitleContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 232, col: 49, offset: 7381},
+							pos:  position{line: 245, col: 49, offset: 8050},
 							name: "EOL",
 						},
 					},
@@ -1608,15 +1732,15 @@ var g = &grammar{
 		},
 		{
 			name: "ElementTitleContent",
-			pos:  position{line: 236, col: 1, offset: 7439},
+			pos:  position{line: 249, col: 1, offset: 8108},
 			expr: &actionExpr{
-				pos: position{line: 236, col: 24, offset: 7462},
+				pos: position{line: 249, col: 24, offset: 8131},
 				run: (*parser).callonElementTitleContent1,
 				expr: &seqExpr{
-					pos: position{line: 236, col: 24, offset: 7462},
+					pos: position{line: 249, col: 24, offset: 8131},
 					exprs: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 236, col: 24, offset: 7462},
+							pos:        position{line: 249, col: 24, offset: 8131},
 							val:        "[\\pL0-9]",
 							ranges:     []rune{'0', '9'},
 							classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -1624,9 +1748,9 @@ var g = &grammar{
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 236, col: 32, offset: 7470},
+							pos: position{line: 249, col: 32, offset: 8139},
 							expr: &charClassMatcher{
-								pos:        position{line: 236, col: 32, offset: 7470},
+								pos:        position{line: 249, col: 32, offset: 8139},
 								val:        "[^\\r\\n<>]",
 								chars:      []rune{'\r', '\n', '<', '>'},
 								ignoreCase: false,
@@ -1639,30 +1763,30 @@ var g = &grammar{
 		},
 		{
 			name: "ElementRole",
-			pos:  position{line: 242, col: 1, offset: 7697},
+			pos:  position{line: 255, col: 1, offset: 8366},
 			expr: &actionExpr{
-				pos: position{line: 242, col: 16, offset: 7712},
+				pos: position{line: 255, col: 16, offset: 8381},
 				run: (*parser).callonElementRole1,
 				expr: &seqExpr{
-					pos: position{line: 242, col: 16, offset: 7712},
+					pos: position{line: 255, col: 16, offset: 8381},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 242, col: 16, offset: 7712},
+							pos:        position{line: 255, col: 16, offset: 8381},
 							val:        "[.",
 							ignoreCase: false,
 							want:       "\"[.\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 242, col: 21, offset: 7717},
+							pos:   position{line: 255, col: 21, offset: 8386},
 							label: "role",
 							expr: &actionExpr{
-								pos: position{line: 242, col: 27, offset: 7723},
+								pos: position{line: 255, col: 27, offset: 8392},
 								run: (*parser).callonElementRole5,
 								expr: &seqExpr{
-									pos: position{line: 242, col: 27, offset: 7723},
+									pos: position{line: 255, col: 27, offset: 8392},
 									exprs: []interface{}{
 										&charClassMatcher{
-											pos:        position{line: 242, col: 27, offset: 7723},
+											pos:        position{line: 255, col: 27, offset: 8392},
 											val:        "[\\pL0-9]",
 											ranges:     []rune{'0', '9'},
 											classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -1670,9 +1794,9 @@ var g = &grammar{
 											inverted:   false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 242, col: 36, offset: 7732},
+											pos: position{line: 255, col: 36, offset: 8401},
 											expr: &charClassMatcher{
-												pos:        position{line: 242, col: 36, offset: 7732},
+												pos:        position{line: 255, col: 36, offset: 8401},
 												val:        "[^\\]\\r\\n]",
 												chars:      []rune{']', '\r', '\n'},
 												ignoreCase: false,
@@ -1684,20 +1808,20 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 244, col: 4, offset: 7779},
+							pos:        position{line: 257, col: 4, offset: 8448},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 244, col: 8, offset: 7783},
+							pos: position{line: 257, col: 8, offset: 8452},
 							expr: &ruleRefExpr{
-								pos:  position{line: 244, col: 8, offset: 7783},
+								pos:  position{line: 257, col: 8, offset: 8452},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 244, col: 15, offset: 7790},
+							pos:  position{line: 257, col: 15, offset: 8459},
 							name: "EOL",
 						},
 					},
@@ -1706,28 +1830,28 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralAttribute",
-			pos:  position{line: 248, col: 1, offset: 7846},
+			pos:  position{line: 261, col: 1, offset: 8515},
 			expr: &actionExpr{
-				pos: position{line: 248, col: 21, offset: 7866},
+				pos: position{line: 261, col: 21, offset: 8535},
 				run: (*parser).callonLiteralAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 248, col: 21, offset: 7866},
+					pos: position{line: 261, col: 21, offset: 8535},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 248, col: 21, offset: 7866},
+							pos:        position{line: 261, col: 21, offset: 8535},
 							val:        "[literal]",
 							ignoreCase: false,
 							want:       "\"[literal]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 248, col: 33, offset: 7878},
+							pos: position{line: 261, col: 33, offset: 8547},
 							expr: &ruleRefExpr{
-								pos:  position{line: 248, col: 33, offset: 7878},
+								pos:  position{line: 261, col: 33, offset: 8547},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 248, col: 40, offset: 7885},
+							pos:  position{line: 261, col: 40, offset: 8554},
 							name: "Newline",
 						},
 					},
@@ -1736,28 +1860,28 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockAttribute",
-			pos:  position{line: 252, col: 1, offset: 7937},
+			pos:  position{line: 265, col: 1, offset: 8606},
 			expr: &actionExpr{
-				pos: position{line: 252, col: 30, offset: 7966},
+				pos: position{line: 265, col: 30, offset: 8635},
 				run: (*parser).callonPassthroughBlockAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 252, col: 30, offset: 7966},
+					pos: position{line: 265, col: 30, offset: 8635},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 252, col: 30, offset: 7966},
+							pos:        position{line: 265, col: 30, offset: 8635},
 							val:        "[pass]",
 							ignoreCase: false,
 							want:       "\"[pass]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 252, col: 39, offset: 7975},
+							pos: position{line: 265, col: 39, offset: 8644},
 							expr: &ruleRefExpr{
-								pos:  position{line: 252, col: 39, offset: 7975},
+								pos:  position{line: 265, col: 39, offset: 8644},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 252, col: 46, offset: 7982},
+							pos:  position{line: 265, col: 46, offset: 8651},
 							name: "Newline",
 						},
 					},
@@ -1766,42 +1890,42 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionMarkerAttribute",
-			pos:  position{line: 257, col: 1, offset: 8123},
+			pos:  position{line: 270, col: 1, offset: 8792},
 			expr: &actionExpr{
-				pos: position{line: 257, col: 30, offset: 8152},
+				pos: position{line: 270, col: 30, offset: 8821},
 				run: (*parser).callonAdmonitionMarkerAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 257, col: 30, offset: 8152},
+					pos: position{line: 270, col: 30, offset: 8821},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 257, col: 30, offset: 8152},
+							pos:        position{line: 270, col: 30, offset: 8821},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 257, col: 34, offset: 8156},
+							pos:   position{line: 270, col: 34, offset: 8825},
 							label: "k",
 							expr: &ruleRefExpr{
-								pos:  position{line: 257, col: 37, offset: 8159},
+								pos:  position{line: 270, col: 37, offset: 8828},
 								name: "AdmonitionKind",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 257, col: 53, offset: 8175},
+							pos:        position{line: 270, col: 53, offset: 8844},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 257, col: 57, offset: 8179},
+							pos: position{line: 270, col: 57, offset: 8848},
 							expr: &ruleRefExpr{
-								pos:  position{line: 257, col: 57, offset: 8179},
+								pos:  position{line: 270, col: 57, offset: 8848},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 257, col: 64, offset: 8186},
+							pos:  position{line: 270, col: 64, offset: 8855},
 							name: "EOL",
 						},
 					},
@@ -1810,43 +1934,43 @@ var g = &grammar{
 		},
 		{
 			name: "SourceAttributes",
-			pos:  position{line: 262, col: 1, offset: 8341},
+			pos:  position{line: 275, col: 1, offset: 9010},
 			expr: &actionExpr{
-				pos: position{line: 262, col: 21, offset: 8361},
+				pos: position{line: 275, col: 21, offset: 9030},
 				run: (*parser).callonSourceAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 262, col: 21, offset: 8361},
+					pos: position{line: 275, col: 21, offset: 9030},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 262, col: 21, offset: 8361},
+							pos:        position{line: 275, col: 21, offset: 9030},
 							val:        "[source",
 							ignoreCase: false,
 							want:       "\"[source\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 263, col: 5, offset: 8376},
+							pos:   position{line: 276, col: 5, offset: 9045},
 							label: "language",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 263, col: 14, offset: 8385},
+								pos: position{line: 276, col: 14, offset: 9054},
 								expr: &actionExpr{
-									pos: position{line: 263, col: 15, offset: 8386},
+									pos: position{line: 276, col: 15, offset: 9055},
 									run: (*parser).callonSourceAttributes6,
 									expr: &seqExpr{
-										pos: position{line: 263, col: 15, offset: 8386},
+										pos: position{line: 276, col: 15, offset: 9055},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 263, col: 15, offset: 8386},
+												pos:        position{line: 276, col: 15, offset: 9055},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 263, col: 19, offset: 8390},
+												pos:   position{line: 276, col: 19, offset: 9059},
 												label: "attr",
 												expr: &zeroOrOneExpr{
-													pos: position{line: 263, col: 24, offset: 8395},
+													pos: position{line: 276, col: 24, offset: 9064},
 													expr: &ruleRefExpr{
-														pos:  position{line: 263, col: 25, offset: 8396},
+														pos:  position{line: 276, col: 25, offset: 9065},
 														name: "StandaloneAttributeValue",
 													},
 												},
@@ -1857,31 +1981,48 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 264, col: 5, offset: 8451},
+							pos:   position{line: 277, col: 5, offset: 9120},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 264, col: 12, offset: 8458},
-								expr: &actionExpr{
-									pos: position{line: 264, col: 13, offset: 8459},
-									run: (*parser).callonSourceAttributes14,
-									expr: &seqExpr{
-										pos: position{line: 264, col: 13, offset: 8459},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 264, col: 13, offset: 8459},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
+								pos: position{line: 277, col: 12, offset: 9127},
+								expr: &choiceExpr{
+									pos: position{line: 277, col: 13, offset: 9128},
+									alternatives: []interface{}{
+										&actionExpr{
+											pos: position{line: 277, col: 13, offset: 9128},
+											run: (*parser).callonSourceAttributes15,
+											expr: &seqExpr{
+												pos: position{line: 277, col: 13, offset: 9128},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 277, col: 13, offset: 9128},
+														val:        ",",
+														ignoreCase: false,
+														want:       "\",\"",
+													},
+													&labeledExpr{
+														pos:   position{line: 277, col: 17, offset: 9132},
+														label: "attr",
+														expr: &zeroOrOneExpr{
+															pos: position{line: 277, col: 22, offset: 9137},
+															expr: &ruleRefExpr{
+																pos:  position{line: 277, col: 23, offset: 9138},
+																name: "GenericAttribute",
+															},
+														},
+													},
+												},
 											},
-											&labeledExpr{
-												pos:   position{line: 264, col: 17, offset: 8463},
+										},
+										&actionExpr{
+											pos: position{line: 277, col: 65, offset: 9180},
+											run: (*parser).callonSourceAttributes21,
+											expr: &labeledExpr{
+												pos:   position{line: 277, col: 65, offset: 9180},
 												label: "attr",
-												expr: &zeroOrOneExpr{
-													pos: position{line: 264, col: 22, offset: 8468},
-													expr: &ruleRefExpr{
-														pos:  position{line: 264, col: 23, offset: 8469},
-														name: "GenericAttribute",
-													},
+												expr: &ruleRefExpr{
+													pos:  position{line: 277, col: 71, offset: 9186},
+													name: "GenericAttribute",
 												},
 											},
 										},
@@ -1890,20 +2031,20 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 265, col: 5, offset: 8516},
+							pos:        position{line: 278, col: 5, offset: 9232},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 265, col: 9, offset: 8520},
+							pos: position{line: 278, col: 9, offset: 9236},
 							expr: &ruleRefExpr{
-								pos:  position{line: 265, col: 9, offset: 8520},
+								pos:  position{line: 278, col: 9, offset: 9236},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 265, col: 16, offset: 8527},
+							pos:  position{line: 278, col: 16, offset: 9243},
 							name: "EOL",
 						},
 					},
@@ -1912,45 +2053,45 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeGroup",
-			pos:  position{line: 270, col: 1, offset: 8678},
+			pos:  position{line: 283, col: 1, offset: 9394},
 			expr: &actionExpr{
-				pos: position{line: 270, col: 19, offset: 8696},
+				pos: position{line: 283, col: 19, offset: 9412},
 				run: (*parser).callonAttributeGroup1,
 				expr: &seqExpr{
-					pos: position{line: 270, col: 19, offset: 8696},
+					pos: position{line: 283, col: 19, offset: 9412},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 270, col: 19, offset: 8696},
+							pos:        position{line: 283, col: 19, offset: 9412},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 270, col: 23, offset: 8700},
+							pos:   position{line: 283, col: 23, offset: 9416},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 270, col: 34, offset: 8711},
+								pos: position{line: 283, col: 34, offset: 9427},
 								expr: &ruleRefExpr{
-									pos:  position{line: 270, col: 35, offset: 8712},
+									pos:  position{line: 283, col: 35, offset: 9428},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 270, col: 54, offset: 8731},
+							pos:        position{line: 283, col: 54, offset: 9447},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 270, col: 58, offset: 8735},
+							pos: position{line: 283, col: 58, offset: 9451},
 							expr: &ruleRefExpr{
-								pos:  position{line: 270, col: 58, offset: 8735},
+								pos:  position{line: 283, col: 58, offset: 9451},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 270, col: 65, offset: 8742},
+							pos:  position{line: 283, col: 65, offset: 9458},
 							name: "EOL",
 						},
 					},
@@ -1959,16 +2100,16 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttribute",
-			pos:  position{line: 274, col: 1, offset: 8814},
+			pos:  position{line: 287, col: 1, offset: 9530},
 			expr: &choiceExpr{
-				pos: position{line: 274, col: 21, offset: 8834},
+				pos: position{line: 287, col: 21, offset: 9550},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 274, col: 21, offset: 8834},
+						pos:  position{line: 287, col: 21, offset: 9550},
 						name: "GenericAttributeWithValue",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 274, col: 49, offset: 8862},
+						pos:  position{line: 287, col: 49, offset: 9578},
 						name: "GenericAttributeWithoutValue",
 					},
 				},
@@ -1976,51 +2117,51 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttributeWithValue",
-			pos:  position{line: 276, col: 1, offset: 8892},
+			pos:  position{line: 289, col: 1, offset: 9608},
 			expr: &actionExpr{
-				pos: position{line: 276, col: 30, offset: 8921},
+				pos: position{line: 289, col: 30, offset: 9637},
 				run: (*parser).callonGenericAttributeWithValue1,
 				expr: &seqExpr{
-					pos: position{line: 276, col: 30, offset: 8921},
+					pos: position{line: 289, col: 30, offset: 9637},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 276, col: 30, offset: 8921},
+							pos:   position{line: 289, col: 30, offset: 9637},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 35, offset: 8926},
+								pos:  position{line: 289, col: 35, offset: 9642},
 								name: "AttributeKey",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 276, col: 49, offset: 8940},
+							pos:        position{line: 289, col: 49, offset: 9656},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 276, col: 53, offset: 8944},
+							pos:   position{line: 289, col: 53, offset: 9660},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 276, col: 59, offset: 8950},
+								pos: position{line: 289, col: 59, offset: 9666},
 								expr: &ruleRefExpr{
-									pos:  position{line: 276, col: 60, offset: 8951},
+									pos:  position{line: 289, col: 60, offset: 9667},
 									name: "AttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 276, col: 77, offset: 8968},
+							pos: position{line: 289, col: 77, offset: 9684},
 							expr: &litMatcher{
-								pos:        position{line: 276, col: 77, offset: 8968},
+								pos:        position{line: 289, col: 77, offset: 9684},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 276, col: 82, offset: 8973},
+							pos: position{line: 289, col: 82, offset: 9689},
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 82, offset: 8973},
+								pos:  position{line: 289, col: 82, offset: 9689},
 								name: "Space",
 							},
 						},
@@ -2030,34 +2171,34 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttributeWithoutValue",
-			pos:  position{line: 280, col: 1, offset: 9072},
+			pos:  position{line: 293, col: 1, offset: 9788},
 			expr: &actionExpr{
-				pos: position{line: 280, col: 33, offset: 9104},
+				pos: position{line: 293, col: 33, offset: 9820},
 				run: (*parser).callonGenericAttributeWithoutValue1,
 				expr: &seqExpr{
-					pos: position{line: 280, col: 33, offset: 9104},
+					pos: position{line: 293, col: 33, offset: 9820},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 280, col: 33, offset: 9104},
+							pos:   position{line: 293, col: 33, offset: 9820},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 280, col: 38, offset: 9109},
+								pos:  position{line: 293, col: 38, offset: 9825},
 								name: "AttributeKey",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 280, col: 52, offset: 9123},
+							pos: position{line: 293, col: 52, offset: 9839},
 							expr: &litMatcher{
-								pos:        position{line: 280, col: 52, offset: 9123},
+								pos:        position{line: 293, col: 52, offset: 9839},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 280, col: 57, offset: 9128},
+							pos: position{line: 293, col: 57, offset: 9844},
 							expr: &ruleRefExpr{
-								pos:  position{line: 280, col: 57, offset: 9128},
+								pos:  position{line: 293, col: 57, offset: 9844},
 								name: "Space",
 							},
 						},
@@ -2067,57 +2208,57 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeKey",
-			pos:  position{line: 284, col: 1, offset: 9216},
+			pos:  position{line: 297, col: 1, offset: 9932},
 			expr: &actionExpr{
-				pos: position{line: 284, col: 17, offset: 9232},
+				pos: position{line: 297, col: 17, offset: 9948},
 				run: (*parser).callonAttributeKey1,
 				expr: &seqExpr{
-					pos: position{line: 284, col: 17, offset: 9232},
+					pos: position{line: 297, col: 17, offset: 9948},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 284, col: 17, offset: 9232},
+							pos: position{line: 297, col: 17, offset: 9948},
 							expr: &litMatcher{
-								pos:        position{line: 284, col: 18, offset: 9233},
+								pos:        position{line: 297, col: 18, offset: 9949},
 								val:        "quote",
 								ignoreCase: false,
 								want:       "\"quote\"",
 							},
 						},
 						&notExpr{
-							pos: position{line: 284, col: 26, offset: 9241},
+							pos: position{line: 297, col: 26, offset: 9957},
 							expr: &litMatcher{
-								pos:        position{line: 284, col: 27, offset: 9242},
+								pos:        position{line: 297, col: 27, offset: 9958},
 								val:        "verse",
 								ignoreCase: false,
 								want:       "\"verse\"",
 							},
 						},
 						&notExpr{
-							pos: position{line: 284, col: 35, offset: 9250},
+							pos: position{line: 297, col: 35, offset: 9966},
 							expr: &litMatcher{
-								pos:        position{line: 284, col: 36, offset: 9251},
+								pos:        position{line: 297, col: 36, offset: 9967},
 								val:        "literal",
 								ignoreCase: false,
 								want:       "\"literal\"",
 							},
 						},
 						&notExpr{
-							pos: position{line: 284, col: 46, offset: 9261},
+							pos: position{line: 297, col: 46, offset: 9977},
 							expr: &oneOrMoreExpr{
-								pos: position{line: 284, col: 48, offset: 9263},
+								pos: position{line: 297, col: 48, offset: 9979},
 								expr: &ruleRefExpr{
-									pos:  position{line: 284, col: 48, offset: 9263},
+									pos:  position{line: 297, col: 48, offset: 9979},
 									name: "Space",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 284, col: 56, offset: 9271},
+							pos:   position{line: 297, col: 56, offset: 9987},
 							label: "key",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 284, col: 61, offset: 9276},
+								pos: position{line: 297, col: 61, offset: 9992},
 								expr: &charClassMatcher{
-									pos:        position{line: 284, col: 61, offset: 9276},
+									pos:        position{line: 297, col: 61, offset: 9992},
 									val:        "[^\\r\\n=,\\]]",
 									chars:      []rune{'\r', '\n', '=', ',', ']'},
 									ignoreCase: false,
@@ -2126,9 +2267,9 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 284, col: 75, offset: 9290},
+							pos: position{line: 297, col: 75, offset: 10006},
 							expr: &ruleRefExpr{
-								pos:  position{line: 284, col: 75, offset: 9290},
+								pos:  position{line: 297, col: 75, offset: 10006},
 								name: "Space",
 							},
 						},
@@ -2138,21 +2279,72 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeValue",
-			pos:  position{line: 288, col: 1, offset: 9333},
-			expr: &actionExpr{
-				pos: position{line: 288, col: 19, offset: 9351},
-				run: (*parser).callonAttributeValue1,
-				expr: &labeledExpr{
-					pos:   position{line: 288, col: 19, offset: 9351},
-					label: "value",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 288, col: 26, offset: 9358},
-						expr: &charClassMatcher{
-							pos:        position{line: 288, col: 26, offset: 9358},
-							val:        "[^\\r\\n=,\\]]",
-							chars:      []rune{'\r', '\n', '=', ',', ']'},
-							ignoreCase: false,
-							inverted:   true,
+			pos:  position{line: 301, col: 1, offset: 10049},
+			expr: &choiceExpr{
+				pos: position{line: 301, col: 19, offset: 10067},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 301, col: 19, offset: 10067},
+						run: (*parser).callonAttributeValue2,
+						expr: &seqExpr{
+							pos: position{line: 301, col: 19, offset: 10067},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 301, col: 19, offset: 10067},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 301, col: 24, offset: 10072},
+									label: "value",
+									expr: &actionExpr{
+										pos: position{line: 301, col: 31, offset: 10079},
+										run: (*parser).callonAttributeValue6,
+										expr: &zeroOrMoreExpr{
+											pos: position{line: 301, col: 31, offset: 10079},
+											expr: &charClassMatcher{
+												pos:        position{line: 301, col: 31, offset: 10079},
+												val:        "[^\\r\\n\"]",
+												chars:      []rune{'\r', '\n', '"'},
+												ignoreCase: false,
+												inverted:   true,
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 301, col: 73, offset: 10121},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 301, col: 78, offset: 10126},
+									expr: &ruleRefExpr{
+										pos:  position{line: 301, col: 78, offset: 10126},
+										name: "Space",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 303, col: 5, offset: 10203},
+						run: (*parser).callonAttributeValue12,
+						expr: &labeledExpr{
+							pos:   position{line: 303, col: 5, offset: 10203},
+							label: "value",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 303, col: 12, offset: 10210},
+								expr: &charClassMatcher{
+									pos:        position{line: 303, col: 12, offset: 10210},
+									val:        "[^\\r\\n=,\\]]",
+									chars:      []rune{'\r', '\n', '=', ',', ']'},
+									ignoreCase: false,
+									inverted:   true,
+								},
+							},
 						},
 					},
 				},
@@ -2160,20 +2352,20 @@ var g = &grammar{
 		},
 		{
 			name: "StandaloneAttributeValue",
-			pos:  position{line: 292, col: 1, offset: 9409},
+			pos:  position{line: 307, col: 1, offset: 10261},
 			expr: &actionExpr{
-				pos: position{line: 292, col: 29, offset: 9437},
+				pos: position{line: 307, col: 29, offset: 10289},
 				run: (*parser).callonStandaloneAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 292, col: 29, offset: 9437},
+					pos: position{line: 307, col: 29, offset: 10289},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 292, col: 29, offset: 9437},
+							pos:   position{line: 307, col: 29, offset: 10289},
 							label: "value",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 292, col: 36, offset: 9444},
+								pos: position{line: 307, col: 36, offset: 10296},
 								expr: &charClassMatcher{
-									pos:        position{line: 292, col: 36, offset: 9444},
+									pos:        position{line: 307, col: 36, offset: 10296},
 									val:        "[^\\r\\n=,\\]]",
 									chars:      []rune{'\r', '\n', '=', ',', ']'},
 									ignoreCase: false,
@@ -2182,9 +2374,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 292, col: 50, offset: 9458},
+							pos: position{line: 307, col: 50, offset: 10310},
 							expr: &litMatcher{
-								pos:        position{line: 292, col: 51, offset: 9459},
+								pos:        position{line: 307, col: 51, offset: 10311},
 								val:        "=",
 								ignoreCase: false,
 								want:       "\"=\"",
@@ -2196,28 +2388,28 @@ var g = &grammar{
 		},
 		{
 			name: "HorizontalLayout",
-			pos:  position{line: 296, col: 1, offset: 9625},
+			pos:  position{line: 311, col: 1, offset: 10477},
 			expr: &actionExpr{
-				pos: position{line: 296, col: 21, offset: 9645},
+				pos: position{line: 311, col: 21, offset: 10497},
 				run: (*parser).callonHorizontalLayout1,
 				expr: &seqExpr{
-					pos: position{line: 296, col: 21, offset: 9645},
+					pos: position{line: 311, col: 21, offset: 10497},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 296, col: 21, offset: 9645},
+							pos:        position{line: 311, col: 21, offset: 10497},
 							val:        "[horizontal]",
 							ignoreCase: false,
 							want:       "\"[horizontal]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 296, col: 36, offset: 9660},
+							pos: position{line: 311, col: 36, offset: 10512},
 							expr: &ruleRefExpr{
-								pos:  position{line: 296, col: 36, offset: 9660},
+								pos:  position{line: 311, col: 36, offset: 10512},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 296, col: 43, offset: 9667},
+							pos:  position{line: 311, col: 43, offset: 10519},
 							name: "EOL",
 						},
 					},
@@ -2226,81 +2418,81 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttributes",
-			pos:  position{line: 300, col: 1, offset: 9733},
+			pos:  position{line: 315, col: 1, offset: 10585},
 			expr: &actionExpr{
-				pos: position{line: 300, col: 20, offset: 9752},
+				pos: position{line: 315, col: 20, offset: 10604},
 				run: (*parser).callonQuoteAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 300, col: 20, offset: 9752},
+					pos: position{line: 315, col: 20, offset: 10604},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 300, col: 20, offset: 9752},
+							pos:        position{line: 315, col: 20, offset: 10604},
 							val:        "[quote",
 							ignoreCase: false,
 							want:       "\"[quote\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 300, col: 29, offset: 9761},
+							pos: position{line: 315, col: 29, offset: 10613},
 							expr: &ruleRefExpr{
-								pos:  position{line: 300, col: 29, offset: 9761},
+								pos:  position{line: 315, col: 29, offset: 10613},
 								name: "Space",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 300, col: 36, offset: 9768},
+							pos: position{line: 315, col: 36, offset: 10620},
 							expr: &litMatcher{
-								pos:        position{line: 300, col: 36, offset: 9768},
+								pos:        position{line: 315, col: 36, offset: 10620},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 300, col: 41, offset: 9773},
+							pos:   position{line: 315, col: 41, offset: 10625},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 300, col: 48, offset: 9780},
+								pos: position{line: 315, col: 48, offset: 10632},
 								expr: &ruleRefExpr{
-									pos:  position{line: 300, col: 49, offset: 9781},
+									pos:  position{line: 315, col: 49, offset: 10633},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 300, col: 66, offset: 9798},
+							pos: position{line: 315, col: 66, offset: 10650},
 							expr: &litMatcher{
-								pos:        position{line: 300, col: 66, offset: 9798},
+								pos:        position{line: 315, col: 66, offset: 10650},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 300, col: 71, offset: 9803},
+							pos:   position{line: 315, col: 71, offset: 10655},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 300, col: 77, offset: 9809},
+								pos: position{line: 315, col: 77, offset: 10661},
 								expr: &ruleRefExpr{
-									pos:  position{line: 300, col: 78, offset: 9810},
+									pos:  position{line: 315, col: 78, offset: 10662},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 300, col: 95, offset: 9827},
+							pos:        position{line: 315, col: 95, offset: 10679},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 300, col: 99, offset: 9831},
+							pos: position{line: 315, col: 99, offset: 10683},
 							expr: &ruleRefExpr{
-								pos:  position{line: 300, col: 99, offset: 9831},
+								pos:  position{line: 315, col: 99, offset: 10683},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 300, col: 106, offset: 9838},
+							pos:  position{line: 315, col: 106, offset: 10690},
 							name: "EOL",
 						},
 					},
@@ -2309,81 +2501,81 @@ var g = &grammar{
 		},
 		{
 			name: "VerseAttributes",
-			pos:  position{line: 304, col: 1, offset: 9907},
+			pos:  position{line: 319, col: 1, offset: 10759},
 			expr: &actionExpr{
-				pos: position{line: 304, col: 20, offset: 9926},
+				pos: position{line: 319, col: 20, offset: 10778},
 				run: (*parser).callonVerseAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 304, col: 20, offset: 9926},
+					pos: position{line: 319, col: 20, offset: 10778},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 304, col: 20, offset: 9926},
+							pos:        position{line: 319, col: 20, offset: 10778},
 							val:        "[verse",
 							ignoreCase: false,
 							want:       "\"[verse\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 304, col: 29, offset: 9935},
+							pos: position{line: 319, col: 29, offset: 10787},
 							expr: &ruleRefExpr{
-								pos:  position{line: 304, col: 29, offset: 9935},
+								pos:  position{line: 319, col: 29, offset: 10787},
 								name: "Space",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 304, col: 36, offset: 9942},
+							pos: position{line: 319, col: 36, offset: 10794},
 							expr: &litMatcher{
-								pos:        position{line: 304, col: 36, offset: 9942},
+								pos:        position{line: 319, col: 36, offset: 10794},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 304, col: 41, offset: 9947},
+							pos:   position{line: 319, col: 41, offset: 10799},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 304, col: 48, offset: 9954},
+								pos: position{line: 319, col: 48, offset: 10806},
 								expr: &ruleRefExpr{
-									pos:  position{line: 304, col: 49, offset: 9955},
+									pos:  position{line: 319, col: 49, offset: 10807},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 304, col: 66, offset: 9972},
+							pos: position{line: 319, col: 66, offset: 10824},
 							expr: &litMatcher{
-								pos:        position{line: 304, col: 66, offset: 9972},
+								pos:        position{line: 319, col: 66, offset: 10824},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 304, col: 71, offset: 9977},
+							pos:   position{line: 319, col: 71, offset: 10829},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 304, col: 77, offset: 9983},
+								pos: position{line: 319, col: 77, offset: 10835},
 								expr: &ruleRefExpr{
-									pos:  position{line: 304, col: 78, offset: 9984},
+									pos:  position{line: 319, col: 78, offset: 10836},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 304, col: 95, offset: 10001},
+							pos:        position{line: 319, col: 95, offset: 10853},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 304, col: 99, offset: 10005},
+							pos: position{line: 319, col: 99, offset: 10857},
 							expr: &ruleRefExpr{
-								pos:  position{line: 304, col: 99, offset: 10005},
+								pos:  position{line: 319, col: 99, offset: 10857},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 304, col: 106, offset: 10012},
+							pos:  position{line: 319, col: 106, offset: 10864},
 							name: "EOL",
 						},
 					},
@@ -2392,14 +2584,14 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttribute",
-			pos:  position{line: 308, col: 1, offset: 10099},
+			pos:  position{line: 323, col: 1, offset: 10951},
 			expr: &actionExpr{
-				pos: position{line: 308, col: 19, offset: 10117},
+				pos: position{line: 323, col: 19, offset: 10969},
 				run: (*parser).callonQuoteAttribute1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 308, col: 20, offset: 10118},
+					pos: position{line: 323, col: 20, offset: 10970},
 					expr: &charClassMatcher{
-						pos:        position{line: 308, col: 20, offset: 10118},
+						pos:        position{line: 323, col: 20, offset: 10970},
 						val:        "[^\\r\\n,\\]]",
 						chars:      []rune{'\r', '\n', ',', ']'},
 						ignoreCase: false,
@@ -2410,32 +2602,32 @@ var g = &grammar{
 		},
 		{
 			name: "InlineAttributes",
-			pos:  position{line: 312, col: 1, offset: 10167},
+			pos:  position{line: 327, col: 1, offset: 11019},
 			expr: &actionExpr{
-				pos: position{line: 312, col: 21, offset: 10187},
+				pos: position{line: 327, col: 21, offset: 11039},
 				run: (*parser).callonInlineAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 312, col: 21, offset: 10187},
+					pos: position{line: 327, col: 21, offset: 11039},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 312, col: 21, offset: 10187},
+							pos:        position{line: 327, col: 21, offset: 11039},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 312, col: 25, offset: 10191},
+							pos:   position{line: 327, col: 25, offset: 11043},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 312, col: 31, offset: 10197},
+								pos: position{line: 327, col: 31, offset: 11049},
 								expr: &ruleRefExpr{
-									pos:  position{line: 312, col: 32, offset: 10198},
+									pos:  position{line: 327, col: 32, offset: 11050},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 312, col: 51, offset: 10217},
+							pos:        position{line: 327, col: 51, offset: 11069},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -2446,34 +2638,34 @@ var g = &grammar{
 		},
 		{
 			name: "Section",
-			pos:  position{line: 319, col: 1, offset: 10393},
+			pos:  position{line: 334, col: 1, offset: 11245},
 			expr: &actionExpr{
-				pos: position{line: 319, col: 12, offset: 10404},
+				pos: position{line: 334, col: 12, offset: 11256},
 				run: (*parser).callonSection1,
 				expr: &seqExpr{
-					pos: position{line: 319, col: 12, offset: 10404},
+					pos: position{line: 334, col: 12, offset: 11256},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 319, col: 12, offset: 10404},
+							pos:   position{line: 334, col: 12, offset: 11256},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 319, col: 23, offset: 10415},
+								pos: position{line: 334, col: 23, offset: 11267},
 								expr: &ruleRefExpr{
-									pos:  position{line: 319, col: 24, offset: 10416},
+									pos:  position{line: 334, col: 24, offset: 11268},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 320, col: 5, offset: 10433},
+							pos:   position{line: 335, col: 5, offset: 11285},
 							label: "level",
 							expr: &actionExpr{
-								pos: position{line: 320, col: 12, offset: 10440},
+								pos: position{line: 335, col: 12, offset: 11292},
 								run: (*parser).callonSection7,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 320, col: 12, offset: 10440},
+									pos: position{line: 335, col: 12, offset: 11292},
 									expr: &litMatcher{
-										pos:        position{line: 320, col: 13, offset: 10441},
+										pos:        position{line: 335, col: 13, offset: 11293},
 										val:        "=",
 										ignoreCase: false,
 										want:       "\"=\"",
@@ -2482,37 +2674,37 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 324, col: 5, offset: 10532},
+							pos: position{line: 339, col: 5, offset: 11384},
 							run: (*parser).callonSection10,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 328, col: 5, offset: 10684},
+							pos: position{line: 343, col: 5, offset: 11536},
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 5, offset: 10684},
+								pos:  position{line: 343, col: 5, offset: 11536},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 328, col: 12, offset: 10691},
+							pos:   position{line: 343, col: 12, offset: 11543},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 19, offset: 10698},
+								pos:  position{line: 343, col: 19, offset: 11550},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 328, col: 34, offset: 10713},
+							pos:   position{line: 343, col: 34, offset: 11565},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 328, col: 38, offset: 10717},
+								pos: position{line: 343, col: 38, offset: 11569},
 								expr: &ruleRefExpr{
-									pos:  position{line: 328, col: 38, offset: 10717},
+									pos:  position{line: 343, col: 38, offset: 11569},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 56, offset: 10735},
+							pos:  position{line: 343, col: 56, offset: 11587},
 							name: "EOL",
 						},
 					},
@@ -2521,34 +2713,34 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElements",
-			pos:  position{line: 332, col: 1, offset: 10841},
+			pos:  position{line: 347, col: 1, offset: 11713},
 			expr: &actionExpr{
-				pos: position{line: 332, col: 18, offset: 10858},
+				pos: position{line: 347, col: 18, offset: 11730},
 				run: (*parser).callonTitleElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 332, col: 18, offset: 10858},
+					pos:   position{line: 347, col: 18, offset: 11730},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 332, col: 27, offset: 10867},
+						pos: position{line: 347, col: 27, offset: 11739},
 						expr: &seqExpr{
-							pos: position{line: 332, col: 28, offset: 10868},
+							pos: position{line: 347, col: 28, offset: 11740},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 332, col: 28, offset: 10868},
+									pos: position{line: 347, col: 28, offset: 11740},
 									expr: &ruleRefExpr{
-										pos:  position{line: 332, col: 29, offset: 10869},
+										pos:  position{line: 347, col: 29, offset: 11741},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 332, col: 37, offset: 10877},
+									pos: position{line: 347, col: 37, offset: 11749},
 									expr: &ruleRefExpr{
-										pos:  position{line: 332, col: 38, offset: 10878},
+										pos:  position{line: 347, col: 38, offset: 11750},
 										name: "InlineElementID",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 332, col: 54, offset: 10894},
+									pos:  position{line: 347, col: 54, offset: 11766},
 									name: "TitleElement",
 								},
 							},
@@ -2559,61 +2751,61 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElement",
-			pos:  position{line: 336, col: 1, offset: 11015},
+			pos:  position{line: 351, col: 1, offset: 11887},
 			expr: &actionExpr{
-				pos: position{line: 336, col: 17, offset: 11031},
+				pos: position{line: 351, col: 17, offset: 11903},
 				run: (*parser).callonTitleElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 336, col: 17, offset: 11031},
+					pos:   position{line: 351, col: 17, offset: 11903},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 336, col: 26, offset: 11040},
+						pos: position{line: 351, col: 26, offset: 11912},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 336, col: 26, offset: 11040},
+								pos:  position{line: 351, col: 26, offset: 11912},
 								name: "Word",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 337, col: 11, offset: 11055},
+								pos:  position{line: 352, col: 11, offset: 11927},
 								name: "LineBreak",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 338, col: 11, offset: 11100},
+								pos: position{line: 353, col: 11, offset: 11972},
 								expr: &ruleRefExpr{
-									pos:  position{line: 338, col: 11, offset: 11100},
+									pos:  position{line: 353, col: 11, offset: 11972},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 339, col: 11, offset: 11118},
+								pos:  position{line: 354, col: 11, offset: 11990},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 340, col: 11, offset: 11143},
+								pos:  position{line: 355, col: 11, offset: 12015},
 								name: "InlinePassthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 341, col: 11, offset: 11171},
+								pos:  position{line: 356, col: 11, offset: 12043},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 342, col: 11, offset: 11194},
+								pos:  position{line: 357, col: 11, offset: 12066},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 343, col: 11, offset: 11209},
+								pos:  position{line: 358, col: 11, offset: 12081},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 344, col: 11, offset: 11234},
+								pos:  position{line: 359, col: 11, offset: 12106},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 345, col: 11, offset: 11255},
+								pos:  position{line: 360, col: 11, offset: 12127},
 								name: "AttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 346, col: 11, offset: 11287},
+								pos:  position{line: 361, col: 11, offset: 12159},
 								name: "AnyChar",
 							},
 						},
@@ -2623,59 +2815,63 @@ var g = &grammar{
 		},
 		{
 			name: "TableOfContentsPlaceHolder",
-			pos:  position{line: 353, col: 1, offset: 11438},
-			expr: &seqExpr{
-				pos: position{line: 353, col: 31, offset: 11468},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 353, col: 31, offset: 11468},
-						val:        "toc::[]",
-						ignoreCase: false,
-						want:       "\"toc::[]\"",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 353, col: 41, offset: 11478},
-						name: "EOL",
+			pos:  position{line: 368, col: 1, offset: 12310},
+			expr: &actionExpr{
+				pos: position{line: 368, col: 31, offset: 12340},
+				run: (*parser).callonTableOfContentsPlaceHolder1,
+				expr: &seqExpr{
+					pos: position{line: 368, col: 31, offset: 12340},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 368, col: 31, offset: 12340},
+							val:        "toc::[]",
+							ignoreCase: false,
+							want:       "\"toc::[]\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 368, col: 41, offset: 12350},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "UserMacroBlock",
-			pos:  position{line: 358, col: 1, offset: 11589},
+			pos:  position{line: 375, col: 1, offset: 12516},
 			expr: &actionExpr{
-				pos: position{line: 358, col: 19, offset: 11607},
+				pos: position{line: 375, col: 19, offset: 12534},
 				run: (*parser).callonUserMacroBlock1,
 				expr: &seqExpr{
-					pos: position{line: 358, col: 19, offset: 11607},
+					pos: position{line: 375, col: 19, offset: 12534},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 358, col: 19, offset: 11607},
+							pos:   position{line: 375, col: 19, offset: 12534},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 358, col: 25, offset: 11613},
+								pos:  position{line: 375, col: 25, offset: 12540},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 358, col: 40, offset: 11628},
+							pos:        position{line: 375, col: 40, offset: 12555},
 							val:        "::",
 							ignoreCase: false,
 							want:       "\"::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 358, col: 45, offset: 11633},
+							pos:   position{line: 375, col: 45, offset: 12560},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 358, col: 52, offset: 11640},
+								pos:  position{line: 375, col: 52, offset: 12567},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 358, col: 68, offset: 11656},
+							pos:   position{line: 375, col: 68, offset: 12583},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 358, col: 75, offset: 11663},
+								pos:  position{line: 375, col: 75, offset: 12590},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -2685,40 +2881,40 @@ var g = &grammar{
 		},
 		{
 			name: "InlineUserMacro",
-			pos:  position{line: 362, col: 1, offset: 11778},
+			pos:  position{line: 379, col: 1, offset: 12725},
 			expr: &actionExpr{
-				pos: position{line: 362, col: 20, offset: 11797},
+				pos: position{line: 379, col: 20, offset: 12744},
 				run: (*parser).callonInlineUserMacro1,
 				expr: &seqExpr{
-					pos: position{line: 362, col: 20, offset: 11797},
+					pos: position{line: 379, col: 20, offset: 12744},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 362, col: 20, offset: 11797},
+							pos:   position{line: 379, col: 20, offset: 12744},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 362, col: 26, offset: 11803},
+								pos:  position{line: 379, col: 26, offset: 12750},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 362, col: 41, offset: 11818},
+							pos:        position{line: 379, col: 41, offset: 12765},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 362, col: 45, offset: 11822},
+							pos:   position{line: 379, col: 45, offset: 12769},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 362, col: 52, offset: 11829},
+								pos:  position{line: 379, col: 52, offset: 12776},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 362, col: 68, offset: 11845},
+							pos:   position{line: 379, col: 68, offset: 12792},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 362, col: 75, offset: 11852},
+								pos:  position{line: 379, col: 75, offset: 12799},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -2728,14 +2924,14 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroName",
-			pos:  position{line: 366, col: 1, offset: 11968},
+			pos:  position{line: 383, col: 1, offset: 12935},
 			expr: &actionExpr{
-				pos: position{line: 366, col: 18, offset: 11985},
+				pos: position{line: 383, col: 18, offset: 12952},
 				run: (*parser).callonUserMacroName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 366, col: 19, offset: 11986},
+					pos: position{line: 383, col: 19, offset: 12953},
 					expr: &charClassMatcher{
-						pos:        position{line: 366, col: 19, offset: 11986},
+						pos:        position{line: 383, col: 19, offset: 12953},
 						val:        "[\\pL0-9_-]",
 						chars:      []rune{'_', '-'},
 						ranges:     []rune{'0', '9'},
@@ -2748,14 +2944,14 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroValue",
-			pos:  position{line: 370, col: 1, offset: 12035},
+			pos:  position{line: 387, col: 1, offset: 13002},
 			expr: &actionExpr{
-				pos: position{line: 370, col: 19, offset: 12053},
+				pos: position{line: 387, col: 19, offset: 13020},
 				run: (*parser).callonUserMacroValue1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 370, col: 19, offset: 12053},
+					pos: position{line: 387, col: 19, offset: 13020},
 					expr: &charClassMatcher{
-						pos:        position{line: 370, col: 19, offset: 12053},
+						pos:        position{line: 387, col: 19, offset: 13020},
 						val:        "[^:[ \\r\\n]",
 						chars:      []rune{':', '[', ' ', '\r', '\n'},
 						ignoreCase: false,
@@ -2766,32 +2962,32 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroAttributes",
-			pos:  position{line: 374, col: 1, offset: 12101},
+			pos:  position{line: 391, col: 1, offset: 13068},
 			expr: &actionExpr{
-				pos: position{line: 374, col: 24, offset: 12124},
+				pos: position{line: 391, col: 24, offset: 13091},
 				run: (*parser).callonUserMacroAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 374, col: 24, offset: 12124},
+					pos: position{line: 391, col: 24, offset: 13091},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 374, col: 24, offset: 12124},
+							pos:        position{line: 391, col: 24, offset: 13091},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 374, col: 28, offset: 12128},
+							pos:   position{line: 391, col: 28, offset: 13095},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 374, col: 34, offset: 12134},
+								pos: position{line: 391, col: 34, offset: 13101},
 								expr: &ruleRefExpr{
-									pos:  position{line: 374, col: 35, offset: 12135},
+									pos:  position{line: 391, col: 35, offset: 13102},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 374, col: 54, offset: 12154},
+							pos:        position{line: 391, col: 54, offset: 13121},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -2802,41 +2998,41 @@ var g = &grammar{
 		},
 		{
 			name: "FileInclusion",
-			pos:  position{line: 381, col: 1, offset: 12336},
+			pos:  position{line: 398, col: 1, offset: 13303},
 			expr: &actionExpr{
-				pos: position{line: 381, col: 18, offset: 12353},
+				pos: position{line: 398, col: 18, offset: 13320},
 				run: (*parser).callonFileInclusion1,
 				expr: &seqExpr{
-					pos: position{line: 381, col: 18, offset: 12353},
+					pos: position{line: 398, col: 18, offset: 13320},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 381, col: 18, offset: 12353},
+							pos:   position{line: 398, col: 18, offset: 13320},
 							label: "incl",
 							expr: &actionExpr{
-								pos: position{line: 381, col: 24, offset: 12359},
+								pos: position{line: 398, col: 24, offset: 13326},
 								run: (*parser).callonFileInclusion4,
 								expr: &seqExpr{
-									pos: position{line: 381, col: 24, offset: 12359},
+									pos: position{line: 398, col: 24, offset: 13326},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 381, col: 24, offset: 12359},
+											pos:        position{line: 398, col: 24, offset: 13326},
 											val:        "include::",
 											ignoreCase: false,
 											want:       "\"include::\"",
 										},
 										&labeledExpr{
-											pos:   position{line: 381, col: 36, offset: 12371},
+											pos:   position{line: 398, col: 36, offset: 13338},
 											label: "path",
 											expr: &ruleRefExpr{
-												pos:  position{line: 381, col: 42, offset: 12377},
+												pos:  position{line: 398, col: 42, offset: 13344},
 												name: "FileLocation",
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 381, col: 56, offset: 12391},
+											pos:   position{line: 398, col: 56, offset: 13358},
 											label: "inlineAttributes",
 											expr: &ruleRefExpr{
-												pos:  position{line: 381, col: 74, offset: 12409},
+												pos:  position{line: 398, col: 74, offset: 13376},
 												name: "FileIncludeAttributes",
 											},
 										},
@@ -2845,14 +3041,14 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 383, col: 8, offset: 12556},
+							pos: position{line: 400, col: 8, offset: 13543},
 							expr: &ruleRefExpr{
-								pos:  position{line: 383, col: 8, offset: 12556},
+								pos:  position{line: 400, col: 8, offset: 13543},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 383, col: 15, offset: 12563},
+							pos:  position{line: 400, col: 15, offset: 13550},
 							name: "EOL",
 						},
 					},
@@ -2861,37 +3057,37 @@ var g = &grammar{
 		},
 		{
 			name: "FileIncludeAttributes",
-			pos:  position{line: 387, col: 1, offset: 12615},
+			pos:  position{line: 404, col: 1, offset: 13602},
 			expr: &actionExpr{
-				pos: position{line: 387, col: 26, offset: 12640},
+				pos: position{line: 404, col: 26, offset: 13627},
 				run: (*parser).callonFileIncludeAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 387, col: 26, offset: 12640},
+					pos: position{line: 404, col: 26, offset: 13627},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 387, col: 26, offset: 12640},
+							pos:        position{line: 404, col: 26, offset: 13627},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 387, col: 30, offset: 12644},
+							pos:   position{line: 404, col: 30, offset: 13631},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 387, col: 36, offset: 12650},
+								pos: position{line: 404, col: 36, offset: 13637},
 								expr: &choiceExpr{
-									pos: position{line: 387, col: 37, offset: 12651},
+									pos: position{line: 404, col: 37, offset: 13638},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 387, col: 37, offset: 12651},
+											pos:  position{line: 404, col: 37, offset: 13638},
 											name: "LineRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 387, col: 59, offset: 12673},
+											pos:  position{line: 404, col: 59, offset: 13660},
 											name: "TagRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 387, col: 80, offset: 12694},
+											pos:  position{line: 404, col: 80, offset: 13681},
 											name: "GenericAttribute",
 										},
 									},
@@ -2899,7 +3095,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 387, col: 99, offset: 12713},
+							pos:        position{line: 404, col: 99, offset: 13700},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -2910,31 +3106,31 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttribute",
-			pos:  position{line: 391, col: 1, offset: 12785},
+			pos:  position{line: 408, col: 1, offset: 13772},
 			expr: &actionExpr{
-				pos: position{line: 391, col: 24, offset: 12808},
+				pos: position{line: 408, col: 24, offset: 13795},
 				run: (*parser).callonLineRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 391, col: 24, offset: 12808},
+					pos: position{line: 408, col: 24, offset: 13795},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 391, col: 24, offset: 12808},
+							pos:        position{line: 408, col: 24, offset: 13795},
 							val:        "lines=",
 							ignoreCase: false,
 							want:       "\"lines=\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 391, col: 33, offset: 12817},
+							pos:   position{line: 408, col: 33, offset: 13804},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 391, col: 40, offset: 12824},
+								pos:  position{line: 408, col: 40, offset: 13811},
 								name: "LineRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 391, col: 66, offset: 12850},
+							pos: position{line: 408, col: 66, offset: 13837},
 							expr: &litMatcher{
-								pos:        position{line: 391, col: 66, offset: 12850},
+								pos:        position{line: 408, col: 66, offset: 13837},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
@@ -2946,73 +3142,73 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttributeValue",
-			pos:  position{line: 395, col: 1, offset: 12909},
+			pos:  position{line: 412, col: 1, offset: 13896},
 			expr: &actionExpr{
-				pos: position{line: 395, col: 29, offset: 12937},
+				pos: position{line: 412, col: 29, offset: 13924},
 				run: (*parser).callonLineRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 395, col: 29, offset: 12937},
+					pos: position{line: 412, col: 29, offset: 13924},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 395, col: 29, offset: 12937},
+							pos:   position{line: 412, col: 29, offset: 13924},
 							label: "value",
 							expr: &choiceExpr{
-								pos: position{line: 395, col: 36, offset: 12944},
+								pos: position{line: 412, col: 36, offset: 13931},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 395, col: 36, offset: 12944},
+										pos:  position{line: 412, col: 36, offset: 13931},
 										name: "MultipleLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 396, col: 11, offset: 13061},
+										pos:  position{line: 413, col: 11, offset: 14048},
 										name: "MultipleQuotedLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 397, col: 11, offset: 13097},
+										pos:  position{line: 414, col: 11, offset: 14084},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 398, col: 11, offset: 13123},
+										pos:  position{line: 415, col: 11, offset: 14110},
 										name: "MultiLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 399, col: 11, offset: 13155},
+										pos:  position{line: 416, col: 11, offset: 14142},
 										name: "SingleLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 400, col: 11, offset: 13187},
+										pos:  position{line: 417, col: 11, offset: 14174},
 										name: "SingleLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 401, col: 11, offset: 13214},
+										pos:  position{line: 418, col: 11, offset: 14201},
 										name: "UndefinedLineRange",
 									},
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 401, col: 31, offset: 13234},
+							pos: position{line: 418, col: 31, offset: 14221},
 							expr: &ruleRefExpr{
-								pos:  position{line: 401, col: 31, offset: 13234},
+								pos:  position{line: 418, col: 31, offset: 14221},
 								name: "Space",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 401, col: 39, offset: 13242},
+							pos: position{line: 418, col: 39, offset: 14229},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 401, col: 39, offset: 13242},
+									pos: position{line: 418, col: 39, offset: 14229},
 									expr: &litMatcher{
-										pos:        position{line: 401, col: 40, offset: 13243},
+										pos:        position{line: 418, col: 40, offset: 14230},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 								},
 								&andExpr{
-									pos: position{line: 401, col: 46, offset: 13249},
+									pos: position{line: 418, col: 46, offset: 14236},
 									expr: &litMatcher{
-										pos:        position{line: 401, col: 47, offset: 13250},
+										pos:        position{line: 418, col: 47, offset: 14237},
 										val:        "]",
 										ignoreCase: false,
 										want:       "\"]\"",
@@ -3026,59 +3222,59 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleLineRanges",
-			pos:  position{line: 405, col: 1, offset: 13282},
+			pos:  position{line: 422, col: 1, offset: 14269},
 			expr: &actionExpr{
-				pos: position{line: 405, col: 23, offset: 13304},
+				pos: position{line: 422, col: 23, offset: 14291},
 				run: (*parser).callonMultipleLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 405, col: 23, offset: 13304},
+					pos: position{line: 422, col: 23, offset: 14291},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 405, col: 23, offset: 13304},
+							pos:   position{line: 422, col: 23, offset: 14291},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 405, col: 30, offset: 13311},
+								pos: position{line: 422, col: 30, offset: 14298},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 405, col: 30, offset: 13311},
+										pos:  position{line: 422, col: 30, offset: 14298},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 405, col: 47, offset: 13328},
+										pos:  position{line: 422, col: 47, offset: 14315},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 406, col: 5, offset: 13350},
+							pos:   position{line: 423, col: 5, offset: 14337},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 406, col: 12, offset: 13357},
+								pos: position{line: 423, col: 12, offset: 14344},
 								expr: &actionExpr{
-									pos: position{line: 406, col: 13, offset: 13358},
+									pos: position{line: 423, col: 13, offset: 14345},
 									run: (*parser).callonMultipleLineRanges9,
 									expr: &seqExpr{
-										pos: position{line: 406, col: 13, offset: 13358},
+										pos: position{line: 423, col: 13, offset: 14345},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 406, col: 13, offset: 13358},
+												pos:        position{line: 423, col: 13, offset: 14345},
 												val:        ";",
 												ignoreCase: false,
 												want:       "\";\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 406, col: 17, offset: 13362},
+												pos:   position{line: 423, col: 17, offset: 14349},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 406, col: 24, offset: 13369},
+													pos: position{line: 423, col: 24, offset: 14356},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 406, col: 24, offset: 13369},
+															pos:  position{line: 423, col: 24, offset: 14356},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 406, col: 41, offset: 13386},
+															pos:  position{line: 423, col: 41, offset: 14373},
 															name: "SingleLineRange",
 														},
 													},
@@ -3095,65 +3291,65 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleQuotedLineRanges",
-			pos:  position{line: 412, col: 1, offset: 13524},
+			pos:  position{line: 429, col: 1, offset: 14511},
 			expr: &actionExpr{
-				pos: position{line: 412, col: 29, offset: 13552},
+				pos: position{line: 429, col: 29, offset: 14539},
 				run: (*parser).callonMultipleQuotedLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 412, col: 29, offset: 13552},
+					pos: position{line: 429, col: 29, offset: 14539},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 412, col: 29, offset: 13552},
+							pos:        position{line: 429, col: 29, offset: 14539},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 412, col: 34, offset: 13557},
+							pos:   position{line: 429, col: 34, offset: 14544},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 412, col: 41, offset: 13564},
+								pos: position{line: 429, col: 41, offset: 14551},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 412, col: 41, offset: 13564},
+										pos:  position{line: 429, col: 41, offset: 14551},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 412, col: 58, offset: 13581},
+										pos:  position{line: 429, col: 58, offset: 14568},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 413, col: 5, offset: 13603},
+							pos:   position{line: 430, col: 5, offset: 14590},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 413, col: 12, offset: 13610},
+								pos: position{line: 430, col: 12, offset: 14597},
 								expr: &actionExpr{
-									pos: position{line: 413, col: 13, offset: 13611},
+									pos: position{line: 430, col: 13, offset: 14598},
 									run: (*parser).callonMultipleQuotedLineRanges10,
 									expr: &seqExpr{
-										pos: position{line: 413, col: 13, offset: 13611},
+										pos: position{line: 430, col: 13, offset: 14598},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 413, col: 13, offset: 13611},
+												pos:        position{line: 430, col: 13, offset: 14598},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 413, col: 17, offset: 13615},
+												pos:   position{line: 430, col: 17, offset: 14602},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 413, col: 24, offset: 13622},
+													pos: position{line: 430, col: 24, offset: 14609},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 413, col: 24, offset: 13622},
+															pos:  position{line: 430, col: 24, offset: 14609},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 413, col: 41, offset: 13639},
+															pos:  position{line: 430, col: 41, offset: 14626},
 															name: "SingleLineRange",
 														},
 													},
@@ -3165,7 +3361,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 415, col: 9, offset: 13692},
+							pos:        position{line: 432, col: 9, offset: 14679},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -3176,32 +3372,32 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineRange",
-			pos:  position{line: 419, col: 1, offset: 13782},
+			pos:  position{line: 436, col: 1, offset: 14769},
 			expr: &actionExpr{
-				pos: position{line: 419, col: 19, offset: 13800},
+				pos: position{line: 436, col: 19, offset: 14787},
 				run: (*parser).callonMultiLineRange1,
 				expr: &seqExpr{
-					pos: position{line: 419, col: 19, offset: 13800},
+					pos: position{line: 436, col: 19, offset: 14787},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 419, col: 19, offset: 13800},
+							pos:   position{line: 436, col: 19, offset: 14787},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 419, col: 26, offset: 13807},
+								pos:  position{line: 436, col: 26, offset: 14794},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 419, col: 34, offset: 13815},
+							pos:        position{line: 436, col: 34, offset: 14802},
 							val:        "..",
 							ignoreCase: false,
 							want:       "\"..\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 419, col: 39, offset: 13820},
+							pos:   position{line: 436, col: 39, offset: 14807},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 419, col: 44, offset: 13825},
+								pos:  position{line: 436, col: 44, offset: 14812},
 								name: "NUMBER",
 							},
 						},
@@ -3211,43 +3407,43 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineQuotedRange",
-			pos:  position{line: 423, col: 1, offset: 13913},
+			pos:  position{line: 440, col: 1, offset: 14900},
 			expr: &actionExpr{
-				pos: position{line: 423, col: 25, offset: 13937},
+				pos: position{line: 440, col: 25, offset: 14924},
 				run: (*parser).callonMultiLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 423, col: 25, offset: 13937},
+					pos: position{line: 440, col: 25, offset: 14924},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 423, col: 25, offset: 13937},
+							pos:        position{line: 440, col: 25, offset: 14924},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 423, col: 30, offset: 13942},
+							pos:   position{line: 440, col: 30, offset: 14929},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 423, col: 37, offset: 13949},
+								pos:  position{line: 440, col: 37, offset: 14936},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 423, col: 45, offset: 13957},
+							pos:        position{line: 440, col: 45, offset: 14944},
 							val:        "..",
 							ignoreCase: false,
 							want:       "\"..\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 423, col: 50, offset: 13962},
+							pos:   position{line: 440, col: 50, offset: 14949},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 423, col: 55, offset: 13967},
+								pos:  position{line: 440, col: 55, offset: 14954},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 423, col: 63, offset: 13975},
+							pos:        position{line: 440, col: 63, offset: 14962},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -3258,15 +3454,15 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineRange",
-			pos:  position{line: 427, col: 1, offset: 14060},
+			pos:  position{line: 444, col: 1, offset: 15047},
 			expr: &actionExpr{
-				pos: position{line: 427, col: 20, offset: 14079},
+				pos: position{line: 444, col: 20, offset: 15066},
 				run: (*parser).callonSingleLineRange1,
 				expr: &labeledExpr{
-					pos:   position{line: 427, col: 20, offset: 14079},
+					pos:   position{line: 444, col: 20, offset: 15066},
 					label: "singleline",
 					expr: &ruleRefExpr{
-						pos:  position{line: 427, col: 32, offset: 14091},
+						pos:  position{line: 444, col: 32, offset: 15078},
 						name: "NUMBER",
 					},
 				},
@@ -3274,29 +3470,29 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineQuotedRange",
-			pos:  position{line: 431, col: 1, offset: 14186},
+			pos:  position{line: 448, col: 1, offset: 15173},
 			expr: &actionExpr{
-				pos: position{line: 431, col: 26, offset: 14211},
+				pos: position{line: 448, col: 26, offset: 15198},
 				run: (*parser).callonSingleLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 431, col: 26, offset: 14211},
+					pos: position{line: 448, col: 26, offset: 15198},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 431, col: 26, offset: 14211},
+							pos:        position{line: 448, col: 26, offset: 15198},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 431, col: 31, offset: 14216},
+							pos:   position{line: 448, col: 31, offset: 15203},
 							label: "singleline",
 							expr: &ruleRefExpr{
-								pos:  position{line: 431, col: 43, offset: 14228},
+								pos:  position{line: 448, col: 43, offset: 15215},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 431, col: 51, offset: 14236},
+							pos:        position{line: 448, col: 51, offset: 15223},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -3307,14 +3503,14 @@ var g = &grammar{
 		},
 		{
 			name: "UndefinedLineRange",
-			pos:  position{line: 435, col: 1, offset: 14328},
+			pos:  position{line: 452, col: 1, offset: 15315},
 			expr: &actionExpr{
-				pos: position{line: 435, col: 23, offset: 14350},
+				pos: position{line: 452, col: 23, offset: 15337},
 				run: (*parser).callonUndefinedLineRange1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 435, col: 23, offset: 14350},
+					pos: position{line: 452, col: 23, offset: 15337},
 					expr: &charClassMatcher{
-						pos:        position{line: 435, col: 23, offset: 14350},
+						pos:        position{line: 452, col: 23, offset: 15337},
 						val:        "[^\\], ]",
 						chars:      []rune{']', ',', ' '},
 						ignoreCase: false,
@@ -3325,24 +3521,24 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttribute",
-			pos:  position{line: 439, col: 1, offset: 14395},
+			pos:  position{line: 456, col: 1, offset: 15382},
 			expr: &actionExpr{
-				pos: position{line: 439, col: 23, offset: 14417},
+				pos: position{line: 456, col: 23, offset: 15404},
 				run: (*parser).callonTagRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 439, col: 23, offset: 14417},
+					pos: position{line: 456, col: 23, offset: 15404},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 439, col: 24, offset: 14418},
+							pos: position{line: 456, col: 24, offset: 15405},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 439, col: 24, offset: 14418},
+									pos:        position{line: 456, col: 24, offset: 15405},
 									val:        "tags=",
 									ignoreCase: false,
 									want:       "\"tags=\"",
 								},
 								&litMatcher{
-									pos:        position{line: 439, col: 34, offset: 14428},
+									pos:        position{line: 456, col: 34, offset: 15415},
 									val:        "tag=",
 									ignoreCase: false,
 									want:       "\"tag=\"",
@@ -3350,17 +3546,17 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 439, col: 42, offset: 14436},
+							pos:   position{line: 456, col: 42, offset: 15423},
 							label: "tags",
 							expr: &ruleRefExpr{
-								pos:  position{line: 439, col: 48, offset: 14442},
+								pos:  position{line: 456, col: 48, offset: 15429},
 								name: "TagRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 439, col: 73, offset: 14467},
+							pos: position{line: 456, col: 73, offset: 15454},
 							expr: &litMatcher{
-								pos:        position{line: 439, col: 73, offset: 14467},
+								pos:        position{line: 456, col: 73, offset: 15454},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
@@ -3372,44 +3568,44 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttributeValue",
-			pos:  position{line: 443, col: 1, offset: 14616},
+			pos:  position{line: 460, col: 1, offset: 15603},
 			expr: &actionExpr{
-				pos: position{line: 443, col: 28, offset: 14643},
+				pos: position{line: 460, col: 28, offset: 15630},
 				run: (*parser).callonTagRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 443, col: 28, offset: 14643},
+					pos: position{line: 460, col: 28, offset: 15630},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 443, col: 28, offset: 14643},
+							pos:   position{line: 460, col: 28, offset: 15630},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 443, col: 35, offset: 14650},
+								pos:  position{line: 460, col: 35, offset: 15637},
 								name: "MultipleTagRanges",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 443, col: 54, offset: 14669},
+							pos: position{line: 460, col: 54, offset: 15656},
 							expr: &ruleRefExpr{
-								pos:  position{line: 443, col: 54, offset: 14669},
+								pos:  position{line: 460, col: 54, offset: 15656},
 								name: "Space",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 443, col: 62, offset: 14677},
+							pos: position{line: 460, col: 62, offset: 15664},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 443, col: 62, offset: 14677},
+									pos: position{line: 460, col: 62, offset: 15664},
 									expr: &litMatcher{
-										pos:        position{line: 443, col: 63, offset: 14678},
+										pos:        position{line: 460, col: 63, offset: 15665},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 								},
 								&andExpr{
-									pos: position{line: 443, col: 69, offset: 14684},
+									pos: position{line: 460, col: 69, offset: 15671},
 									expr: &litMatcher{
-										pos:        position{line: 443, col: 70, offset: 14685},
+										pos:        position{line: 460, col: 70, offset: 15672},
 										val:        "]",
 										ignoreCase: false,
 										want:       "\"]\"",
@@ -3423,43 +3619,43 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleTagRanges",
-			pos:  position{line: 447, col: 1, offset: 14717},
+			pos:  position{line: 464, col: 1, offset: 15704},
 			expr: &actionExpr{
-				pos: position{line: 447, col: 22, offset: 14738},
+				pos: position{line: 464, col: 22, offset: 15725},
 				run: (*parser).callonMultipleTagRanges1,
 				expr: &seqExpr{
-					pos: position{line: 447, col: 22, offset: 14738},
+					pos: position{line: 464, col: 22, offset: 15725},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 447, col: 22, offset: 14738},
+							pos:   position{line: 464, col: 22, offset: 15725},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 447, col: 29, offset: 14745},
+								pos:  position{line: 464, col: 29, offset: 15732},
 								name: "TagRange",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 448, col: 5, offset: 14759},
+							pos:   position{line: 465, col: 5, offset: 15746},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 448, col: 12, offset: 14766},
+								pos: position{line: 465, col: 12, offset: 15753},
 								expr: &actionExpr{
-									pos: position{line: 448, col: 13, offset: 14767},
+									pos: position{line: 465, col: 13, offset: 15754},
 									run: (*parser).callonMultipleTagRanges7,
 									expr: &seqExpr{
-										pos: position{line: 448, col: 13, offset: 14767},
+										pos: position{line: 465, col: 13, offset: 15754},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 448, col: 13, offset: 14767},
+												pos:        position{line: 465, col: 13, offset: 15754},
 												val:        ";",
 												ignoreCase: false,
 												want:       "\";\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 448, col: 17, offset: 14771},
+												pos:   position{line: 465, col: 17, offset: 15758},
 												label: "other",
 												expr: &ruleRefExpr{
-													pos:  position{line: 448, col: 24, offset: 14778},
+													pos:  position{line: 465, col: 24, offset: 15765},
 													name: "TagRange",
 												},
 											},
@@ -3474,25 +3670,25 @@ var g = &grammar{
 		},
 		{
 			name: "TagRange",
-			pos:  position{line: 454, col: 1, offset: 14909},
+			pos:  position{line: 471, col: 1, offset: 15896},
 			expr: &choiceExpr{
-				pos: position{line: 454, col: 13, offset: 14921},
+				pos: position{line: 471, col: 13, offset: 15908},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 454, col: 13, offset: 14921},
+						pos: position{line: 471, col: 13, offset: 15908},
 						run: (*parser).callonTagRange2,
 						expr: &labeledExpr{
-							pos:   position{line: 454, col: 13, offset: 14921},
+							pos:   position{line: 471, col: 13, offset: 15908},
 							label: "tag",
 							expr: &choiceExpr{
-								pos: position{line: 454, col: 18, offset: 14926},
+								pos: position{line: 471, col: 18, offset: 15913},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 454, col: 18, offset: 14926},
+										pos:  position{line: 471, col: 18, offset: 15913},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 454, col: 30, offset: 14938},
+										pos:  position{line: 471, col: 30, offset: 15925},
 										name: "TagWildcard",
 									},
 								},
@@ -3500,29 +3696,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 456, col: 5, offset: 15006},
+						pos: position{line: 473, col: 5, offset: 15993},
 						run: (*parser).callonTagRange7,
 						expr: &seqExpr{
-							pos: position{line: 456, col: 5, offset: 15006},
+							pos: position{line: 473, col: 5, offset: 15993},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 456, col: 5, offset: 15006},
+									pos:        position{line: 473, col: 5, offset: 15993},
 									val:        "!",
 									ignoreCase: false,
 									want:       "\"!\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 456, col: 9, offset: 15010},
+									pos:   position{line: 473, col: 9, offset: 15997},
 									label: "tag",
 									expr: &choiceExpr{
-										pos: position{line: 456, col: 14, offset: 15015},
+										pos: position{line: 473, col: 14, offset: 16002},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 456, col: 14, offset: 15015},
+												pos:  position{line: 473, col: 14, offset: 16002},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 456, col: 26, offset: 15027},
+												pos:  position{line: 473, col: 26, offset: 16014},
 												name: "TagWildcard",
 											},
 										},
@@ -3536,23 +3732,23 @@ var g = &grammar{
 		},
 		{
 			name: "TagWildcard",
-			pos:  position{line: 460, col: 1, offset: 15095},
+			pos:  position{line: 477, col: 1, offset: 16082},
 			expr: &actionExpr{
-				pos: position{line: 460, col: 16, offset: 15110},
+				pos: position{line: 477, col: 16, offset: 16097},
 				run: (*parser).callonTagWildcard1,
 				expr: &seqExpr{
-					pos: position{line: 460, col: 16, offset: 15110},
+					pos: position{line: 477, col: 16, offset: 16097},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 460, col: 16, offset: 15110},
+							pos:   position{line: 477, col: 16, offset: 16097},
 							label: "stars",
 							expr: &actionExpr{
-								pos: position{line: 460, col: 23, offset: 15117},
+								pos: position{line: 477, col: 23, offset: 16104},
 								run: (*parser).callonTagWildcard4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 460, col: 23, offset: 15117},
+									pos: position{line: 477, col: 23, offset: 16104},
 									expr: &litMatcher{
-										pos:        position{line: 460, col: 24, offset: 15118},
+										pos:        position{line: 477, col: 24, offset: 16105},
 										val:        "*",
 										ignoreCase: false,
 										want:       "\"*\"",
@@ -3561,7 +3757,7 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 463, col: 5, offset: 15172},
+							pos: position{line: 480, col: 5, offset: 16159},
 							run: (*parser).callonTagWildcard7,
 						},
 					},
@@ -3570,18 +3766,18 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimFileContent",
-			pos:  position{line: 471, col: 1, offset: 15414},
+			pos:  position{line: 488, col: 1, offset: 16401},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 471, col: 24, offset: 15437},
+				pos: position{line: 488, col: 24, offset: 16424},
 				expr: &choiceExpr{
-					pos: position{line: 471, col: 25, offset: 15438},
+					pos: position{line: 488, col: 25, offset: 16425},
 					alternatives: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 471, col: 25, offset: 15438},
+							pos:  position{line: 488, col: 25, offset: 16425},
 							name: "FileInclusion",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 471, col: 41, offset: 15454},
+							pos:  position{line: 488, col: 41, offset: 16441},
 							name: "VerbatimFileLine",
 						},
 					},
@@ -3590,30 +3786,30 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimFileLine",
-			pos:  position{line: 473, col: 1, offset: 15474},
+			pos:  position{line: 490, col: 1, offset: 16461},
 			expr: &actionExpr{
-				pos: position{line: 473, col: 21, offset: 15494},
+				pos: position{line: 490, col: 21, offset: 16481},
 				run: (*parser).callonVerbatimFileLine1,
 				expr: &seqExpr{
-					pos: position{line: 473, col: 21, offset: 15494},
+					pos: position{line: 490, col: 21, offset: 16481},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 473, col: 21, offset: 15494},
+							pos: position{line: 490, col: 21, offset: 16481},
 							expr: &ruleRefExpr{
-								pos:  position{line: 473, col: 22, offset: 15495},
+								pos:  position{line: 490, col: 22, offset: 16482},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 473, col: 26, offset: 15499},
+							pos:   position{line: 490, col: 26, offset: 16486},
 							label: "content",
 							expr: &actionExpr{
-								pos: position{line: 473, col: 35, offset: 15508},
+								pos: position{line: 490, col: 35, offset: 16495},
 								run: (*parser).callonVerbatimFileLine6,
 								expr: &zeroOrMoreExpr{
-									pos: position{line: 473, col: 35, offset: 15508},
+									pos: position{line: 490, col: 35, offset: 16495},
 									expr: &charClassMatcher{
-										pos:        position{line: 473, col: 35, offset: 15508},
+										pos:        position{line: 490, col: 35, offset: 16495},
 										val:        "[^\\r\\n]",
 										chars:      []rune{'\r', '\n'},
 										ignoreCase: false,
@@ -3623,7 +3819,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 475, col: 12, offset: 15570},
+							pos:  position{line: 492, col: 12, offset: 16557},
 							name: "EOL",
 						},
 					},
@@ -3632,34 +3828,34 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileLine",
-			pos:  position{line: 482, col: 1, offset: 15769},
+			pos:  position{line: 499, col: 1, offset: 16776},
 			expr: &actionExpr{
-				pos: position{line: 482, col: 21, offset: 15789},
+				pos: position{line: 499, col: 21, offset: 16796},
 				run: (*parser).callonIncludedFileLine1,
 				expr: &seqExpr{
-					pos: position{line: 482, col: 21, offset: 15789},
+					pos: position{line: 499, col: 21, offset: 16796},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 482, col: 21, offset: 15789},
+							pos:   position{line: 499, col: 21, offset: 16796},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 482, col: 29, offset: 15797},
+								pos: position{line: 499, col: 29, offset: 16804},
 								expr: &choiceExpr{
-									pos: position{line: 482, col: 30, offset: 15798},
+									pos: position{line: 499, col: 30, offset: 16805},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 482, col: 30, offset: 15798},
+											pos:  position{line: 499, col: 30, offset: 16805},
 											name: "IncludedFileStartTag",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 482, col: 53, offset: 15821},
+											pos:  position{line: 499, col: 53, offset: 16828},
 											name: "IncludedFileEndTag",
 										},
 										&actionExpr{
-											pos: position{line: 482, col: 74, offset: 15842},
+											pos: position{line: 499, col: 74, offset: 16849},
 											run: (*parser).callonIncludedFileLine8,
 											expr: &anyMatcher{
-												line: 482, col: 74, offset: 15842,
+												line: 499, col: 74, offset: 16849,
 											},
 										},
 									},
@@ -3667,7 +3863,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 482, col: 107, offset: 15875},
+							pos:  position{line: 499, col: 107, offset: 16882},
 							name: "EOL",
 						},
 					},
@@ -3676,33 +3872,33 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileStartTag",
-			pos:  position{line: 486, col: 1, offset: 15946},
+			pos:  position{line: 503, col: 1, offset: 16953},
 			expr: &actionExpr{
-				pos: position{line: 486, col: 25, offset: 15970},
+				pos: position{line: 503, col: 25, offset: 16977},
 				run: (*parser).callonIncludedFileStartTag1,
 				expr: &seqExpr{
-					pos: position{line: 486, col: 25, offset: 15970},
+					pos: position{line: 503, col: 25, offset: 16977},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 486, col: 25, offset: 15970},
+							pos:        position{line: 503, col: 25, offset: 16977},
 							val:        "tag::",
 							ignoreCase: false,
 							want:       "\"tag::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 486, col: 33, offset: 15978},
+							pos:   position{line: 503, col: 33, offset: 16985},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 486, col: 38, offset: 15983},
+								pos: position{line: 503, col: 38, offset: 16990},
 								run: (*parser).callonIncludedFileStartTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 486, col: 38, offset: 15983},
+									pos:  position{line: 503, col: 38, offset: 16990},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 486, col: 78, offset: 16023},
+							pos:        position{line: 503, col: 78, offset: 17030},
 							val:        "[]",
 							ignoreCase: false,
 							want:       "\"[]\"",
@@ -3713,33 +3909,33 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileEndTag",
-			pos:  position{line: 490, col: 1, offset: 16088},
+			pos:  position{line: 507, col: 1, offset: 17095},
 			expr: &actionExpr{
-				pos: position{line: 490, col: 23, offset: 16110},
+				pos: position{line: 507, col: 23, offset: 17117},
 				run: (*parser).callonIncludedFileEndTag1,
 				expr: &seqExpr{
-					pos: position{line: 490, col: 23, offset: 16110},
+					pos: position{line: 507, col: 23, offset: 17117},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 490, col: 23, offset: 16110},
+							pos:        position{line: 507, col: 23, offset: 17117},
 							val:        "end::",
 							ignoreCase: false,
 							want:       "\"end::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 490, col: 31, offset: 16118},
+							pos:   position{line: 507, col: 31, offset: 17125},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 490, col: 36, offset: 16123},
+								pos: position{line: 507, col: 36, offset: 17130},
 								run: (*parser).callonIncludedFileEndTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 490, col: 36, offset: 16123},
+									pos:  position{line: 507, col: 36, offset: 17130},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 490, col: 76, offset: 16163},
+							pos:        position{line: 507, col: 76, offset: 17170},
 							val:        "[]",
 							ignoreCase: false,
 							want:       "\"[]\"",
@@ -3750,32 +3946,32 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraph",
-			pos:  position{line: 497, col: 1, offset: 16327},
+			pos:  position{line: 514, col: 1, offset: 17334},
 			expr: &choiceExpr{
-				pos: position{line: 497, col: 18, offset: 16344},
+				pos: position{line: 514, col: 18, offset: 17351},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 497, col: 18, offset: 16344},
+						pos: position{line: 514, col: 18, offset: 17351},
 						run: (*parser).callonListParagraph2,
 						expr: &labeledExpr{
-							pos:   position{line: 497, col: 18, offset: 16344},
+							pos:   position{line: 514, col: 18, offset: 17351},
 							label: "comment",
 							expr: &ruleRefExpr{
-								pos:  position{line: 497, col: 27, offset: 16353},
+								pos:  position{line: 514, col: 27, offset: 17360},
 								name: "SingleLineComment",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 499, col: 9, offset: 16410},
+						pos: position{line: 516, col: 9, offset: 17417},
 						run: (*parser).callonListParagraph5,
 						expr: &labeledExpr{
-							pos:   position{line: 499, col: 9, offset: 16410},
+							pos:   position{line: 516, col: 9, offset: 17417},
 							label: "lines",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 499, col: 15, offset: 16416},
+								pos: position{line: 516, col: 15, offset: 17423},
 								expr: &ruleRefExpr{
-									pos:  position{line: 499, col: 16, offset: 16417},
+									pos:  position{line: 516, col: 16, offset: 17424},
 									name: "ListParagraphLine",
 								},
 							},
@@ -3786,96 +3982,96 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraphLine",
-			pos:  position{line: 503, col: 1, offset: 16509},
+			pos:  position{line: 520, col: 1, offset: 17536},
 			expr: &actionExpr{
-				pos: position{line: 503, col: 22, offset: 16530},
+				pos: position{line: 520, col: 22, offset: 17557},
 				run: (*parser).callonListParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 503, col: 22, offset: 16530},
+					pos: position{line: 520, col: 22, offset: 17557},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 503, col: 22, offset: 16530},
+							pos: position{line: 520, col: 22, offset: 17557},
 							expr: &ruleRefExpr{
-								pos:  position{line: 503, col: 23, offset: 16531},
+								pos:  position{line: 520, col: 23, offset: 17558},
 								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 504, col: 5, offset: 16539},
+							pos: position{line: 521, col: 5, offset: 17566},
 							expr: &ruleRefExpr{
-								pos:  position{line: 504, col: 6, offset: 16540},
+								pos:  position{line: 521, col: 6, offset: 17567},
 								name: "BlankLine",
 							},
 						},
 						&notExpr{
-							pos: position{line: 505, col: 5, offset: 16555},
+							pos: position{line: 522, col: 5, offset: 17582},
 							expr: &ruleRefExpr{
-								pos:  position{line: 505, col: 6, offset: 16556},
+								pos:  position{line: 522, col: 6, offset: 17583},
 								name: "SingleLineComment",
 							},
 						},
 						&notExpr{
-							pos: position{line: 506, col: 5, offset: 16578},
+							pos: position{line: 523, col: 5, offset: 17605},
 							expr: &ruleRefExpr{
-								pos:  position{line: 506, col: 6, offset: 16579},
+								pos:  position{line: 523, col: 6, offset: 17606},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 507, col: 5, offset: 16605},
+							pos: position{line: 524, col: 5, offset: 17632},
 							expr: &ruleRefExpr{
-								pos:  position{line: 507, col: 6, offset: 16606},
+								pos:  position{line: 524, col: 6, offset: 17633},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 508, col: 5, offset: 16634},
+							pos: position{line: 525, col: 5, offset: 17661},
 							expr: &ruleRefExpr{
-								pos:  position{line: 508, col: 6, offset: 16635},
+								pos:  position{line: 525, col: 6, offset: 17662},
 								name: "CalloutListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 509, col: 5, offset: 16661},
+							pos: position{line: 526, col: 5, offset: 17688},
 							expr: &ruleRefExpr{
-								pos:  position{line: 509, col: 6, offset: 16662},
+								pos:  position{line: 526, col: 6, offset: 17689},
 								name: "ListItemContinuation",
 							},
 						},
 						&notExpr{
-							pos: position{line: 510, col: 5, offset: 16687},
+							pos: position{line: 527, col: 5, offset: 17714},
 							expr: &ruleRefExpr{
-								pos:  position{line: 510, col: 6, offset: 16688},
+								pos:  position{line: 527, col: 6, offset: 17715},
 								name: "ElementAttribute",
 							},
 						},
 						&notExpr{
-							pos: position{line: 511, col: 5, offset: 16709},
+							pos: position{line: 528, col: 5, offset: 17736},
 							expr: &ruleRefExpr{
-								pos:  position{line: 511, col: 6, offset: 16710},
+								pos:  position{line: 528, col: 6, offset: 17737},
 								name: "BlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 512, col: 5, offset: 16729},
+							pos: position{line: 529, col: 5, offset: 17756},
 							expr: &ruleRefExpr{
-								pos:  position{line: 512, col: 6, offset: 16730},
+								pos:  position{line: 529, col: 6, offset: 17757},
 								name: "LabeledListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 513, col: 5, offset: 16757},
+							pos:   position{line: 530, col: 5, offset: 17784},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 513, col: 11, offset: 16763},
+								pos: position{line: 530, col: 11, offset: 17790},
 								run: (*parser).callonListParagraphLine24,
 								expr: &labeledExpr{
-									pos:   position{line: 513, col: 11, offset: 16763},
+									pos:   position{line: 530, col: 11, offset: 17790},
 									label: "elements",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 513, col: 20, offset: 16772},
+										pos: position{line: 530, col: 20, offset: 17799},
 										expr: &ruleRefExpr{
-											pos:  position{line: 513, col: 21, offset: 16773},
+											pos:  position{line: 530, col: 21, offset: 17800},
 											name: "InlineElement",
 										},
 									},
@@ -3883,7 +4079,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 515, col: 12, offset: 16872},
+							pos:  position{line: 532, col: 12, offset: 17899},
 							name: "EOL",
 						},
 					},
@@ -3892,25 +4088,25 @@ var g = &grammar{
 		},
 		{
 			name: "ListItemContinuation",
-			pos:  position{line: 519, col: 1, offset: 16911},
+			pos:  position{line: 536, col: 1, offset: 17938},
 			expr: &seqExpr{
-				pos: position{line: 519, col: 25, offset: 16935},
+				pos: position{line: 536, col: 25, offset: 17962},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 519, col: 25, offset: 16935},
+						pos:        position{line: 536, col: 25, offset: 17962},
 						val:        "+",
 						ignoreCase: false,
 						want:       "\"+\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 519, col: 29, offset: 16939},
+						pos: position{line: 536, col: 29, offset: 17966},
 						expr: &ruleRefExpr{
-							pos:  position{line: 519, col: 29, offset: 16939},
+							pos:  position{line: 536, col: 29, offset: 17966},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 519, col: 36, offset: 16946},
+						pos:  position{line: 536, col: 36, offset: 17973},
 						name: "Newline",
 					},
 				},
@@ -3918,22 +4114,22 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemElement",
-			pos:  position{line: 521, col: 1, offset: 17018},
+			pos:  position{line: 538, col: 1, offset: 18045},
 			expr: &actionExpr{
-				pos: position{line: 521, col: 29, offset: 17046},
+				pos: position{line: 538, col: 29, offset: 18073},
 				run: (*parser).callonContinuedListItemElement1,
 				expr: &seqExpr{
-					pos: position{line: 521, col: 29, offset: 17046},
+					pos: position{line: 538, col: 29, offset: 18073},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 521, col: 29, offset: 17046},
+							pos:  position{line: 538, col: 29, offset: 18073},
 							name: "ListItemContinuation",
 						},
 						&labeledExpr{
-							pos:   position{line: 521, col: 50, offset: 17067},
+							pos:   position{line: 538, col: 50, offset: 18094},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 521, col: 58, offset: 17075},
+								pos:  position{line: 538, col: 58, offset: 18102},
 								name: "ContinuedListItemContent",
 							},
 						},
@@ -3943,80 +4139,80 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemContent",
-			pos:  position{line: 525, col: 1, offset: 17181},
+			pos:  position{line: 542, col: 1, offset: 18228},
 			expr: &actionExpr{
-				pos: position{line: 525, col: 29, offset: 17209},
+				pos: position{line: 542, col: 29, offset: 18256},
 				run: (*parser).callonContinuedListItemContent1,
 				expr: &seqExpr{
-					pos: position{line: 525, col: 29, offset: 17209},
+					pos: position{line: 542, col: 29, offset: 18256},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 525, col: 29, offset: 17209},
+							pos: position{line: 542, col: 29, offset: 18256},
 							expr: &ruleRefExpr{
-								pos:  position{line: 525, col: 30, offset: 17210},
+								pos:  position{line: 542, col: 30, offset: 18257},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 526, col: 5, offset: 17219},
+							pos:   position{line: 543, col: 5, offset: 18266},
 							label: "content",
 							expr: &choiceExpr{
-								pos: position{line: 526, col: 14, offset: 17228},
+								pos: position{line: 543, col: 14, offset: 18275},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 526, col: 14, offset: 17228},
+										pos:  position{line: 543, col: 14, offset: 18275},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 527, col: 11, offset: 17253},
+										pos:  position{line: 544, col: 11, offset: 18300},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 528, col: 11, offset: 17277},
+										pos:  position{line: 545, col: 11, offset: 18324},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 529, col: 11, offset: 17331},
+										pos:  position{line: 546, col: 11, offset: 18378},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 530, col: 11, offset: 17353},
+										pos:  position{line: 547, col: 11, offset: 18400},
 										name: "OrderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 531, col: 11, offset: 17380},
+										pos:  position{line: 548, col: 11, offset: 18427},
 										name: "UnorderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 532, col: 11, offset: 17409},
+										pos:  position{line: 549, col: 11, offset: 18456},
 										name: "LabeledListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 534, col: 11, offset: 17474},
+										pos:  position{line: 551, col: 11, offset: 18521},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 535, col: 11, offset: 17525},
+										pos:  position{line: 552, col: 11, offset: 18572},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 536, col: 11, offset: 17549},
+										pos:  position{line: 553, col: 11, offset: 18596},
 										name: "AttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 537, col: 11, offset: 17581},
+										pos:  position{line: 554, col: 11, offset: 18628},
 										name: "AttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 538, col: 11, offset: 17607},
+										pos:  position{line: 555, col: 11, offset: 18654},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 539, col: 11, offset: 17644},
+										pos:  position{line: 556, col: 11, offset: 18691},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 540, col: 11, offset: 17669},
+										pos:  position{line: 557, col: 11, offset: 18716},
 										name: "ContinuedParagraph",
 									},
 								},
@@ -4028,37 +4224,37 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItem",
-			pos:  position{line: 547, col: 1, offset: 17832},
+			pos:  position{line: 564, col: 1, offset: 18879},
 			expr: &actionExpr{
-				pos: position{line: 547, col: 20, offset: 17851},
+				pos: position{line: 564, col: 20, offset: 18898},
 				run: (*parser).callonOrderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 547, col: 20, offset: 17851},
+					pos: position{line: 564, col: 20, offset: 18898},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 547, col: 20, offset: 17851},
+							pos:   position{line: 564, col: 20, offset: 18898},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 547, col: 31, offset: 17862},
+								pos: position{line: 564, col: 31, offset: 18909},
 								expr: &ruleRefExpr{
-									pos:  position{line: 547, col: 32, offset: 17863},
+									pos:  position{line: 564, col: 32, offset: 18910},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 547, col: 45, offset: 17876},
+							pos:   position{line: 564, col: 45, offset: 18923},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 547, col: 53, offset: 17884},
+								pos:  position{line: 564, col: 53, offset: 18931},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 547, col: 76, offset: 17907},
+							pos:   position{line: 564, col: 76, offset: 18954},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 547, col: 85, offset: 17916},
+								pos:  position{line: 564, col: 85, offset: 18963},
 								name: "OrderedListItemContent",
 							},
 						},
@@ -4068,42 +4264,42 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemPrefix",
-			pos:  position{line: 551, col: 1, offset: 18056},
+			pos:  position{line: 568, col: 1, offset: 19123},
 			expr: &actionExpr{
-				pos: position{line: 552, col: 5, offset: 18086},
+				pos: position{line: 569, col: 5, offset: 19153},
 				run: (*parser).callonOrderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 552, col: 5, offset: 18086},
+					pos: position{line: 569, col: 5, offset: 19153},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 552, col: 5, offset: 18086},
+							pos: position{line: 569, col: 5, offset: 19153},
 							expr: &ruleRefExpr{
-								pos:  position{line: 552, col: 5, offset: 18086},
+								pos:  position{line: 569, col: 5, offset: 19153},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 552, col: 12, offset: 18093},
+							pos:   position{line: 569, col: 12, offset: 19160},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 554, col: 9, offset: 18156},
+								pos: position{line: 571, col: 9, offset: 19223},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 554, col: 9, offset: 18156},
+										pos: position{line: 571, col: 9, offset: 19223},
 										run: (*parser).callonOrderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 554, col: 9, offset: 18156},
+											pos: position{line: 571, col: 9, offset: 19223},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 554, col: 9, offset: 18156},
+													pos:   position{line: 571, col: 9, offset: 19223},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 554, col: 16, offset: 18163},
+														pos: position{line: 571, col: 16, offset: 19230},
 														run: (*parser).callonOrderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 554, col: 16, offset: 18163},
+															pos: position{line: 571, col: 16, offset: 19230},
 															expr: &litMatcher{
-																pos:        position{line: 554, col: 17, offset: 18164},
+																pos:        position{line: 571, col: 17, offset: 19231},
 																val:        ".",
 																ignoreCase: false,
 																want:       "\".\"",
@@ -4112,22 +4308,22 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 558, col: 9, offset: 18264},
+													pos: position{line: 575, col: 9, offset: 19331},
 													run: (*parser).callonOrderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 577, col: 11, offset: 18981},
+										pos: position{line: 594, col: 11, offset: 20048},
 										run: (*parser).callonOrderedListItemPrefix14,
 										expr: &seqExpr{
-											pos: position{line: 577, col: 11, offset: 18981},
+											pos: position{line: 594, col: 11, offset: 20048},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 577, col: 11, offset: 18981},
+													pos: position{line: 594, col: 11, offset: 20048},
 													expr: &charClassMatcher{
-														pos:        position{line: 577, col: 12, offset: 18982},
+														pos:        position{line: 594, col: 12, offset: 20049},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
@@ -4135,7 +4331,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 577, col: 20, offset: 18990},
+													pos:        position{line: 594, col: 20, offset: 20057},
 													val:        ".",
 													ignoreCase: false,
 													want:       "\".\"",
@@ -4144,20 +4340,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 579, col: 13, offset: 19101},
+										pos: position{line: 596, col: 13, offset: 20168},
 										run: (*parser).callonOrderedListItemPrefix19,
 										expr: &seqExpr{
-											pos: position{line: 579, col: 13, offset: 19101},
+											pos: position{line: 596, col: 13, offset: 20168},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 579, col: 14, offset: 19102},
+													pos:        position{line: 596, col: 14, offset: 20169},
 													val:        "[a-z]",
 													ranges:     []rune{'a', 'z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 579, col: 21, offset: 19109},
+													pos:        position{line: 596, col: 21, offset: 20176},
 													val:        ".",
 													ignoreCase: false,
 													want:       "\".\"",
@@ -4166,20 +4362,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 581, col: 13, offset: 19223},
+										pos: position{line: 598, col: 13, offset: 20290},
 										run: (*parser).callonOrderedListItemPrefix23,
 										expr: &seqExpr{
-											pos: position{line: 581, col: 13, offset: 19223},
+											pos: position{line: 598, col: 13, offset: 20290},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 581, col: 14, offset: 19224},
+													pos:        position{line: 598, col: 14, offset: 20291},
 													val:        "[A-Z]",
 													ranges:     []rune{'A', 'Z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 581, col: 21, offset: 19231},
+													pos:        position{line: 598, col: 21, offset: 20298},
 													val:        ".",
 													ignoreCase: false,
 													want:       "\".\"",
@@ -4188,15 +4384,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 583, col: 13, offset: 19345},
+										pos: position{line: 600, col: 13, offset: 20412},
 										run: (*parser).callonOrderedListItemPrefix27,
 										expr: &seqExpr{
-											pos: position{line: 583, col: 13, offset: 19345},
+											pos: position{line: 600, col: 13, offset: 20412},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 583, col: 13, offset: 19345},
+													pos: position{line: 600, col: 13, offset: 20412},
 													expr: &charClassMatcher{
-														pos:        position{line: 583, col: 14, offset: 19346},
+														pos:        position{line: 600, col: 14, offset: 20413},
 														val:        "[a-z]",
 														ranges:     []rune{'a', 'z'},
 														ignoreCase: false,
@@ -4204,7 +4400,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 583, col: 22, offset: 19354},
+													pos:        position{line: 600, col: 22, offset: 20421},
 													val:        ")",
 													ignoreCase: false,
 													want:       "\")\"",
@@ -4213,15 +4409,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 585, col: 13, offset: 19468},
+										pos: position{line: 602, col: 13, offset: 20535},
 										run: (*parser).callonOrderedListItemPrefix32,
 										expr: &seqExpr{
-											pos: position{line: 585, col: 13, offset: 19468},
+											pos: position{line: 602, col: 13, offset: 20535},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 585, col: 13, offset: 19468},
+													pos: position{line: 602, col: 13, offset: 20535},
 													expr: &charClassMatcher{
-														pos:        position{line: 585, col: 14, offset: 19469},
+														pos:        position{line: 602, col: 14, offset: 20536},
 														val:        "[A-Z]",
 														ranges:     []rune{'A', 'Z'},
 														ignoreCase: false,
@@ -4229,7 +4425,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 585, col: 22, offset: 19477},
+													pos:        position{line: 602, col: 22, offset: 20544},
 													val:        ")",
 													ignoreCase: false,
 													want:       "\")\"",
@@ -4241,9 +4437,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 587, col: 12, offset: 19590},
+							pos: position{line: 604, col: 12, offset: 20657},
 							expr: &ruleRefExpr{
-								pos:  position{line: 587, col: 12, offset: 19590},
+								pos:  position{line: 604, col: 12, offset: 20657},
 								name: "Space",
 							},
 						},
@@ -4253,17 +4449,17 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemContent",
-			pos:  position{line: 591, col: 1, offset: 19625},
+			pos:  position{line: 608, col: 1, offset: 20692},
 			expr: &actionExpr{
-				pos: position{line: 591, col: 27, offset: 19651},
+				pos: position{line: 608, col: 27, offset: 20718},
 				run: (*parser).callonOrderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 591, col: 27, offset: 19651},
+					pos:   position{line: 608, col: 27, offset: 20718},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 591, col: 37, offset: 19661},
+						pos: position{line: 608, col: 37, offset: 20728},
 						expr: &ruleRefExpr{
-							pos:  position{line: 591, col: 37, offset: 19661},
+							pos:  position{line: 608, col: 37, offset: 20728},
 							name: "ListParagraph",
 						},
 					},
@@ -4272,48 +4468,48 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItem",
-			pos:  position{line: 598, col: 1, offset: 19861},
+			pos:  position{line: 615, col: 1, offset: 20928},
 			expr: &actionExpr{
-				pos: position{line: 598, col: 22, offset: 19882},
+				pos: position{line: 615, col: 22, offset: 20949},
 				run: (*parser).callonUnorderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 598, col: 22, offset: 19882},
+					pos: position{line: 615, col: 22, offset: 20949},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 598, col: 22, offset: 19882},
+							pos:   position{line: 615, col: 22, offset: 20949},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 598, col: 33, offset: 19893},
+								pos: position{line: 615, col: 33, offset: 20960},
 								expr: &ruleRefExpr{
-									pos:  position{line: 598, col: 34, offset: 19894},
+									pos:  position{line: 615, col: 34, offset: 20961},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 598, col: 47, offset: 19907},
+							pos:   position{line: 615, col: 47, offset: 20974},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 598, col: 55, offset: 19915},
+								pos:  position{line: 615, col: 55, offset: 20982},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 598, col: 80, offset: 19940},
+							pos:   position{line: 615, col: 80, offset: 21007},
 							label: "checkstyle",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 598, col: 91, offset: 19951},
+								pos: position{line: 615, col: 91, offset: 21018},
 								expr: &ruleRefExpr{
-									pos:  position{line: 598, col: 92, offset: 19952},
+									pos:  position{line: 615, col: 92, offset: 21019},
 									name: "UnorderedListItemCheckStyle",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 598, col: 122, offset: 19982},
+							pos:   position{line: 615, col: 122, offset: 21049},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 598, col: 131, offset: 19991},
+								pos:  position{line: 615, col: 131, offset: 21058},
 								name: "UnorderedListItemContent",
 							},
 						},
@@ -4323,42 +4519,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemPrefix",
-			pos:  position{line: 602, col: 1, offset: 20149},
+			pos:  position{line: 619, col: 1, offset: 21236},
 			expr: &actionExpr{
-				pos: position{line: 603, col: 5, offset: 20181},
+				pos: position{line: 620, col: 5, offset: 21268},
 				run: (*parser).callonUnorderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 603, col: 5, offset: 20181},
+					pos: position{line: 620, col: 5, offset: 21268},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 603, col: 5, offset: 20181},
+							pos: position{line: 620, col: 5, offset: 21268},
 							expr: &ruleRefExpr{
-								pos:  position{line: 603, col: 5, offset: 20181},
+								pos:  position{line: 620, col: 5, offset: 21268},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 603, col: 12, offset: 20188},
+							pos:   position{line: 620, col: 12, offset: 21275},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 603, col: 20, offset: 20196},
+								pos: position{line: 620, col: 20, offset: 21283},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 605, col: 9, offset: 20253},
+										pos: position{line: 622, col: 9, offset: 21340},
 										run: (*parser).callonUnorderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 605, col: 9, offset: 20253},
+											pos: position{line: 622, col: 9, offset: 21340},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 605, col: 9, offset: 20253},
+													pos:   position{line: 622, col: 9, offset: 21340},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 605, col: 16, offset: 20260},
+														pos: position{line: 622, col: 16, offset: 21347},
 														run: (*parser).callonUnorderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 605, col: 16, offset: 20260},
+															pos: position{line: 622, col: 16, offset: 21347},
 															expr: &litMatcher{
-																pos:        position{line: 605, col: 17, offset: 20261},
+																pos:        position{line: 622, col: 17, offset: 21348},
 																val:        "*",
 																ignoreCase: false,
 																want:       "\"*\"",
@@ -4367,20 +4563,20 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 609, col: 9, offset: 20361},
+													pos: position{line: 626, col: 9, offset: 21448},
 													run: (*parser).callonUnorderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 626, col: 14, offset: 21068},
+										pos:   position{line: 643, col: 14, offset: 22155},
 										label: "depth",
 										expr: &actionExpr{
-											pos: position{line: 626, col: 21, offset: 21075},
+											pos: position{line: 643, col: 21, offset: 22162},
 											run: (*parser).callonUnorderedListItemPrefix15,
 											expr: &litMatcher{
-												pos:        position{line: 626, col: 22, offset: 21076},
+												pos:        position{line: 643, col: 22, offset: 22163},
 												val:        "-",
 												ignoreCase: false,
 												want:       "\"-\"",
@@ -4391,9 +4587,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 628, col: 13, offset: 21162},
+							pos: position{line: 645, col: 13, offset: 22249},
 							expr: &ruleRefExpr{
-								pos:  position{line: 628, col: 13, offset: 21162},
+								pos:  position{line: 645, col: 13, offset: 22249},
 								name: "Space",
 							},
 						},
@@ -4403,53 +4599,53 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemCheckStyle",
-			pos:  position{line: 632, col: 1, offset: 21198},
+			pos:  position{line: 649, col: 1, offset: 22285},
 			expr: &actionExpr{
-				pos: position{line: 632, col: 32, offset: 21229},
+				pos: position{line: 649, col: 32, offset: 22316},
 				run: (*parser).callonUnorderedListItemCheckStyle1,
 				expr: &seqExpr{
-					pos: position{line: 632, col: 32, offset: 21229},
+					pos: position{line: 649, col: 32, offset: 22316},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 632, col: 32, offset: 21229},
+							pos: position{line: 649, col: 32, offset: 22316},
 							expr: &litMatcher{
-								pos:        position{line: 632, col: 33, offset: 21230},
+								pos:        position{line: 649, col: 33, offset: 22317},
 								val:        "[",
 								ignoreCase: false,
 								want:       "\"[\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 632, col: 37, offset: 21234},
+							pos:   position{line: 649, col: 37, offset: 22321},
 							label: "style",
 							expr: &choiceExpr{
-								pos: position{line: 633, col: 7, offset: 21248},
+								pos: position{line: 650, col: 7, offset: 22335},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 633, col: 7, offset: 21248},
+										pos: position{line: 650, col: 7, offset: 22335},
 										run: (*parser).callonUnorderedListItemCheckStyle7,
 										expr: &litMatcher{
-											pos:        position{line: 633, col: 7, offset: 21248},
+											pos:        position{line: 650, col: 7, offset: 22335},
 											val:        "[ ]",
 											ignoreCase: false,
 											want:       "\"[ ]\"",
 										},
 									},
 									&actionExpr{
-										pos: position{line: 634, col: 7, offset: 21293},
+										pos: position{line: 651, col: 7, offset: 22380},
 										run: (*parser).callonUnorderedListItemCheckStyle9,
 										expr: &litMatcher{
-											pos:        position{line: 634, col: 7, offset: 21293},
+											pos:        position{line: 651, col: 7, offset: 22380},
 											val:        "[*]",
 											ignoreCase: false,
 											want:       "\"[*]\"",
 										},
 									},
 									&actionExpr{
-										pos: position{line: 635, col: 7, offset: 21336},
+										pos: position{line: 652, col: 7, offset: 22423},
 										run: (*parser).callonUnorderedListItemCheckStyle11,
 										expr: &litMatcher{
-											pos:        position{line: 635, col: 7, offset: 21336},
+											pos:        position{line: 652, col: 7, offset: 22423},
 											val:        "[x]",
 											ignoreCase: false,
 											want:       "\"[x]\"",
@@ -4459,9 +4655,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 636, col: 7, offset: 21378},
+							pos: position{line: 653, col: 7, offset: 22465},
 							expr: &ruleRefExpr{
-								pos:  position{line: 636, col: 7, offset: 21378},
+								pos:  position{line: 653, col: 7, offset: 22465},
 								name: "Space",
 							},
 						},
@@ -4471,17 +4667,17 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemContent",
-			pos:  position{line: 640, col: 1, offset: 21420},
+			pos:  position{line: 657, col: 1, offset: 22507},
 			expr: &actionExpr{
-				pos: position{line: 640, col: 29, offset: 21448},
+				pos: position{line: 657, col: 29, offset: 22535},
 				run: (*parser).callonUnorderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 640, col: 29, offset: 21448},
+					pos:   position{line: 657, col: 29, offset: 22535},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 640, col: 39, offset: 21458},
+						pos: position{line: 657, col: 39, offset: 22545},
 						expr: &ruleRefExpr{
-							pos:  position{line: 640, col: 39, offset: 21458},
+							pos:  position{line: 657, col: 39, offset: 22545},
 							name: "ListParagraph",
 						},
 					},
@@ -4490,47 +4686,47 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItem",
-			pos:  position{line: 647, col: 1, offset: 21774},
+			pos:  position{line: 664, col: 1, offset: 22861},
 			expr: &actionExpr{
-				pos: position{line: 647, col: 20, offset: 21793},
+				pos: position{line: 664, col: 20, offset: 22880},
 				run: (*parser).callonLabeledListItem1,
 				expr: &seqExpr{
-					pos: position{line: 647, col: 20, offset: 21793},
+					pos: position{line: 664, col: 20, offset: 22880},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 647, col: 20, offset: 21793},
+							pos:   position{line: 664, col: 20, offset: 22880},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 647, col: 31, offset: 21804},
+								pos: position{line: 664, col: 31, offset: 22891},
 								expr: &ruleRefExpr{
-									pos:  position{line: 647, col: 32, offset: 21805},
+									pos:  position{line: 664, col: 32, offset: 22892},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 647, col: 45, offset: 21818},
+							pos:   position{line: 664, col: 45, offset: 22905},
 							label: "term",
 							expr: &ruleRefExpr{
-								pos:  position{line: 647, col: 51, offset: 21824},
+								pos:  position{line: 664, col: 51, offset: 22911},
 								name: "VerbatimLabeledListItemTerm",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 647, col: 80, offset: 21853},
+							pos:   position{line: 664, col: 80, offset: 22940},
 							label: "separator",
 							expr: &ruleRefExpr{
-								pos:  position{line: 647, col: 91, offset: 21864},
+								pos:  position{line: 664, col: 91, offset: 22951},
 								name: "LabeledListItemSeparator",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 647, col: 117, offset: 21890},
+							pos:   position{line: 664, col: 117, offset: 22977},
 							label: "description",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 647, col: 129, offset: 21902},
+								pos: position{line: 664, col: 129, offset: 22989},
 								expr: &ruleRefExpr{
-									pos:  position{line: 647, col: 130, offset: 21903},
+									pos:  position{line: 664, col: 130, offset: 22990},
 									name: "LabeledListItemDescription",
 								},
 							},
@@ -4541,16 +4737,16 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemPrefix",
-			pos:  position{line: 651, col: 1, offset: 22049},
+			pos:  position{line: 668, col: 1, offset: 23156},
 			expr: &seqExpr{
-				pos: position{line: 651, col: 26, offset: 22074},
+				pos: position{line: 668, col: 26, offset: 23181},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 651, col: 26, offset: 22074},
+						pos:  position{line: 668, col: 26, offset: 23181},
 						name: "VerbatimLabeledListItemTerm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 651, col: 54, offset: 22102},
+						pos:  position{line: 668, col: 54, offset: 23209},
 						name: "LabeledListItemSeparator",
 					},
 				},
@@ -4558,20 +4754,20 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimLabeledListItemTerm",
-			pos:  position{line: 653, col: 1, offset: 22128},
+			pos:  position{line: 670, col: 1, offset: 23235},
 			expr: &actionExpr{
-				pos: position{line: 653, col: 32, offset: 22159},
+				pos: position{line: 670, col: 32, offset: 23266},
 				run: (*parser).callonVerbatimLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 653, col: 32, offset: 22159},
+					pos:   position{line: 670, col: 32, offset: 23266},
 					label: "content",
 					expr: &actionExpr{
-						pos: position{line: 653, col: 41, offset: 22168},
+						pos: position{line: 670, col: 41, offset: 23275},
 						run: (*parser).callonVerbatimLabeledListItemTerm3,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 653, col: 41, offset: 22168},
+							pos: position{line: 670, col: 41, offset: 23275},
 							expr: &charClassMatcher{
-								pos:        position{line: 653, col: 41, offset: 22168},
+								pos:        position{line: 670, col: 41, offset: 23275},
 								val:        "[^:\\r\\n]",
 								chars:      []rune{':', '\r', '\n'},
 								ignoreCase: false,
@@ -4584,36 +4780,36 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTerm",
-			pos:  position{line: 659, col: 1, offset: 22302},
+			pos:  position{line: 676, col: 1, offset: 23409},
 			expr: &actionExpr{
-				pos: position{line: 659, col: 24, offset: 22325},
+				pos: position{line: 676, col: 24, offset: 23432},
 				run: (*parser).callonLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 659, col: 24, offset: 22325},
+					pos:   position{line: 676, col: 24, offset: 23432},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 659, col: 33, offset: 22334},
+						pos: position{line: 676, col: 33, offset: 23441},
 						expr: &seqExpr{
-							pos: position{line: 659, col: 34, offset: 22335},
+							pos: position{line: 676, col: 34, offset: 23442},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 659, col: 34, offset: 22335},
+									pos: position{line: 676, col: 34, offset: 23442},
 									expr: &ruleRefExpr{
-										pos:  position{line: 659, col: 35, offset: 22336},
+										pos:  position{line: 676, col: 35, offset: 23443},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 659, col: 43, offset: 22344},
+									pos: position{line: 676, col: 43, offset: 23451},
 									expr: &litMatcher{
-										pos:        position{line: 659, col: 44, offset: 22345},
+										pos:        position{line: 676, col: 44, offset: 23452},
 										val:        "::",
 										ignoreCase: false,
 										want:       "\"::\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 659, col: 49, offset: 22350},
+									pos:  position{line: 676, col: 49, offset: 23457},
 									name: "LabeledListItemTermElement",
 								},
 							},
@@ -4624,69 +4820,69 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTermElement",
-			pos:  position{line: 663, col: 1, offset: 22477},
+			pos:  position{line: 680, col: 1, offset: 23584},
 			expr: &actionExpr{
-				pos: position{line: 663, col: 31, offset: 22507},
+				pos: position{line: 680, col: 31, offset: 23614},
 				run: (*parser).callonLabeledListItemTermElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 663, col: 31, offset: 22507},
+					pos:   position{line: 680, col: 31, offset: 23614},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 663, col: 40, offset: 22516},
+						pos: position{line: 680, col: 40, offset: 23623},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 663, col: 40, offset: 22516},
+								pos:  position{line: 680, col: 40, offset: 23623},
 								name: "Word",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 664, col: 11, offset: 22531},
+								pos:  position{line: 681, col: 11, offset: 23638},
 								name: "LineBreak",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 665, col: 11, offset: 22580},
+								pos: position{line: 682, col: 11, offset: 23687},
 								expr: &ruleRefExpr{
-									pos:  position{line: 665, col: 11, offset: 22580},
+									pos:  position{line: 682, col: 11, offset: 23687},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 666, col: 11, offset: 22598},
+								pos:  position{line: 683, col: 11, offset: 23705},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 667, col: 11, offset: 22623},
+								pos:  position{line: 684, col: 11, offset: 23730},
 								name: "ConcealedIndexTerm",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 668, col: 11, offset: 22652},
+								pos:  position{line: 685, col: 11, offset: 23759},
 								name: "IndexTerm",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 669, col: 11, offset: 22672},
+								pos:  position{line: 686, col: 11, offset: 23779},
 								name: "InlinePassthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 670, col: 11, offset: 22700},
+								pos:  position{line: 687, col: 11, offset: 23807},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 671, col: 11, offset: 22723},
+								pos:  position{line: 688, col: 11, offset: 23830},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 672, col: 11, offset: 22738},
+								pos:  position{line: 689, col: 11, offset: 23845},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 673, col: 11, offset: 22763},
+								pos:  position{line: 690, col: 11, offset: 23870},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 674, col: 11, offset: 22784},
+								pos:  position{line: 691, col: 11, offset: 23891},
 								name: "AttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 675, col: 11, offset: 22816},
+								pos:  position{line: 692, col: 11, offset: 23923},
 								name: "AnyChar",
 							},
 						},
@@ -4696,23 +4892,23 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemSeparator",
-			pos:  position{line: 679, col: 1, offset: 22855},
+			pos:  position{line: 696, col: 1, offset: 23962},
 			expr: &actionExpr{
-				pos: position{line: 680, col: 5, offset: 22888},
+				pos: position{line: 697, col: 5, offset: 23995},
 				run: (*parser).callonLabeledListItemSeparator1,
 				expr: &seqExpr{
-					pos: position{line: 680, col: 5, offset: 22888},
+					pos: position{line: 697, col: 5, offset: 23995},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 680, col: 5, offset: 22888},
+							pos:   position{line: 697, col: 5, offset: 23995},
 							label: "separator",
 							expr: &actionExpr{
-								pos: position{line: 680, col: 16, offset: 22899},
+								pos: position{line: 697, col: 16, offset: 24006},
 								run: (*parser).callonLabeledListItemSeparator4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 680, col: 16, offset: 22899},
+									pos: position{line: 697, col: 16, offset: 24006},
 									expr: &litMatcher{
-										pos:        position{line: 680, col: 17, offset: 22900},
+										pos:        position{line: 697, col: 17, offset: 24007},
 										val:        ":",
 										ignoreCase: false,
 										want:       "\":\"",
@@ -4721,30 +4917,30 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 683, col: 5, offset: 22958},
+							pos: position{line: 700, col: 5, offset: 24065},
 							run: (*parser).callonLabeledListItemSeparator7,
 						},
 						&choiceExpr{
-							pos: position{line: 687, col: 6, offset: 23134},
+							pos: position{line: 704, col: 6, offset: 24241},
 							alternatives: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 687, col: 6, offset: 23134},
+									pos: position{line: 704, col: 6, offset: 24241},
 									expr: &choiceExpr{
-										pos: position{line: 687, col: 7, offset: 23135},
+										pos: position{line: 704, col: 7, offset: 24242},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 687, col: 7, offset: 23135},
+												pos:  position{line: 704, col: 7, offset: 24242},
 												name: "Space",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 687, col: 15, offset: 23143},
+												pos:  position{line: 704, col: 15, offset: 24250},
 												name: "Newline",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 687, col: 27, offset: 23155},
+									pos:  position{line: 704, col: 27, offset: 24262},
 									name: "EOL",
 								},
 							},
@@ -4755,17 +4951,17 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemDescription",
-			pos:  position{line: 691, col: 1, offset: 23195},
+			pos:  position{line: 708, col: 1, offset: 24302},
 			expr: &actionExpr{
-				pos: position{line: 691, col: 31, offset: 23225},
+				pos: position{line: 708, col: 31, offset: 24332},
 				run: (*parser).callonLabeledListItemDescription1,
 				expr: &labeledExpr{
-					pos:   position{line: 691, col: 31, offset: 23225},
+					pos:   position{line: 708, col: 31, offset: 24332},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 691, col: 40, offset: 23234},
+						pos: position{line: 708, col: 40, offset: 24341},
 						expr: &ruleRefExpr{
-							pos:  position{line: 691, col: 41, offset: 23235},
+							pos:  position{line: 708, col: 41, offset: 24342},
 							name: "ListParagraph",
 						},
 					},
@@ -4774,55 +4970,55 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionKind",
-			pos:  position{line: 698, col: 1, offset: 23426},
+			pos:  position{line: 715, col: 1, offset: 24533},
 			expr: &choiceExpr{
-				pos: position{line: 698, col: 19, offset: 23444},
+				pos: position{line: 715, col: 19, offset: 24551},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 698, col: 19, offset: 23444},
+						pos: position{line: 715, col: 19, offset: 24551},
 						run: (*parser).callonAdmonitionKind2,
 						expr: &litMatcher{
-							pos:        position{line: 698, col: 19, offset: 23444},
+							pos:        position{line: 715, col: 19, offset: 24551},
 							val:        "TIP",
 							ignoreCase: false,
 							want:       "\"TIP\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 700, col: 9, offset: 23490},
+						pos: position{line: 717, col: 9, offset: 24597},
 						run: (*parser).callonAdmonitionKind4,
 						expr: &litMatcher{
-							pos:        position{line: 700, col: 9, offset: 23490},
+							pos:        position{line: 717, col: 9, offset: 24597},
 							val:        "NOTE",
 							ignoreCase: false,
 							want:       "\"NOTE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 702, col: 9, offset: 23538},
+						pos: position{line: 719, col: 9, offset: 24645},
 						run: (*parser).callonAdmonitionKind6,
 						expr: &litMatcher{
-							pos:        position{line: 702, col: 9, offset: 23538},
+							pos:        position{line: 719, col: 9, offset: 24645},
 							val:        "IMPORTANT",
 							ignoreCase: false,
 							want:       "\"IMPORTANT\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 704, col: 9, offset: 23596},
+						pos: position{line: 721, col: 9, offset: 24703},
 						run: (*parser).callonAdmonitionKind8,
 						expr: &litMatcher{
-							pos:        position{line: 704, col: 9, offset: 23596},
+							pos:        position{line: 721, col: 9, offset: 24703},
 							val:        "WARNING",
 							ignoreCase: false,
 							want:       "\"WARNING\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 706, col: 9, offset: 23650},
+						pos: position{line: 723, col: 9, offset: 24757},
 						run: (*parser).callonAdmonitionKind10,
 						expr: &litMatcher{
-							pos:        position{line: 706, col: 9, offset: 23650},
+							pos:        position{line: 723, col: 9, offset: 24757},
 							val:        "CAUTION",
 							ignoreCase: false,
 							want:       "\"CAUTION\"",
@@ -4833,48 +5029,48 @@ var g = &grammar{
 		},
 		{
 			name: "Paragraph",
-			pos:  position{line: 715, col: 1, offset: 23957},
+			pos:  position{line: 732, col: 1, offset: 25064},
 			expr: &choiceExpr{
-				pos: position{line: 717, col: 5, offset: 24004},
+				pos: position{line: 734, col: 5, offset: 25111},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 717, col: 5, offset: 24004},
+						pos: position{line: 734, col: 5, offset: 25111},
 						run: (*parser).callonParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 717, col: 5, offset: 24004},
+							pos: position{line: 734, col: 5, offset: 25111},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 717, col: 5, offset: 24004},
+									pos:   position{line: 734, col: 5, offset: 25111},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 717, col: 16, offset: 24015},
+										pos: position{line: 734, col: 16, offset: 25122},
 										expr: &ruleRefExpr{
-											pos:  position{line: 717, col: 17, offset: 24016},
+											pos:  position{line: 734, col: 17, offset: 25123},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 717, col: 30, offset: 24029},
+									pos:   position{line: 734, col: 30, offset: 25136},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 717, col: 33, offset: 24032},
+										pos:  position{line: 734, col: 33, offset: 25139},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 717, col: 49, offset: 24048},
+									pos:        position{line: 734, col: 49, offset: 25155},
 									val:        ": ",
 									ignoreCase: false,
 									want:       "\": \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 717, col: 54, offset: 24053},
+									pos:   position{line: 734, col: 54, offset: 25160},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 717, col: 60, offset: 24059},
+										pos: position{line: 734, col: 60, offset: 25166},
 										expr: &ruleRefExpr{
-											pos:  position{line: 717, col: 61, offset: 24060},
+											pos:  position{line: 734, col: 61, offset: 25167},
 											name: "InlineElements",
 										},
 									},
@@ -4883,33 +5079,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 721, col: 5, offset: 24241},
+						pos: position{line: 738, col: 5, offset: 25368},
 						run: (*parser).callonParagraph13,
 						expr: &seqExpr{
-							pos: position{line: 721, col: 5, offset: 24241},
+							pos: position{line: 738, col: 5, offset: 25368},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 721, col: 5, offset: 24241},
+									pos:   position{line: 738, col: 5, offset: 25368},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 721, col: 16, offset: 24252},
+										pos: position{line: 738, col: 16, offset: 25379},
 										expr: &ruleRefExpr{
-											pos:  position{line: 721, col: 17, offset: 24253},
+											pos:  position{line: 738, col: 17, offset: 25380},
 											name: "Attributes",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 721, col: 30, offset: 24266},
+									pos:        position{line: 738, col: 30, offset: 25393},
 									val:        "> ",
 									ignoreCase: false,
 									want:       "\"> \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 721, col: 35, offset: 24271},
+									pos:   position{line: 738, col: 35, offset: 25398},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 721, col: 44, offset: 24280},
+										pos:  position{line: 738, col: 44, offset: 25407},
 										name: "MarkdownQuoteBlockVerbatimContent",
 									},
 								},
@@ -4917,38 +5113,38 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 725, col: 5, offset: 24475},
+						pos: position{line: 742, col: 5, offset: 25622},
 						run: (*parser).callonParagraph21,
 						expr: &seqExpr{
-							pos: position{line: 725, col: 5, offset: 24475},
+							pos: position{line: 742, col: 5, offset: 25622},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 725, col: 5, offset: 24475},
+									pos:   position{line: 742, col: 5, offset: 25622},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 725, col: 16, offset: 24486},
+										pos: position{line: 742, col: 16, offset: 25633},
 										expr: &ruleRefExpr{
-											pos:  position{line: 725, col: 17, offset: 24487},
+											pos:  position{line: 742, col: 17, offset: 25634},
 											name: "Attributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 725, col: 30, offset: 24500},
+									pos: position{line: 742, col: 30, offset: 25647},
 									run: (*parser).callonParagraph26,
 								},
 								&notExpr{
-									pos: position{line: 732, col: 7, offset: 24779},
+									pos: position{line: 749, col: 7, offset: 25926},
 									expr: &ruleRefExpr{
-										pos:  position{line: 732, col: 8, offset: 24780},
+										pos:  position{line: 749, col: 8, offset: 25927},
 										name: "BlockDelimiter",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 732, col: 23, offset: 24795},
+									pos:   position{line: 749, col: 23, offset: 25942},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 732, col: 32, offset: 24804},
+										pos:  position{line: 749, col: 32, offset: 25951},
 										name: "OpenPassthroughParagraphContent",
 									},
 								},
@@ -4956,36 +5152,36 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 736, col: 5, offset: 25001},
+						pos: position{line: 753, col: 5, offset: 26168},
 						run: (*parser).callonParagraph31,
 						expr: &seqExpr{
-							pos: position{line: 736, col: 5, offset: 25001},
+							pos: position{line: 753, col: 5, offset: 26168},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 736, col: 5, offset: 25001},
+									pos:   position{line: 753, col: 5, offset: 26168},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 736, col: 16, offset: 25012},
+										pos: position{line: 753, col: 16, offset: 26179},
 										expr: &ruleRefExpr{
-											pos:  position{line: 736, col: 17, offset: 25013},
+											pos:  position{line: 753, col: 17, offset: 26180},
 											name: "Attributes",
 										},
 									},
 								},
 								&notExpr{
-									pos: position{line: 736, col: 30, offset: 25026},
+									pos: position{line: 753, col: 30, offset: 26193},
 									expr: &ruleRefExpr{
-										pos:  position{line: 736, col: 31, offset: 25027},
+										pos:  position{line: 753, col: 31, offset: 26194},
 										name: "BlockDelimiter",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 736, col: 46, offset: 25042},
+									pos:   position{line: 753, col: 46, offset: 26209},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 736, col: 52, offset: 25048},
+										pos: position{line: 753, col: 52, offset: 26215},
 										expr: &ruleRefExpr{
-											pos:  position{line: 736, col: 53, offset: 25049},
+											pos:  position{line: 753, col: 53, offset: 26216},
 											name: "InlineElements",
 										},
 									},
@@ -4998,36 +5194,36 @@ var g = &grammar{
 		},
 		{
 			name: "MarkdownQuoteBlockVerbatimContent",
-			pos:  position{line: 740, col: 1, offset: 25145},
+			pos:  position{line: 757, col: 1, offset: 26332},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 740, col: 38, offset: 25182},
+				pos: position{line: 757, col: 38, offset: 26369},
 				expr: &actionExpr{
-					pos: position{line: 740, col: 39, offset: 25183},
+					pos: position{line: 757, col: 39, offset: 26370},
 					run: (*parser).callonMarkdownQuoteBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 740, col: 39, offset: 25183},
+						pos: position{line: 757, col: 39, offset: 26370},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 740, col: 39, offset: 25183},
+								pos: position{line: 757, col: 39, offset: 26370},
 								expr: &ruleRefExpr{
-									pos:  position{line: 740, col: 40, offset: 25184},
+									pos:  position{line: 757, col: 40, offset: 26371},
 									name: "BlankLine",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 740, col: 50, offset: 25194},
+								pos: position{line: 757, col: 50, offset: 26381},
 								expr: &litMatcher{
-									pos:        position{line: 740, col: 50, offset: 25194},
+									pos:        position{line: 757, col: 50, offset: 26381},
 									val:        "> ",
 									ignoreCase: false,
 									want:       "\"> \"",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 740, col: 56, offset: 25200},
+								pos:   position{line: 757, col: 56, offset: 26387},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 740, col: 65, offset: 25209},
+									pos:  position{line: 757, col: 65, offset: 26396},
 									name: "VerbatimContent",
 								},
 							},
@@ -5038,29 +5234,29 @@ var g = &grammar{
 		},
 		{
 			name: "MarkdownQuoteBlockAttribution",
-			pos:  position{line: 744, col: 1, offset: 25350},
+			pos:  position{line: 761, col: 1, offset: 26537},
 			expr: &actionExpr{
-				pos: position{line: 744, col: 34, offset: 25383},
+				pos: position{line: 761, col: 34, offset: 26570},
 				run: (*parser).callonMarkdownQuoteBlockAttribution1,
 				expr: &seqExpr{
-					pos: position{line: 744, col: 34, offset: 25383},
+					pos: position{line: 761, col: 34, offset: 26570},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 744, col: 34, offset: 25383},
+							pos:        position{line: 761, col: 34, offset: 26570},
 							val:        "-- ",
 							ignoreCase: false,
 							want:       "\"-- \"",
 						},
 						&labeledExpr{
-							pos:   position{line: 744, col: 40, offset: 25389},
+							pos:   position{line: 761, col: 40, offset: 26576},
 							label: "author",
 							expr: &actionExpr{
-								pos: position{line: 744, col: 48, offset: 25397},
+								pos: position{line: 761, col: 48, offset: 26584},
 								run: (*parser).callonMarkdownQuoteBlockAttribution5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 744, col: 49, offset: 25398},
+									pos: position{line: 761, col: 49, offset: 26585},
 									expr: &charClassMatcher{
-										pos:        position{line: 744, col: 49, offset: 25398},
+										pos:        position{line: 761, col: 49, offset: 26585},
 										val:        "[^\\r\\n]",
 										chars:      []rune{'\r', '\n'},
 										ignoreCase: false,
@@ -5070,7 +5266,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 746, col: 8, offset: 25448},
+							pos:  position{line: 763, col: 8, offset: 26635},
 							name: "EOL",
 						},
 					},
@@ -5079,27 +5275,27 @@ var g = &grammar{
 		},
 		{
 			name: "OpenPassthroughParagraphContent",
-			pos:  position{line: 750, col: 1, offset: 25480},
+			pos:  position{line: 767, col: 1, offset: 26667},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 750, col: 36, offset: 25515},
+				pos: position{line: 767, col: 36, offset: 26702},
 				expr: &actionExpr{
-					pos: position{line: 750, col: 37, offset: 25516},
+					pos: position{line: 767, col: 37, offset: 26703},
 					run: (*parser).callonOpenPassthroughParagraphContent2,
 					expr: &seqExpr{
-						pos: position{line: 750, col: 37, offset: 25516},
+						pos: position{line: 767, col: 37, offset: 26703},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 750, col: 37, offset: 25516},
+								pos: position{line: 767, col: 37, offset: 26703},
 								expr: &ruleRefExpr{
-									pos:  position{line: 750, col: 38, offset: 25517},
+									pos:  position{line: 767, col: 38, offset: 26704},
 									name: "BlankLine",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 750, col: 48, offset: 25527},
+								pos:   position{line: 767, col: 48, offset: 26714},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 750, col: 57, offset: 25536},
+									pos:  position{line: 767, col: 57, offset: 26723},
 									name: "VerbatimContent",
 								},
 							},
@@ -5110,43 +5306,43 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleParagraph",
-			pos:  position{line: 755, col: 1, offset: 25749},
+			pos:  position{line: 772, col: 1, offset: 26936},
 			expr: &actionExpr{
-				pos: position{line: 755, col: 20, offset: 25768},
+				pos: position{line: 772, col: 20, offset: 26955},
 				run: (*parser).callonSimpleParagraph1,
 				expr: &seqExpr{
-					pos: position{line: 755, col: 20, offset: 25768},
+					pos: position{line: 772, col: 20, offset: 26955},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 755, col: 20, offset: 25768},
+							pos:   position{line: 772, col: 20, offset: 26955},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 755, col: 31, offset: 25779},
+								pos: position{line: 772, col: 31, offset: 26966},
 								expr: &ruleRefExpr{
-									pos:  position{line: 755, col: 32, offset: 25780},
+									pos:  position{line: 772, col: 32, offset: 26967},
 									name: "Attributes",
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 756, col: 5, offset: 25798},
+							pos: position{line: 773, col: 5, offset: 26985},
 							run: (*parser).callonSimpleParagraph6,
 						},
 						&labeledExpr{
-							pos:   position{line: 764, col: 5, offset: 26084},
+							pos:   position{line: 781, col: 5, offset: 27271},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 764, col: 16, offset: 26095},
+								pos:  position{line: 781, col: 16, offset: 27282},
 								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 765, col: 5, offset: 26118},
+							pos:   position{line: 782, col: 5, offset: 27305},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 765, col: 16, offset: 26129},
+								pos: position{line: 782, col: 16, offset: 27316},
 								expr: &ruleRefExpr{
-									pos:  position{line: 765, col: 17, offset: 26130},
+									pos:  position{line: 782, col: 17, offset: 27317},
 									name: "OtherParagraphLine",
 								},
 							},
@@ -5157,27 +5353,27 @@ var g = &grammar{
 		},
 		{
 			name: "FirstParagraphLine",
-			pos:  position{line: 769, col: 1, offset: 26264},
+			pos:  position{line: 786, col: 1, offset: 27471},
 			expr: &actionExpr{
-				pos: position{line: 770, col: 5, offset: 26291},
+				pos: position{line: 787, col: 5, offset: 27498},
 				run: (*parser).callonFirstParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 770, col: 5, offset: 26291},
+					pos: position{line: 787, col: 5, offset: 27498},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 770, col: 5, offset: 26291},
+							pos:   position{line: 787, col: 5, offset: 27498},
 							label: "elements",
 							expr: &seqExpr{
-								pos: position{line: 770, col: 15, offset: 26301},
+								pos: position{line: 787, col: 15, offset: 27508},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 770, col: 15, offset: 26301},
+										pos:  position{line: 787, col: 15, offset: 27508},
 										name: "Word",
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 770, col: 20, offset: 26306},
+										pos: position{line: 787, col: 20, offset: 27513},
 										expr: &ruleRefExpr{
-											pos:  position{line: 770, col: 20, offset: 26306},
+											pos:  position{line: 787, col: 20, offset: 27513},
 											name: "InlineElement",
 										},
 									},
@@ -5185,7 +5381,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 770, col: 36, offset: 26322},
+							pos:  position{line: 787, col: 36, offset: 27529},
 							name: "EOL",
 						},
 					},
@@ -5194,15 +5390,15 @@ var g = &grammar{
 		},
 		{
 			name: "OtherParagraphLine",
-			pos:  position{line: 774, col: 1, offset: 26393},
+			pos:  position{line: 791, col: 1, offset: 27600},
 			expr: &actionExpr{
-				pos: position{line: 774, col: 23, offset: 26415},
+				pos: position{line: 791, col: 23, offset: 27622},
 				run: (*parser).callonOtherParagraphLine1,
 				expr: &labeledExpr{
-					pos:   position{line: 774, col: 23, offset: 26415},
+					pos:   position{line: 791, col: 23, offset: 27622},
 					label: "elements",
 					expr: &ruleRefExpr{
-						pos:  position{line: 774, col: 33, offset: 26425},
+						pos:  position{line: 791, col: 33, offset: 27632},
 						name: "InlineElements",
 					},
 				},
@@ -5210,46 +5406,46 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedParagraph",
-			pos:  position{line: 779, col: 1, offset: 26545},
+			pos:  position{line: 796, col: 1, offset: 27752},
 			expr: &choiceExpr{
-				pos: position{line: 781, col: 5, offset: 26601},
+				pos: position{line: 798, col: 5, offset: 27808},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 781, col: 5, offset: 26601},
+						pos: position{line: 798, col: 5, offset: 27808},
 						run: (*parser).callonContinuedParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 781, col: 5, offset: 26601},
+							pos: position{line: 798, col: 5, offset: 27808},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 781, col: 5, offset: 26601},
+									pos:   position{line: 798, col: 5, offset: 27808},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 781, col: 16, offset: 26612},
+										pos: position{line: 798, col: 16, offset: 27819},
 										expr: &ruleRefExpr{
-											pos:  position{line: 781, col: 17, offset: 26613},
+											pos:  position{line: 798, col: 17, offset: 27820},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 781, col: 30, offset: 26626},
+									pos:   position{line: 798, col: 30, offset: 27833},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 781, col: 33, offset: 26629},
+										pos:  position{line: 798, col: 33, offset: 27836},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 781, col: 49, offset: 26645},
+									pos:        position{line: 798, col: 49, offset: 27852},
 									val:        ": ",
 									ignoreCase: false,
 									want:       "\": \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 781, col: 54, offset: 26650},
+									pos:   position{line: 798, col: 54, offset: 27857},
 									label: "lines",
 									expr: &ruleRefExpr{
-										pos:  position{line: 781, col: 61, offset: 26657},
+										pos:  position{line: 798, col: 61, offset: 27864},
 										name: "ContinuedParagraphLines",
 									},
 								},
@@ -5257,27 +5453,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 785, col: 5, offset: 26857},
+						pos: position{line: 802, col: 5, offset: 28084},
 						run: (*parser).callonContinuedParagraph12,
 						expr: &seqExpr{
-							pos: position{line: 785, col: 5, offset: 26857},
+							pos: position{line: 802, col: 5, offset: 28084},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 785, col: 5, offset: 26857},
+									pos:   position{line: 802, col: 5, offset: 28084},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 785, col: 16, offset: 26868},
+										pos: position{line: 802, col: 16, offset: 28095},
 										expr: &ruleRefExpr{
-											pos:  position{line: 785, col: 17, offset: 26869},
+											pos:  position{line: 802, col: 17, offset: 28096},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 785, col: 30, offset: 26882},
+									pos:   position{line: 802, col: 30, offset: 28109},
 									label: "lines",
 									expr: &ruleRefExpr{
-										pos:  position{line: 785, col: 37, offset: 26889},
+										pos:  position{line: 802, col: 37, offset: 28116},
 										name: "ContinuedParagraphLines",
 									},
 								},
@@ -5289,38 +5485,38 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedParagraphLines",
-			pos:  position{line: 789, col: 1, offset: 26990},
+			pos:  position{line: 806, col: 1, offset: 28237},
 			expr: &actionExpr{
-				pos: position{line: 789, col: 28, offset: 27017},
+				pos: position{line: 806, col: 28, offset: 28264},
 				run: (*parser).callonContinuedParagraphLines1,
 				expr: &seqExpr{
-					pos: position{line: 789, col: 28, offset: 27017},
+					pos: position{line: 806, col: 28, offset: 28264},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 789, col: 28, offset: 27017},
+							pos:   position{line: 806, col: 28, offset: 28264},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 789, col: 39, offset: 27028},
+								pos:  position{line: 806, col: 39, offset: 28275},
 								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 789, col: 59, offset: 27048},
+							pos:   position{line: 806, col: 59, offset: 28295},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 789, col: 70, offset: 27059},
+								pos: position{line: 806, col: 70, offset: 28306},
 								expr: &seqExpr{
-									pos: position{line: 789, col: 71, offset: 27060},
+									pos: position{line: 806, col: 71, offset: 28307},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 789, col: 71, offset: 27060},
+											pos: position{line: 806, col: 71, offset: 28307},
 											expr: &ruleRefExpr{
-												pos:  position{line: 789, col: 72, offset: 27061},
+												pos:  position{line: 806, col: 72, offset: 28308},
 												name: "ListItemContinuation",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 789, col: 93, offset: 27082},
+											pos:  position{line: 806, col: 93, offset: 28329},
 											name: "OtherParagraphLine",
 										},
 									},
@@ -5333,52 +5529,52 @@ var g = &grammar{
 		},
 		{
 			name: "VerseParagraph",
-			pos:  position{line: 793, col: 1, offset: 27188},
+			pos:  position{line: 810, col: 1, offset: 28435},
 			expr: &choiceExpr{
-				pos: position{line: 795, col: 5, offset: 27240},
+				pos: position{line: 812, col: 5, offset: 28487},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 795, col: 5, offset: 27240},
+						pos: position{line: 812, col: 5, offset: 28487},
 						run: (*parser).callonVerseParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 795, col: 5, offset: 27240},
+							pos: position{line: 812, col: 5, offset: 28487},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 795, col: 5, offset: 27240},
+									pos:   position{line: 812, col: 5, offset: 28487},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 795, col: 16, offset: 27251},
+										pos: position{line: 812, col: 16, offset: 28498},
 										expr: &ruleRefExpr{
-											pos:  position{line: 795, col: 17, offset: 27252},
+											pos:  position{line: 812, col: 17, offset: 28499},
 											name: "Attributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 796, col: 5, offset: 27269},
+									pos: position{line: 813, col: 5, offset: 28516},
 									run: (*parser).callonVerseParagraph7,
 								},
 								&labeledExpr{
-									pos:   position{line: 803, col: 5, offset: 27474},
+									pos:   position{line: 820, col: 5, offset: 28721},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 803, col: 8, offset: 27477},
+										pos:  position{line: 820, col: 8, offset: 28724},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 803, col: 24, offset: 27493},
+									pos:        position{line: 820, col: 24, offset: 28740},
 									val:        ": ",
 									ignoreCase: false,
 									want:       "\": \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 803, col: 29, offset: 27498},
+									pos:   position{line: 820, col: 29, offset: 28745},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 803, col: 35, offset: 27504},
+										pos: position{line: 820, col: 35, offset: 28751},
 										expr: &ruleRefExpr{
-											pos:  position{line: 803, col: 36, offset: 27505},
+											pos:  position{line: 820, col: 36, offset: 28752},
 											name: "InlineElements",
 										},
 									},
@@ -5387,33 +5583,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 807, col: 5, offset: 27697},
+						pos: position{line: 824, col: 5, offset: 28964},
 						run: (*parser).callonVerseParagraph14,
 						expr: &seqExpr{
-							pos: position{line: 807, col: 5, offset: 27697},
+							pos: position{line: 824, col: 5, offset: 28964},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 807, col: 5, offset: 27697},
+									pos:   position{line: 824, col: 5, offset: 28964},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 807, col: 16, offset: 27708},
+										pos: position{line: 824, col: 16, offset: 28975},
 										expr: &ruleRefExpr{
-											pos:  position{line: 807, col: 17, offset: 27709},
+											pos:  position{line: 824, col: 17, offset: 28976},
 											name: "Attributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 808, col: 5, offset: 27726},
+									pos: position{line: 825, col: 5, offset: 28993},
 									run: (*parser).callonVerseParagraph19,
 								},
 								&labeledExpr{
-									pos:   position{line: 815, col: 5, offset: 27931},
+									pos:   position{line: 832, col: 5, offset: 29198},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 815, col: 11, offset: 27937},
+										pos: position{line: 832, col: 11, offset: 29204},
 										expr: &ruleRefExpr{
-											pos:  position{line: 815, col: 12, offset: 27938},
+											pos:  position{line: 832, col: 12, offset: 29205},
 											name: "InlineElements",
 										},
 									},
@@ -5426,57 +5622,57 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElements",
-			pos:  position{line: 819, col: 1, offset: 28039},
+			pos:  position{line: 836, col: 1, offset: 29326},
 			expr: &actionExpr{
-				pos: position{line: 819, col: 19, offset: 28057},
+				pos: position{line: 836, col: 19, offset: 29344},
 				run: (*parser).callonInlineElements1,
 				expr: &seqExpr{
-					pos: position{line: 819, col: 19, offset: 28057},
+					pos: position{line: 836, col: 19, offset: 29344},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 819, col: 19, offset: 28057},
+							pos: position{line: 836, col: 19, offset: 29344},
 							expr: &ruleRefExpr{
-								pos:  position{line: 819, col: 20, offset: 28058},
+								pos:  position{line: 836, col: 20, offset: 29345},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 820, col: 5, offset: 28072},
+							pos:   position{line: 837, col: 5, offset: 29359},
 							label: "elements",
 							expr: &choiceExpr{
-								pos: position{line: 820, col: 15, offset: 28082},
+								pos: position{line: 837, col: 15, offset: 29369},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 820, col: 15, offset: 28082},
+										pos: position{line: 837, col: 15, offset: 29369},
 										run: (*parser).callonInlineElements7,
 										expr: &labeledExpr{
-											pos:   position{line: 820, col: 15, offset: 28082},
+											pos:   position{line: 837, col: 15, offset: 29369},
 											label: "comment",
 											expr: &ruleRefExpr{
-												pos:  position{line: 820, col: 24, offset: 28091},
+												pos:  position{line: 837, col: 24, offset: 29378},
 												name: "SingleLineComment",
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 822, col: 9, offset: 28183},
+										pos: position{line: 839, col: 9, offset: 29470},
 										run: (*parser).callonInlineElements10,
 										expr: &seqExpr{
-											pos: position{line: 822, col: 9, offset: 28183},
+											pos: position{line: 839, col: 9, offset: 29470},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 822, col: 9, offset: 28183},
+													pos:   position{line: 839, col: 9, offset: 29470},
 													label: "elements",
 													expr: &oneOrMoreExpr{
-														pos: position{line: 822, col: 18, offset: 28192},
+														pos: position{line: 839, col: 18, offset: 29479},
 														expr: &ruleRefExpr{
-															pos:  position{line: 822, col: 19, offset: 28193},
+															pos:  position{line: 839, col: 19, offset: 29480},
 															name: "InlineElement",
 														},
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 822, col: 35, offset: 28209},
+													pos:  position{line: 839, col: 35, offset: 29496},
 													name: "EOL",
 												},
 											},
@@ -5491,90 +5687,90 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElement",
-			pos:  position{line: 828, col: 1, offset: 28326},
+			pos:  position{line: 845, col: 1, offset: 29613},
 			expr: &actionExpr{
-				pos: position{line: 829, col: 5, offset: 28349},
+				pos: position{line: 846, col: 5, offset: 29636},
 				run: (*parser).callonInlineElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 829, col: 5, offset: 28349},
+					pos:   position{line: 846, col: 5, offset: 29636},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 829, col: 14, offset: 28358},
+						pos: position{line: 846, col: 14, offset: 29645},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 829, col: 14, offset: 28358},
+								pos:  position{line: 846, col: 14, offset: 29645},
 								name: "InlineWord",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 830, col: 11, offset: 28409},
+								pos:  position{line: 847, col: 11, offset: 29696},
 								name: "LineBreak",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 831, col: 11, offset: 28454},
+								pos: position{line: 848, col: 11, offset: 29741},
 								expr: &ruleRefExpr{
-									pos:  position{line: 831, col: 11, offset: 28454},
+									pos:  position{line: 848, col: 11, offset: 29741},
 									name: "Space",
 								},
 							},
 							&seqExpr{
-								pos: position{line: 832, col: 11, offset: 28472},
+								pos: position{line: 849, col: 11, offset: 29759},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 832, col: 11, offset: 28472},
+										pos: position{line: 849, col: 11, offset: 29759},
 										expr: &ruleRefExpr{
-											pos:  position{line: 832, col: 12, offset: 28473},
+											pos:  position{line: 849, col: 12, offset: 29760},
 											name: "EOL",
 										},
 									},
 									&choiceExpr{
-										pos: position{line: 833, col: 13, offset: 28492},
+										pos: position{line: 850, col: 13, offset: 29779},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 833, col: 13, offset: 28492},
+												pos:  position{line: 850, col: 13, offset: 29779},
 												name: "QuotedText",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 834, col: 15, offset: 28518},
+												pos:  position{line: 851, col: 15, offset: 29805},
 												name: "InlineImage",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 835, col: 15, offset: 28545},
+												pos:  position{line: 852, col: 15, offset: 29832},
 												name: "Link",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 836, col: 15, offset: 28565},
+												pos:  position{line: 853, col: 15, offset: 29852},
 												name: "InlinePassthrough",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 837, col: 15, offset: 28598},
+												pos:  position{line: 854, col: 15, offset: 29885},
 												name: "InlineFootnote",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 838, col: 15, offset: 28628},
+												pos:  position{line: 855, col: 15, offset: 29915},
 												name: "CrossReference",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 839, col: 15, offset: 28658},
+												pos:  position{line: 856, col: 15, offset: 29945},
 												name: "InlineUserMacro",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 840, col: 15, offset: 28689},
+												pos:  position{line: 857, col: 15, offset: 29976},
 												name: "AttributeSubstitution",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 841, col: 15, offset: 28726},
+												pos:  position{line: 858, col: 15, offset: 30013},
 												name: "InlineElementID",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 842, col: 15, offset: 28757},
+												pos:  position{line: 859, col: 15, offset: 30044},
 												name: "ConcealedIndexTerm",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 843, col: 15, offset: 28790},
+												pos:  position{line: 860, col: 15, offset: 30077},
 												name: "IndexTerm",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 844, col: 15, offset: 28814},
+												pos:  position{line: 861, col: 15, offset: 30101},
 												name: "AnyChar",
 											},
 										},
@@ -5588,34 +5784,34 @@ var g = &grammar{
 		},
 		{
 			name: "LineBreak",
-			pos:  position{line: 851, col: 1, offset: 29037},
+			pos:  position{line: 868, col: 1, offset: 30324},
 			expr: &actionExpr{
-				pos: position{line: 851, col: 14, offset: 29050},
+				pos: position{line: 868, col: 14, offset: 30337},
 				run: (*parser).callonLineBreak1,
 				expr: &seqExpr{
-					pos: position{line: 851, col: 14, offset: 29050},
+					pos: position{line: 868, col: 14, offset: 30337},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 851, col: 14, offset: 29050},
+							pos:  position{line: 868, col: 14, offset: 30337},
 							name: "Space",
 						},
 						&litMatcher{
-							pos:        position{line: 851, col: 20, offset: 29056},
+							pos:        position{line: 868, col: 20, offset: 30343},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 851, col: 24, offset: 29060},
+							pos: position{line: 868, col: 24, offset: 30347},
 							expr: &ruleRefExpr{
-								pos:  position{line: 851, col: 24, offset: 29060},
+								pos:  position{line: 868, col: 24, offset: 30347},
 								name: "Space",
 							},
 						},
 						&andExpr{
-							pos: position{line: 851, col: 31, offset: 29067},
+							pos: position{line: 868, col: 31, offset: 30354},
 							expr: &ruleRefExpr{
-								pos:  position{line: 851, col: 32, offset: 29068},
+								pos:  position{line: 868, col: 32, offset: 30355},
 								name: "EOL",
 							},
 						},
@@ -5625,20 +5821,20 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedText",
-			pos:  position{line: 858, col: 1, offset: 29352},
+			pos:  position{line: 875, col: 1, offset: 30657},
 			expr: &choiceExpr{
-				pos: position{line: 858, col: 15, offset: 29366},
+				pos: position{line: 875, col: 15, offset: 30671},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 858, col: 15, offset: 29366},
+						pos:  position{line: 875, col: 15, offset: 30671},
 						name: "UnconstrainedQuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 858, col: 41, offset: 29392},
+						pos:  position{line: 875, col: 41, offset: 30697},
 						name: "ConstrainedQuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 858, col: 65, offset: 29416},
+						pos:  position{line: 875, col: 65, offset: 30721},
 						name: "EscapedQuotedText",
 					},
 				},
@@ -5646,23 +5842,23 @@ var g = &grammar{
 		},
 		{
 			name: "ConstrainedQuotedTextMarker",
-			pos:  position{line: 860, col: 1, offset: 29435},
+			pos:  position{line: 877, col: 1, offset: 30740},
 			expr: &choiceExpr{
-				pos: position{line: 860, col: 32, offset: 29466},
+				pos: position{line: 877, col: 32, offset: 30771},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 860, col: 32, offset: 29466},
+						pos: position{line: 877, col: 32, offset: 30771},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 860, col: 32, offset: 29466},
+								pos:        position{line: 877, col: 32, offset: 30771},
 								val:        "*",
 								ignoreCase: false,
 								want:       "\"*\"",
 							},
 							&notExpr{
-								pos: position{line: 860, col: 36, offset: 29470},
+								pos: position{line: 877, col: 36, offset: 30775},
 								expr: &litMatcher{
-									pos:        position{line: 860, col: 37, offset: 29471},
+									pos:        position{line: 877, col: 37, offset: 30776},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -5671,18 +5867,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 860, col: 43, offset: 29477},
+						pos: position{line: 877, col: 43, offset: 30782},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 860, col: 43, offset: 29477},
+								pos:        position{line: 877, col: 43, offset: 30782},
 								val:        "_",
 								ignoreCase: false,
 								want:       "\"_\"",
 							},
 							&notExpr{
-								pos: position{line: 860, col: 47, offset: 29481},
+								pos: position{line: 877, col: 47, offset: 30786},
 								expr: &litMatcher{
-									pos:        position{line: 860, col: 48, offset: 29482},
+									pos:        position{line: 877, col: 48, offset: 30787},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -5691,18 +5887,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 860, col: 54, offset: 29488},
+						pos: position{line: 877, col: 54, offset: 30793},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 860, col: 54, offset: 29488},
+								pos:        position{line: 877, col: 54, offset: 30793},
 								val:        "`",
 								ignoreCase: false,
 								want:       "\"`\"",
 							},
 							&notExpr{
-								pos: position{line: 860, col: 58, offset: 29492},
+								pos: position{line: 877, col: 58, offset: 30797},
 								expr: &litMatcher{
-									pos:        position{line: 860, col: 59, offset: 29493},
+									pos:        position{line: 877, col: 59, offset: 30798},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -5715,36 +5911,36 @@ var g = &grammar{
 		},
 		{
 			name: "UnconstrainedQuotedTextPrefix",
-			pos:  position{line: 862, col: 1, offset: 29499},
+			pos:  position{line: 879, col: 1, offset: 30804},
 			expr: &choiceExpr{
-				pos: position{line: 862, col: 34, offset: 29532},
+				pos: position{line: 879, col: 34, offset: 30837},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 862, col: 34, offset: 29532},
+						pos:        position{line: 879, col: 34, offset: 30837},
 						val:        "**",
 						ignoreCase: false,
 						want:       "\"**\"",
 					},
 					&litMatcher{
-						pos:        position{line: 862, col: 41, offset: 29539},
+						pos:        position{line: 879, col: 41, offset: 30844},
 						val:        "__",
 						ignoreCase: false,
 						want:       "\"__\"",
 					},
 					&litMatcher{
-						pos:        position{line: 862, col: 48, offset: 29546},
+						pos:        position{line: 879, col: 48, offset: 30851},
 						val:        "``",
 						ignoreCase: false,
 						want:       "\"``\"",
 					},
 					&litMatcher{
-						pos:        position{line: 862, col: 55, offset: 29553},
+						pos:        position{line: 879, col: 55, offset: 30858},
 						val:        "^",
 						ignoreCase: false,
 						want:       "\"^\"",
 					},
 					&litMatcher{
-						pos:        position{line: 862, col: 61, offset: 29559},
+						pos:        position{line: 879, col: 61, offset: 30864},
 						val:        "~",
 						ignoreCase: false,
 						want:       "\"~\"",
@@ -5754,38 +5950,38 @@ var g = &grammar{
 		},
 		{
 			name: "ConstrainedQuotedText",
-			pos:  position{line: 864, col: 1, offset: 29564},
+			pos:  position{line: 881, col: 1, offset: 30869},
 			expr: &actionExpr{
-				pos: position{line: 864, col: 26, offset: 29589},
+				pos: position{line: 881, col: 26, offset: 30894},
 				run: (*parser).callonConstrainedQuotedText1,
 				expr: &labeledExpr{
-					pos:   position{line: 864, col: 26, offset: 29589},
+					pos:   position{line: 881, col: 26, offset: 30894},
 					label: "text",
 					expr: &choiceExpr{
-						pos: position{line: 864, col: 32, offset: 29595},
+						pos: position{line: 881, col: 32, offset: 30900},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 864, col: 32, offset: 29595},
+								pos:  position{line: 881, col: 32, offset: 30900},
 								name: "SingleQuoteBoldText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 865, col: 15, offset: 29630},
+								pos:  position{line: 882, col: 15, offset: 30935},
 								name: "SingleQuoteItalicText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 866, col: 15, offset: 29667},
+								pos:  position{line: 883, col: 15, offset: 30972},
 								name: "SingleQuoteMonospaceText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 867, col: 15, offset: 29707},
+								pos:  position{line: 884, col: 15, offset: 31012},
 								name: "SubscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 868, col: 15, offset: 29736},
+								pos:  position{line: 885, col: 15, offset: 31041},
 								name: "SuperscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 869, col: 15, offset: 29767},
+								pos:  position{line: 886, col: 15, offset: 31072},
 								name: "SubscriptOrSuperscriptPrefix",
 							},
 						},
@@ -5795,20 +5991,20 @@ var g = &grammar{
 		},
 		{
 			name: "UnconstrainedQuotedText",
-			pos:  position{line: 873, col: 1, offset: 29921},
+			pos:  position{line: 890, col: 1, offset: 31226},
 			expr: &choiceExpr{
-				pos: position{line: 873, col: 28, offset: 29948},
+				pos: position{line: 890, col: 28, offset: 31253},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 873, col: 28, offset: 29948},
+						pos:  position{line: 890, col: 28, offset: 31253},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 874, col: 15, offset: 29982},
+						pos:  position{line: 891, col: 15, offset: 31287},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 875, col: 15, offset: 30018},
+						pos:  position{line: 892, col: 15, offset: 31323},
 						name: "DoubleQuoteMonospaceText",
 					},
 				},
@@ -5816,28 +6012,28 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedQuotedText",
-			pos:  position{line: 877, col: 1, offset: 30044},
+			pos:  position{line: 894, col: 1, offset: 31349},
 			expr: &choiceExpr{
-				pos: position{line: 877, col: 22, offset: 30065},
+				pos: position{line: 894, col: 22, offset: 31370},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 877, col: 22, offset: 30065},
+						pos:  position{line: 894, col: 22, offset: 31370},
 						name: "EscapedBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 878, col: 15, offset: 30096},
+						pos:  position{line: 895, col: 15, offset: 31401},
 						name: "EscapedItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 879, col: 15, offset: 30129},
+						pos:  position{line: 896, col: 15, offset: 31434},
 						name: "EscapedMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 880, col: 15, offset: 30165},
+						pos:  position{line: 897, col: 15, offset: 31470},
 						name: "EscapedSubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 881, col: 15, offset: 30201},
+						pos:  position{line: 898, col: 15, offset: 31506},
 						name: "EscapedSuperscriptText",
 					},
 				},
@@ -5845,21 +6041,21 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptOrSuperscriptPrefix",
-			pos:  position{line: 883, col: 1, offset: 30225},
+			pos:  position{line: 900, col: 1, offset: 31530},
 			expr: &choiceExpr{
-				pos: position{line: 883, col: 33, offset: 30257},
+				pos: position{line: 900, col: 33, offset: 31562},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 883, col: 33, offset: 30257},
+						pos:        position{line: 900, col: 33, offset: 31562},
 						val:        "^",
 						ignoreCase: false,
 						want:       "\"^\"",
 					},
 					&actionExpr{
-						pos: position{line: 883, col: 39, offset: 30263},
+						pos: position{line: 900, col: 39, offset: 31568},
 						run: (*parser).callonSubscriptOrSuperscriptPrefix3,
 						expr: &litMatcher{
-							pos:        position{line: 883, col: 39, offset: 30263},
+							pos:        position{line: 900, col: 39, offset: 31568},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
@@ -5870,14 +6066,14 @@ var g = &grammar{
 		},
 		{
 			name: "OneOrMoreBackslashes",
-			pos:  position{line: 887, col: 1, offset: 30396},
+			pos:  position{line: 904, col: 1, offset: 31701},
 			expr: &actionExpr{
-				pos: position{line: 887, col: 25, offset: 30420},
+				pos: position{line: 904, col: 25, offset: 31725},
 				run: (*parser).callonOneOrMoreBackslashes1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 887, col: 25, offset: 30420},
+					pos: position{line: 904, col: 25, offset: 31725},
 					expr: &litMatcher{
-						pos:        position{line: 887, col: 25, offset: 30420},
+						pos:        position{line: 904, col: 25, offset: 31725},
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
@@ -5887,23 +6083,23 @@ var g = &grammar{
 		},
 		{
 			name: "TwoOrMoreBackslashes",
-			pos:  position{line: 891, col: 1, offset: 30461},
+			pos:  position{line: 908, col: 1, offset: 31766},
 			expr: &actionExpr{
-				pos: position{line: 891, col: 25, offset: 30485},
+				pos: position{line: 908, col: 25, offset: 31790},
 				run: (*parser).callonTwoOrMoreBackslashes1,
 				expr: &seqExpr{
-					pos: position{line: 891, col: 25, offset: 30485},
+					pos: position{line: 908, col: 25, offset: 31790},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 891, col: 25, offset: 30485},
+							pos:        position{line: 908, col: 25, offset: 31790},
 							val:        "\\\\",
 							ignoreCase: false,
 							want:       "\"\\\\\\\\\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 891, col: 30, offset: 30490},
+							pos: position{line: 908, col: 30, offset: 31795},
 							expr: &litMatcher{
-								pos:        position{line: 891, col: 30, offset: 30490},
+								pos:        position{line: 908, col: 30, offset: 31795},
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
@@ -5915,16 +6111,16 @@ var g = &grammar{
 		},
 		{
 			name: "BoldText",
-			pos:  position{line: 899, col: 1, offset: 30587},
+			pos:  position{line: 916, col: 1, offset: 31892},
 			expr: &choiceExpr{
-				pos: position{line: 899, col: 13, offset: 30599},
+				pos: position{line: 916, col: 13, offset: 31904},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 899, col: 13, offset: 30599},
+						pos:  position{line: 916, col: 13, offset: 31904},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 899, col: 35, offset: 30621},
+						pos:  position{line: 916, col: 35, offset: 31926},
 						name: "SingleQuoteBoldText",
 					},
 				},
@@ -5932,38 +6128,38 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldText",
-			pos:  position{line: 901, col: 1, offset: 30688},
+			pos:  position{line: 918, col: 1, offset: 31993},
 			expr: &actionExpr{
-				pos: position{line: 901, col: 24, offset: 30711},
+				pos: position{line: 918, col: 24, offset: 32016},
 				run: (*parser).callonDoubleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 901, col: 24, offset: 30711},
+					pos: position{line: 918, col: 24, offset: 32016},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 901, col: 24, offset: 30711},
+							pos: position{line: 918, col: 24, offset: 32016},
 							expr: &litMatcher{
-								pos:        position{line: 901, col: 25, offset: 30712},
+								pos:        position{line: 918, col: 25, offset: 32017},
 								val:        "\\\\",
 								ignoreCase: false,
 								want:       "\"\\\\\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 901, col: 30, offset: 30717},
+							pos:        position{line: 918, col: 30, offset: 32022},
 							val:        "**",
 							ignoreCase: false,
 							want:       "\"**\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 901, col: 35, offset: 30722},
+							pos:   position{line: 918, col: 35, offset: 32027},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 901, col: 45, offset: 30732},
+								pos:  position{line: 918, col: 45, offset: 32037},
 								name: "DoubleQuoteBoldTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 901, col: 74, offset: 30761},
+							pos:        position{line: 918, col: 74, offset: 32066},
 							val:        "**",
 							ignoreCase: false,
 							want:       "\"**\"",
@@ -5974,37 +6170,37 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextElements",
-			pos:  position{line: 905, col: 1, offset: 30842},
+			pos:  position{line: 922, col: 1, offset: 32167},
 			expr: &seqExpr{
-				pos: position{line: 905, col: 32, offset: 30873},
+				pos: position{line: 922, col: 32, offset: 32198},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 905, col: 32, offset: 30873},
+						pos:  position{line: 922, col: 32, offset: 32198},
 						name: "DoubleQuoteBoldTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 905, col: 59, offset: 30900},
+						pos: position{line: 922, col: 59, offset: 32225},
 						expr: &seqExpr{
-							pos: position{line: 905, col: 60, offset: 30901},
+							pos: position{line: 922, col: 60, offset: 32226},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 905, col: 60, offset: 30901},
+									pos: position{line: 922, col: 60, offset: 32226},
 									expr: &litMatcher{
-										pos:        position{line: 905, col: 62, offset: 30903},
+										pos:        position{line: 922, col: 62, offset: 32228},
 										val:        "**",
 										ignoreCase: false,
 										want:       "\"**\"",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 905, col: 69, offset: 30910},
+									pos: position{line: 922, col: 69, offset: 32235},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 905, col: 69, offset: 30910},
+											pos:  position{line: 922, col: 69, offset: 32235},
 											name: "Space",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 905, col: 77, offset: 30918},
+											pos:  position{line: 922, col: 77, offset: 32243},
 											name: "DoubleQuoteBoldTextElement",
 										},
 									},
@@ -6017,56 +6213,56 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextElement",
-			pos:  position{line: 907, col: 1, offset: 30983},
+			pos:  position{line: 924, col: 1, offset: 32308},
 			expr: &choiceExpr{
-				pos: position{line: 907, col: 31, offset: 31013},
+				pos: position{line: 924, col: 31, offset: 32338},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 907, col: 31, offset: 31013},
+						pos:  position{line: 924, col: 31, offset: 32338},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 908, col: 11, offset: 31029},
+						pos:  position{line: 925, col: 11, offset: 32354},
 						name: "SingleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 909, col: 11, offset: 31060},
+						pos:  position{line: 926, col: 11, offset: 32385},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 910, col: 11, offset: 31082},
+						pos:  position{line: 927, col: 11, offset: 32407},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 911, col: 11, offset: 31106},
+						pos:  position{line: 928, col: 11, offset: 32431},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 912, col: 11, offset: 31130},
+						pos:  position{line: 929, col: 11, offset: 32455},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 913, col: 11, offset: 31156},
+						pos:  position{line: 930, col: 11, offset: 32481},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 914, col: 11, offset: 31179},
+						pos:  position{line: 931, col: 11, offset: 32504},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 915, col: 11, offset: 31195},
+						pos:  position{line: 932, col: 11, offset: 32520},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 916, col: 11, offset: 31224},
+						pos:  position{line: 933, col: 11, offset: 32549},
 						name: "AttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 917, col: 11, offset: 31256},
+						pos:  position{line: 934, col: 11, offset: 32581},
 						name: "DoubleQuoteBoldTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 918, col: 11, offset: 31299},
+						pos:  position{line: 935, col: 11, offset: 32624},
 						name: "DoubleQuoteBoldTextFallbackCharacter",
 					},
 				},
@@ -6074,26 +6270,26 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextStringElement",
-			pos:  position{line: 921, col: 1, offset: 31338},
+			pos:  position{line: 938, col: 1, offset: 32663},
 			expr: &actionExpr{
-				pos: position{line: 921, col: 37, offset: 31374},
+				pos: position{line: 938, col: 37, offset: 32699},
 				run: (*parser).callonDoubleQuoteBoldTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 921, col: 37, offset: 31374},
+					pos: position{line: 938, col: 37, offset: 32699},
 					expr: &seqExpr{
-						pos: position{line: 921, col: 38, offset: 31375},
+						pos: position{line: 938, col: 38, offset: 32700},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 921, col: 38, offset: 31375},
+								pos: position{line: 938, col: 38, offset: 32700},
 								expr: &litMatcher{
-									pos:        position{line: 921, col: 39, offset: 31376},
+									pos:        position{line: 938, col: 39, offset: 32701},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 							},
 							&charClassMatcher{
-								pos:        position{line: 921, col: 44, offset: 31381},
+								pos:        position{line: 938, col: 44, offset: 32706},
 								val:        "[^\\r\\n ^~{}]",
 								chars:      []rune{'\r', '\n', ' ', '^', '~', '{', '}'},
 								ignoreCase: false,
@@ -6106,31 +6302,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextFallbackCharacter",
-			pos:  position{line: 925, col: 1, offset: 31452},
+			pos:  position{line: 942, col: 1, offset: 32777},
 			expr: &choiceExpr{
-				pos: position{line: 926, col: 5, offset: 31497},
+				pos: position{line: 943, col: 5, offset: 32822},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 926, col: 5, offset: 31497},
+						pos:        position{line: 943, col: 5, offset: 32822},
 						val:        "[^\\r\\n*]",
 						chars:      []rune{'\r', '\n', '*'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 927, col: 7, offset: 31594},
+						pos: position{line: 944, col: 7, offset: 32919},
 						run: (*parser).callonDoubleQuoteBoldTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 927, col: 7, offset: 31594},
+							pos: position{line: 944, col: 7, offset: 32919},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 927, col: 7, offset: 31594},
+									pos:        position{line: 944, col: 7, offset: 32919},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 927, col: 12, offset: 31599},
+									pos:  position{line: 944, col: 12, offset: 32924},
 									name: "Alphanums",
 								},
 							},
@@ -6141,38 +6337,38 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldText",
-			pos:  position{line: 931, col: 1, offset: 31762},
+			pos:  position{line: 948, col: 1, offset: 33087},
 			expr: &choiceExpr{
-				pos: position{line: 931, col: 24, offset: 31785},
+				pos: position{line: 948, col: 24, offset: 33110},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 931, col: 24, offset: 31785},
+						pos: position{line: 948, col: 24, offset: 33110},
 						run: (*parser).callonSingleQuoteBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 931, col: 24, offset: 31785},
+							pos: position{line: 948, col: 24, offset: 33110},
 							exprs: []interface{}{
 								&seqExpr{
-									pos: position{line: 931, col: 25, offset: 31786},
+									pos: position{line: 948, col: 25, offset: 33111},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 931, col: 25, offset: 31786},
+											pos: position{line: 948, col: 25, offset: 33111},
 											expr: &litMatcher{
-												pos:        position{line: 931, col: 26, offset: 31787},
+												pos:        position{line: 948, col: 26, offset: 33112},
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 931, col: 30, offset: 31791},
+											pos:        position{line: 948, col: 30, offset: 33116},
 											val:        "*",
 											ignoreCase: false,
 											want:       "\"*\"",
 										},
 										&notExpr{
-											pos: position{line: 931, col: 34, offset: 31795},
+											pos: position{line: 948, col: 34, offset: 33120},
 											expr: &litMatcher{
-												pos:        position{line: 931, col: 35, offset: 31796},
+												pos:        position{line: 948, col: 35, offset: 33121},
 												val:        "*",
 												ignoreCase: false,
 												want:       "\"*\"",
@@ -6181,25 +6377,25 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 931, col: 40, offset: 31801},
+									pos:   position{line: 948, col: 40, offset: 33126},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 931, col: 50, offset: 31811},
+										pos:  position{line: 948, col: 50, offset: 33136},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 931, col: 79, offset: 31840},
+									pos:        position{line: 948, col: 79, offset: 33165},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&andExpr{
-									pos: position{line: 931, col: 83, offset: 31844},
+									pos: position{line: 948, col: 83, offset: 33169},
 									expr: &notExpr{
-										pos: position{line: 931, col: 85, offset: 31846},
+										pos: position{line: 948, col: 85, offset: 33171},
 										expr: &ruleRefExpr{
-											pos:  position{line: 931, col: 86, offset: 31847},
+											pos:  position{line: 948, col: 86, offset: 33172},
 											name: "Alphanum",
 										},
 									},
@@ -6208,47 +6404,47 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 933, col: 5, offset: 32034},
+						pos: position{line: 950, col: 5, offset: 33379},
 						run: (*parser).callonSingleQuoteBoldText16,
 						expr: &seqExpr{
-							pos: position{line: 933, col: 5, offset: 32034},
+							pos: position{line: 950, col: 5, offset: 33379},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 933, col: 5, offset: 32034},
+									pos: position{line: 950, col: 5, offset: 33379},
 									expr: &litMatcher{
-										pos:        position{line: 933, col: 6, offset: 32035},
+										pos:        position{line: 950, col: 6, offset: 33380},
 										val:        "\\\\",
 										ignoreCase: false,
 										want:       "\"\\\\\\\\\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 933, col: 11, offset: 32040},
+									pos:        position{line: 950, col: 11, offset: 33385},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 933, col: 15, offset: 32044},
+									pos:   position{line: 950, col: 15, offset: 33389},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 933, col: 25, offset: 32054},
+										pos: position{line: 950, col: 25, offset: 33399},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 933, col: 25, offset: 32054},
+												pos:        position{line: 950, col: 25, offset: 33399},
 												val:        "*",
 												ignoreCase: false,
 												want:       "\"*\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 933, col: 29, offset: 32058},
+												pos:  position{line: 950, col: 29, offset: 33403},
 												name: "SingleQuoteBoldTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 933, col: 58, offset: 32087},
+									pos:        position{line: 950, col: 58, offset: 33432},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -6261,21 +6457,21 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextElements",
-			pos:  position{line: 937, col: 1, offset: 32286},
+			pos:  position{line: 954, col: 1, offset: 33651},
 			expr: &seqExpr{
-				pos: position{line: 937, col: 32, offset: 32317},
+				pos: position{line: 954, col: 32, offset: 33682},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 937, col: 32, offset: 32317},
+						pos: position{line: 954, col: 32, offset: 33682},
 						expr: &ruleRefExpr{
-							pos:  position{line: 937, col: 33, offset: 32318},
+							pos:  position{line: 954, col: 33, offset: 33683},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 937, col: 39, offset: 32324},
+						pos: position{line: 954, col: 39, offset: 33689},
 						expr: &ruleRefExpr{
-							pos:  position{line: 937, col: 39, offset: 32324},
+							pos:  position{line: 954, col: 39, offset: 33689},
 							name: "SingleQuoteBoldTextElement",
 						},
 					},
@@ -6284,43 +6480,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextElement",
-			pos:  position{line: 939, col: 1, offset: 32353},
+			pos:  position{line: 956, col: 1, offset: 33718},
 			expr: &choiceExpr{
-				pos: position{line: 939, col: 31, offset: 32383},
+				pos: position{line: 956, col: 31, offset: 33748},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 939, col: 31, offset: 32383},
+						pos:  position{line: 956, col: 31, offset: 33748},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 940, col: 11, offset: 32399},
+						pos:  position{line: 957, col: 11, offset: 33764},
 						name: "DoubleQuoteBoldText",
 					},
 					&seqExpr{
-						pos: position{line: 941, col: 11, offset: 32429},
+						pos: position{line: 958, col: 11, offset: 33794},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 941, col: 11, offset: 32429},
+								pos: position{line: 958, col: 11, offset: 33794},
 								expr: &ruleRefExpr{
-									pos:  position{line: 941, col: 11, offset: 32429},
+									pos:  position{line: 958, col: 11, offset: 33794},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 941, col: 18, offset: 32436},
+								pos: position{line: 958, col: 18, offset: 33801},
 								expr: &seqExpr{
-									pos: position{line: 941, col: 19, offset: 32437},
+									pos: position{line: 958, col: 19, offset: 33802},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 941, col: 19, offset: 32437},
+											pos:        position{line: 958, col: 19, offset: 33802},
 											val:        "*",
 											ignoreCase: false,
 											want:       "\"*\"",
 										},
 										&notExpr{
-											pos: position{line: 941, col: 23, offset: 32441},
+											pos: position{line: 958, col: 23, offset: 33806},
 											expr: &litMatcher{
-												pos:        position{line: 941, col: 24, offset: 32442},
+												pos:        position{line: 958, col: 24, offset: 33807},
 												val:        "*",
 												ignoreCase: false,
 												want:       "\"*\"",
@@ -6332,43 +6528,43 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 942, col: 11, offset: 32458},
+						pos:  position{line: 959, col: 11, offset: 33823},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 943, col: 11, offset: 32480},
+						pos:  position{line: 960, col: 11, offset: 33845},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 944, col: 11, offset: 32504},
+						pos:  position{line: 961, col: 11, offset: 33869},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 945, col: 11, offset: 32528},
+						pos:  position{line: 962, col: 11, offset: 33893},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 946, col: 11, offset: 32554},
+						pos:  position{line: 963, col: 11, offset: 33919},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 947, col: 11, offset: 32577},
+						pos:  position{line: 964, col: 11, offset: 33942},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 948, col: 11, offset: 32594},
+						pos:  position{line: 965, col: 11, offset: 33959},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 949, col: 11, offset: 32623},
+						pos:  position{line: 966, col: 11, offset: 33988},
 						name: "AttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 950, col: 11, offset: 32655},
+						pos:  position{line: 967, col: 11, offset: 34020},
 						name: "SingleQuoteBoldTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 951, col: 11, offset: 32698},
+						pos:  position{line: 968, col: 11, offset: 34063},
 						name: "SingleQuoteBoldTextFallbackCharacter",
 					},
 				},
@@ -6376,14 +6572,14 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextStringElement",
-			pos:  position{line: 953, col: 1, offset: 32736},
+			pos:  position{line: 970, col: 1, offset: 34101},
 			expr: &actionExpr{
-				pos: position{line: 953, col: 37, offset: 32772},
+				pos: position{line: 970, col: 37, offset: 34137},
 				run: (*parser).callonSingleQuoteBoldTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 953, col: 37, offset: 32772},
+					pos: position{line: 970, col: 37, offset: 34137},
 					expr: &charClassMatcher{
-						pos:        position{line: 953, col: 37, offset: 32772},
+						pos:        position{line: 970, col: 37, offset: 34137},
 						val:        "[^\\r\\n{} *^~]",
 						chars:      []rune{'\r', '\n', '{', '}', ' ', '*', '^', '~'},
 						ignoreCase: false,
@@ -6394,31 +6590,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextFallbackCharacter",
-			pos:  position{line: 957, col: 1, offset: 32998},
+			pos:  position{line: 974, col: 1, offset: 34363},
 			expr: &choiceExpr{
-				pos: position{line: 958, col: 5, offset: 33043},
+				pos: position{line: 975, col: 5, offset: 34408},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 958, col: 5, offset: 33043},
+						pos:        position{line: 975, col: 5, offset: 34408},
 						val:        "[^\\r\\n*]",
 						chars:      []rune{'\r', '\n', '*'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 959, col: 7, offset: 33140},
+						pos: position{line: 976, col: 7, offset: 34505},
 						run: (*parser).callonSingleQuoteBoldTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 959, col: 7, offset: 33140},
+							pos: position{line: 976, col: 7, offset: 34505},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 959, col: 7, offset: 33140},
+									pos:        position{line: 976, col: 7, offset: 34505},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 959, col: 11, offset: 33144},
+									pos:  position{line: 976, col: 11, offset: 34509},
 									name: "Alphanums",
 								},
 							},
@@ -6429,40 +6625,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedBoldText",
-			pos:  position{line: 963, col: 1, offset: 33307},
+			pos:  position{line: 980, col: 1, offset: 34672},
 			expr: &choiceExpr{
-				pos: position{line: 964, col: 5, offset: 33331},
+				pos: position{line: 981, col: 5, offset: 34696},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 964, col: 5, offset: 33331},
+						pos: position{line: 981, col: 5, offset: 34696},
 						run: (*parser).callonEscapedBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 964, col: 5, offset: 33331},
+							pos: position{line: 981, col: 5, offset: 34696},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 964, col: 5, offset: 33331},
+									pos:   position{line: 981, col: 5, offset: 34696},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 964, col: 18, offset: 33344},
+										pos:  position{line: 981, col: 18, offset: 34709},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 964, col: 40, offset: 33366},
+									pos:        position{line: 981, col: 40, offset: 34731},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 964, col: 45, offset: 33371},
+									pos:   position{line: 981, col: 45, offset: 34736},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 964, col: 55, offset: 33381},
+										pos:  position{line: 981, col: 55, offset: 34746},
 										name: "DoubleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 964, col: 84, offset: 33410},
+									pos:        position{line: 981, col: 84, offset: 34775},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
@@ -6471,35 +6667,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 966, col: 9, offset: 33567},
+						pos: position{line: 983, col: 9, offset: 34932},
 						run: (*parser).callonEscapedBoldText10,
 						expr: &seqExpr{
-							pos: position{line: 966, col: 9, offset: 33567},
+							pos: position{line: 983, col: 9, offset: 34932},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 966, col: 9, offset: 33567},
+									pos:   position{line: 983, col: 9, offset: 34932},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 966, col: 22, offset: 33580},
+										pos:  position{line: 983, col: 22, offset: 34945},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 966, col: 44, offset: 33602},
+									pos:        position{line: 983, col: 44, offset: 34967},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 966, col: 49, offset: 33607},
+									pos:   position{line: 983, col: 49, offset: 34972},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 966, col: 59, offset: 33617},
+										pos:  position{line: 983, col: 59, offset: 34982},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 966, col: 88, offset: 33646},
+									pos:        position{line: 983, col: 88, offset: 35011},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -6508,35 +6704,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 969, col: 9, offset: 33846},
+						pos: position{line: 986, col: 9, offset: 35211},
 						run: (*parser).callonEscapedBoldText18,
 						expr: &seqExpr{
-							pos: position{line: 969, col: 9, offset: 33846},
+							pos: position{line: 986, col: 9, offset: 35211},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 969, col: 9, offset: 33846},
+									pos:   position{line: 986, col: 9, offset: 35211},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 969, col: 22, offset: 33859},
+										pos:  position{line: 986, col: 22, offset: 35224},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 969, col: 44, offset: 33881},
+									pos:        position{line: 986, col: 44, offset: 35246},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 969, col: 48, offset: 33885},
+									pos:   position{line: 986, col: 48, offset: 35250},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 969, col: 58, offset: 33895},
+										pos:  position{line: 986, col: 58, offset: 35260},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 969, col: 87, offset: 33924},
+									pos:        position{line: 986, col: 87, offset: 35289},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -6549,16 +6745,16 @@ var g = &grammar{
 		},
 		{
 			name: "ItalicText",
-			pos:  position{line: 977, col: 1, offset: 34132},
+			pos:  position{line: 994, col: 1, offset: 35497},
 			expr: &choiceExpr{
-				pos: position{line: 977, col: 15, offset: 34146},
+				pos: position{line: 994, col: 15, offset: 35511},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 977, col: 15, offset: 34146},
+						pos:  position{line: 994, col: 15, offset: 35511},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 977, col: 39, offset: 34170},
+						pos:  position{line: 994, col: 39, offset: 35535},
 						name: "SingleQuoteItalicText",
 					},
 				},
@@ -6566,38 +6762,38 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicText",
-			pos:  position{line: 979, col: 1, offset: 34193},
+			pos:  position{line: 996, col: 1, offset: 35558},
 			expr: &actionExpr{
-				pos: position{line: 979, col: 26, offset: 34218},
+				pos: position{line: 996, col: 26, offset: 35583},
 				run: (*parser).callonDoubleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 979, col: 26, offset: 34218},
+					pos: position{line: 996, col: 26, offset: 35583},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 979, col: 26, offset: 34218},
+							pos: position{line: 996, col: 26, offset: 35583},
 							expr: &litMatcher{
-								pos:        position{line: 979, col: 27, offset: 34219},
+								pos:        position{line: 996, col: 27, offset: 35584},
 								val:        "\\\\",
 								ignoreCase: false,
 								want:       "\"\\\\\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 979, col: 32, offset: 34224},
+							pos:        position{line: 996, col: 32, offset: 35589},
 							val:        "__",
 							ignoreCase: false,
 							want:       "\"__\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 979, col: 37, offset: 34229},
+							pos:   position{line: 996, col: 37, offset: 35594},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 979, col: 47, offset: 34239},
+								pos:  position{line: 996, col: 47, offset: 35604},
 								name: "DoubleQuoteItalicTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 979, col: 78, offset: 34270},
+							pos:        position{line: 996, col: 78, offset: 35635},
 							val:        "__",
 							ignoreCase: false,
 							want:       "\"__\"",
@@ -6608,37 +6804,37 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextElements",
-			pos:  position{line: 983, col: 1, offset: 34397},
+			pos:  position{line: 1000, col: 1, offset: 35782},
 			expr: &seqExpr{
-				pos: position{line: 983, col: 34, offset: 34430},
+				pos: position{line: 1000, col: 34, offset: 35815},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 983, col: 34, offset: 34430},
+						pos:  position{line: 1000, col: 34, offset: 35815},
 						name: "DoubleQuoteItalicTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 983, col: 63, offset: 34459},
+						pos: position{line: 1000, col: 63, offset: 35844},
 						expr: &seqExpr{
-							pos: position{line: 983, col: 64, offset: 34460},
+							pos: position{line: 1000, col: 64, offset: 35845},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 983, col: 64, offset: 34460},
+									pos: position{line: 1000, col: 64, offset: 35845},
 									expr: &litMatcher{
-										pos:        position{line: 983, col: 66, offset: 34462},
+										pos:        position{line: 1000, col: 66, offset: 35847},
 										val:        "__",
 										ignoreCase: false,
 										want:       "\"__\"",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 983, col: 73, offset: 34469},
+									pos: position{line: 1000, col: 73, offset: 35854},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 983, col: 73, offset: 34469},
+											pos:  position{line: 1000, col: 73, offset: 35854},
 											name: "Space",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 983, col: 81, offset: 34477},
+											pos:  position{line: 1000, col: 81, offset: 35862},
 											name: "DoubleQuoteItalicTextElement",
 										},
 									},
@@ -6651,52 +6847,52 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextElement",
-			pos:  position{line: 985, col: 1, offset: 34544},
+			pos:  position{line: 1002, col: 1, offset: 35929},
 			expr: &choiceExpr{
-				pos: position{line: 985, col: 33, offset: 34576},
+				pos: position{line: 1002, col: 33, offset: 35961},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 985, col: 33, offset: 34576},
+						pos:  position{line: 1002, col: 33, offset: 35961},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 986, col: 11, offset: 34592},
+						pos:  position{line: 1003, col: 11, offset: 35977},
 						name: "SingleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 987, col: 11, offset: 34625},
+						pos:  position{line: 1004, col: 11, offset: 36010},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 988, col: 11, offset: 34645},
+						pos:  position{line: 1005, col: 11, offset: 36030},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 989, col: 11, offset: 34669},
+						pos:  position{line: 1006, col: 11, offset: 36054},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 990, col: 11, offset: 34693},
+						pos:  position{line: 1007, col: 11, offset: 36078},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 991, col: 11, offset: 34719},
+						pos:  position{line: 1008, col: 11, offset: 36104},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 992, col: 11, offset: 34742},
+						pos:  position{line: 1009, col: 11, offset: 36127},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 993, col: 11, offset: 34758},
+						pos:  position{line: 1010, col: 11, offset: 36143},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 994, col: 11, offset: 34787},
+						pos:  position{line: 1011, col: 11, offset: 36172},
 						name: "DoubleQuoteItalicTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 995, col: 11, offset: 34832},
+						pos:  position{line: 1012, col: 11, offset: 36217},
 						name: "DoubleQuoteItalicTextFallbackCharacter",
 					},
 				},
@@ -6704,26 +6900,26 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextStringElement",
-			pos:  position{line: 997, col: 1, offset: 34872},
+			pos:  position{line: 1014, col: 1, offset: 36257},
 			expr: &actionExpr{
-				pos: position{line: 997, col: 39, offset: 34910},
+				pos: position{line: 1014, col: 39, offset: 36295},
 				run: (*parser).callonDoubleQuoteItalicTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 997, col: 39, offset: 34910},
+					pos: position{line: 1014, col: 39, offset: 36295},
 					expr: &seqExpr{
-						pos: position{line: 997, col: 40, offset: 34911},
+						pos: position{line: 1014, col: 40, offset: 36296},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 997, col: 40, offset: 34911},
+								pos: position{line: 1014, col: 40, offset: 36296},
 								expr: &litMatcher{
-									pos:        position{line: 997, col: 41, offset: 34912},
+									pos:        position{line: 1014, col: 41, offset: 36297},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 							},
 							&charClassMatcher{
-								pos:        position{line: 997, col: 46, offset: 34917},
+								pos:        position{line: 1014, col: 46, offset: 36302},
 								val:        "[^\\r\\n ^~{}]",
 								chars:      []rune{'\r', '\n', ' ', '^', '~', '{', '}'},
 								ignoreCase: false,
@@ -6736,31 +6932,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextFallbackCharacter",
-			pos:  position{line: 1001, col: 1, offset: 34988},
+			pos:  position{line: 1018, col: 1, offset: 36373},
 			expr: &choiceExpr{
-				pos: position{line: 1002, col: 5, offset: 35035},
+				pos: position{line: 1019, col: 5, offset: 36420},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1002, col: 5, offset: 35035},
+						pos:        position{line: 1019, col: 5, offset: 36420},
 						val:        "[^\\r\\n_]",
 						chars:      []rune{'\r', '\n', '_'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1003, col: 7, offset: 35134},
+						pos: position{line: 1020, col: 7, offset: 36519},
 						run: (*parser).callonDoubleQuoteItalicTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1003, col: 7, offset: 35134},
+							pos: position{line: 1020, col: 7, offset: 36519},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1003, col: 7, offset: 35134},
+									pos:        position{line: 1020, col: 7, offset: 36519},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1003, col: 12, offset: 35139},
+									pos:  position{line: 1020, col: 12, offset: 36524},
 									name: "Alphanums",
 								},
 							},
@@ -6771,38 +6967,38 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicText",
-			pos:  position{line: 1007, col: 1, offset: 35304},
+			pos:  position{line: 1024, col: 1, offset: 36689},
 			expr: &choiceExpr{
-				pos: position{line: 1007, col: 26, offset: 35329},
+				pos: position{line: 1024, col: 26, offset: 36714},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1007, col: 26, offset: 35329},
+						pos: position{line: 1024, col: 26, offset: 36714},
 						run: (*parser).callonSingleQuoteItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 1007, col: 26, offset: 35329},
+							pos: position{line: 1024, col: 26, offset: 36714},
 							exprs: []interface{}{
 								&seqExpr{
-									pos: position{line: 1007, col: 27, offset: 35330},
+									pos: position{line: 1024, col: 27, offset: 36715},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1007, col: 27, offset: 35330},
+											pos: position{line: 1024, col: 27, offset: 36715},
 											expr: &litMatcher{
-												pos:        position{line: 1007, col: 28, offset: 35331},
+												pos:        position{line: 1024, col: 28, offset: 36716},
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 1007, col: 32, offset: 35335},
+											pos:        position{line: 1024, col: 32, offset: 36720},
 											val:        "_",
 											ignoreCase: false,
 											want:       "\"_\"",
 										},
 										&notExpr{
-											pos: position{line: 1007, col: 36, offset: 35339},
+											pos: position{line: 1024, col: 36, offset: 36724},
 											expr: &litMatcher{
-												pos:        position{line: 1007, col: 37, offset: 35340},
+												pos:        position{line: 1024, col: 37, offset: 36725},
 												val:        "_",
 												ignoreCase: false,
 												want:       "\"_\"",
@@ -6811,15 +7007,15 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1007, col: 42, offset: 35345},
+									pos:   position{line: 1024, col: 42, offset: 36730},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1007, col: 52, offset: 35355},
+										pos:  position{line: 1024, col: 52, offset: 36740},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1007, col: 83, offset: 35386},
+									pos:        position{line: 1024, col: 83, offset: 36771},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -6828,47 +7024,47 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1009, col: 5, offset: 35569},
+						pos: position{line: 1026, col: 5, offset: 36974},
 						run: (*parser).callonSingleQuoteItalicText13,
 						expr: &seqExpr{
-							pos: position{line: 1009, col: 5, offset: 35569},
+							pos: position{line: 1026, col: 5, offset: 36974},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1009, col: 5, offset: 35569},
+									pos: position{line: 1026, col: 5, offset: 36974},
 									expr: &litMatcher{
-										pos:        position{line: 1009, col: 6, offset: 35570},
+										pos:        position{line: 1026, col: 6, offset: 36975},
 										val:        "\\\\",
 										ignoreCase: false,
 										want:       "\"\\\\\\\\\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1009, col: 11, offset: 35575},
+									pos:        position{line: 1026, col: 11, offset: 36980},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1009, col: 15, offset: 35579},
+									pos:   position{line: 1026, col: 15, offset: 36984},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1009, col: 25, offset: 35589},
+										pos: position{line: 1026, col: 25, offset: 36994},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1009, col: 25, offset: 35589},
+												pos:        position{line: 1026, col: 25, offset: 36994},
 												val:        "_",
 												ignoreCase: false,
 												want:       "\"_\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1009, col: 29, offset: 35593},
+												pos:  position{line: 1026, col: 29, offset: 36998},
 												name: "SingleQuoteItalicTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1009, col: 60, offset: 35624},
+									pos:        position{line: 1026, col: 60, offset: 37029},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -6881,21 +7077,21 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextElements",
-			pos:  position{line: 1013, col: 1, offset: 35827},
+			pos:  position{line: 1030, col: 1, offset: 37252},
 			expr: &seqExpr{
-				pos: position{line: 1013, col: 34, offset: 35860},
+				pos: position{line: 1030, col: 34, offset: 37285},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 1013, col: 34, offset: 35860},
+						pos: position{line: 1030, col: 34, offset: 37285},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1013, col: 35, offset: 35861},
+							pos:  position{line: 1030, col: 35, offset: 37286},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1013, col: 41, offset: 35867},
+						pos: position{line: 1030, col: 41, offset: 37292},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1013, col: 41, offset: 35867},
+							pos:  position{line: 1030, col: 41, offset: 37292},
 							name: "SingleQuoteItalicTextElement",
 						},
 					},
@@ -6904,43 +7100,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextElement",
-			pos:  position{line: 1015, col: 1, offset: 35898},
+			pos:  position{line: 1032, col: 1, offset: 37323},
 			expr: &choiceExpr{
-				pos: position{line: 1015, col: 33, offset: 35930},
+				pos: position{line: 1032, col: 33, offset: 37355},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1015, col: 33, offset: 35930},
+						pos:  position{line: 1032, col: 33, offset: 37355},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1016, col: 11, offset: 35946},
+						pos:  position{line: 1033, col: 11, offset: 37371},
 						name: "DoubleQuoteItalicText",
 					},
 					&seqExpr{
-						pos: position{line: 1017, col: 11, offset: 35978},
+						pos: position{line: 1034, col: 11, offset: 37403},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1017, col: 11, offset: 35978},
+								pos: position{line: 1034, col: 11, offset: 37403},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1017, col: 11, offset: 35978},
+									pos:  position{line: 1034, col: 11, offset: 37403},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1017, col: 18, offset: 35985},
+								pos: position{line: 1034, col: 18, offset: 37410},
 								expr: &seqExpr{
-									pos: position{line: 1017, col: 19, offset: 35986},
+									pos: position{line: 1034, col: 19, offset: 37411},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1017, col: 19, offset: 35986},
+											pos:        position{line: 1034, col: 19, offset: 37411},
 											val:        "_",
 											ignoreCase: false,
 											want:       "\"_\"",
 										},
 										&notExpr{
-											pos: position{line: 1017, col: 23, offset: 35990},
+											pos: position{line: 1034, col: 23, offset: 37415},
 											expr: &litMatcher{
-												pos:        position{line: 1017, col: 24, offset: 35991},
+												pos:        position{line: 1034, col: 24, offset: 37416},
 												val:        "_",
 												ignoreCase: false,
 												want:       "\"_\"",
@@ -6952,43 +7148,43 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1018, col: 11, offset: 36007},
+						pos:  position{line: 1035, col: 11, offset: 37432},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1019, col: 11, offset: 36027},
+						pos:  position{line: 1036, col: 11, offset: 37452},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1020, col: 11, offset: 36051},
+						pos:  position{line: 1037, col: 11, offset: 37476},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1021, col: 11, offset: 36075},
+						pos:  position{line: 1038, col: 11, offset: 37500},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1022, col: 11, offset: 36101},
+						pos:  position{line: 1039, col: 11, offset: 37526},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1023, col: 11, offset: 36124},
+						pos:  position{line: 1040, col: 11, offset: 37549},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1024, col: 11, offset: 36141},
+						pos:  position{line: 1041, col: 11, offset: 37566},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1025, col: 11, offset: 36170},
+						pos:  position{line: 1042, col: 11, offset: 37595},
 						name: "AttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1026, col: 11, offset: 36202},
+						pos:  position{line: 1043, col: 11, offset: 37627},
 						name: "SingleQuoteItalicTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1027, col: 11, offset: 36247},
+						pos:  position{line: 1044, col: 11, offset: 37672},
 						name: "SingleQuoteItalicTextFallbackCharacter",
 					},
 				},
@@ -6996,14 +7192,14 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextStringElement",
-			pos:  position{line: 1029, col: 1, offset: 36287},
+			pos:  position{line: 1046, col: 1, offset: 37712},
 			expr: &actionExpr{
-				pos: position{line: 1029, col: 39, offset: 36325},
+				pos: position{line: 1046, col: 39, offset: 37750},
 				run: (*parser).callonSingleQuoteItalicTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1029, col: 39, offset: 36325},
+					pos: position{line: 1046, col: 39, offset: 37750},
 					expr: &charClassMatcher{
-						pos:        position{line: 1029, col: 39, offset: 36325},
+						pos:        position{line: 1046, col: 39, offset: 37750},
 						val:        "[^\\r\\n{} _^~]",
 						chars:      []rune{'\r', '\n', '{', '}', ' ', '_', '^', '~'},
 						ignoreCase: false,
@@ -7014,31 +7210,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextFallbackCharacter",
-			pos:  position{line: 1033, col: 1, offset: 36551},
+			pos:  position{line: 1050, col: 1, offset: 37976},
 			expr: &choiceExpr{
-				pos: position{line: 1034, col: 5, offset: 36598},
+				pos: position{line: 1051, col: 5, offset: 38023},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1034, col: 5, offset: 36598},
+						pos:        position{line: 1051, col: 5, offset: 38023},
 						val:        "[^\\r\\n_]",
 						chars:      []rune{'\r', '\n', '_'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1035, col: 7, offset: 36697},
+						pos: position{line: 1052, col: 7, offset: 38122},
 						run: (*parser).callonSingleQuoteItalicTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1035, col: 7, offset: 36697},
+							pos: position{line: 1052, col: 7, offset: 38122},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1035, col: 7, offset: 36697},
+									pos:        position{line: 1052, col: 7, offset: 38122},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1035, col: 11, offset: 36701},
+									pos:  position{line: 1052, col: 11, offset: 38126},
 									name: "Alphanums",
 								},
 							},
@@ -7049,40 +7245,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedItalicText",
-			pos:  position{line: 1039, col: 1, offset: 36867},
+			pos:  position{line: 1056, col: 1, offset: 38292},
 			expr: &choiceExpr{
-				pos: position{line: 1040, col: 5, offset: 36893},
+				pos: position{line: 1057, col: 5, offset: 38318},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1040, col: 5, offset: 36893},
+						pos: position{line: 1057, col: 5, offset: 38318},
 						run: (*parser).callonEscapedItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 1040, col: 5, offset: 36893},
+							pos: position{line: 1057, col: 5, offset: 38318},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1040, col: 5, offset: 36893},
+									pos:   position{line: 1057, col: 5, offset: 38318},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1040, col: 18, offset: 36906},
+										pos:  position{line: 1057, col: 18, offset: 38331},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1040, col: 40, offset: 36928},
+									pos:        position{line: 1057, col: 40, offset: 38353},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1040, col: 45, offset: 36933},
+									pos:   position{line: 1057, col: 45, offset: 38358},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1040, col: 55, offset: 36943},
+										pos:  position{line: 1057, col: 55, offset: 38368},
 										name: "DoubleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1040, col: 86, offset: 36974},
+									pos:        position{line: 1057, col: 86, offset: 38399},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
@@ -7091,35 +7287,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1042, col: 9, offset: 37131},
+						pos: position{line: 1059, col: 9, offset: 38556},
 						run: (*parser).callonEscapedItalicText10,
 						expr: &seqExpr{
-							pos: position{line: 1042, col: 9, offset: 37131},
+							pos: position{line: 1059, col: 9, offset: 38556},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1042, col: 9, offset: 37131},
+									pos:   position{line: 1059, col: 9, offset: 38556},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1042, col: 22, offset: 37144},
+										pos:  position{line: 1059, col: 22, offset: 38569},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1042, col: 44, offset: 37166},
+									pos:        position{line: 1059, col: 44, offset: 38591},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1042, col: 49, offset: 37171},
+									pos:   position{line: 1059, col: 49, offset: 38596},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1042, col: 59, offset: 37181},
+										pos:  position{line: 1059, col: 59, offset: 38606},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1042, col: 90, offset: 37212},
+									pos:        position{line: 1059, col: 90, offset: 38637},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -7128,35 +7324,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1045, col: 9, offset: 37412},
+						pos: position{line: 1062, col: 9, offset: 38837},
 						run: (*parser).callonEscapedItalicText18,
 						expr: &seqExpr{
-							pos: position{line: 1045, col: 9, offset: 37412},
+							pos: position{line: 1062, col: 9, offset: 38837},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1045, col: 9, offset: 37412},
+									pos:   position{line: 1062, col: 9, offset: 38837},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1045, col: 22, offset: 37425},
+										pos:  position{line: 1062, col: 22, offset: 38850},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1045, col: 44, offset: 37447},
+									pos:        position{line: 1062, col: 44, offset: 38872},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1045, col: 48, offset: 37451},
+									pos:   position{line: 1062, col: 48, offset: 38876},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1045, col: 58, offset: 37461},
+										pos:  position{line: 1062, col: 58, offset: 38886},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1045, col: 89, offset: 37492},
+									pos:        position{line: 1062, col: 89, offset: 38917},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -7169,16 +7365,16 @@ var g = &grammar{
 		},
 		{
 			name: "MonospaceText",
-			pos:  position{line: 1052, col: 1, offset: 37702},
+			pos:  position{line: 1069, col: 1, offset: 39127},
 			expr: &choiceExpr{
-				pos: position{line: 1052, col: 18, offset: 37719},
+				pos: position{line: 1069, col: 18, offset: 39144},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1052, col: 18, offset: 37719},
+						pos:  position{line: 1069, col: 18, offset: 39144},
 						name: "DoubleQuoteMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1052, col: 45, offset: 37746},
+						pos:  position{line: 1069, col: 45, offset: 39171},
 						name: "SingleQuoteMonospaceText",
 					},
 				},
@@ -7186,38 +7382,38 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceText",
-			pos:  position{line: 1054, col: 1, offset: 37772},
+			pos:  position{line: 1071, col: 1, offset: 39197},
 			expr: &actionExpr{
-				pos: position{line: 1054, col: 29, offset: 37800},
+				pos: position{line: 1071, col: 29, offset: 39225},
 				run: (*parser).callonDoubleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 1054, col: 29, offset: 37800},
+					pos: position{line: 1071, col: 29, offset: 39225},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1054, col: 29, offset: 37800},
+							pos: position{line: 1071, col: 29, offset: 39225},
 							expr: &litMatcher{
-								pos:        position{line: 1054, col: 30, offset: 37801},
+								pos:        position{line: 1071, col: 30, offset: 39226},
 								val:        "\\\\",
 								ignoreCase: false,
 								want:       "\"\\\\\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1054, col: 35, offset: 37806},
+							pos:        position{line: 1071, col: 35, offset: 39231},
 							val:        "``",
 							ignoreCase: false,
 							want:       "\"``\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1054, col: 40, offset: 37811},
+							pos:   position{line: 1071, col: 40, offset: 39236},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1054, col: 50, offset: 37821},
+								pos:  position{line: 1071, col: 50, offset: 39246},
 								name: "DoubleQuoteMonospaceTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1054, col: 84, offset: 37855},
+							pos:        position{line: 1071, col: 84, offset: 39280},
 							val:        "``",
 							ignoreCase: false,
 							want:       "\"``\"",
@@ -7228,37 +7424,37 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextElements",
-			pos:  position{line: 1058, col: 1, offset: 37985},
+			pos:  position{line: 1075, col: 1, offset: 39430},
 			expr: &seqExpr{
-				pos: position{line: 1058, col: 37, offset: 38021},
+				pos: position{line: 1075, col: 37, offset: 39466},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1058, col: 37, offset: 38021},
+						pos:  position{line: 1075, col: 37, offset: 39466},
 						name: "DoubleQuoteMonospaceTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1058, col: 69, offset: 38053},
+						pos: position{line: 1075, col: 69, offset: 39498},
 						expr: &seqExpr{
-							pos: position{line: 1058, col: 70, offset: 38054},
+							pos: position{line: 1075, col: 70, offset: 39499},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1058, col: 70, offset: 38054},
+									pos: position{line: 1075, col: 70, offset: 39499},
 									expr: &litMatcher{
-										pos:        position{line: 1058, col: 72, offset: 38056},
+										pos:        position{line: 1075, col: 72, offset: 39501},
 										val:        "``",
 										ignoreCase: false,
 										want:       "\"``\"",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 1058, col: 79, offset: 38063},
+									pos: position{line: 1075, col: 79, offset: 39508},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1058, col: 79, offset: 38063},
+											pos:  position{line: 1075, col: 79, offset: 39508},
 											name: "Space",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1058, col: 87, offset: 38071},
+											pos:  position{line: 1075, col: 87, offset: 39516},
 											name: "DoubleQuoteMonospaceTextElement",
 										},
 									},
@@ -7271,52 +7467,52 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextElement",
-			pos:  position{line: 1060, col: 1, offset: 38140},
+			pos:  position{line: 1077, col: 1, offset: 39585},
 			expr: &choiceExpr{
-				pos: position{line: 1060, col: 36, offset: 38175},
+				pos: position{line: 1077, col: 36, offset: 39620},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1060, col: 36, offset: 38175},
+						pos:  position{line: 1077, col: 36, offset: 39620},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1061, col: 11, offset: 38191},
+						pos:  position{line: 1078, col: 11, offset: 39636},
 						name: "SingleQuoteMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1062, col: 11, offset: 38227},
+						pos:  position{line: 1079, col: 11, offset: 39672},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1063, col: 11, offset: 38246},
+						pos:  position{line: 1080, col: 11, offset: 39691},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1064, col: 11, offset: 38268},
+						pos:  position{line: 1081, col: 11, offset: 39713},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1065, col: 11, offset: 38292},
+						pos:  position{line: 1082, col: 11, offset: 39737},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1066, col: 11, offset: 38318},
+						pos:  position{line: 1083, col: 11, offset: 39763},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1067, col: 11, offset: 38341},
+						pos:  position{line: 1084, col: 11, offset: 39786},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1068, col: 11, offset: 38357},
+						pos:  position{line: 1085, col: 11, offset: 39802},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1069, col: 11, offset: 38386},
+						pos:  position{line: 1086, col: 11, offset: 39831},
 						name: "DoubleQuoteMonospaceTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1070, col: 11, offset: 38434},
+						pos:  position{line: 1087, col: 11, offset: 39879},
 						name: "DoubleQuoteMonospaceTextFallbackCharacter",
 					},
 				},
@@ -7324,26 +7520,26 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextStringElement",
-			pos:  position{line: 1072, col: 1, offset: 38477},
+			pos:  position{line: 1089, col: 1, offset: 39922},
 			expr: &actionExpr{
-				pos: position{line: 1072, col: 42, offset: 38518},
+				pos: position{line: 1089, col: 42, offset: 39963},
 				run: (*parser).callonDoubleQuoteMonospaceTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1072, col: 42, offset: 38518},
+					pos: position{line: 1089, col: 42, offset: 39963},
 					expr: &seqExpr{
-						pos: position{line: 1072, col: 43, offset: 38519},
+						pos: position{line: 1089, col: 43, offset: 39964},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1072, col: 43, offset: 38519},
+								pos: position{line: 1089, col: 43, offset: 39964},
 								expr: &litMatcher{
-									pos:        position{line: 1072, col: 44, offset: 38520},
+									pos:        position{line: 1089, col: 44, offset: 39965},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 							},
 							&charClassMatcher{
-								pos:        position{line: 1072, col: 49, offset: 38525},
+								pos:        position{line: 1089, col: 49, offset: 39970},
 								val:        "[^\\r\\n ^~{}]",
 								chars:      []rune{'\r', '\n', ' ', '^', '~', '{', '}'},
 								ignoreCase: false,
@@ -7356,31 +7552,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextFallbackCharacter",
-			pos:  position{line: 1076, col: 1, offset: 38596},
+			pos:  position{line: 1093, col: 1, offset: 40041},
 			expr: &choiceExpr{
-				pos: position{line: 1077, col: 5, offset: 38646},
+				pos: position{line: 1094, col: 5, offset: 40091},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1077, col: 5, offset: 38646},
+						pos:        position{line: 1094, col: 5, offset: 40091},
 						val:        "[^\\r\\n`]",
 						chars:      []rune{'\r', '\n', '`'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1078, col: 7, offset: 38748},
+						pos: position{line: 1095, col: 7, offset: 40193},
 						run: (*parser).callonDoubleQuoteMonospaceTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1078, col: 7, offset: 38748},
+							pos: position{line: 1095, col: 7, offset: 40193},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1078, col: 7, offset: 38748},
+									pos:        position{line: 1095, col: 7, offset: 40193},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1078, col: 12, offset: 38753},
+									pos:  position{line: 1095, col: 12, offset: 40198},
 									name: "Alphanums",
 								},
 							},
@@ -7391,38 +7587,38 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceText",
-			pos:  position{line: 1082, col: 1, offset: 38921},
+			pos:  position{line: 1099, col: 1, offset: 40366},
 			expr: &choiceExpr{
-				pos: position{line: 1082, col: 29, offset: 38949},
+				pos: position{line: 1099, col: 29, offset: 40394},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1082, col: 29, offset: 38949},
+						pos: position{line: 1099, col: 29, offset: 40394},
 						run: (*parser).callonSingleQuoteMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1082, col: 29, offset: 38949},
+							pos: position{line: 1099, col: 29, offset: 40394},
 							exprs: []interface{}{
 								&seqExpr{
-									pos: position{line: 1082, col: 30, offset: 38950},
+									pos: position{line: 1099, col: 30, offset: 40395},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1082, col: 30, offset: 38950},
+											pos: position{line: 1099, col: 30, offset: 40395},
 											expr: &litMatcher{
-												pos:        position{line: 1082, col: 31, offset: 38951},
+												pos:        position{line: 1099, col: 31, offset: 40396},
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 1082, col: 35, offset: 38955},
+											pos:        position{line: 1099, col: 35, offset: 40400},
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 										&notExpr{
-											pos: position{line: 1082, col: 39, offset: 38959},
+											pos: position{line: 1099, col: 39, offset: 40404},
 											expr: &litMatcher{
-												pos:        position{line: 1082, col: 40, offset: 38960},
+												pos:        position{line: 1099, col: 40, offset: 40405},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
@@ -7431,15 +7627,15 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1082, col: 45, offset: 38965},
+									pos:   position{line: 1099, col: 45, offset: 40410},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1082, col: 55, offset: 38975},
+										pos:  position{line: 1099, col: 55, offset: 40420},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1082, col: 89, offset: 39009},
+									pos:        position{line: 1099, col: 89, offset: 40454},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -7448,47 +7644,47 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1084, col: 5, offset: 39195},
+						pos: position{line: 1101, col: 5, offset: 40660},
 						run: (*parser).callonSingleQuoteMonospaceText13,
 						expr: &seqExpr{
-							pos: position{line: 1084, col: 5, offset: 39195},
+							pos: position{line: 1101, col: 5, offset: 40660},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1084, col: 5, offset: 39195},
+									pos: position{line: 1101, col: 5, offset: 40660},
 									expr: &litMatcher{
-										pos:        position{line: 1084, col: 6, offset: 39196},
+										pos:        position{line: 1101, col: 6, offset: 40661},
 										val:        "\\\\",
 										ignoreCase: false,
 										want:       "\"\\\\\\\\\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1084, col: 11, offset: 39201},
+									pos:        position{line: 1101, col: 11, offset: 40666},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1084, col: 15, offset: 39205},
+									pos:   position{line: 1101, col: 15, offset: 40670},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1084, col: 25, offset: 39215},
+										pos: position{line: 1101, col: 25, offset: 40680},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1084, col: 25, offset: 39215},
+												pos:        position{line: 1101, col: 25, offset: 40680},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1084, col: 29, offset: 39219},
+												pos:  position{line: 1101, col: 29, offset: 40684},
 												name: "SingleQuoteMonospaceTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1084, col: 63, offset: 39253},
+									pos:        position{line: 1101, col: 63, offset: 40718},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -7501,21 +7697,21 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextElements",
-			pos:  position{line: 1088, col: 1, offset: 39462},
+			pos:  position{line: 1105, col: 1, offset: 40947},
 			expr: &seqExpr{
-				pos: position{line: 1088, col: 37, offset: 39498},
+				pos: position{line: 1105, col: 37, offset: 40983},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 1088, col: 37, offset: 39498},
+						pos: position{line: 1105, col: 37, offset: 40983},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1088, col: 38, offset: 39499},
+							pos:  position{line: 1105, col: 38, offset: 40984},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1088, col: 44, offset: 39505},
+						pos: position{line: 1105, col: 44, offset: 40990},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1088, col: 44, offset: 39505},
+							pos:  position{line: 1105, col: 44, offset: 40990},
 							name: "SingleQuoteMonospaceTextElement",
 						},
 					},
@@ -7524,43 +7720,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextElement",
-			pos:  position{line: 1090, col: 1, offset: 39539},
+			pos:  position{line: 1107, col: 1, offset: 41024},
 			expr: &choiceExpr{
-				pos: position{line: 1090, col: 37, offset: 39575},
+				pos: position{line: 1107, col: 37, offset: 41060},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1090, col: 37, offset: 39575},
+						pos:  position{line: 1107, col: 37, offset: 41060},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1091, col: 11, offset: 39591},
+						pos:  position{line: 1108, col: 11, offset: 41076},
 						name: "DoubleQuoteMonospaceText",
 					},
 					&seqExpr{
-						pos: position{line: 1092, col: 11, offset: 39627},
+						pos: position{line: 1109, col: 11, offset: 41112},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1092, col: 11, offset: 39627},
+								pos: position{line: 1109, col: 11, offset: 41112},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1092, col: 11, offset: 39627},
+									pos:  position{line: 1109, col: 11, offset: 41112},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1092, col: 18, offset: 39634},
+								pos: position{line: 1109, col: 18, offset: 41119},
 								expr: &seqExpr{
-									pos: position{line: 1092, col: 19, offset: 39635},
+									pos: position{line: 1109, col: 19, offset: 41120},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1092, col: 19, offset: 39635},
+											pos:        position{line: 1109, col: 19, offset: 41120},
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 										&notExpr{
-											pos: position{line: 1092, col: 23, offset: 39639},
+											pos: position{line: 1109, col: 23, offset: 41124},
 											expr: &litMatcher{
-												pos:        position{line: 1092, col: 24, offset: 39640},
+												pos:        position{line: 1109, col: 24, offset: 41125},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
@@ -7572,47 +7768,47 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1093, col: 11, offset: 39768},
+						pos:  position{line: 1110, col: 11, offset: 41253},
 						name: "Newline",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1094, col: 11, offset: 39806},
+						pos:  position{line: 1111, col: 11, offset: 41291},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1095, col: 11, offset: 39825},
+						pos:  position{line: 1112, col: 11, offset: 41310},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1096, col: 11, offset: 39846},
+						pos:  position{line: 1113, col: 11, offset: 41331},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1097, col: 11, offset: 39870},
+						pos:  position{line: 1114, col: 11, offset: 41355},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1098, col: 11, offset: 39896},
+						pos:  position{line: 1115, col: 11, offset: 41381},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1099, col: 11, offset: 39919},
+						pos:  position{line: 1116, col: 11, offset: 41404},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1100, col: 11, offset: 39935},
+						pos:  position{line: 1117, col: 11, offset: 41420},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1101, col: 11, offset: 39964},
+						pos:  position{line: 1118, col: 11, offset: 41449},
 						name: "AttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1102, col: 11, offset: 39996},
+						pos:  position{line: 1119, col: 11, offset: 41481},
 						name: "SingleQuoteMonospaceTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1103, col: 11, offset: 40044},
+						pos:  position{line: 1120, col: 11, offset: 41529},
 						name: "SingleQuoteMonospaceTextFallbackCharacter",
 					},
 				},
@@ -7620,14 +7816,14 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextStringElement",
-			pos:  position{line: 1105, col: 1, offset: 40087},
+			pos:  position{line: 1122, col: 1, offset: 41572},
 			expr: &actionExpr{
-				pos: position{line: 1105, col: 42, offset: 40128},
+				pos: position{line: 1122, col: 42, offset: 41613},
 				run: (*parser).callonSingleQuoteMonospaceTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1105, col: 42, offset: 40128},
+					pos: position{line: 1122, col: 42, offset: 41613},
 					expr: &charClassMatcher{
-						pos:        position{line: 1105, col: 42, offset: 40128},
+						pos:        position{line: 1122, col: 42, offset: 41613},
 						val:        "[^\\r\\n {}`^~]",
 						chars:      []rune{'\r', '\n', ' ', '{', '}', '`', '^', '~'},
 						ignoreCase: false,
@@ -7638,31 +7834,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextFallbackCharacter",
-			pos:  position{line: 1109, col: 1, offset: 40346},
+			pos:  position{line: 1126, col: 1, offset: 41831},
 			expr: &choiceExpr{
-				pos: position{line: 1110, col: 5, offset: 40396},
+				pos: position{line: 1127, col: 5, offset: 41881},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1110, col: 5, offset: 40396},
+						pos:        position{line: 1127, col: 5, offset: 41881},
 						val:        "[^\\r\\n`]",
 						chars:      []rune{'\r', '\n', '`'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1111, col: 7, offset: 40498},
+						pos: position{line: 1128, col: 7, offset: 41983},
 						run: (*parser).callonSingleQuoteMonospaceTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1111, col: 7, offset: 40498},
+							pos: position{line: 1128, col: 7, offset: 41983},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1111, col: 7, offset: 40498},
+									pos:        position{line: 1128, col: 7, offset: 41983},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1111, col: 11, offset: 40502},
+									pos:  position{line: 1128, col: 11, offset: 41987},
 									name: "Alphanums",
 								},
 							},
@@ -7673,40 +7869,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedMonospaceText",
-			pos:  position{line: 1115, col: 1, offset: 40671},
+			pos:  position{line: 1132, col: 1, offset: 42156},
 			expr: &choiceExpr{
-				pos: position{line: 1116, col: 5, offset: 40700},
+				pos: position{line: 1133, col: 5, offset: 42185},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1116, col: 5, offset: 40700},
+						pos: position{line: 1133, col: 5, offset: 42185},
 						run: (*parser).callonEscapedMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1116, col: 5, offset: 40700},
+							pos: position{line: 1133, col: 5, offset: 42185},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1116, col: 5, offset: 40700},
+									pos:   position{line: 1133, col: 5, offset: 42185},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1116, col: 18, offset: 40713},
+										pos:  position{line: 1133, col: 18, offset: 42198},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1116, col: 40, offset: 40735},
+									pos:        position{line: 1133, col: 40, offset: 42220},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1116, col: 45, offset: 40740},
+									pos:   position{line: 1133, col: 45, offset: 42225},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1116, col: 55, offset: 40750},
+										pos:  position{line: 1133, col: 55, offset: 42235},
 										name: "DoubleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1116, col: 89, offset: 40784},
+									pos:        position{line: 1133, col: 89, offset: 42269},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
@@ -7715,35 +7911,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1118, col: 9, offset: 40941},
+						pos: position{line: 1135, col: 9, offset: 42426},
 						run: (*parser).callonEscapedMonospaceText10,
 						expr: &seqExpr{
-							pos: position{line: 1118, col: 9, offset: 40941},
+							pos: position{line: 1135, col: 9, offset: 42426},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1118, col: 9, offset: 40941},
+									pos:   position{line: 1135, col: 9, offset: 42426},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1118, col: 22, offset: 40954},
+										pos:  position{line: 1135, col: 22, offset: 42439},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1118, col: 44, offset: 40976},
+									pos:        position{line: 1135, col: 44, offset: 42461},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1118, col: 49, offset: 40981},
+									pos:   position{line: 1135, col: 49, offset: 42466},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1118, col: 59, offset: 40991},
+										pos:  position{line: 1135, col: 59, offset: 42476},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1118, col: 93, offset: 41025},
+									pos:        position{line: 1135, col: 93, offset: 42510},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -7752,35 +7948,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1121, col: 9, offset: 41225},
+						pos: position{line: 1138, col: 9, offset: 42710},
 						run: (*parser).callonEscapedMonospaceText18,
 						expr: &seqExpr{
-							pos: position{line: 1121, col: 9, offset: 41225},
+							pos: position{line: 1138, col: 9, offset: 42710},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1121, col: 9, offset: 41225},
+									pos:   position{line: 1138, col: 9, offset: 42710},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1121, col: 22, offset: 41238},
+										pos:  position{line: 1138, col: 22, offset: 42723},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1121, col: 44, offset: 41260},
+									pos:        position{line: 1138, col: 44, offset: 42745},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1121, col: 48, offset: 41264},
+									pos:   position{line: 1138, col: 48, offset: 42749},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1121, col: 58, offset: 41274},
+										pos:  position{line: 1138, col: 58, offset: 42759},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1121, col: 92, offset: 41308},
+									pos:        position{line: 1138, col: 92, offset: 42793},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -7793,38 +7989,38 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptText",
-			pos:  position{line: 1125, col: 1, offset: 41458},
+			pos:  position{line: 1142, col: 1, offset: 42943},
 			expr: &actionExpr{
-				pos: position{line: 1125, col: 18, offset: 41475},
+				pos: position{line: 1142, col: 18, offset: 42960},
 				run: (*parser).callonSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1125, col: 18, offset: 41475},
+					pos: position{line: 1142, col: 18, offset: 42960},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1125, col: 18, offset: 41475},
+							pos: position{line: 1142, col: 18, offset: 42960},
 							expr: &litMatcher{
-								pos:        position{line: 1125, col: 19, offset: 41476},
+								pos:        position{line: 1142, col: 19, offset: 42961},
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1125, col: 23, offset: 41480},
+							pos:        position{line: 1142, col: 23, offset: 42965},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1125, col: 27, offset: 41484},
+							pos:   position{line: 1142, col: 27, offset: 42969},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1125, col: 36, offset: 41493},
+								pos:  position{line: 1142, col: 36, offset: 42978},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1125, col: 58, offset: 41515},
+							pos:        position{line: 1142, col: 58, offset: 43000},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
@@ -7835,16 +8031,16 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptTextElement",
-			pos:  position{line: 1129, col: 1, offset: 41604},
+			pos:  position{line: 1146, col: 1, offset: 43124},
 			expr: &choiceExpr{
-				pos: position{line: 1129, col: 25, offset: 41628},
+				pos: position{line: 1146, col: 25, offset: 43148},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1129, col: 25, offset: 41628},
+						pos:  position{line: 1146, col: 25, offset: 43148},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1129, col: 38, offset: 41641},
+						pos:  position{line: 1146, col: 38, offset: 43161},
 						name: "NonSubscriptText",
 					},
 				},
@@ -7852,14 +8048,14 @@ var g = &grammar{
 		},
 		{
 			name: "NonSubscriptText",
-			pos:  position{line: 1131, col: 1, offset: 41660},
+			pos:  position{line: 1148, col: 1, offset: 43180},
 			expr: &actionExpr{
-				pos: position{line: 1131, col: 21, offset: 41680},
+				pos: position{line: 1148, col: 21, offset: 43200},
 				run: (*parser).callonNonSubscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1131, col: 21, offset: 41680},
+					pos: position{line: 1148, col: 21, offset: 43200},
 					expr: &charClassMatcher{
-						pos:        position{line: 1131, col: 21, offset: 41680},
+						pos:        position{line: 1148, col: 21, offset: 43200},
 						val:        "[^\\r\\n ~]",
 						chars:      []rune{'\r', '\n', ' ', '~'},
 						ignoreCase: false,
@@ -7870,37 +8066,37 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSubscriptText",
-			pos:  position{line: 1135, col: 1, offset: 41757},
+			pos:  position{line: 1152, col: 1, offset: 43277},
 			expr: &actionExpr{
-				pos: position{line: 1135, col: 25, offset: 41781},
+				pos: position{line: 1152, col: 25, offset: 43301},
 				run: (*parser).callonEscapedSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1135, col: 25, offset: 41781},
+					pos: position{line: 1152, col: 25, offset: 43301},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1135, col: 25, offset: 41781},
+							pos:   position{line: 1152, col: 25, offset: 43301},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1135, col: 38, offset: 41794},
+								pos:  position{line: 1152, col: 38, offset: 43314},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1135, col: 60, offset: 41816},
+							pos:        position{line: 1152, col: 60, offset: 43336},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1135, col: 64, offset: 41820},
+							pos:   position{line: 1152, col: 64, offset: 43340},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1135, col: 73, offset: 41829},
+								pos:  position{line: 1152, col: 73, offset: 43349},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1135, col: 95, offset: 41851},
+							pos:        position{line: 1152, col: 95, offset: 43371},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
@@ -7911,38 +8107,38 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptText",
-			pos:  position{line: 1139, col: 1, offset: 41980},
+			pos:  position{line: 1156, col: 1, offset: 43500},
 			expr: &actionExpr{
-				pos: position{line: 1139, col: 20, offset: 41999},
+				pos: position{line: 1156, col: 20, offset: 43519},
 				run: (*parser).callonSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1139, col: 20, offset: 41999},
+					pos: position{line: 1156, col: 20, offset: 43519},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1139, col: 20, offset: 41999},
+							pos: position{line: 1156, col: 20, offset: 43519},
 							expr: &litMatcher{
-								pos:        position{line: 1139, col: 21, offset: 42000},
+								pos:        position{line: 1156, col: 21, offset: 43520},
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1139, col: 25, offset: 42004},
+							pos:        position{line: 1156, col: 25, offset: 43524},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1139, col: 29, offset: 42008},
+							pos:   position{line: 1156, col: 29, offset: 43528},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1139, col: 38, offset: 42017},
+								pos:  position{line: 1156, col: 38, offset: 43537},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1139, col: 62, offset: 42041},
+							pos:        position{line: 1156, col: 62, offset: 43561},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
@@ -7953,16 +8149,16 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptTextElement",
-			pos:  position{line: 1143, col: 1, offset: 42132},
+			pos:  position{line: 1160, col: 1, offset: 43687},
 			expr: &choiceExpr{
-				pos: position{line: 1143, col: 27, offset: 42158},
+				pos: position{line: 1160, col: 27, offset: 43713},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1143, col: 27, offset: 42158},
+						pos:  position{line: 1160, col: 27, offset: 43713},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1143, col: 40, offset: 42171},
+						pos:  position{line: 1160, col: 40, offset: 43726},
 						name: "NonSuperscriptText",
 					},
 				},
@@ -7970,14 +8166,14 @@ var g = &grammar{
 		},
 		{
 			name: "NonSuperscriptText",
-			pos:  position{line: 1145, col: 1, offset: 42192},
+			pos:  position{line: 1162, col: 1, offset: 43747},
 			expr: &actionExpr{
-				pos: position{line: 1145, col: 23, offset: 42214},
+				pos: position{line: 1162, col: 23, offset: 43769},
 				run: (*parser).callonNonSuperscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1145, col: 23, offset: 42214},
+					pos: position{line: 1162, col: 23, offset: 43769},
 					expr: &charClassMatcher{
-						pos:        position{line: 1145, col: 23, offset: 42214},
+						pos:        position{line: 1162, col: 23, offset: 43769},
 						val:        "[^\\r\\n ^]",
 						chars:      []rune{'\r', '\n', ' ', '^'},
 						ignoreCase: false,
@@ -7988,37 +8184,37 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSuperscriptText",
-			pos:  position{line: 1149, col: 1, offset: 42291},
+			pos:  position{line: 1166, col: 1, offset: 43846},
 			expr: &actionExpr{
-				pos: position{line: 1149, col: 27, offset: 42317},
+				pos: position{line: 1166, col: 27, offset: 43872},
 				run: (*parser).callonEscapedSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1149, col: 27, offset: 42317},
+					pos: position{line: 1166, col: 27, offset: 43872},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1149, col: 27, offset: 42317},
+							pos:   position{line: 1166, col: 27, offset: 43872},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1149, col: 40, offset: 42330},
+								pos:  position{line: 1166, col: 40, offset: 43885},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1149, col: 62, offset: 42352},
+							pos:        position{line: 1166, col: 62, offset: 43907},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1149, col: 66, offset: 42356},
+							pos:   position{line: 1166, col: 66, offset: 43911},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1149, col: 75, offset: 42365},
+								pos:  position{line: 1166, col: 75, offset: 43920},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1149, col: 99, offset: 42389},
+							pos:        position{line: 1166, col: 99, offset: 43944},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
@@ -8029,20 +8225,20 @@ var g = &grammar{
 		},
 		{
 			name: "InlinePassthrough",
-			pos:  position{line: 1156, col: 1, offset: 42631},
+			pos:  position{line: 1173, col: 1, offset: 44186},
 			expr: &choiceExpr{
-				pos: position{line: 1156, col: 22, offset: 42652},
+				pos: position{line: 1173, col: 22, offset: 44207},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1156, col: 22, offset: 42652},
+						pos:  position{line: 1173, col: 22, offset: 44207},
 						name: "TriplePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1156, col: 46, offset: 42676},
+						pos:  position{line: 1173, col: 46, offset: 44231},
 						name: "SinglePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1156, col: 70, offset: 42700},
+						pos:  position{line: 1173, col: 70, offset: 44255},
 						name: "PassthroughMacro",
 					},
 				},
@@ -8050,9 +8246,9 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthroughPrefix",
-			pos:  position{line: 1158, col: 1, offset: 42718},
+			pos:  position{line: 1175, col: 1, offset: 44273},
 			expr: &litMatcher{
-				pos:        position{line: 1158, col: 32, offset: 42749},
+				pos:        position{line: 1175, col: 32, offset: 44304},
 				val:        "+",
 				ignoreCase: false,
 				want:       "\"+\"",
@@ -8060,33 +8256,33 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthrough",
-			pos:  position{line: 1160, col: 1, offset: 42754},
+			pos:  position{line: 1177, col: 1, offset: 44309},
 			expr: &actionExpr{
-				pos: position{line: 1160, col: 26, offset: 42779},
+				pos: position{line: 1177, col: 26, offset: 44334},
 				run: (*parser).callonSinglePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1160, col: 26, offset: 42779},
+					pos: position{line: 1177, col: 26, offset: 44334},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1160, col: 26, offset: 42779},
+							pos:  position{line: 1177, col: 26, offset: 44334},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1160, col: 54, offset: 42807},
+							pos:   position{line: 1177, col: 54, offset: 44362},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1160, col: 63, offset: 42816},
+								pos:  position{line: 1177, col: 63, offset: 44371},
 								name: "SinglePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1160, col: 93, offset: 42846},
+							pos:  position{line: 1177, col: 93, offset: 44401},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1160, col: 121, offset: 42874},
+							pos: position{line: 1177, col: 121, offset: 44429},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1160, col: 122, offset: 42875},
+								pos:  position{line: 1177, col: 122, offset: 44430},
 								name: "Alphanum",
 							},
 						},
@@ -8096,85 +8292,85 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthroughContent",
-			pos:  position{line: 1164, col: 1, offset: 42980},
+			pos:  position{line: 1181, col: 1, offset: 44555},
 			expr: &choiceExpr{
-				pos: position{line: 1164, col: 33, offset: 43012},
+				pos: position{line: 1181, col: 33, offset: 44587},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1164, col: 34, offset: 43013},
+						pos: position{line: 1181, col: 34, offset: 44588},
 						run: (*parser).callonSinglePlusPassthroughContent2,
 						expr: &seqExpr{
-							pos: position{line: 1164, col: 34, offset: 43013},
+							pos: position{line: 1181, col: 34, offset: 44588},
 							exprs: []interface{}{
 								&seqExpr{
-									pos: position{line: 1164, col: 35, offset: 43014},
+									pos: position{line: 1181, col: 35, offset: 44589},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1164, col: 35, offset: 43014},
+											pos: position{line: 1181, col: 35, offset: 44589},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1164, col: 36, offset: 43015},
+												pos:  position{line: 1181, col: 36, offset: 44590},
 												name: "SinglePlusPassthroughPrefix",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1164, col: 64, offset: 43043},
+											pos: position{line: 1181, col: 64, offset: 44618},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1164, col: 65, offset: 43044},
+												pos:  position{line: 1181, col: 65, offset: 44619},
 												name: "Space",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1164, col: 71, offset: 43050},
+											pos: position{line: 1181, col: 71, offset: 44625},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1164, col: 72, offset: 43051},
+												pos:  position{line: 1181, col: 72, offset: 44626},
 												name: "Newline",
 											},
 										},
 										&anyMatcher{
-											line: 1164, col: 80, offset: 43059,
+											line: 1181, col: 80, offset: 44634,
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1164, col: 83, offset: 43062},
+									pos: position{line: 1181, col: 83, offset: 44637},
 									expr: &seqExpr{
-										pos: position{line: 1164, col: 84, offset: 43063},
+										pos: position{line: 1181, col: 84, offset: 44638},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1164, col: 84, offset: 43063},
+												pos: position{line: 1181, col: 84, offset: 44638},
 												expr: &seqExpr{
-													pos: position{line: 1164, col: 86, offset: 43065},
+													pos: position{line: 1181, col: 86, offset: 44640},
 													exprs: []interface{}{
 														&oneOrMoreExpr{
-															pos: position{line: 1164, col: 86, offset: 43065},
+															pos: position{line: 1181, col: 86, offset: 44640},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1164, col: 86, offset: 43065},
+																pos:  position{line: 1181, col: 86, offset: 44640},
 																name: "Space",
 															},
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1164, col: 93, offset: 43072},
+															pos:  position{line: 1181, col: 93, offset: 44647},
 															name: "SinglePlusPassthroughPrefix",
 														},
 													},
 												},
 											},
 											&notExpr{
-												pos: position{line: 1164, col: 122, offset: 43101},
+												pos: position{line: 1181, col: 122, offset: 44676},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1164, col: 123, offset: 43102},
+													pos:  position{line: 1181, col: 123, offset: 44677},
 													name: "SinglePlusPassthroughPrefix",
 												},
 											},
 											&notExpr{
-												pos: position{line: 1164, col: 151, offset: 43130},
+												pos: position{line: 1181, col: 151, offset: 44705},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1164, col: 152, offset: 43131},
+													pos:  position{line: 1181, col: 152, offset: 44706},
 													name: "Newline",
 												},
 											},
 											&anyMatcher{
-												line: 1164, col: 160, offset: 43139,
+												line: 1181, col: 160, offset: 44714,
 											},
 										},
 									},
@@ -8183,34 +8379,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1166, col: 7, offset: 43281},
+						pos: position{line: 1183, col: 7, offset: 44856},
 						run: (*parser).callonSinglePlusPassthroughContent24,
 						expr: &seqExpr{
-							pos: position{line: 1166, col: 8, offset: 43282},
+							pos: position{line: 1183, col: 8, offset: 44857},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1166, col: 8, offset: 43282},
+									pos: position{line: 1183, col: 8, offset: 44857},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1166, col: 9, offset: 43283},
+										pos:  position{line: 1183, col: 9, offset: 44858},
 										name: "Space",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1166, col: 15, offset: 43289},
+									pos: position{line: 1183, col: 15, offset: 44864},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1166, col: 16, offset: 43290},
+										pos:  position{line: 1183, col: 16, offset: 44865},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1166, col: 24, offset: 43298},
+									pos: position{line: 1183, col: 24, offset: 44873},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1166, col: 25, offset: 43299},
+										pos:  position{line: 1183, col: 25, offset: 44874},
 										name: "SinglePlusPassthroughPrefix",
 									},
 								},
 								&anyMatcher{
-									line: 1166, col: 53, offset: 43327,
+									line: 1183, col: 53, offset: 44902,
 								},
 							},
 						},
@@ -8220,9 +8416,9 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughPrefix",
-			pos:  position{line: 1170, col: 1, offset: 43409},
+			pos:  position{line: 1187, col: 1, offset: 44984},
 			expr: &litMatcher{
-				pos:        position{line: 1170, col: 32, offset: 43440},
+				pos:        position{line: 1187, col: 32, offset: 45015},
 				val:        "+++",
 				ignoreCase: false,
 				want:       "\"+++\"",
@@ -8230,33 +8426,33 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthrough",
-			pos:  position{line: 1172, col: 1, offset: 43447},
+			pos:  position{line: 1189, col: 1, offset: 45022},
 			expr: &actionExpr{
-				pos: position{line: 1172, col: 26, offset: 43472},
+				pos: position{line: 1189, col: 26, offset: 45047},
 				run: (*parser).callonTriplePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1172, col: 26, offset: 43472},
+					pos: position{line: 1189, col: 26, offset: 45047},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1172, col: 26, offset: 43472},
+							pos:  position{line: 1189, col: 26, offset: 45047},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1172, col: 54, offset: 43500},
+							pos:   position{line: 1189, col: 54, offset: 45075},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1172, col: 63, offset: 43509},
+								pos:  position{line: 1189, col: 63, offset: 45084},
 								name: "TriplePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1172, col: 93, offset: 43539},
+							pos:  position{line: 1189, col: 93, offset: 45114},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1172, col: 121, offset: 43567},
+							pos: position{line: 1189, col: 121, offset: 45142},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1172, col: 122, offset: 43568},
+								pos:  position{line: 1189, col: 122, offset: 45143},
 								name: "Alphanum",
 							},
 						},
@@ -8266,63 +8462,63 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughContent",
-			pos:  position{line: 1176, col: 1, offset: 43673},
+			pos:  position{line: 1193, col: 1, offset: 45268},
 			expr: &choiceExpr{
-				pos: position{line: 1176, col: 33, offset: 43705},
+				pos: position{line: 1193, col: 33, offset: 45300},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1176, col: 34, offset: 43706},
+						pos: position{line: 1193, col: 34, offset: 45301},
 						run: (*parser).callonTriplePlusPassthroughContent2,
 						expr: &zeroOrMoreExpr{
-							pos: position{line: 1176, col: 34, offset: 43706},
+							pos: position{line: 1193, col: 34, offset: 45301},
 							expr: &seqExpr{
-								pos: position{line: 1176, col: 35, offset: 43707},
+								pos: position{line: 1193, col: 35, offset: 45302},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1176, col: 35, offset: 43707},
+										pos: position{line: 1193, col: 35, offset: 45302},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1176, col: 36, offset: 43708},
+											pos:  position{line: 1193, col: 36, offset: 45303},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1176, col: 64, offset: 43736,
+										line: 1193, col: 64, offset: 45331,
 									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1178, col: 7, offset: 43901},
+						pos: position{line: 1195, col: 7, offset: 45496},
 						run: (*parser).callonTriplePlusPassthroughContent8,
 						expr: &zeroOrOneExpr{
-							pos: position{line: 1178, col: 7, offset: 43901},
+							pos: position{line: 1195, col: 7, offset: 45496},
 							expr: &seqExpr{
-								pos: position{line: 1178, col: 8, offset: 43902},
+								pos: position{line: 1195, col: 8, offset: 45497},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1178, col: 8, offset: 43902},
+										pos: position{line: 1195, col: 8, offset: 45497},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1178, col: 9, offset: 43903},
+											pos:  position{line: 1195, col: 9, offset: 45498},
 											name: "Space",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1178, col: 15, offset: 43909},
+										pos: position{line: 1195, col: 15, offset: 45504},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1178, col: 16, offset: 43910},
+											pos:  position{line: 1195, col: 16, offset: 45505},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1178, col: 24, offset: 43918},
+										pos: position{line: 1195, col: 24, offset: 45513},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1178, col: 25, offset: 43919},
+											pos:  position{line: 1195, col: 25, offset: 45514},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1178, col: 53, offset: 43947,
+										line: 1195, col: 53, offset: 45542,
 									},
 								},
 							},
@@ -8333,35 +8529,35 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughMacro",
-			pos:  position{line: 1182, col: 1, offset: 44030},
+			pos:  position{line: 1199, col: 1, offset: 45625},
 			expr: &choiceExpr{
-				pos: position{line: 1182, col: 21, offset: 44050},
+				pos: position{line: 1199, col: 21, offset: 45645},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1182, col: 21, offset: 44050},
+						pos: position{line: 1199, col: 21, offset: 45645},
 						run: (*parser).callonPassthroughMacro2,
 						expr: &seqExpr{
-							pos: position{line: 1182, col: 21, offset: 44050},
+							pos: position{line: 1199, col: 21, offset: 45645},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1182, col: 21, offset: 44050},
+									pos:        position{line: 1199, col: 21, offset: 45645},
 									val:        "pass:[",
 									ignoreCase: false,
 									want:       "\"pass:[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1182, col: 30, offset: 44059},
+									pos:   position{line: 1199, col: 30, offset: 45654},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1182, col: 38, offset: 44067},
+										pos: position{line: 1199, col: 38, offset: 45662},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1182, col: 39, offset: 44068},
+											pos:  position{line: 1199, col: 39, offset: 45663},
 											name: "PassthroughMacroCharacter",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1182, col: 67, offset: 44096},
+									pos:        position{line: 1199, col: 67, offset: 45691},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -8370,31 +8566,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1184, col: 5, offset: 44192},
+						pos: position{line: 1201, col: 5, offset: 45807},
 						run: (*parser).callonPassthroughMacro9,
 						expr: &seqExpr{
-							pos: position{line: 1184, col: 5, offset: 44192},
+							pos: position{line: 1201, col: 5, offset: 45807},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1184, col: 5, offset: 44192},
+									pos:        position{line: 1201, col: 5, offset: 45807},
 									val:        "pass:q[",
 									ignoreCase: false,
 									want:       "\"pass:q[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1184, col: 15, offset: 44202},
+									pos:   position{line: 1201, col: 15, offset: 45817},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1184, col: 23, offset: 44210},
+										pos: position{line: 1201, col: 23, offset: 45825},
 										expr: &choiceExpr{
-											pos: position{line: 1184, col: 24, offset: 44211},
+											pos: position{line: 1201, col: 24, offset: 45826},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 1184, col: 24, offset: 44211},
+													pos:  position{line: 1201, col: 24, offset: 45826},
 													name: "QuotedText",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1184, col: 37, offset: 44224},
+													pos:  position{line: 1201, col: 37, offset: 45839},
 													name: "PassthroughMacroCharacter",
 												},
 											},
@@ -8402,7 +8598,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1184, col: 65, offset: 44252},
+									pos:        position{line: 1201, col: 65, offset: 45867},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -8415,12 +8611,12 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughMacroCharacter",
-			pos:  position{line: 1188, col: 1, offset: 44348},
+			pos:  position{line: 1205, col: 1, offset: 45983},
 			expr: &actionExpr{
-				pos: position{line: 1188, col: 30, offset: 44377},
+				pos: position{line: 1205, col: 30, offset: 46012},
 				run: (*parser).callonPassthroughMacroCharacter1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1188, col: 30, offset: 44377},
+					pos:        position{line: 1205, col: 30, offset: 46012},
 					val:        "[^\\]]",
 					chars:      []rune{']'},
 					ignoreCase: false,
@@ -8430,16 +8626,16 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReference",
-			pos:  position{line: 1195, col: 1, offset: 44550},
+			pos:  position{line: 1212, col: 1, offset: 46185},
 			expr: &choiceExpr{
-				pos: position{line: 1195, col: 19, offset: 44568},
+				pos: position{line: 1212, col: 19, offset: 46203},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1195, col: 19, offset: 44568},
+						pos:  position{line: 1212, col: 19, offset: 46203},
 						name: "InternalCrossReference",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1195, col: 44, offset: 44593},
+						pos:  position{line: 1212, col: 44, offset: 46228},
 						name: "ExternalCrossReference",
 					},
 				},
@@ -8447,53 +8643,53 @@ var g = &grammar{
 		},
 		{
 			name: "InternalCrossReference",
-			pos:  position{line: 1197, col: 1, offset: 44618},
+			pos:  position{line: 1214, col: 1, offset: 46253},
 			expr: &choiceExpr{
-				pos: position{line: 1197, col: 27, offset: 44644},
+				pos: position{line: 1214, col: 27, offset: 46279},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1197, col: 27, offset: 44644},
+						pos: position{line: 1214, col: 27, offset: 46279},
 						run: (*parser).callonInternalCrossReference2,
 						expr: &seqExpr{
-							pos: position{line: 1197, col: 27, offset: 44644},
+							pos: position{line: 1214, col: 27, offset: 46279},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1197, col: 27, offset: 44644},
+									pos:        position{line: 1214, col: 27, offset: 46279},
 									val:        "<<",
 									ignoreCase: false,
 									want:       "\"<<\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1197, col: 32, offset: 44649},
+									pos:   position{line: 1214, col: 32, offset: 46284},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1197, col: 36, offset: 44653},
+										pos:  position{line: 1214, col: 36, offset: 46288},
 										name: "ID",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1197, col: 40, offset: 44657},
+									pos: position{line: 1214, col: 40, offset: 46292},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1197, col: 40, offset: 44657},
+										pos:  position{line: 1214, col: 40, offset: 46292},
 										name: "Space",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1197, col: 47, offset: 44664},
+									pos:        position{line: 1214, col: 47, offset: 46299},
 									val:        ",",
 									ignoreCase: false,
 									want:       "\",\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1197, col: 51, offset: 44668},
+									pos:   position{line: 1214, col: 51, offset: 46303},
 									label: "label",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1197, col: 58, offset: 44675},
+										pos:  position{line: 1214, col: 58, offset: 46310},
 										name: "CrossReferenceLabel",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1197, col: 79, offset: 44696},
+									pos:        position{line: 1214, col: 79, offset: 46331},
 									val:        ">>",
 									ignoreCase: false,
 									want:       "\">>\"",
@@ -8502,27 +8698,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1199, col: 5, offset: 44779},
+						pos: position{line: 1216, col: 5, offset: 46434},
 						run: (*parser).callonInternalCrossReference13,
 						expr: &seqExpr{
-							pos: position{line: 1199, col: 5, offset: 44779},
+							pos: position{line: 1216, col: 5, offset: 46434},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1199, col: 5, offset: 44779},
+									pos:        position{line: 1216, col: 5, offset: 46434},
 									val:        "<<",
 									ignoreCase: false,
 									want:       "\"<<\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1199, col: 10, offset: 44784},
+									pos:   position{line: 1216, col: 10, offset: 46439},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1199, col: 14, offset: 44788},
+										pos:  position{line: 1216, col: 14, offset: 46443},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1199, col: 18, offset: 44792},
+									pos:        position{line: 1216, col: 18, offset: 46447},
 									val:        ">>",
 									ignoreCase: false,
 									want:       "\">>\"",
@@ -8535,32 +8731,32 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalCrossReference",
-			pos:  position{line: 1203, col: 1, offset: 44864},
+			pos:  position{line: 1220, col: 1, offset: 46539},
 			expr: &actionExpr{
-				pos: position{line: 1203, col: 27, offset: 44890},
+				pos: position{line: 1220, col: 27, offset: 46565},
 				run: (*parser).callonExternalCrossReference1,
 				expr: &seqExpr{
-					pos: position{line: 1203, col: 27, offset: 44890},
+					pos: position{line: 1220, col: 27, offset: 46565},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1203, col: 27, offset: 44890},
+							pos:        position{line: 1220, col: 27, offset: 46565},
 							val:        "xref:",
 							ignoreCase: false,
 							want:       "\"xref:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1203, col: 35, offset: 44898},
+							pos:   position{line: 1220, col: 35, offset: 46573},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1203, col: 40, offset: 44903},
+								pos:  position{line: 1220, col: 40, offset: 46578},
 								name: "FileLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1203, col: 54, offset: 44917},
+							pos:   position{line: 1220, col: 54, offset: 46592},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1203, col: 72, offset: 44935},
+								pos:  position{line: 1220, col: 72, offset: 46610},
 								name: "LinkAttributes",
 							},
 						},
@@ -8570,24 +8766,24 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReferenceLabel",
-			pos:  position{line: 1207, col: 1, offset: 45058},
+			pos:  position{line: 1224, col: 1, offset: 46753},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1207, col: 24, offset: 45081},
+				pos:  position{line: 1224, col: 24, offset: 46776},
 				name: "ElementTitleContent",
 			},
 		},
 		{
 			name: "Link",
-			pos:  position{line: 1212, col: 1, offset: 45203},
+			pos:  position{line: 1229, col: 1, offset: 46898},
 			expr: &choiceExpr{
-				pos: position{line: 1212, col: 9, offset: 45211},
+				pos: position{line: 1229, col: 9, offset: 46906},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1212, col: 9, offset: 45211},
+						pos:  position{line: 1229, col: 9, offset: 46906},
 						name: "RelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1212, col: 24, offset: 45226},
+						pos:  position{line: 1229, col: 24, offset: 46921},
 						name: "ExternalLink",
 					},
 				},
@@ -8595,32 +8791,32 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeLink",
-			pos:  position{line: 1215, col: 1, offset: 45307},
+			pos:  position{line: 1232, col: 1, offset: 47002},
 			expr: &actionExpr{
-				pos: position{line: 1215, col: 17, offset: 45323},
+				pos: position{line: 1232, col: 17, offset: 47018},
 				run: (*parser).callonRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1215, col: 17, offset: 45323},
+					pos: position{line: 1232, col: 17, offset: 47018},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1215, col: 17, offset: 45323},
+							pos:        position{line: 1232, col: 17, offset: 47018},
 							val:        "link:",
 							ignoreCase: false,
 							want:       "\"link:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1215, col: 25, offset: 45331},
+							pos:   position{line: 1232, col: 25, offset: 47026},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1215, col: 30, offset: 45336},
+								pos:  position{line: 1232, col: 30, offset: 47031},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1215, col: 40, offset: 45346},
+							pos:   position{line: 1232, col: 40, offset: 47041},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1215, col: 58, offset: 45364},
+								pos:  position{line: 1232, col: 58, offset: 47059},
 								name: "LinkAttributes",
 							},
 						},
@@ -8630,28 +8826,28 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalLink",
-			pos:  position{line: 1219, col: 1, offset: 45475},
+			pos:  position{line: 1236, col: 1, offset: 47190},
 			expr: &actionExpr{
-				pos: position{line: 1219, col: 17, offset: 45491},
+				pos: position{line: 1236, col: 17, offset: 47206},
 				run: (*parser).callonExternalLink1,
 				expr: &seqExpr{
-					pos: position{line: 1219, col: 17, offset: 45491},
+					pos: position{line: 1236, col: 17, offset: 47206},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1219, col: 17, offset: 45491},
+							pos:   position{line: 1236, col: 17, offset: 47206},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1219, col: 22, offset: 45496},
+								pos:  position{line: 1236, col: 22, offset: 47211},
 								name: "LocationWithScheme",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1219, col: 42, offset: 45516},
+							pos:   position{line: 1236, col: 42, offset: 47231},
 							label: "inlineAttributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1219, col: 59, offset: 45533},
+								pos: position{line: 1236, col: 59, offset: 47248},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1219, col: 60, offset: 45534},
+									pos:  position{line: 1236, col: 60, offset: 47249},
 									name: "LinkAttributes",
 								},
 							},
@@ -8662,50 +8858,50 @@ var g = &grammar{
 		},
 		{
 			name: "LinkAttributes",
-			pos:  position{line: 1223, col: 1, offset: 45627},
+			pos:  position{line: 1240, col: 1, offset: 47362},
 			expr: &actionExpr{
-				pos: position{line: 1223, col: 19, offset: 45645},
+				pos: position{line: 1240, col: 19, offset: 47380},
 				run: (*parser).callonLinkAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1223, col: 19, offset: 45645},
+					pos: position{line: 1240, col: 19, offset: 47380},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1223, col: 19, offset: 45645},
+							pos:        position{line: 1240, col: 19, offset: 47380},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1223, col: 23, offset: 45649},
+							pos:   position{line: 1240, col: 23, offset: 47384},
 							label: "firstAttr",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1223, col: 33, offset: 45659},
+								pos: position{line: 1240, col: 33, offset: 47394},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1223, col: 34, offset: 45660},
+									pos:  position{line: 1240, col: 34, offset: 47395},
 									name: "FirstLinkAttributeElement",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1224, col: 5, offset: 45692},
+							pos: position{line: 1241, col: 5, offset: 47427},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1224, col: 5, offset: 45692},
+								pos:  position{line: 1241, col: 5, offset: 47427},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1224, col: 12, offset: 45699},
+							pos:   position{line: 1241, col: 12, offset: 47434},
 							label: "otherattrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1224, col: 23, offset: 45710},
+								pos: position{line: 1241, col: 23, offset: 47445},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1224, col: 24, offset: 45711},
+									pos:  position{line: 1241, col: 24, offset: 47446},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1224, col: 43, offset: 45730},
+							pos:        position{line: 1241, col: 43, offset: 47465},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -8716,42 +8912,42 @@ var g = &grammar{
 		},
 		{
 			name: "FirstLinkAttributeElement",
-			pos:  position{line: 1228, col: 1, offset: 45847},
+			pos:  position{line: 1245, col: 1, offset: 47582},
 			expr: &actionExpr{
-				pos: position{line: 1228, col: 30, offset: 45876},
+				pos: position{line: 1245, col: 30, offset: 47611},
 				run: (*parser).callonFirstLinkAttributeElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 1228, col: 30, offset: 45876},
+					pos:   position{line: 1245, col: 30, offset: 47611},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 1230, col: 5, offset: 45927},
+						pos: position{line: 1247, col: 5, offset: 47662},
 						alternatives: []interface{}{
 							&actionExpr{
-								pos: position{line: 1230, col: 6, offset: 45928},
+								pos: position{line: 1247, col: 6, offset: 47663},
 								run: (*parser).callonFirstLinkAttributeElement4,
 								expr: &seqExpr{
-									pos: position{line: 1230, col: 6, offset: 45928},
+									pos: position{line: 1247, col: 6, offset: 47663},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1230, col: 6, offset: 45928},
+											pos:        position{line: 1247, col: 6, offset: 47663},
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&labeledExpr{
-											pos:   position{line: 1230, col: 11, offset: 45933},
+											pos:   position{line: 1247, col: 11, offset: 47668},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 1230, col: 20, offset: 45942},
+												pos: position{line: 1247, col: 20, offset: 47677},
 												expr: &choiceExpr{
-													pos: position{line: 1230, col: 21, offset: 45943},
+													pos: position{line: 1247, col: 21, offset: 47678},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1230, col: 21, offset: 45943},
+															pos:  position{line: 1247, col: 21, offset: 47678},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1230, col: 34, offset: 45956},
+															pos:  position{line: 1247, col: 34, offset: 47691},
 															name: "QuotedAttributeChar",
 														},
 													},
@@ -8759,17 +8955,17 @@ var g = &grammar{
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 1230, col: 56, offset: 45978},
+											pos:        position{line: 1247, col: 56, offset: 47713},
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&andExpr{
-											pos: position{line: 1230, col: 61, offset: 45983},
+											pos: position{line: 1247, col: 61, offset: 47718},
 											expr: &notExpr{
-												pos: position{line: 1230, col: 63, offset: 45985},
+												pos: position{line: 1247, col: 63, offset: 47720},
 												expr: &litMatcher{
-													pos:        position{line: 1230, col: 64, offset: 45986},
+													pos:        position{line: 1247, col: 64, offset: 47721},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
@@ -8777,9 +8973,9 @@ var g = &grammar{
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 1230, col: 69, offset: 45991},
+											pos: position{line: 1247, col: 69, offset: 47726},
 											expr: &litMatcher{
-												pos:        position{line: 1230, col: 69, offset: 45991},
+												pos:        position{line: 1247, col: 69, offset: 47726},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
@@ -8789,25 +8985,25 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 1234, col: 6, offset: 46118},
+								pos: position{line: 1251, col: 6, offset: 47853},
 								run: (*parser).callonFirstLinkAttributeElement18,
 								expr: &seqExpr{
-									pos: position{line: 1234, col: 6, offset: 46118},
+									pos: position{line: 1251, col: 6, offset: 47853},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 1234, col: 6, offset: 46118},
+											pos:   position{line: 1251, col: 6, offset: 47853},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 1234, col: 15, offset: 46127},
+												pos: position{line: 1251, col: 15, offset: 47862},
 												expr: &choiceExpr{
-													pos: position{line: 1234, col: 16, offset: 46128},
+													pos: position{line: 1251, col: 16, offset: 47863},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1234, col: 16, offset: 46128},
+															pos:  position{line: 1251, col: 16, offset: 47863},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1234, col: 29, offset: 46141},
+															pos:  position{line: 1251, col: 29, offset: 47876},
 															name: "UnquotedAttributeChar",
 														},
 													},
@@ -8815,11 +9011,11 @@ var g = &grammar{
 											},
 										},
 										&andExpr{
-											pos: position{line: 1234, col: 53, offset: 46165},
+											pos: position{line: 1251, col: 53, offset: 47900},
 											expr: &notExpr{
-												pos: position{line: 1234, col: 55, offset: 46167},
+												pos: position{line: 1251, col: 55, offset: 47902},
 												expr: &litMatcher{
-													pos:        position{line: 1234, col: 56, offset: 46168},
+													pos:        position{line: 1251, col: 56, offset: 47903},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
@@ -8827,9 +9023,9 @@ var g = &grammar{
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 1234, col: 61, offset: 46173},
+											pos: position{line: 1251, col: 61, offset: 47908},
 											expr: &litMatcher{
-												pos:        position{line: 1234, col: 61, offset: 46173},
+												pos:        position{line: 1251, col: 61, offset: 47908},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
@@ -8845,12 +9041,12 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeChar",
-			pos:  position{line: 1240, col: 1, offset: 46287},
+			pos:  position{line: 1257, col: 1, offset: 48022},
 			expr: &actionExpr{
-				pos: position{line: 1240, col: 18, offset: 46304},
+				pos: position{line: 1257, col: 18, offset: 48039},
 				run: (*parser).callonAttributeChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1240, col: 18, offset: 46304},
+					pos:        position{line: 1257, col: 18, offset: 48039},
 					val:        "[^\\r\\n\"=\\],]",
 					chars:      []rune{'\r', '\n', '"', '=', ']', ','},
 					ignoreCase: false,
@@ -8860,12 +9056,12 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedAttributeChar",
-			pos:  position{line: 1244, col: 1, offset: 46390},
+			pos:  position{line: 1261, col: 1, offset: 48125},
 			expr: &actionExpr{
-				pos: position{line: 1244, col: 24, offset: 46413},
+				pos: position{line: 1261, col: 24, offset: 48148},
 				run: (*parser).callonQuotedAttributeChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1244, col: 24, offset: 46413},
+					pos:        position{line: 1261, col: 24, offset: 48148},
 					val:        "[^\\r\\n\"=\\]]",
 					chars:      []rune{'\r', '\n', '"', '=', ']'},
 					ignoreCase: false,
@@ -8875,12 +9071,12 @@ var g = &grammar{
 		},
 		{
 			name: "UnquotedAttributeChar",
-			pos:  position{line: 1248, col: 1, offset: 46506},
+			pos:  position{line: 1265, col: 1, offset: 48241},
 			expr: &actionExpr{
-				pos: position{line: 1248, col: 26, offset: 46531},
+				pos: position{line: 1265, col: 26, offset: 48266},
 				run: (*parser).callonUnquotedAttributeChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1248, col: 26, offset: 46531},
+					pos:        position{line: 1265, col: 26, offset: 48266},
 					val:        "[^\\r\\n\"=\\],]",
 					chars:      []rune{'\r', '\n', '"', '=', ']', ','},
 					ignoreCase: false,
@@ -8890,46 +9086,46 @@ var g = &grammar{
 		},
 		{
 			name: "InlineLinks",
-			pos:  position{line: 1253, col: 1, offset: 46688},
+			pos:  position{line: 1270, col: 1, offset: 48423},
 			expr: &actionExpr{
-				pos: position{line: 1254, col: 5, offset: 46708},
+				pos: position{line: 1271, col: 5, offset: 48443},
 				run: (*parser).callonInlineLinks1,
 				expr: &seqExpr{
-					pos: position{line: 1254, col: 5, offset: 46708},
+					pos: position{line: 1271, col: 5, offset: 48443},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1254, col: 5, offset: 46708},
+							pos:   position{line: 1271, col: 5, offset: 48443},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1254, col: 14, offset: 46717},
+								pos: position{line: 1271, col: 14, offset: 48452},
 								expr: &choiceExpr{
-									pos: position{line: 1254, col: 15, offset: 46718},
+									pos: position{line: 1271, col: 15, offset: 48453},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1254, col: 15, offset: 46718},
+											pos:  position{line: 1271, col: 15, offset: 48453},
 											name: "Word",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1255, col: 11, offset: 46733},
+											pos: position{line: 1272, col: 11, offset: 48468},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1255, col: 11, offset: 46733},
+												pos:  position{line: 1272, col: 11, offset: 48468},
 												name: "Space",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1256, col: 11, offset: 46751},
+											pos:  position{line: 1273, col: 11, offset: 48486},
 											name: "ResolvedLink",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1257, col: 11, offset: 46775},
+											pos:  position{line: 1274, col: 11, offset: 48510},
 											name: "Parenthesis",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1258, col: 11, offset: 46797},
+											pos:  position{line: 1275, col: 11, offset: 48532},
 											name: "AnyChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1259, col: 11, offset: 46815},
+											pos:  position{line: 1276, col: 11, offset: 48550},
 											name: "Newline",
 										},
 									},
@@ -8937,7 +9133,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1259, col: 21, offset: 46825},
+							pos:  position{line: 1276, col: 21, offset: 48560},
 							name: "EOF",
 						},
 					},
@@ -8946,16 +9142,16 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLink",
-			pos:  position{line: 1263, col: 1, offset: 46895},
+			pos:  position{line: 1280, col: 1, offset: 48630},
 			expr: &choiceExpr{
-				pos: position{line: 1263, col: 17, offset: 46911},
+				pos: position{line: 1280, col: 17, offset: 48646},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1263, col: 17, offset: 46911},
+						pos:  position{line: 1280, col: 17, offset: 48646},
 						name: "ResolvedRelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1263, col: 40, offset: 46934},
+						pos:  position{line: 1280, col: 40, offset: 48669},
 						name: "ResolvedExternalLink",
 					},
 				},
@@ -8963,41 +9159,41 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedRelativeLink",
-			pos:  position{line: 1266, col: 1, offset: 47062},
+			pos:  position{line: 1283, col: 1, offset: 48797},
 			expr: &actionExpr{
-				pos: position{line: 1266, col: 25, offset: 47086},
+				pos: position{line: 1283, col: 25, offset: 48821},
 				run: (*parser).callonResolvedRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1266, col: 25, offset: 47086},
+					pos: position{line: 1283, col: 25, offset: 48821},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1266, col: 25, offset: 47086},
+							pos:        position{line: 1283, col: 25, offset: 48821},
 							val:        "link:",
 							ignoreCase: false,
 							want:       "\"link:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1266, col: 33, offset: 47094},
+							pos:   position{line: 1283, col: 33, offset: 48829},
 							label: "url",
 							expr: &choiceExpr{
-								pos: position{line: 1266, col: 38, offset: 47099},
+								pos: position{line: 1283, col: 38, offset: 48834},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1266, col: 38, offset: 47099},
+										pos:  position{line: 1283, col: 38, offset: 48834},
 										name: "ResolvedLocation",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1266, col: 57, offset: 47118},
+										pos:  position{line: 1283, col: 57, offset: 48853},
 										name: "ResolvedFileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1266, col: 79, offset: 47140},
+							pos:   position{line: 1283, col: 79, offset: 48875},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1266, col: 97, offset: 47158},
+								pos:  position{line: 1283, col: 97, offset: 48893},
 								name: "LinkAttributes",
 							},
 						},
@@ -9007,28 +9203,28 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedExternalLink",
-			pos:  position{line: 1270, col: 1, offset: 47269},
+			pos:  position{line: 1287, col: 1, offset: 49024},
 			expr: &actionExpr{
-				pos: position{line: 1270, col: 25, offset: 47293},
+				pos: position{line: 1287, col: 25, offset: 49048},
 				run: (*parser).callonResolvedExternalLink1,
 				expr: &seqExpr{
-					pos: position{line: 1270, col: 25, offset: 47293},
+					pos: position{line: 1287, col: 25, offset: 49048},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1270, col: 25, offset: 47293},
+							pos:   position{line: 1287, col: 25, offset: 49048},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1270, col: 30, offset: 47298},
+								pos:  position{line: 1287, col: 30, offset: 49053},
 								name: "ResolvedLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1270, col: 48, offset: 47316},
+							pos:   position{line: 1287, col: 48, offset: 49071},
 							label: "inlineAttributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1270, col: 65, offset: 47333},
+								pos: position{line: 1287, col: 65, offset: 49088},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1270, col: 66, offset: 47334},
+									pos:  position{line: 1287, col: 66, offset: 49089},
 									name: "LinkAttributes",
 								},
 							},
@@ -9039,55 +9235,55 @@ var g = &grammar{
 		},
 		{
 			name: "ImageBlock",
-			pos:  position{line: 1277, col: 1, offset: 47529},
+			pos:  position{line: 1294, col: 1, offset: 49304},
 			expr: &actionExpr{
-				pos: position{line: 1277, col: 15, offset: 47543},
+				pos: position{line: 1294, col: 15, offset: 49318},
 				run: (*parser).callonImageBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1277, col: 15, offset: 47543},
+					pos: position{line: 1294, col: 15, offset: 49318},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1277, col: 15, offset: 47543},
+							pos:   position{line: 1294, col: 15, offset: 49318},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1277, col: 26, offset: 47554},
+								pos: position{line: 1294, col: 26, offset: 49329},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1277, col: 27, offset: 47555},
+									pos:  position{line: 1294, col: 27, offset: 49330},
 									name: "Attributes",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1277, col: 40, offset: 47568},
+							pos:        position{line: 1294, col: 40, offset: 49343},
 							val:        "image::",
 							ignoreCase: false,
 							want:       "\"image::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1277, col: 50, offset: 47578},
+							pos:   position{line: 1294, col: 50, offset: 49353},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1277, col: 56, offset: 47584},
+								pos:  position{line: 1294, col: 56, offset: 49359},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1277, col: 66, offset: 47594},
+							pos:   position{line: 1294, col: 66, offset: 49369},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1277, col: 84, offset: 47612},
+								pos:  position{line: 1294, col: 84, offset: 49387},
 								name: "ImageAttributes",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1277, col: 101, offset: 47629},
+							pos: position{line: 1294, col: 101, offset: 49404},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1277, col: 101, offset: 47629},
+								pos:  position{line: 1294, col: 101, offset: 49404},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1277, col: 108, offset: 47636},
+							pos:  position{line: 1294, col: 108, offset: 49411},
 							name: "EOL",
 						},
 					},
@@ -9096,41 +9292,41 @@ var g = &grammar{
 		},
 		{
 			name: "InlineImage",
-			pos:  position{line: 1281, col: 1, offset: 47748},
+			pos:  position{line: 1298, col: 1, offset: 49543},
 			expr: &actionExpr{
-				pos: position{line: 1281, col: 16, offset: 47763},
+				pos: position{line: 1298, col: 16, offset: 49558},
 				run: (*parser).callonInlineImage1,
 				expr: &seqExpr{
-					pos: position{line: 1281, col: 16, offset: 47763},
+					pos: position{line: 1298, col: 16, offset: 49558},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1281, col: 16, offset: 47763},
+							pos:        position{line: 1298, col: 16, offset: 49558},
 							val:        "image:",
 							ignoreCase: false,
 							want:       "\"image:\"",
 						},
 						&notExpr{
-							pos: position{line: 1281, col: 25, offset: 47772},
+							pos: position{line: 1298, col: 25, offset: 49567},
 							expr: &litMatcher{
-								pos:        position{line: 1281, col: 26, offset: 47773},
+								pos:        position{line: 1298, col: 26, offset: 49568},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1281, col: 30, offset: 47777},
+							pos:   position{line: 1298, col: 30, offset: 49572},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1281, col: 36, offset: 47783},
+								pos:  position{line: 1298, col: 36, offset: 49578},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1281, col: 46, offset: 47793},
+							pos:   position{line: 1298, col: 46, offset: 49588},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1281, col: 64, offset: 47811},
+								pos:  position{line: 1298, col: 64, offset: 49606},
 								name: "ImageAttributes",
 							},
 						},
@@ -9140,99 +9336,99 @@ var g = &grammar{
 		},
 		{
 			name: "ImageAttributes",
-			pos:  position{line: 1285, col: 1, offset: 47925},
+			pos:  position{line: 1302, col: 1, offset: 49740},
 			expr: &actionExpr{
-				pos: position{line: 1285, col: 20, offset: 47944},
+				pos: position{line: 1302, col: 20, offset: 49759},
 				run: (*parser).callonImageAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1285, col: 20, offset: 47944},
+					pos: position{line: 1302, col: 20, offset: 49759},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1285, col: 20, offset: 47944},
+							pos:        position{line: 1302, col: 20, offset: 49759},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1285, col: 24, offset: 47948},
+							pos:   position{line: 1302, col: 24, offset: 49763},
 							label: "alt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1285, col: 28, offset: 47952},
+								pos: position{line: 1302, col: 28, offset: 49767},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1285, col: 29, offset: 47953},
+									pos:  position{line: 1302, col: 29, offset: 49768},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1285, col: 56, offset: 47980},
+							pos: position{line: 1302, col: 56, offset: 49795},
 							expr: &litMatcher{
-								pos:        position{line: 1285, col: 56, offset: 47980},
+								pos:        position{line: 1302, col: 56, offset: 49795},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1285, col: 61, offset: 47985},
+							pos:   position{line: 1302, col: 61, offset: 49800},
 							label: "width",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1285, col: 67, offset: 47991},
+								pos: position{line: 1302, col: 67, offset: 49806},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1285, col: 68, offset: 47992},
+									pos:  position{line: 1302, col: 68, offset: 49807},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1285, col: 95, offset: 48019},
+							pos: position{line: 1302, col: 95, offset: 49834},
 							expr: &litMatcher{
-								pos:        position{line: 1285, col: 95, offset: 48019},
+								pos:        position{line: 1302, col: 95, offset: 49834},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1285, col: 100, offset: 48024},
+							pos:   position{line: 1302, col: 100, offset: 49839},
 							label: "height",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1285, col: 107, offset: 48031},
+								pos: position{line: 1302, col: 107, offset: 49846},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1285, col: 108, offset: 48032},
+									pos:  position{line: 1302, col: 108, offset: 49847},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1285, col: 135, offset: 48059},
+							pos: position{line: 1302, col: 135, offset: 49874},
 							expr: &litMatcher{
-								pos:        position{line: 1285, col: 135, offset: 48059},
+								pos:        position{line: 1302, col: 135, offset: 49874},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1285, col: 140, offset: 48064},
+							pos: position{line: 1302, col: 140, offset: 49879},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1285, col: 140, offset: 48064},
+								pos:  position{line: 1302, col: 140, offset: 49879},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1285, col: 147, offset: 48071},
+							pos:   position{line: 1302, col: 147, offset: 49886},
 							label: "otherattrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1285, col: 158, offset: 48082},
+								pos: position{line: 1302, col: 158, offset: 49897},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1285, col: 159, offset: 48083},
+									pos:  position{line: 1302, col: 159, offset: 49898},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1285, col: 178, offset: 48102},
+							pos:        position{line: 1302, col: 178, offset: 49917},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -9243,32 +9439,32 @@ var g = &grammar{
 		},
 		{
 			name: "InlineFootnote",
-			pos:  position{line: 1292, col: 1, offset: 48392},
+			pos:  position{line: 1309, col: 1, offset: 50207},
 			expr: &choiceExpr{
-				pos: position{line: 1292, col: 19, offset: 48410},
+				pos: position{line: 1309, col: 19, offset: 50225},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1292, col: 19, offset: 48410},
+						pos: position{line: 1309, col: 19, offset: 50225},
 						run: (*parser).callonInlineFootnote2,
 						expr: &seqExpr{
-							pos: position{line: 1292, col: 19, offset: 48410},
+							pos: position{line: 1309, col: 19, offset: 50225},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1292, col: 19, offset: 48410},
+									pos:        position{line: 1309, col: 19, offset: 50225},
 									val:        "footnote:[",
 									ignoreCase: false,
 									want:       "\"footnote:[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1292, col: 32, offset: 48423},
+									pos:   position{line: 1309, col: 32, offset: 50238},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1292, col: 41, offset: 48432},
+										pos:  position{line: 1309, col: 41, offset: 50247},
 										name: "FootnoteContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1292, col: 58, offset: 48449},
+									pos:        position{line: 1309, col: 58, offset: 50264},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -9277,44 +9473,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1294, col: 5, offset: 48517},
+						pos: position{line: 1311, col: 5, offset: 50352},
 						run: (*parser).callonInlineFootnote8,
 						expr: &seqExpr{
-							pos: position{line: 1294, col: 5, offset: 48517},
+							pos: position{line: 1311, col: 5, offset: 50352},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1294, col: 5, offset: 48517},
+									pos:        position{line: 1311, col: 5, offset: 50352},
 									val:        "footnote:",
 									ignoreCase: false,
 									want:       "\"footnote:\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1294, col: 17, offset: 48529},
+									pos:   position{line: 1311, col: 17, offset: 50364},
 									label: "ref",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1294, col: 22, offset: 48534},
+										pos:  position{line: 1311, col: 22, offset: 50369},
 										name: "FootnoteRef",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1294, col: 35, offset: 48547},
+									pos:        position{line: 1311, col: 35, offset: 50382},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1294, col: 39, offset: 48551},
+									pos:   position{line: 1311, col: 39, offset: 50386},
 									label: "content",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1294, col: 47, offset: 48559},
+										pos: position{line: 1311, col: 47, offset: 50394},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1294, col: 48, offset: 48560},
+											pos:  position{line: 1311, col: 48, offset: 50395},
 											name: "FootnoteContent",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1294, col: 66, offset: 48578},
+									pos:        position{line: 1311, col: 66, offset: 50413},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -9327,37 +9523,37 @@ var g = &grammar{
 		},
 		{
 			name: "FootnoteRef",
-			pos:  position{line: 1298, col: 1, offset: 48639},
+			pos:  position{line: 1315, col: 1, offset: 50494},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1298, col: 16, offset: 48654},
+				pos:  position{line: 1315, col: 16, offset: 50509},
 				name: "Alphanums",
 			},
 		},
 		{
 			name: "FootnoteContent",
-			pos:  position{line: 1300, col: 1, offset: 48665},
+			pos:  position{line: 1317, col: 1, offset: 50520},
 			expr: &actionExpr{
-				pos: position{line: 1300, col: 20, offset: 48684},
+				pos: position{line: 1317, col: 20, offset: 50539},
 				run: (*parser).callonFootnoteContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 1300, col: 20, offset: 48684},
+					pos:   position{line: 1317, col: 20, offset: 50539},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1300, col: 29, offset: 48693},
+						pos: position{line: 1317, col: 29, offset: 50548},
 						expr: &seqExpr{
-							pos: position{line: 1300, col: 30, offset: 48694},
+							pos: position{line: 1317, col: 30, offset: 50549},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1300, col: 30, offset: 48694},
+									pos: position{line: 1317, col: 30, offset: 50549},
 									expr: &litMatcher{
-										pos:        position{line: 1300, col: 31, offset: 48695},
+										pos:        position{line: 1317, col: 31, offset: 50550},
 										val:        "]",
 										ignoreCase: false,
 										want:       "\"]\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1300, col: 35, offset: 48699},
+									pos:  position{line: 1317, col: 35, offset: 50554},
 									name: "InlineElement",
 								},
 							},
@@ -9368,64 +9564,64 @@ var g = &grammar{
 		},
 		{
 			name: "DelimitedBlock",
-			pos:  position{line: 1307, col: 1, offset: 49023},
+			pos:  position{line: 1324, col: 1, offset: 50878},
 			expr: &actionExpr{
-				pos: position{line: 1307, col: 19, offset: 49041},
+				pos: position{line: 1324, col: 19, offset: 50896},
 				run: (*parser).callonDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1307, col: 19, offset: 49041},
+					pos: position{line: 1324, col: 19, offset: 50896},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1307, col: 19, offset: 49041},
+							pos: position{line: 1324, col: 19, offset: 50896},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1307, col: 20, offset: 49042},
+								pos:  position{line: 1324, col: 20, offset: 50897},
 								name: "Alphanum",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1308, col: 5, offset: 49130},
+							pos:   position{line: 1325, col: 5, offset: 50985},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 1308, col: 12, offset: 49137},
+								pos: position{line: 1325, col: 12, offset: 50992},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1308, col: 12, offset: 49137},
+										pos:  position{line: 1325, col: 12, offset: 50992},
 										name: "FencedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1309, col: 11, offset: 49160},
+										pos:  position{line: 1326, col: 11, offset: 51015},
 										name: "ListingBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1310, col: 11, offset: 49184},
+										pos:  position{line: 1327, col: 11, offset: 51039},
 										name: "ExampleBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1311, col: 11, offset: 49208},
+										pos:  position{line: 1328, col: 11, offset: 51063},
 										name: "VerseBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1312, col: 11, offset: 49230},
+										pos:  position{line: 1329, col: 11, offset: 51085},
 										name: "QuoteBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1313, col: 11, offset: 49252},
+										pos:  position{line: 1330, col: 11, offset: 51107},
 										name: "SidebarBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1314, col: 11, offset: 49275},
+										pos:  position{line: 1331, col: 11, offset: 51130},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1315, col: 11, offset: 49303},
+										pos:  position{line: 1332, col: 11, offset: 51158},
 										name: "PassthroughBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1316, col: 11, offset: 49330},
+										pos:  position{line: 1333, col: 11, offset: 51185},
 										name: "Table",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1317, col: 11, offset: 49346},
+										pos:  position{line: 1334, col: 11, offset: 51201},
 										name: "CommentBlock",
 									},
 								},
@@ -9437,52 +9633,52 @@ var g = &grammar{
 		},
 		{
 			name: "BlockDelimiter",
-			pos:  position{line: 1321, col: 1, offset: 49387},
+			pos:  position{line: 1338, col: 1, offset: 51242},
 			expr: &choiceExpr{
-				pos: position{line: 1321, col: 19, offset: 49405},
+				pos: position{line: 1338, col: 19, offset: 51260},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1321, col: 19, offset: 49405},
+						pos: position{line: 1338, col: 19, offset: 51260},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1321, col: 19, offset: 49405},
+								pos: position{line: 1338, col: 19, offset: 51260},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1321, col: 21, offset: 49407},
+									pos:  position{line: 1338, col: 21, offset: 51262},
 									name: "Alphanum",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1321, col: 31, offset: 49417},
+								pos:  position{line: 1338, col: 31, offset: 51272},
 								name: "LiteralBlockDelimiter",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1322, col: 19, offset: 49488},
+						pos:  position{line: 1339, col: 19, offset: 51343},
 						name: "FencedBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1323, col: 19, offset: 49528},
+						pos:  position{line: 1340, col: 19, offset: 51383},
 						name: "ListingBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1324, col: 19, offset: 49569},
+						pos:  position{line: 1341, col: 19, offset: 51424},
 						name: "ExampleBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1325, col: 19, offset: 49610},
+						pos:  position{line: 1342, col: 19, offset: 51465},
 						name: "CommentBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1326, col: 19, offset: 49651},
+						pos:  position{line: 1343, col: 19, offset: 51506},
 						name: "QuoteBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1327, col: 19, offset: 49689},
+						pos:  position{line: 1344, col: 19, offset: 51544},
 						name: "SidebarBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1328, col: 19, offset: 49729},
+						pos:  position{line: 1345, col: 19, offset: 51584},
 						name: "PassthroughBlockDelimiter",
 					},
 				},
@@ -9490,16 +9686,16 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimContent",
-			pos:  position{line: 1330, col: 1, offset: 49756},
+			pos:  position{line: 1347, col: 1, offset: 51611},
 			expr: &choiceExpr{
-				pos: position{line: 1330, col: 20, offset: 49775},
+				pos: position{line: 1347, col: 20, offset: 51630},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1330, col: 20, offset: 49775},
+						pos:  position{line: 1347, col: 20, offset: 51630},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1330, col: 36, offset: 49791},
+						pos:  position{line: 1347, col: 36, offset: 51646},
 						name: "VerbatimLine",
 					},
 				},
@@ -9507,41 +9703,41 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimLine",
-			pos:  position{line: 1332, col: 1, offset: 49805},
+			pos:  position{line: 1349, col: 1, offset: 51660},
 			expr: &actionExpr{
-				pos: position{line: 1332, col: 17, offset: 49821},
+				pos: position{line: 1349, col: 17, offset: 51676},
 				run: (*parser).callonVerbatimLine1,
 				expr: &seqExpr{
-					pos: position{line: 1332, col: 17, offset: 49821},
+					pos: position{line: 1349, col: 17, offset: 51676},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1332, col: 17, offset: 49821},
+							pos: position{line: 1349, col: 17, offset: 51676},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1332, col: 18, offset: 49822},
+								pos:  position{line: 1349, col: 18, offset: 51677},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1332, col: 22, offset: 49826},
+							pos:   position{line: 1349, col: 22, offset: 51681},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1332, col: 31, offset: 49835},
+								pos:  position{line: 1349, col: 31, offset: 51690},
 								name: "VerbatimLineContent",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1332, col: 52, offset: 49856},
+							pos:   position{line: 1349, col: 52, offset: 51711},
 							label: "callouts",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1332, col: 61, offset: 49865},
+								pos: position{line: 1349, col: 61, offset: 51720},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1332, col: 62, offset: 49866},
+									pos:  position{line: 1349, col: 62, offset: 51721},
 									name: "Callouts",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1332, col: 73, offset: 49877},
+							pos:  position{line: 1349, col: 73, offset: 51732},
 							name: "EOL",
 						},
 					},
@@ -9550,36 +9746,36 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimLineContent",
-			pos:  position{line: 1336, col: 1, offset: 49947},
+			pos:  position{line: 1353, col: 1, offset: 51822},
 			expr: &actionExpr{
-				pos: position{line: 1336, col: 24, offset: 49970},
+				pos: position{line: 1353, col: 24, offset: 51845},
 				run: (*parser).callonVerbatimLineContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1336, col: 24, offset: 49970},
+					pos: position{line: 1353, col: 24, offset: 51845},
 					expr: &seqExpr{
-						pos: position{line: 1336, col: 25, offset: 49971},
+						pos: position{line: 1353, col: 25, offset: 51846},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1336, col: 25, offset: 49971},
+								pos: position{line: 1353, col: 25, offset: 51846},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1336, col: 26, offset: 49972},
+									pos:  position{line: 1353, col: 26, offset: 51847},
 									name: "Callouts",
 								},
 							},
 							&choiceExpr{
-								pos: position{line: 1336, col: 36, offset: 49982},
+								pos: position{line: 1353, col: 36, offset: 51857},
 								alternatives: []interface{}{
 									&oneOrMoreExpr{
-										pos: position{line: 1336, col: 36, offset: 49982},
+										pos: position{line: 1353, col: 36, offset: 51857},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1336, col: 36, offset: 49982},
+											pos:  position{line: 1353, col: 36, offset: 51857},
 											name: "Space",
 										},
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 1336, col: 45, offset: 49991},
+										pos: position{line: 1353, col: 45, offset: 51866},
 										expr: &charClassMatcher{
-											pos:        position{line: 1336, col: 45, offset: 49991},
+											pos:        position{line: 1353, col: 45, offset: 51866},
 											val:        "[^ \\r\\n]",
 											chars:      []rune{' ', '\r', '\n'},
 											ignoreCase: false,
@@ -9595,40 +9791,40 @@ var g = &grammar{
 		},
 		{
 			name: "Callouts",
-			pos:  position{line: 1340, col: 1, offset: 50041},
+			pos:  position{line: 1357, col: 1, offset: 51916},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1340, col: 13, offset: 50053},
+				pos: position{line: 1357, col: 13, offset: 51928},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1340, col: 13, offset: 50053},
+					pos:  position{line: 1357, col: 13, offset: 51928},
 					name: "Callout",
 				},
 			},
 		},
 		{
 			name: "Callout",
-			pos:  position{line: 1342, col: 1, offset: 50063},
+			pos:  position{line: 1359, col: 1, offset: 51938},
 			expr: &actionExpr{
-				pos: position{line: 1342, col: 12, offset: 50074},
+				pos: position{line: 1359, col: 12, offset: 51949},
 				run: (*parser).callonCallout1,
 				expr: &seqExpr{
-					pos: position{line: 1342, col: 12, offset: 50074},
+					pos: position{line: 1359, col: 12, offset: 51949},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1342, col: 12, offset: 50074},
+							pos:        position{line: 1359, col: 12, offset: 51949},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1342, col: 16, offset: 50078},
+							pos:   position{line: 1359, col: 16, offset: 51953},
 							label: "ref",
 							expr: &actionExpr{
-								pos: position{line: 1342, col: 21, offset: 50083},
+								pos: position{line: 1359, col: 21, offset: 51958},
 								run: (*parser).callonCallout5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1342, col: 21, offset: 50083},
+									pos: position{line: 1359, col: 21, offset: 51958},
 									expr: &charClassMatcher{
-										pos:        position{line: 1342, col: 21, offset: 50083},
+										pos:        position{line: 1359, col: 21, offset: 51958},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -9638,29 +9834,29 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1342, col: 69, offset: 50131},
+							pos:        position{line: 1359, col: 69, offset: 52006},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1342, col: 73, offset: 50135},
+							pos: position{line: 1359, col: 73, offset: 52010},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1342, col: 73, offset: 50135},
+								pos:  position{line: 1359, col: 73, offset: 52010},
 								name: "Space",
 							},
 						},
 						&andExpr{
-							pos: position{line: 1342, col: 80, offset: 50142},
+							pos: position{line: 1359, col: 80, offset: 52017},
 							expr: &choiceExpr{
-								pos: position{line: 1342, col: 82, offset: 50144},
+								pos: position{line: 1359, col: 82, offset: 52019},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1342, col: 82, offset: 50144},
+										pos:  position{line: 1359, col: 82, offset: 52019},
 										name: "EOL",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1342, col: 88, offset: 50150},
+										pos:  position{line: 1359, col: 88, offset: 52025},
 										name: "Callout",
 									},
 								},
@@ -9672,28 +9868,28 @@ var g = &grammar{
 		},
 		{
 			name: "CalloutListItem",
-			pos:  position{line: 1346, col: 1, offset: 50203},
+			pos:  position{line: 1363, col: 1, offset: 52098},
 			expr: &actionExpr{
-				pos: position{line: 1346, col: 20, offset: 50222},
+				pos: position{line: 1363, col: 20, offset: 52117},
 				run: (*parser).callonCalloutListItem1,
 				expr: &seqExpr{
-					pos: position{line: 1346, col: 20, offset: 50222},
+					pos: position{line: 1363, col: 20, offset: 52117},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1346, col: 20, offset: 50222},
+							pos:   position{line: 1363, col: 20, offset: 52117},
 							label: "ref",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1346, col: 25, offset: 50227},
+								pos:  position{line: 1363, col: 25, offset: 52122},
 								name: "CalloutListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1346, col: 48, offset: 50250},
+							pos:   position{line: 1363, col: 48, offset: 52145},
 							label: "description",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1346, col: 61, offset: 50263},
+								pos: position{line: 1363, col: 61, offset: 52158},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1346, col: 61, offset: 50263},
+									pos:  position{line: 1363, col: 61, offset: 52158},
 									name: "ListParagraph",
 								},
 							},
@@ -9704,29 +9900,29 @@ var g = &grammar{
 		},
 		{
 			name: "CalloutListItemPrefix",
-			pos:  position{line: 1350, col: 1, offset: 50360},
+			pos:  position{line: 1367, col: 1, offset: 52275},
 			expr: &actionExpr{
-				pos: position{line: 1350, col: 26, offset: 50385},
+				pos: position{line: 1367, col: 26, offset: 52300},
 				run: (*parser).callonCalloutListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 1350, col: 26, offset: 50385},
+					pos: position{line: 1367, col: 26, offset: 52300},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1350, col: 26, offset: 50385},
+							pos:        position{line: 1367, col: 26, offset: 52300},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1350, col: 30, offset: 50389},
+							pos:   position{line: 1367, col: 30, offset: 52304},
 							label: "ref",
 							expr: &actionExpr{
-								pos: position{line: 1350, col: 35, offset: 50394},
+								pos: position{line: 1367, col: 35, offset: 52309},
 								run: (*parser).callonCalloutListItemPrefix5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1350, col: 35, offset: 50394},
+									pos: position{line: 1367, col: 35, offset: 52309},
 									expr: &charClassMatcher{
-										pos:        position{line: 1350, col: 35, offset: 50394},
+										pos:        position{line: 1367, col: 35, offset: 52309},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -9736,15 +9932,15 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1350, col: 83, offset: 50442},
+							pos:        position{line: 1367, col: 83, offset: 52357},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1350, col: 87, offset: 50446},
+							pos: position{line: 1367, col: 87, offset: 52361},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1350, col: 87, offset: 50446},
+								pos:  position{line: 1367, col: 87, offset: 52361},
 								name: "Space",
 							},
 						},
@@ -9754,25 +9950,25 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockDelimiter",
-			pos:  position{line: 1357, col: 1, offset: 50673},
+			pos:  position{line: 1374, col: 1, offset: 52588},
 			expr: &seqExpr{
-				pos: position{line: 1357, col: 25, offset: 50697},
+				pos: position{line: 1374, col: 25, offset: 52612},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1357, col: 25, offset: 50697},
+						pos:        position{line: 1374, col: 25, offset: 52612},
 						val:        "```",
 						ignoreCase: false,
 						want:       "\"```\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1357, col: 31, offset: 50703},
+						pos: position{line: 1374, col: 31, offset: 52618},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1357, col: 31, offset: 50703},
+							pos:  position{line: 1374, col: 31, offset: 52618},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1357, col: 38, offset: 50710},
+						pos:  position{line: 1374, col: 38, offset: 52625},
 						name: "EOL",
 					},
 				},
@@ -9780,25 +9976,25 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockStartDelimiter",
-			pos:  position{line: 1359, col: 1, offset: 50770},
+			pos:  position{line: 1376, col: 1, offset: 52685},
 			expr: &seqExpr{
-				pos: position{line: 1359, col: 30, offset: 50799},
+				pos: position{line: 1376, col: 30, offset: 52714},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1359, col: 30, offset: 50799},
+						pos:        position{line: 1376, col: 30, offset: 52714},
 						val:        "```",
 						ignoreCase: false,
 						want:       "\"```\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1359, col: 36, offset: 50805},
+						pos: position{line: 1376, col: 36, offset: 52720},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1359, col: 36, offset: 50805},
+							pos:  position{line: 1376, col: 36, offset: 52720},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1359, col: 43, offset: 50812},
+						pos:  position{line: 1376, col: 43, offset: 52727},
 						name: "EOL",
 					},
 				},
@@ -9806,34 +10002,34 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockEndDelimiter",
-			pos:  position{line: 1361, col: 1, offset: 50817},
+			pos:  position{line: 1378, col: 1, offset: 52732},
 			expr: &choiceExpr{
-				pos: position{line: 1361, col: 28, offset: 50844},
+				pos: position{line: 1378, col: 28, offset: 52759},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1361, col: 29, offset: 50845},
+						pos: position{line: 1378, col: 29, offset: 52760},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1361, col: 29, offset: 50845},
+								pos:        position{line: 1378, col: 29, offset: 52760},
 								val:        "```",
 								ignoreCase: false,
 								want:       "\"```\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1361, col: 35, offset: 50851},
+								pos: position{line: 1378, col: 35, offset: 52766},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1361, col: 35, offset: 50851},
+									pos:  position{line: 1378, col: 35, offset: 52766},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1361, col: 42, offset: 50858},
+								pos:  position{line: 1378, col: 42, offset: 52773},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1361, col: 49, offset: 50865},
+						pos:  position{line: 1378, col: 49, offset: 52780},
 						name: "EOF",
 					},
 				},
@@ -9841,38 +10037,38 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlock",
-			pos:  position{line: 1363, col: 1, offset: 50870},
+			pos:  position{line: 1380, col: 1, offset: 52785},
 			expr: &actionExpr{
-				pos: position{line: 1363, col: 16, offset: 50885},
+				pos: position{line: 1380, col: 16, offset: 52800},
 				run: (*parser).callonFencedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1363, col: 16, offset: 50885},
+					pos: position{line: 1380, col: 16, offset: 52800},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1363, col: 16, offset: 50885},
+							pos:   position{line: 1380, col: 16, offset: 52800},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1363, col: 27, offset: 50896},
+								pos: position{line: 1380, col: 27, offset: 52811},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1363, col: 28, offset: 50897},
+									pos:  position{line: 1380, col: 28, offset: 52812},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1363, col: 41, offset: 50910},
+							pos:  position{line: 1380, col: 41, offset: 52825},
 							name: "FencedBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1363, col: 67, offset: 50936},
+							pos:   position{line: 1380, col: 67, offset: 52851},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1363, col: 76, offset: 50945},
+								pos:  position{line: 1380, col: 76, offset: 52860},
 								name: "FencedBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1363, col: 104, offset: 50973},
+							pos:  position{line: 1380, col: 104, offset: 52888},
 							name: "FencedBlockEndDelimiter",
 						},
 					},
@@ -9881,27 +10077,27 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockVerbatimContent",
-			pos:  position{line: 1367, col: 1, offset: 51088},
+			pos:  position{line: 1384, col: 1, offset: 53023},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1367, col: 31, offset: 51118},
+				pos: position{line: 1384, col: 31, offset: 53053},
 				expr: &actionExpr{
-					pos: position{line: 1367, col: 32, offset: 51119},
+					pos: position{line: 1384, col: 32, offset: 53054},
 					run: (*parser).callonFencedBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1367, col: 32, offset: 51119},
+						pos: position{line: 1384, col: 32, offset: 53054},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1367, col: 32, offset: 51119},
+								pos: position{line: 1384, col: 32, offset: 53054},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1367, col: 33, offset: 51120},
+									pos:  position{line: 1384, col: 33, offset: 53055},
 									name: "FencedBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1367, col: 57, offset: 51144},
+								pos:   position{line: 1384, col: 57, offset: 53079},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1367, col: 66, offset: 51153},
+									pos:  position{line: 1384, col: 66, offset: 53088},
 									name: "VerbatimContent",
 								},
 							},
@@ -9912,25 +10108,25 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockDelimiter",
-			pos:  position{line: 1374, col: 1, offset: 51490},
+			pos:  position{line: 1391, col: 1, offset: 53425},
 			expr: &seqExpr{
-				pos: position{line: 1374, col: 26, offset: 51515},
+				pos: position{line: 1391, col: 26, offset: 53450},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1374, col: 26, offset: 51515},
+						pos:        position{line: 1391, col: 26, offset: 53450},
 						val:        "----",
 						ignoreCase: false,
 						want:       "\"----\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1374, col: 33, offset: 51522},
+						pos: position{line: 1391, col: 33, offset: 53457},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1374, col: 33, offset: 51522},
+							pos:  position{line: 1391, col: 33, offset: 53457},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1374, col: 40, offset: 51529},
+						pos:  position{line: 1391, col: 40, offset: 53464},
 						name: "EOL",
 					},
 				},
@@ -9938,25 +10134,25 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockStartDelimiter",
-			pos:  position{line: 1376, col: 1, offset: 51534},
+			pos:  position{line: 1393, col: 1, offset: 53469},
 			expr: &seqExpr{
-				pos: position{line: 1376, col: 31, offset: 51564},
+				pos: position{line: 1393, col: 31, offset: 53499},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1376, col: 31, offset: 51564},
+						pos:        position{line: 1393, col: 31, offset: 53499},
 						val:        "----",
 						ignoreCase: false,
 						want:       "\"----\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1376, col: 38, offset: 51571},
+						pos: position{line: 1393, col: 38, offset: 53506},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1376, col: 38, offset: 51571},
+							pos:  position{line: 1393, col: 38, offset: 53506},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1376, col: 45, offset: 51578},
+						pos:  position{line: 1393, col: 45, offset: 53513},
 						name: "EOL",
 					},
 				},
@@ -9964,34 +10160,34 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockEndDelimiter",
-			pos:  position{line: 1378, col: 1, offset: 51583},
+			pos:  position{line: 1395, col: 1, offset: 53518},
 			expr: &choiceExpr{
-				pos: position{line: 1378, col: 29, offset: 51611},
+				pos: position{line: 1395, col: 29, offset: 53546},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1378, col: 30, offset: 51612},
+						pos: position{line: 1395, col: 30, offset: 53547},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1378, col: 30, offset: 51612},
+								pos:        position{line: 1395, col: 30, offset: 53547},
 								val:        "----",
 								ignoreCase: false,
 								want:       "\"----\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1378, col: 37, offset: 51619},
+								pos: position{line: 1395, col: 37, offset: 53554},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1378, col: 37, offset: 51619},
+									pos:  position{line: 1395, col: 37, offset: 53554},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1378, col: 44, offset: 51626},
+								pos:  position{line: 1395, col: 44, offset: 53561},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1378, col: 51, offset: 51633},
+						pos:  position{line: 1395, col: 51, offset: 53568},
 						name: "EOF",
 					},
 				},
@@ -9999,38 +10195,38 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlock",
-			pos:  position{line: 1380, col: 1, offset: 51638},
+			pos:  position{line: 1397, col: 1, offset: 53573},
 			expr: &actionExpr{
-				pos: position{line: 1380, col: 17, offset: 51654},
+				pos: position{line: 1397, col: 17, offset: 53589},
 				run: (*parser).callonListingBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1380, col: 17, offset: 51654},
+					pos: position{line: 1397, col: 17, offset: 53589},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1380, col: 17, offset: 51654},
+							pos:   position{line: 1397, col: 17, offset: 53589},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1380, col: 28, offset: 51665},
+								pos: position{line: 1397, col: 28, offset: 53600},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1380, col: 29, offset: 51666},
+									pos:  position{line: 1397, col: 29, offset: 53601},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1380, col: 42, offset: 51679},
+							pos:  position{line: 1397, col: 42, offset: 53614},
 							name: "ListingBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1380, col: 69, offset: 51706},
+							pos:   position{line: 1397, col: 69, offset: 53641},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1380, col: 78, offset: 51715},
+								pos:  position{line: 1397, col: 78, offset: 53650},
 								name: "ListingBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1380, col: 107, offset: 51744},
+							pos:  position{line: 1397, col: 107, offset: 53679},
 							name: "ListingBlockEndDelimiter",
 						},
 					},
@@ -10039,27 +10235,27 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockVerbatimContent",
-			pos:  position{line: 1384, col: 1, offset: 51861},
+			pos:  position{line: 1401, col: 1, offset: 53816},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1384, col: 32, offset: 51892},
+				pos: position{line: 1401, col: 32, offset: 53847},
 				expr: &actionExpr{
-					pos: position{line: 1384, col: 33, offset: 51893},
+					pos: position{line: 1401, col: 33, offset: 53848},
 					run: (*parser).callonListingBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1384, col: 33, offset: 51893},
+						pos: position{line: 1401, col: 33, offset: 53848},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1384, col: 33, offset: 51893},
+								pos: position{line: 1401, col: 33, offset: 53848},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1384, col: 34, offset: 51894},
+									pos:  position{line: 1401, col: 34, offset: 53849},
 									name: "ListingBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1384, col: 59, offset: 51919},
+								pos:   position{line: 1401, col: 59, offset: 53874},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1384, col: 68, offset: 51928},
+									pos:  position{line: 1401, col: 68, offset: 53883},
 									name: "VerbatimContent",
 								},
 							},
@@ -10070,25 +10266,25 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockDelimiter",
-			pos:  position{line: 1391, col: 1, offset: 52265},
+			pos:  position{line: 1408, col: 1, offset: 54220},
 			expr: &seqExpr{
-				pos: position{line: 1391, col: 26, offset: 52290},
+				pos: position{line: 1408, col: 26, offset: 54245},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1391, col: 26, offset: 52290},
+						pos:        position{line: 1408, col: 26, offset: 54245},
 						val:        "====",
 						ignoreCase: false,
 						want:       "\"====\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1391, col: 33, offset: 52297},
+						pos: position{line: 1408, col: 33, offset: 54252},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1391, col: 33, offset: 52297},
+							pos:  position{line: 1408, col: 33, offset: 54252},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1391, col: 40, offset: 52304},
+						pos:  position{line: 1408, col: 40, offset: 54259},
 						name: "EOL",
 					},
 				},
@@ -10096,25 +10292,25 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockStartDelimiter",
-			pos:  position{line: 1393, col: 1, offset: 52309},
+			pos:  position{line: 1410, col: 1, offset: 54264},
 			expr: &seqExpr{
-				pos: position{line: 1393, col: 31, offset: 52339},
+				pos: position{line: 1410, col: 31, offset: 54294},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1393, col: 31, offset: 52339},
+						pos:        position{line: 1410, col: 31, offset: 54294},
 						val:        "====",
 						ignoreCase: false,
 						want:       "\"====\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1393, col: 38, offset: 52346},
+						pos: position{line: 1410, col: 38, offset: 54301},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1393, col: 38, offset: 52346},
+							pos:  position{line: 1410, col: 38, offset: 54301},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1393, col: 45, offset: 52353},
+						pos:  position{line: 1410, col: 45, offset: 54308},
 						name: "EOL",
 					},
 				},
@@ -10122,34 +10318,34 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockEndDelimiter",
-			pos:  position{line: 1395, col: 1, offset: 52358},
+			pos:  position{line: 1412, col: 1, offset: 54313},
 			expr: &choiceExpr{
-				pos: position{line: 1395, col: 29, offset: 52386},
+				pos: position{line: 1412, col: 29, offset: 54341},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1395, col: 30, offset: 52387},
+						pos: position{line: 1412, col: 30, offset: 54342},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1395, col: 30, offset: 52387},
+								pos:        position{line: 1412, col: 30, offset: 54342},
 								val:        "====",
 								ignoreCase: false,
 								want:       "\"====\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1395, col: 37, offset: 52394},
+								pos: position{line: 1412, col: 37, offset: 54349},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1395, col: 37, offset: 52394},
+									pos:  position{line: 1412, col: 37, offset: 54349},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1395, col: 44, offset: 52401},
+								pos:  position{line: 1412, col: 44, offset: 54356},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1395, col: 51, offset: 52408},
+						pos:  position{line: 1412, col: 51, offset: 54363},
 						name: "EOF",
 					},
 				},
@@ -10157,38 +10353,38 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlock",
-			pos:  position{line: 1397, col: 1, offset: 52413},
+			pos:  position{line: 1414, col: 1, offset: 54368},
 			expr: &actionExpr{
-				pos: position{line: 1397, col: 17, offset: 52429},
+				pos: position{line: 1414, col: 17, offset: 54384},
 				run: (*parser).callonExampleBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1397, col: 17, offset: 52429},
+					pos: position{line: 1414, col: 17, offset: 54384},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1397, col: 17, offset: 52429},
+							pos:   position{line: 1414, col: 17, offset: 54384},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1397, col: 28, offset: 52440},
+								pos: position{line: 1414, col: 28, offset: 54395},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1397, col: 29, offset: 52441},
+									pos:  position{line: 1414, col: 29, offset: 54396},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1397, col: 42, offset: 52454},
+							pos:  position{line: 1414, col: 42, offset: 54409},
 							name: "ExampleBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1397, col: 69, offset: 52481},
+							pos:   position{line: 1414, col: 69, offset: 54436},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1397, col: 78, offset: 52490},
+								pos:  position{line: 1414, col: 78, offset: 54445},
 								name: "ExampleBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1397, col: 107, offset: 52519},
+							pos:  position{line: 1414, col: 107, offset: 54474},
 							name: "ExampleBlockEndDelimiter",
 						},
 					},
@@ -10197,27 +10393,27 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockVerbatimContent",
-			pos:  position{line: 1401, col: 1, offset: 52636},
+			pos:  position{line: 1418, col: 1, offset: 54611},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1401, col: 32, offset: 52667},
+				pos: position{line: 1418, col: 32, offset: 54642},
 				expr: &actionExpr{
-					pos: position{line: 1401, col: 33, offset: 52668},
+					pos: position{line: 1418, col: 33, offset: 54643},
 					run: (*parser).callonExampleBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1401, col: 33, offset: 52668},
+						pos: position{line: 1418, col: 33, offset: 54643},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1401, col: 33, offset: 52668},
+								pos: position{line: 1418, col: 33, offset: 54643},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1401, col: 34, offset: 52669},
+									pos:  position{line: 1418, col: 34, offset: 54644},
 									name: "ExampleBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1401, col: 59, offset: 52694},
+								pos:   position{line: 1418, col: 59, offset: 54669},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1401, col: 68, offset: 52703},
+									pos:  position{line: 1418, col: 68, offset: 54678},
 									name: "VerbatimContent",
 								},
 							},
@@ -10228,25 +10424,25 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockDelimiter",
-			pos:  position{line: 1408, col: 1, offset: 53038},
+			pos:  position{line: 1425, col: 1, offset: 55013},
 			expr: &seqExpr{
-				pos: position{line: 1408, col: 24, offset: 53061},
+				pos: position{line: 1425, col: 24, offset: 55036},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1408, col: 24, offset: 53061},
+						pos:        position{line: 1425, col: 24, offset: 55036},
 						val:        "____",
 						ignoreCase: false,
 						want:       "\"____\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1408, col: 31, offset: 53068},
+						pos: position{line: 1425, col: 31, offset: 55043},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1408, col: 31, offset: 53068},
+							pos:  position{line: 1425, col: 31, offset: 55043},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1408, col: 38, offset: 53075},
+						pos:  position{line: 1425, col: 38, offset: 55050},
 						name: "EOL",
 					},
 				},
@@ -10254,25 +10450,25 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockStartDelimiter",
-			pos:  position{line: 1410, col: 1, offset: 53105},
+			pos:  position{line: 1427, col: 1, offset: 55080},
 			expr: &seqExpr{
-				pos: position{line: 1410, col: 29, offset: 53133},
+				pos: position{line: 1427, col: 29, offset: 55108},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1410, col: 29, offset: 53133},
+						pos:        position{line: 1427, col: 29, offset: 55108},
 						val:        "____",
 						ignoreCase: false,
 						want:       "\"____\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1410, col: 36, offset: 53140},
+						pos: position{line: 1427, col: 36, offset: 55115},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1410, col: 36, offset: 53140},
+							pos:  position{line: 1427, col: 36, offset: 55115},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1410, col: 43, offset: 53147},
+						pos:  position{line: 1427, col: 43, offset: 55122},
 						name: "EOL",
 					},
 				},
@@ -10280,34 +10476,34 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockEndDelimiter",
-			pos:  position{line: 1412, col: 1, offset: 53177},
+			pos:  position{line: 1429, col: 1, offset: 55152},
 			expr: &choiceExpr{
-				pos: position{line: 1412, col: 27, offset: 53203},
+				pos: position{line: 1429, col: 27, offset: 55178},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1412, col: 28, offset: 53204},
+						pos: position{line: 1429, col: 28, offset: 55179},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1412, col: 28, offset: 53204},
+								pos:        position{line: 1429, col: 28, offset: 55179},
 								val:        "____",
 								ignoreCase: false,
 								want:       "\"____\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1412, col: 35, offset: 53211},
+								pos: position{line: 1429, col: 35, offset: 55186},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1412, col: 35, offset: 53211},
+									pos:  position{line: 1429, col: 35, offset: 55186},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1412, col: 42, offset: 53218},
+								pos:  position{line: 1429, col: 42, offset: 55193},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1412, col: 49, offset: 53225},
+						pos:  position{line: 1429, col: 49, offset: 55200},
 						name: "EOF",
 					},
 				},
@@ -10315,38 +10511,38 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlock",
-			pos:  position{line: 1414, col: 1, offset: 53255},
+			pos:  position{line: 1431, col: 1, offset: 55230},
 			expr: &actionExpr{
-				pos: position{line: 1414, col: 15, offset: 53269},
+				pos: position{line: 1431, col: 15, offset: 55244},
 				run: (*parser).callonQuoteBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1414, col: 15, offset: 53269},
+					pos: position{line: 1431, col: 15, offset: 55244},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1414, col: 15, offset: 53269},
+							pos:   position{line: 1431, col: 15, offset: 55244},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1414, col: 26, offset: 53280},
+								pos: position{line: 1431, col: 26, offset: 55255},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1414, col: 27, offset: 53281},
+									pos:  position{line: 1431, col: 27, offset: 55256},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1414, col: 40, offset: 53294},
+							pos:  position{line: 1431, col: 40, offset: 55269},
 							name: "QuoteBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1414, col: 65, offset: 53319},
+							pos:   position{line: 1431, col: 65, offset: 55294},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1414, col: 74, offset: 53328},
+								pos:  position{line: 1431, col: 74, offset: 55303},
 								name: "QuoteBlockVerbatimElement",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1414, col: 101, offset: 53355},
+							pos:  position{line: 1431, col: 101, offset: 55330},
 							name: "QuoteBlockEndDelimiter",
 						},
 					},
@@ -10355,27 +10551,27 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockVerbatimElement",
-			pos:  position{line: 1418, col: 1, offset: 53468},
+			pos:  position{line: 1435, col: 1, offset: 55463},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1418, col: 30, offset: 53497},
+				pos: position{line: 1435, col: 30, offset: 55492},
 				expr: &actionExpr{
-					pos: position{line: 1418, col: 31, offset: 53498},
+					pos: position{line: 1435, col: 31, offset: 55493},
 					run: (*parser).callonQuoteBlockVerbatimElement2,
 					expr: &seqExpr{
-						pos: position{line: 1418, col: 31, offset: 53498},
+						pos: position{line: 1435, col: 31, offset: 55493},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1418, col: 31, offset: 53498},
+								pos: position{line: 1435, col: 31, offset: 55493},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1418, col: 32, offset: 53499},
+									pos:  position{line: 1435, col: 32, offset: 55494},
 									name: "QuoteBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1418, col: 55, offset: 53522},
+								pos:   position{line: 1435, col: 55, offset: 55517},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1418, col: 64, offset: 53531},
+									pos:  position{line: 1435, col: 64, offset: 55526},
 									name: "VerbatimContent",
 								},
 							},
@@ -10386,39 +10582,39 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlock",
-			pos:  position{line: 1427, col: 1, offset: 53915},
+			pos:  position{line: 1444, col: 1, offset: 55910},
 			expr: &actionExpr{
-				pos: position{line: 1427, col: 15, offset: 53929},
+				pos: position{line: 1444, col: 15, offset: 55924},
 				run: (*parser).callonVerseBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1427, col: 15, offset: 53929},
+					pos: position{line: 1444, col: 15, offset: 55924},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1427, col: 15, offset: 53929},
+							pos:   position{line: 1444, col: 15, offset: 55924},
 							label: "attributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1427, col: 27, offset: 53941},
+								pos:  position{line: 1444, col: 27, offset: 55936},
 								name: "Attributes",
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 1428, col: 5, offset: 53958},
+							pos: position{line: 1445, col: 5, offset: 55953},
 							run: (*parser).callonVerseBlock5,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1432, col: 5, offset: 54153},
+							pos:  position{line: 1449, col: 5, offset: 56148},
 							name: "QuoteBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1432, col: 30, offset: 54178},
+							pos:   position{line: 1449, col: 30, offset: 56173},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1432, col: 39, offset: 54187},
+								pos:  position{line: 1449, col: 39, offset: 56182},
 								name: "VerseBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1432, col: 66, offset: 54214},
+							pos:  position{line: 1449, col: 66, offset: 56209},
 							name: "QuoteBlockEndDelimiter",
 						},
 					},
@@ -10427,27 +10623,27 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockVerbatimContent",
-			pos:  position{line: 1436, col: 1, offset: 54335},
+			pos:  position{line: 1453, col: 1, offset: 56350},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1436, col: 30, offset: 54364},
+				pos: position{line: 1453, col: 30, offset: 56379},
 				expr: &actionExpr{
-					pos: position{line: 1436, col: 31, offset: 54365},
+					pos: position{line: 1453, col: 31, offset: 56380},
 					run: (*parser).callonVerseBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1436, col: 31, offset: 54365},
+						pos: position{line: 1453, col: 31, offset: 56380},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1436, col: 31, offset: 54365},
+								pos: position{line: 1453, col: 31, offset: 56380},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1436, col: 32, offset: 54366},
+									pos:  position{line: 1453, col: 32, offset: 56381},
 									name: "QuoteBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1436, col: 55, offset: 54389},
+								pos:   position{line: 1453, col: 55, offset: 56404},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1436, col: 64, offset: 54398},
+									pos:  position{line: 1453, col: 64, offset: 56413},
 									name: "VerbatimContent",
 								},
 							},
@@ -10458,25 +10654,25 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockDelimiter",
-			pos:  position{line: 1443, col: 1, offset: 54735},
+			pos:  position{line: 1460, col: 1, offset: 56750},
 			expr: &seqExpr{
-				pos: position{line: 1443, col: 26, offset: 54760},
+				pos: position{line: 1460, col: 26, offset: 56775},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1443, col: 26, offset: 54760},
+						pos:        position{line: 1460, col: 26, offset: 56775},
 						val:        "****",
 						ignoreCase: false,
 						want:       "\"****\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1443, col: 33, offset: 54767},
+						pos: position{line: 1460, col: 33, offset: 56782},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1443, col: 33, offset: 54767},
+							pos:  position{line: 1460, col: 33, offset: 56782},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1443, col: 40, offset: 54774},
+						pos:  position{line: 1460, col: 40, offset: 56789},
 						name: "EOL",
 					},
 				},
@@ -10484,25 +10680,25 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockStartDelimiter",
-			pos:  position{line: 1445, col: 1, offset: 54779},
+			pos:  position{line: 1462, col: 1, offset: 56794},
 			expr: &seqExpr{
-				pos: position{line: 1445, col: 31, offset: 54809},
+				pos: position{line: 1462, col: 31, offset: 56824},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1445, col: 31, offset: 54809},
+						pos:        position{line: 1462, col: 31, offset: 56824},
 						val:        "****",
 						ignoreCase: false,
 						want:       "\"****\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1445, col: 38, offset: 54816},
+						pos: position{line: 1462, col: 38, offset: 56831},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1445, col: 38, offset: 54816},
+							pos:  position{line: 1462, col: 38, offset: 56831},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1445, col: 45, offset: 54823},
+						pos:  position{line: 1462, col: 45, offset: 56838},
 						name: "EOL",
 					},
 				},
@@ -10510,34 +10706,34 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockEndDelimiter",
-			pos:  position{line: 1447, col: 1, offset: 54828},
+			pos:  position{line: 1464, col: 1, offset: 56843},
 			expr: &choiceExpr{
-				pos: position{line: 1447, col: 29, offset: 54856},
+				pos: position{line: 1464, col: 29, offset: 56871},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1447, col: 30, offset: 54857},
+						pos: position{line: 1464, col: 30, offset: 56872},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1447, col: 30, offset: 54857},
+								pos:        position{line: 1464, col: 30, offset: 56872},
 								val:        "****",
 								ignoreCase: false,
 								want:       "\"****\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1447, col: 37, offset: 54864},
+								pos: position{line: 1464, col: 37, offset: 56879},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1447, col: 37, offset: 54864},
+									pos:  position{line: 1464, col: 37, offset: 56879},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1447, col: 44, offset: 54871},
+								pos:  position{line: 1464, col: 44, offset: 56886},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1447, col: 51, offset: 54878},
+						pos:  position{line: 1464, col: 51, offset: 56893},
 						name: "EOF",
 					},
 				},
@@ -10545,38 +10741,38 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlock",
-			pos:  position{line: 1449, col: 1, offset: 54883},
+			pos:  position{line: 1466, col: 1, offset: 56898},
 			expr: &actionExpr{
-				pos: position{line: 1449, col: 17, offset: 54899},
+				pos: position{line: 1466, col: 17, offset: 56914},
 				run: (*parser).callonSidebarBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1449, col: 17, offset: 54899},
+					pos: position{line: 1466, col: 17, offset: 56914},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1449, col: 17, offset: 54899},
+							pos:   position{line: 1466, col: 17, offset: 56914},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1449, col: 28, offset: 54910},
+								pos: position{line: 1466, col: 28, offset: 56925},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1449, col: 29, offset: 54911},
+									pos:  position{line: 1466, col: 29, offset: 56926},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1449, col: 42, offset: 54924},
+							pos:  position{line: 1466, col: 42, offset: 56939},
 							name: "SidebarBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1449, col: 69, offset: 54951},
+							pos:   position{line: 1466, col: 69, offset: 56966},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1449, col: 78, offset: 54960},
+								pos:  position{line: 1466, col: 78, offset: 56975},
 								name: "SidebarBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1449, col: 107, offset: 54989},
+							pos:  position{line: 1466, col: 107, offset: 57004},
 							name: "SidebarBlockEndDelimiter",
 						},
 					},
@@ -10585,27 +10781,27 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockVerbatimContent",
-			pos:  position{line: 1453, col: 1, offset: 55106},
+			pos:  position{line: 1470, col: 1, offset: 57141},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1453, col: 32, offset: 55137},
+				pos: position{line: 1470, col: 32, offset: 57172},
 				expr: &actionExpr{
-					pos: position{line: 1453, col: 33, offset: 55138},
+					pos: position{line: 1470, col: 33, offset: 57173},
 					run: (*parser).callonSidebarBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1453, col: 33, offset: 55138},
+						pos: position{line: 1470, col: 33, offset: 57173},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1453, col: 33, offset: 55138},
+								pos: position{line: 1470, col: 33, offset: 57173},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1453, col: 34, offset: 55139},
+									pos:  position{line: 1470, col: 34, offset: 57174},
 									name: "SidebarBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1453, col: 59, offset: 55164},
+								pos:   position{line: 1470, col: 59, offset: 57199},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1453, col: 68, offset: 55173},
+									pos:  position{line: 1470, col: 68, offset: 57208},
 									name: "VerbatimContent",
 								},
 							},
@@ -10616,25 +10812,25 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockDelimiter",
-			pos:  position{line: 1460, col: 1, offset: 55514},
+			pos:  position{line: 1477, col: 1, offset: 57549},
 			expr: &seqExpr{
-				pos: position{line: 1460, col: 30, offset: 55543},
+				pos: position{line: 1477, col: 30, offset: 57578},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1460, col: 30, offset: 55543},
+						pos:        position{line: 1477, col: 30, offset: 57578},
 						val:        "++++",
 						ignoreCase: false,
 						want:       "\"++++\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1460, col: 37, offset: 55550},
+						pos: position{line: 1477, col: 37, offset: 57585},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1460, col: 37, offset: 55550},
+							pos:  position{line: 1477, col: 37, offset: 57585},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1460, col: 44, offset: 55557},
+						pos:  position{line: 1477, col: 44, offset: 57592},
 						name: "EOL",
 					},
 				},
@@ -10642,25 +10838,25 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockStartDelimiter",
-			pos:  position{line: 1462, col: 1, offset: 55562},
+			pos:  position{line: 1479, col: 1, offset: 57597},
 			expr: &seqExpr{
-				pos: position{line: 1462, col: 35, offset: 55596},
+				pos: position{line: 1479, col: 35, offset: 57631},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1462, col: 35, offset: 55596},
+						pos:        position{line: 1479, col: 35, offset: 57631},
 						val:        "++++",
 						ignoreCase: false,
 						want:       "\"++++\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1462, col: 42, offset: 55603},
+						pos: position{line: 1479, col: 42, offset: 57638},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1462, col: 42, offset: 55603},
+							pos:  position{line: 1479, col: 42, offset: 57638},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1462, col: 49, offset: 55610},
+						pos:  position{line: 1479, col: 49, offset: 57645},
 						name: "EOL",
 					},
 				},
@@ -10668,34 +10864,34 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockEndDelimiter",
-			pos:  position{line: 1464, col: 1, offset: 55615},
+			pos:  position{line: 1481, col: 1, offset: 57650},
 			expr: &choiceExpr{
-				pos: position{line: 1464, col: 33, offset: 55647},
+				pos: position{line: 1481, col: 33, offset: 57682},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1464, col: 34, offset: 55648},
+						pos: position{line: 1481, col: 34, offset: 57683},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1464, col: 34, offset: 55648},
+								pos:        position{line: 1481, col: 34, offset: 57683},
 								val:        "++++",
 								ignoreCase: false,
 								want:       "\"++++\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1464, col: 41, offset: 55655},
+								pos: position{line: 1481, col: 41, offset: 57690},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1464, col: 41, offset: 55655},
+									pos:  position{line: 1481, col: 41, offset: 57690},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1464, col: 48, offset: 55662},
+								pos:  position{line: 1481, col: 48, offset: 57697},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1464, col: 55, offset: 55669},
+						pos:  position{line: 1481, col: 55, offset: 57704},
 						name: "EOF",
 					},
 				},
@@ -10703,38 +10899,38 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlock",
-			pos:  position{line: 1466, col: 1, offset: 55674},
+			pos:  position{line: 1483, col: 1, offset: 57709},
 			expr: &actionExpr{
-				pos: position{line: 1466, col: 21, offset: 55694},
+				pos: position{line: 1483, col: 21, offset: 57729},
 				run: (*parser).callonPassthroughBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1466, col: 21, offset: 55694},
+					pos: position{line: 1483, col: 21, offset: 57729},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1466, col: 21, offset: 55694},
+							pos:   position{line: 1483, col: 21, offset: 57729},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1466, col: 32, offset: 55705},
+								pos: position{line: 1483, col: 32, offset: 57740},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1466, col: 33, offset: 55706},
+									pos:  position{line: 1483, col: 33, offset: 57741},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1466, col: 46, offset: 55719},
+							pos:  position{line: 1483, col: 46, offset: 57754},
 							name: "PassthroughBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1466, col: 77, offset: 55750},
+							pos:   position{line: 1483, col: 77, offset: 57785},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1466, col: 86, offset: 55759},
+								pos:  position{line: 1483, col: 86, offset: 57794},
 								name: "PassthroughBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1466, col: 119, offset: 55792},
+							pos:  position{line: 1483, col: 119, offset: 57827},
 							name: "PassthroughBlockEndDelimiter",
 						},
 					},
@@ -10743,27 +10939,27 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockVerbatimContent",
-			pos:  position{line: 1470, col: 1, offset: 55917},
+			pos:  position{line: 1487, col: 1, offset: 57972},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1470, col: 36, offset: 55952},
+				pos: position{line: 1487, col: 36, offset: 58007},
 				expr: &actionExpr{
-					pos: position{line: 1470, col: 37, offset: 55953},
+					pos: position{line: 1487, col: 37, offset: 58008},
 					run: (*parser).callonPassthroughBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1470, col: 37, offset: 55953},
+						pos: position{line: 1487, col: 37, offset: 58008},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1470, col: 37, offset: 55953},
+								pos: position{line: 1487, col: 37, offset: 58008},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1470, col: 38, offset: 55954},
+									pos:  position{line: 1487, col: 38, offset: 58009},
 									name: "PassthroughBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1470, col: 67, offset: 55983},
+								pos:   position{line: 1487, col: 67, offset: 58038},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1470, col: 76, offset: 55992},
+									pos:  position{line: 1487, col: 76, offset: 58047},
 									name: "VerbatimContent",
 								},
 							},
@@ -10774,87 +10970,87 @@ var g = &grammar{
 		},
 		{
 			name: "NormalBlockContent",
-			pos:  position{line: 1478, col: 1, offset: 56338},
+			pos:  position{line: 1495, col: 1, offset: 58393},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1478, col: 23, offset: 56360},
+				pos: position{line: 1495, col: 23, offset: 58415},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1478, col: 23, offset: 56360},
+					pos:  position{line: 1495, col: 23, offset: 58415},
 					name: "NormalBlockElement",
 				},
 			},
 		},
 		{
 			name: "NormalBlockElement",
-			pos:  position{line: 1480, col: 1, offset: 56381},
+			pos:  position{line: 1497, col: 1, offset: 58436},
 			expr: &actionExpr{
-				pos: position{line: 1481, col: 5, offset: 56408},
+				pos: position{line: 1498, col: 5, offset: 58463},
 				run: (*parser).callonNormalBlockElement1,
 				expr: &seqExpr{
-					pos: position{line: 1481, col: 5, offset: 56408},
+					pos: position{line: 1498, col: 5, offset: 58463},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1481, col: 5, offset: 56408},
+							pos: position{line: 1498, col: 5, offset: 58463},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1481, col: 6, offset: 56409},
+								pos:  position{line: 1498, col: 6, offset: 58464},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1481, col: 10, offset: 56413},
+							pos:   position{line: 1498, col: 10, offset: 58468},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1481, col: 19, offset: 56422},
+								pos: position{line: 1498, col: 19, offset: 58477},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1481, col: 19, offset: 56422},
+										pos:  position{line: 1498, col: 19, offset: 58477},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1482, col: 15, offset: 56447},
+										pos:  position{line: 1499, col: 15, offset: 58502},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1483, col: 15, offset: 56475},
+										pos:  position{line: 1500, col: 15, offset: 58530},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1484, col: 15, offset: 56501},
+										pos:  position{line: 1501, col: 15, offset: 58556},
 										name: "OrderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1485, col: 15, offset: 56532},
+										pos:  position{line: 1502, col: 15, offset: 58587},
 										name: "UnorderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1486, col: 15, offset: 56565},
+										pos:  position{line: 1503, col: 15, offset: 58620},
 										name: "LabeledListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1487, col: 15, offset: 56596},
+										pos:  position{line: 1504, col: 15, offset: 58651},
 										name: "ContinuedListItemElement",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1488, col: 15, offset: 56635},
+										pos:  position{line: 1505, col: 15, offset: 58690},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1489, col: 15, offset: 56664},
+										pos:  position{line: 1506, col: 15, offset: 58719},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1490, col: 15, offset: 56692},
+										pos:  position{line: 1507, col: 15, offset: 58747},
 										name: "AttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1491, col: 15, offset: 56728},
+										pos:  position{line: 1508, col: 15, offset: 58783},
 										name: "AttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1492, col: 15, offset: 56758},
+										pos:  position{line: 1509, col: 15, offset: 58813},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1493, col: 15, offset: 56799},
+										pos:  position{line: 1510, col: 15, offset: 58854},
 										name: "Paragraph",
 									},
 								},
@@ -10866,43 +11062,43 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockContent",
-			pos:  position{line: 1497, col: 1, offset: 56848},
+			pos:  position{line: 1514, col: 1, offset: 58903},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1497, col: 22, offset: 56869},
+				pos: position{line: 1514, col: 22, offset: 58924},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1497, col: 22, offset: 56869},
+					pos:  position{line: 1514, col: 22, offset: 58924},
 					name: "VerseBlockElement",
 				},
 			},
 		},
 		{
 			name: "VerseBlockElement",
-			pos:  position{line: 1499, col: 1, offset: 56889},
+			pos:  position{line: 1516, col: 1, offset: 58944},
 			expr: &actionExpr{
-				pos: position{line: 1499, col: 22, offset: 56910},
+				pos: position{line: 1516, col: 22, offset: 58965},
 				run: (*parser).callonVerseBlockElement1,
 				expr: &seqExpr{
-					pos: position{line: 1499, col: 22, offset: 56910},
+					pos: position{line: 1516, col: 22, offset: 58965},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1499, col: 22, offset: 56910},
+							pos: position{line: 1516, col: 22, offset: 58965},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1499, col: 23, offset: 56911},
+								pos:  position{line: 1516, col: 23, offset: 58966},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1499, col: 27, offset: 56915},
+							pos:   position{line: 1516, col: 27, offset: 58970},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1499, col: 36, offset: 56924},
+								pos: position{line: 1516, col: 36, offset: 58979},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1499, col: 36, offset: 56924},
+										pos:  position{line: 1516, col: 36, offset: 58979},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1499, col: 48, offset: 56936},
+										pos:  position{line: 1516, col: 48, offset: 58991},
 										name: "VerseBlockParagraph",
 									},
 								},
@@ -10914,17 +11110,17 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraph",
-			pos:  position{line: 1503, col: 1, offset: 56986},
+			pos:  position{line: 1520, col: 1, offset: 59041},
 			expr: &actionExpr{
-				pos: position{line: 1503, col: 24, offset: 57009},
+				pos: position{line: 1520, col: 24, offset: 59064},
 				run: (*parser).callonVerseBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1503, col: 24, offset: 57009},
+					pos:   position{line: 1520, col: 24, offset: 59064},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1503, col: 30, offset: 57015},
+						pos: position{line: 1520, col: 30, offset: 59070},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1503, col: 31, offset: 57016},
+							pos:  position{line: 1520, col: 31, offset: 59071},
 							name: "VerseBlockParagraphLine",
 						},
 					},
@@ -10933,26 +11129,26 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraphLine",
-			pos:  position{line: 1507, col: 1, offset: 57106},
+			pos:  position{line: 1524, col: 1, offset: 59181},
 			expr: &actionExpr{
-				pos: position{line: 1507, col: 28, offset: 57133},
+				pos: position{line: 1524, col: 28, offset: 59208},
 				run: (*parser).callonVerseBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1507, col: 28, offset: 57133},
+					pos: position{line: 1524, col: 28, offset: 59208},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1507, col: 28, offset: 57133},
+							pos:   position{line: 1524, col: 28, offset: 59208},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1507, col: 37, offset: 57142},
+								pos: position{line: 1524, col: 37, offset: 59217},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1507, col: 38, offset: 57143},
+									pos:  position{line: 1524, col: 38, offset: 59218},
 									name: "InlineElement",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1507, col: 54, offset: 57159},
+							pos:  position{line: 1524, col: 54, offset: 59234},
 							name: "EOL",
 						},
 					},
@@ -10961,59 +11157,59 @@ var g = &grammar{
 		},
 		{
 			name: "Table",
-			pos:  position{line: 1514, col: 1, offset: 57401},
+			pos:  position{line: 1531, col: 1, offset: 59476},
 			expr: &actionExpr{
-				pos: position{line: 1514, col: 10, offset: 57410},
+				pos: position{line: 1531, col: 10, offset: 59485},
 				run: (*parser).callonTable1,
 				expr: &seqExpr{
-					pos: position{line: 1514, col: 10, offset: 57410},
+					pos: position{line: 1531, col: 10, offset: 59485},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1514, col: 10, offset: 57410},
+							pos:   position{line: 1531, col: 10, offset: 59485},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1514, col: 21, offset: 57421},
+								pos: position{line: 1531, col: 21, offset: 59496},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1514, col: 22, offset: 57422},
+									pos:  position{line: 1531, col: 22, offset: 59497},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1514, col: 35, offset: 57435},
+							pos:  position{line: 1531, col: 35, offset: 59510},
 							name: "TableDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1515, col: 5, offset: 57454},
+							pos:   position{line: 1532, col: 5, offset: 59529},
 							label: "header",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1515, col: 12, offset: 57461},
+								pos: position{line: 1532, col: 12, offset: 59536},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1515, col: 13, offset: 57462},
+									pos:  position{line: 1532, col: 13, offset: 59537},
 									name: "TableLineHeader",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1516, col: 5, offset: 57484},
+							pos:   position{line: 1533, col: 5, offset: 59559},
 							label: "lines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1516, col: 11, offset: 57490},
+								pos: position{line: 1533, col: 11, offset: 59565},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1516, col: 12, offset: 57491},
+									pos:  position{line: 1533, col: 12, offset: 59566},
 									name: "TableLine",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1517, col: 6, offset: 57508},
+							pos: position{line: 1534, col: 6, offset: 59583},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1517, col: 6, offset: 57508},
+									pos:  position{line: 1534, col: 6, offset: 59583},
 									name: "TableDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1517, col: 23, offset: 57525},
+									pos:  position{line: 1534, col: 23, offset: 59600},
 									name: "EOF",
 								},
 							},
@@ -11024,20 +11220,20 @@ var g = &grammar{
 		},
 		{
 			name: "TableCellSeparator",
-			pos:  position{line: 1521, col: 1, offset: 57640},
+			pos:  position{line: 1538, col: 1, offset: 59735},
 			expr: &seqExpr{
-				pos: position{line: 1521, col: 23, offset: 57662},
+				pos: position{line: 1538, col: 23, offset: 59757},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1521, col: 23, offset: 57662},
+						pos:        position{line: 1538, col: 23, offset: 59757},
 						val:        "|",
 						ignoreCase: false,
 						want:       "\"|\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1521, col: 27, offset: 57666},
+						pos: position{line: 1538, col: 27, offset: 59761},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1521, col: 27, offset: 57666},
+							pos:  position{line: 1538, col: 27, offset: 59761},
 							name: "Space",
 						},
 					},
@@ -11046,25 +11242,25 @@ var g = &grammar{
 		},
 		{
 			name: "TableDelimiter",
-			pos:  position{line: 1523, col: 1, offset: 57674},
+			pos:  position{line: 1540, col: 1, offset: 59769},
 			expr: &seqExpr{
-				pos: position{line: 1523, col: 19, offset: 57692},
+				pos: position{line: 1540, col: 19, offset: 59787},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1523, col: 19, offset: 57692},
+						pos:        position{line: 1540, col: 19, offset: 59787},
 						val:        "|===",
 						ignoreCase: false,
 						want:       "\"|===\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1523, col: 26, offset: 57699},
+						pos: position{line: 1540, col: 26, offset: 59794},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1523, col: 26, offset: 57699},
+							pos:  position{line: 1540, col: 26, offset: 59794},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1523, col: 33, offset: 57706},
+						pos:  position{line: 1540, col: 33, offset: 59801},
 						name: "EOL",
 					},
 				},
@@ -11072,37 +11268,37 @@ var g = &grammar{
 		},
 		{
 			name: "TableLineHeader",
-			pos:  position{line: 1526, col: 1, offset: 57774},
+			pos:  position{line: 1543, col: 1, offset: 59869},
 			expr: &actionExpr{
-				pos: position{line: 1526, col: 20, offset: 57793},
+				pos: position{line: 1543, col: 20, offset: 59888},
 				run: (*parser).callonTableLineHeader1,
 				expr: &seqExpr{
-					pos: position{line: 1526, col: 20, offset: 57793},
+					pos: position{line: 1543, col: 20, offset: 59888},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1526, col: 20, offset: 57793},
+							pos: position{line: 1543, col: 20, offset: 59888},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1526, col: 21, offset: 57794},
+								pos:  position{line: 1543, col: 21, offset: 59889},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1526, col: 36, offset: 57809},
+							pos:   position{line: 1543, col: 36, offset: 59904},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1526, col: 42, offset: 57815},
+								pos: position{line: 1543, col: 42, offset: 59910},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1526, col: 43, offset: 57816},
+									pos:  position{line: 1543, col: 43, offset: 59911},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1526, col: 55, offset: 57828},
+							pos:  position{line: 1543, col: 55, offset: 59923},
 							name: "EOL",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1526, col: 59, offset: 57832},
+							pos:  position{line: 1543, col: 59, offset: 59927},
 							name: "BlankLine",
 						},
 					},
@@ -11111,39 +11307,39 @@ var g = &grammar{
 		},
 		{
 			name: "TableLine",
-			pos:  position{line: 1530, col: 1, offset: 57900},
+			pos:  position{line: 1547, col: 1, offset: 59995},
 			expr: &actionExpr{
-				pos: position{line: 1530, col: 14, offset: 57913},
+				pos: position{line: 1547, col: 14, offset: 60008},
 				run: (*parser).callonTableLine1,
 				expr: &seqExpr{
-					pos: position{line: 1530, col: 14, offset: 57913},
+					pos: position{line: 1547, col: 14, offset: 60008},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1530, col: 14, offset: 57913},
+							pos: position{line: 1547, col: 14, offset: 60008},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1530, col: 15, offset: 57914},
+								pos:  position{line: 1547, col: 15, offset: 60009},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1530, col: 30, offset: 57929},
+							pos:   position{line: 1547, col: 30, offset: 60024},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1530, col: 36, offset: 57935},
+								pos: position{line: 1547, col: 36, offset: 60030},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1530, col: 37, offset: 57936},
+									pos:  position{line: 1547, col: 37, offset: 60031},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1530, col: 49, offset: 57948},
+							pos:  position{line: 1547, col: 49, offset: 60043},
 							name: "EOL",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1530, col: 53, offset: 57952},
+							pos: position{line: 1547, col: 53, offset: 60047},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1530, col: 53, offset: 57952},
+								pos:  position{line: 1547, col: 53, offset: 60047},
 								name: "BlankLine",
 							},
 						},
@@ -11153,54 +11349,54 @@ var g = &grammar{
 		},
 		{
 			name: "TableCell",
-			pos:  position{line: 1534, col: 1, offset: 58021},
+			pos:  position{line: 1551, col: 1, offset: 60116},
 			expr: &actionExpr{
-				pos: position{line: 1534, col: 14, offset: 58034},
+				pos: position{line: 1551, col: 14, offset: 60129},
 				run: (*parser).callonTableCell1,
 				expr: &seqExpr{
-					pos: position{line: 1534, col: 14, offset: 58034},
+					pos: position{line: 1551, col: 14, offset: 60129},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1534, col: 14, offset: 58034},
+							pos:  position{line: 1551, col: 14, offset: 60129},
 							name: "TableCellSeparator",
 						},
 						&labeledExpr{
-							pos:   position{line: 1534, col: 33, offset: 58053},
+							pos:   position{line: 1551, col: 33, offset: 60148},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1534, col: 42, offset: 58062},
+								pos: position{line: 1551, col: 42, offset: 60157},
 								expr: &seqExpr{
-									pos: position{line: 1534, col: 43, offset: 58063},
+									pos: position{line: 1551, col: 43, offset: 60158},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1534, col: 43, offset: 58063},
+											pos: position{line: 1551, col: 43, offset: 60158},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1534, col: 44, offset: 58064},
+												pos:  position{line: 1551, col: 44, offset: 60159},
 												name: "TableCellSeparator",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1534, col: 63, offset: 58083},
+											pos: position{line: 1551, col: 63, offset: 60178},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1534, col: 64, offset: 58084},
+												pos:  position{line: 1551, col: 64, offset: 60179},
 												name: "EOL",
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1534, col: 68, offset: 58088},
+											pos: position{line: 1551, col: 68, offset: 60183},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1534, col: 68, offset: 58088},
+												pos:  position{line: 1551, col: 68, offset: 60183},
 												name: "Space",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1534, col: 75, offset: 58095},
+											pos:  position{line: 1551, col: 75, offset: 60190},
 											name: "InlineElement",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1534, col: 89, offset: 58109},
+											pos: position{line: 1551, col: 89, offset: 60204},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1534, col: 89, offset: 58109},
+												pos:  position{line: 1551, col: 89, offset: 60204},
 												name: "Space",
 											},
 										},
@@ -11214,25 +11410,25 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockDelimiter",
-			pos:  position{line: 1541, col: 1, offset: 58358},
+			pos:  position{line: 1558, col: 1, offset: 60453},
 			expr: &seqExpr{
-				pos: position{line: 1541, col: 26, offset: 58383},
+				pos: position{line: 1558, col: 26, offset: 60478},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1541, col: 26, offset: 58383},
+						pos:        position{line: 1558, col: 26, offset: 60478},
 						val:        "////",
 						ignoreCase: false,
 						want:       "\"////\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1541, col: 33, offset: 58390},
+						pos: position{line: 1558, col: 33, offset: 60485},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1541, col: 33, offset: 58390},
+							pos:  position{line: 1558, col: 33, offset: 60485},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1541, col: 40, offset: 58397},
+						pos:  position{line: 1558, col: 40, offset: 60492},
 						name: "EOL",
 					},
 				},
@@ -11240,25 +11436,25 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockStartDelimiter",
-			pos:  position{line: 1543, col: 1, offset: 58402},
+			pos:  position{line: 1560, col: 1, offset: 60497},
 			expr: &seqExpr{
-				pos: position{line: 1543, col: 31, offset: 58432},
+				pos: position{line: 1560, col: 31, offset: 60527},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1543, col: 31, offset: 58432},
+						pos:        position{line: 1560, col: 31, offset: 60527},
 						val:        "////",
 						ignoreCase: false,
 						want:       "\"////\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1543, col: 38, offset: 58439},
+						pos: position{line: 1560, col: 38, offset: 60534},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1543, col: 38, offset: 58439},
+							pos:  position{line: 1560, col: 38, offset: 60534},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1543, col: 45, offset: 58446},
+						pos:  position{line: 1560, col: 45, offset: 60541},
 						name: "EOL",
 					},
 				},
@@ -11266,34 +11462,34 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockEndDelimiter",
-			pos:  position{line: 1545, col: 1, offset: 58451},
+			pos:  position{line: 1562, col: 1, offset: 60546},
 			expr: &choiceExpr{
-				pos: position{line: 1545, col: 29, offset: 58479},
+				pos: position{line: 1562, col: 29, offset: 60574},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1545, col: 30, offset: 58480},
+						pos: position{line: 1562, col: 30, offset: 60575},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1545, col: 30, offset: 58480},
+								pos:        position{line: 1562, col: 30, offset: 60575},
 								val:        "////",
 								ignoreCase: false,
 								want:       "\"////\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1545, col: 37, offset: 58487},
+								pos: position{line: 1562, col: 37, offset: 60582},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1545, col: 37, offset: 58487},
+									pos:  position{line: 1562, col: 37, offset: 60582},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1545, col: 44, offset: 58494},
+								pos:  position{line: 1562, col: 44, offset: 60589},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1545, col: 51, offset: 58501},
+						pos:  position{line: 1562, col: 51, offset: 60596},
 						name: "EOF",
 					},
 				},
@@ -11301,27 +11497,27 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlock",
-			pos:  position{line: 1547, col: 1, offset: 58506},
+			pos:  position{line: 1564, col: 1, offset: 60601},
 			expr: &actionExpr{
-				pos: position{line: 1547, col: 17, offset: 58522},
+				pos: position{line: 1564, col: 17, offset: 60617},
 				run: (*parser).callonCommentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1547, col: 17, offset: 58522},
+					pos: position{line: 1564, col: 17, offset: 60617},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1547, col: 17, offset: 58522},
+							pos:  position{line: 1564, col: 17, offset: 60617},
 							name: "CommentBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1547, col: 44, offset: 58549},
+							pos:   position{line: 1564, col: 44, offset: 60644},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1547, col: 53, offset: 58558},
+								pos:  position{line: 1564, col: 53, offset: 60653},
 								name: "CommentBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1547, col: 83, offset: 58588},
+							pos:  position{line: 1564, col: 83, offset: 60683},
 							name: "CommentBlockEndDelimiter",
 						},
 					},
@@ -11330,27 +11526,27 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockVerbatimContent",
-			pos:  position{line: 1551, col: 1, offset: 58698},
+			pos:  position{line: 1568, col: 1, offset: 60813},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1551, col: 32, offset: 58729},
+				pos: position{line: 1568, col: 32, offset: 60844},
 				expr: &actionExpr{
-					pos: position{line: 1551, col: 33, offset: 58730},
+					pos: position{line: 1568, col: 33, offset: 60845},
 					run: (*parser).callonCommentBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1551, col: 33, offset: 58730},
+						pos: position{line: 1568, col: 33, offset: 60845},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1551, col: 33, offset: 58730},
+								pos: position{line: 1568, col: 33, offset: 60845},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1551, col: 34, offset: 58731},
+									pos:  position{line: 1568, col: 34, offset: 60846},
 									name: "CommentBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1551, col: 59, offset: 58756},
+								pos:   position{line: 1568, col: 59, offset: 60871},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1551, col: 68, offset: 58765},
+									pos:  position{line: 1568, col: 68, offset: 60880},
 									name: "VerbatimContent",
 								},
 							},
@@ -11361,43 +11557,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1555, col: 1, offset: 58906},
+			pos:  position{line: 1572, col: 1, offset: 61021},
 			expr: &actionExpr{
-				pos: position{line: 1555, col: 22, offset: 58927},
+				pos: position{line: 1572, col: 22, offset: 61042},
 				run: (*parser).callonSingleLineComment1,
 				expr: &seqExpr{
-					pos: position{line: 1555, col: 22, offset: 58927},
+					pos: position{line: 1572, col: 22, offset: 61042},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1555, col: 22, offset: 58927},
+							pos: position{line: 1572, col: 22, offset: 61042},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1555, col: 23, offset: 58928},
+								pos:  position{line: 1572, col: 23, offset: 61043},
 								name: "CommentBlockDelimiter",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1555, col: 45, offset: 58950},
+							pos: position{line: 1572, col: 45, offset: 61065},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1555, col: 45, offset: 58950},
+								pos:  position{line: 1572, col: 45, offset: 61065},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1555, col: 52, offset: 58957},
+							pos:        position{line: 1572, col: 52, offset: 61072},
 							val:        "//",
 							ignoreCase: false,
 							want:       "\"//\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1555, col: 57, offset: 58962},
+							pos:   position{line: 1572, col: 57, offset: 61077},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1555, col: 66, offset: 58971},
+								pos:  position{line: 1572, col: 66, offset: 61086},
 								name: "SingleLineCommentContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1555, col: 92, offset: 58997},
+							pos:  position{line: 1572, col: 92, offset: 61112},
 							name: "EOL",
 						},
 					},
@@ -11406,14 +11602,14 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineCommentContent",
-			pos:  position{line: 1559, col: 1, offset: 59062},
+			pos:  position{line: 1576, col: 1, offset: 61197},
 			expr: &actionExpr{
-				pos: position{line: 1559, col: 29, offset: 59090},
+				pos: position{line: 1576, col: 29, offset: 61225},
 				run: (*parser).callonSingleLineCommentContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1559, col: 29, offset: 59090},
+					pos: position{line: 1576, col: 29, offset: 61225},
 					expr: &charClassMatcher{
-						pos:        position{line: 1559, col: 29, offset: 59090},
+						pos:        position{line: 1576, col: 29, offset: 61225},
 						val:        "[^\\r\\n]",
 						chars:      []rune{'\r', '\n'},
 						ignoreCase: false,
@@ -11424,20 +11620,20 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlock",
-			pos:  position{line: 1567, col: 1, offset: 59403},
+			pos:  position{line: 1584, col: 1, offset: 61538},
 			expr: &choiceExpr{
-				pos: position{line: 1567, col: 17, offset: 59419},
+				pos: position{line: 1584, col: 17, offset: 61554},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1567, col: 17, offset: 59419},
+						pos:  position{line: 1584, col: 17, offset: 61554},
 						name: "ParagraphWithLiteralAttribute",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1567, col: 49, offset: 59451},
+						pos:  position{line: 1584, col: 49, offset: 61586},
 						name: "ParagraphWithHeadingSpaces",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1567, col: 78, offset: 59480},
+						pos:  position{line: 1584, col: 78, offset: 61615},
 						name: "ParagraphWithLiteralBlockDelimiter",
 					},
 				},
@@ -11445,9 +11641,9 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlockDelimiter",
-			pos:  position{line: 1569, col: 1, offset: 59516},
+			pos:  position{line: 1586, col: 1, offset: 61651},
 			expr: &litMatcher{
-				pos:        position{line: 1569, col: 26, offset: 59541},
+				pos:        position{line: 1586, col: 26, offset: 61676},
 				val:        "....",
 				ignoreCase: false,
 				want:       "\"....\"",
@@ -11455,29 +11651,29 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithHeadingSpaces",
-			pos:  position{line: 1572, col: 1, offset: 59613},
+			pos:  position{line: 1589, col: 1, offset: 61748},
 			expr: &actionExpr{
-				pos: position{line: 1572, col: 31, offset: 59643},
+				pos: position{line: 1589, col: 31, offset: 61778},
 				run: (*parser).callonParagraphWithHeadingSpaces1,
 				expr: &seqExpr{
-					pos: position{line: 1572, col: 31, offset: 59643},
+					pos: position{line: 1589, col: 31, offset: 61778},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1572, col: 31, offset: 59643},
+							pos:   position{line: 1589, col: 31, offset: 61778},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1572, col: 42, offset: 59654},
+								pos: position{line: 1589, col: 42, offset: 61789},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1572, col: 43, offset: 59655},
+									pos:  position{line: 1589, col: 43, offset: 61790},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1572, col: 56, offset: 59668},
+							pos:   position{line: 1589, col: 56, offset: 61803},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1572, col: 63, offset: 59675},
+								pos:  position{line: 1589, col: 63, offset: 61810},
 								name: "ParagraphWithHeadingSpacesLines",
 							},
 						},
@@ -11487,33 +11683,33 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithHeadingSpacesLines",
-			pos:  position{line: 1577, col: 1, offset: 59905},
+			pos:  position{line: 1594, col: 1, offset: 62060},
 			expr: &actionExpr{
-				pos: position{line: 1578, col: 5, offset: 59945},
+				pos: position{line: 1595, col: 5, offset: 62100},
 				run: (*parser).callonParagraphWithHeadingSpacesLines1,
 				expr: &seqExpr{
-					pos: position{line: 1578, col: 5, offset: 59945},
+					pos: position{line: 1595, col: 5, offset: 62100},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1578, col: 5, offset: 59945},
+							pos:   position{line: 1595, col: 5, offset: 62100},
 							label: "firstLine",
 							expr: &actionExpr{
-								pos: position{line: 1578, col: 16, offset: 59956},
+								pos: position{line: 1595, col: 16, offset: 62111},
 								run: (*parser).callonParagraphWithHeadingSpacesLines4,
 								expr: &seqExpr{
-									pos: position{line: 1578, col: 16, offset: 59956},
+									pos: position{line: 1595, col: 16, offset: 62111},
 									exprs: []interface{}{
 										&oneOrMoreExpr{
-											pos: position{line: 1578, col: 16, offset: 59956},
+											pos: position{line: 1595, col: 16, offset: 62111},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1578, col: 16, offset: 59956},
+												pos:  position{line: 1595, col: 16, offset: 62111},
 												name: "Space",
 											},
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1578, col: 23, offset: 59963},
+											pos: position{line: 1595, col: 23, offset: 62118},
 											expr: &charClassMatcher{
-												pos:        position{line: 1578, col: 23, offset: 59963},
+												pos:        position{line: 1595, col: 23, offset: 62118},
 												val:        "[^\\r\\n]",
 												chars:      []rune{'\r', '\n'},
 												ignoreCase: false,
@@ -11525,37 +11721,37 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1580, col: 8, offset: 60016},
+							pos:  position{line: 1597, col: 8, offset: 62171},
 							name: "EOL",
 						},
 						&labeledExpr{
-							pos:   position{line: 1581, col: 5, offset: 60079},
+							pos:   position{line: 1598, col: 5, offset: 62234},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1581, col: 16, offset: 60090},
+								pos: position{line: 1598, col: 16, offset: 62245},
 								expr: &actionExpr{
-									pos: position{line: 1582, col: 9, offset: 60100},
+									pos: position{line: 1599, col: 9, offset: 62255},
 									run: (*parser).callonParagraphWithHeadingSpacesLines13,
 									expr: &seqExpr{
-										pos: position{line: 1582, col: 9, offset: 60100},
+										pos: position{line: 1599, col: 9, offset: 62255},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1582, col: 9, offset: 60100},
+												pos: position{line: 1599, col: 9, offset: 62255},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1582, col: 10, offset: 60101},
+													pos:  position{line: 1599, col: 10, offset: 62256},
 													name: "BlankLine",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1583, col: 9, offset: 60120},
+												pos:   position{line: 1600, col: 9, offset: 62275},
 												label: "otherLine",
 												expr: &actionExpr{
-													pos: position{line: 1583, col: 20, offset: 60131},
+													pos: position{line: 1600, col: 20, offset: 62286},
 													run: (*parser).callonParagraphWithHeadingSpacesLines18,
 													expr: &oneOrMoreExpr{
-														pos: position{line: 1583, col: 20, offset: 60131},
+														pos: position{line: 1600, col: 20, offset: 62286},
 														expr: &charClassMatcher{
-															pos:        position{line: 1583, col: 20, offset: 60131},
+															pos:        position{line: 1600, col: 20, offset: 62286},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -11565,7 +11761,7 @@ var g = &grammar{
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1585, col: 12, offset: 60192},
+												pos:  position{line: 1602, col: 12, offset: 62347},
 												name: "EOL",
 											},
 										},
@@ -11579,72 +11775,72 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiter",
-			pos:  position{line: 1592, col: 1, offset: 60422},
+			pos:  position{line: 1609, col: 1, offset: 62577},
 			expr: &actionExpr{
-				pos: position{line: 1592, col: 39, offset: 60460},
+				pos: position{line: 1609, col: 39, offset: 62615},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiter1,
 				expr: &seqExpr{
-					pos: position{line: 1592, col: 39, offset: 60460},
+					pos: position{line: 1609, col: 39, offset: 62615},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1592, col: 39, offset: 60460},
+							pos:   position{line: 1609, col: 39, offset: 62615},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1592, col: 50, offset: 60471},
+								pos: position{line: 1609, col: 50, offset: 62626},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1592, col: 51, offset: 60472},
+									pos:  position{line: 1609, col: 51, offset: 62627},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1593, col: 9, offset: 60493},
+							pos:  position{line: 1610, col: 9, offset: 62648},
 							name: "LiteralBlockDelimiter",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1593, col: 31, offset: 60515},
+							pos: position{line: 1610, col: 31, offset: 62670},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1593, col: 31, offset: 60515},
+								pos:  position{line: 1610, col: 31, offset: 62670},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1593, col: 38, offset: 60522},
+							pos:  position{line: 1610, col: 38, offset: 62677},
 							name: "Newline",
 						},
 						&labeledExpr{
-							pos:   position{line: 1593, col: 46, offset: 60530},
+							pos:   position{line: 1610, col: 46, offset: 62685},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1593, col: 53, offset: 60537},
+								pos:  position{line: 1610, col: 53, offset: 62692},
 								name: "ParagraphWithLiteralBlockDelimiterLines",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1593, col: 95, offset: 60579},
+							pos: position{line: 1610, col: 95, offset: 62734},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 1593, col: 96, offset: 60580},
+									pos: position{line: 1610, col: 96, offset: 62735},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1593, col: 96, offset: 60580},
+											pos:  position{line: 1610, col: 96, offset: 62735},
 											name: "LiteralBlockDelimiter",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1593, col: 118, offset: 60602},
+											pos: position{line: 1610, col: 118, offset: 62757},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1593, col: 118, offset: 60602},
+												pos:  position{line: 1610, col: 118, offset: 62757},
 												name: "Space",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1593, col: 125, offset: 60609},
+											pos:  position{line: 1610, col: 125, offset: 62764},
 											name: "EOL",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1593, col: 132, offset: 60616},
+									pos:  position{line: 1610, col: 132, offset: 62771},
 									name: "EOF",
 								},
 							},
@@ -11655,17 +11851,17 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLines",
-			pos:  position{line: 1598, col: 1, offset: 60775},
+			pos:  position{line: 1615, col: 1, offset: 62950},
 			expr: &actionExpr{
-				pos: position{line: 1598, col: 44, offset: 60818},
+				pos: position{line: 1615, col: 44, offset: 62993},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1598, col: 44, offset: 60818},
+					pos:   position{line: 1615, col: 44, offset: 62993},
 					label: "lines",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 1598, col: 50, offset: 60824},
+						pos: position{line: 1615, col: 50, offset: 62999},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1598, col: 51, offset: 60825},
+							pos:  position{line: 1615, col: 51, offset: 63000},
 							name: "ParagraphWithLiteralBlockDelimiterLine",
 						},
 					},
@@ -11674,33 +11870,33 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLine",
-			pos:  position{line: 1602, col: 1, offset: 60909},
+			pos:  position{line: 1619, col: 1, offset: 63084},
 			expr: &actionExpr{
-				pos: position{line: 1603, col: 5, offset: 60964},
+				pos: position{line: 1620, col: 5, offset: 63139},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLine1,
 				expr: &seqExpr{
-					pos: position{line: 1603, col: 5, offset: 60964},
+					pos: position{line: 1620, col: 5, offset: 63139},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1603, col: 5, offset: 60964},
+							pos:   position{line: 1620, col: 5, offset: 63139},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1603, col: 11, offset: 60970},
+								pos: position{line: 1620, col: 11, offset: 63145},
 								run: (*parser).callonParagraphWithLiteralBlockDelimiterLine4,
 								expr: &seqExpr{
-									pos: position{line: 1603, col: 11, offset: 60970},
+									pos: position{line: 1620, col: 11, offset: 63145},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1603, col: 11, offset: 60970},
+											pos: position{line: 1620, col: 11, offset: 63145},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1603, col: 12, offset: 60971},
+												pos:  position{line: 1620, col: 12, offset: 63146},
 												name: "LiteralBlockDelimiter",
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1603, col: 34, offset: 60993},
+											pos: position{line: 1620, col: 34, offset: 63168},
 											expr: &charClassMatcher{
-												pos:        position{line: 1603, col: 34, offset: 60993},
+												pos:        position{line: 1620, col: 34, offset: 63168},
 												val:        "[^\\r\\n]",
 												chars:      []rune{'\r', '\n'},
 												ignoreCase: false,
@@ -11712,7 +11908,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1605, col: 8, offset: 61046},
+							pos:  position{line: 1622, col: 8, offset: 63221},
 							name: "EOL",
 						},
 					},
@@ -11721,33 +11917,33 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttribute",
-			pos:  position{line: 1610, col: 1, offset: 61172},
+			pos:  position{line: 1627, col: 1, offset: 63347},
 			expr: &actionExpr{
-				pos: position{line: 1611, col: 5, offset: 61210},
+				pos: position{line: 1628, col: 5, offset: 63385},
 				run: (*parser).callonParagraphWithLiteralAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 1611, col: 5, offset: 61210},
+					pos: position{line: 1628, col: 5, offset: 63385},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1611, col: 5, offset: 61210},
+							pos:   position{line: 1628, col: 5, offset: 63385},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1611, col: 16, offset: 61221},
+								pos: position{line: 1628, col: 16, offset: 63396},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1611, col: 17, offset: 61222},
+									pos:  position{line: 1628, col: 17, offset: 63397},
 									name: "Attributes",
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 1612, col: 5, offset: 61239},
+							pos: position{line: 1629, col: 5, offset: 63414},
 							run: (*parser).callonParagraphWithLiteralAttribute6,
 						},
 						&labeledExpr{
-							pos:   position{line: 1619, col: 5, offset: 61446},
+							pos:   position{line: 1636, col: 5, offset: 63621},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1619, col: 12, offset: 61453},
+								pos:  position{line: 1636, col: 12, offset: 63628},
 								name: "ParagraphWithLiteralAttributeLines",
 							},
 						},
@@ -11757,12 +11953,12 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralKind",
-			pos:  position{line: 1623, col: 1, offset: 61603},
+			pos:  position{line: 1640, col: 1, offset: 63798},
 			expr: &actionExpr{
-				pos: position{line: 1623, col: 16, offset: 61618},
+				pos: position{line: 1640, col: 16, offset: 63813},
 				run: (*parser).callonLiteralKind1,
 				expr: &litMatcher{
-					pos:        position{line: 1623, col: 16, offset: 61618},
+					pos:        position{line: 1640, col: 16, offset: 63813},
 					val:        "literal",
 					ignoreCase: false,
 					want:       "\"literal\"",
@@ -11771,17 +11967,17 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttributeLines",
-			pos:  position{line: 1628, col: 1, offset: 61701},
+			pos:  position{line: 1645, col: 1, offset: 63896},
 			expr: &actionExpr{
-				pos: position{line: 1628, col: 39, offset: 61739},
+				pos: position{line: 1645, col: 39, offset: 63934},
 				run: (*parser).callonParagraphWithLiteralAttributeLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1628, col: 39, offset: 61739},
+					pos:   position{line: 1645, col: 39, offset: 63934},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1628, col: 45, offset: 61745},
+						pos: position{line: 1645, col: 45, offset: 63940},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1628, col: 46, offset: 61746},
+							pos:  position{line: 1645, col: 46, offset: 63941},
 							name: "ParagraphWithLiteralAttributeLine",
 						},
 					},
@@ -11790,30 +11986,30 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttributeLine",
-			pos:  position{line: 1632, col: 1, offset: 61826},
+			pos:  position{line: 1649, col: 1, offset: 64021},
 			expr: &actionExpr{
-				pos: position{line: 1632, col: 38, offset: 61863},
+				pos: position{line: 1649, col: 38, offset: 64058},
 				run: (*parser).callonParagraphWithLiteralAttributeLine1,
 				expr: &seqExpr{
-					pos: position{line: 1632, col: 38, offset: 61863},
+					pos: position{line: 1649, col: 38, offset: 64058},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1632, col: 38, offset: 61863},
+							pos: position{line: 1649, col: 38, offset: 64058},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1632, col: 39, offset: 61864},
+								pos:  position{line: 1649, col: 39, offset: 64059},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1632, col: 49, offset: 61874},
+							pos:   position{line: 1649, col: 49, offset: 64069},
 							label: "content",
 							expr: &actionExpr{
-								pos: position{line: 1632, col: 58, offset: 61883},
+								pos: position{line: 1649, col: 58, offset: 64078},
 								run: (*parser).callonParagraphWithLiteralAttributeLine6,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1632, col: 58, offset: 61883},
+									pos: position{line: 1649, col: 58, offset: 64078},
 									expr: &charClassMatcher{
-										pos:        position{line: 1632, col: 58, offset: 61883},
+										pos:        position{line: 1649, col: 58, offset: 64078},
 										val:        "[^\\r\\n]",
 										chars:      []rune{'\r', '\n'},
 										ignoreCase: false,
@@ -11823,7 +12019,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1634, col: 4, offset: 61928},
+							pos:  position{line: 1651, col: 4, offset: 64123},
 							name: "EOL",
 						},
 					},
@@ -11832,29 +12028,29 @@ var g = &grammar{
 		},
 		{
 			name: "IndexTerm",
-			pos:  position{line: 1641, col: 1, offset: 62114},
+			pos:  position{line: 1658, col: 1, offset: 64309},
 			expr: &actionExpr{
-				pos: position{line: 1641, col: 14, offset: 62127},
+				pos: position{line: 1658, col: 14, offset: 64322},
 				run: (*parser).callonIndexTerm1,
 				expr: &seqExpr{
-					pos: position{line: 1641, col: 14, offset: 62127},
+					pos: position{line: 1658, col: 14, offset: 64322},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1641, col: 14, offset: 62127},
+							pos:        position{line: 1658, col: 14, offset: 64322},
 							val:        "((",
 							ignoreCase: false,
 							want:       "\"((\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1641, col: 19, offset: 62132},
+							pos:   position{line: 1658, col: 19, offset: 64327},
 							label: "term",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1641, col: 25, offset: 62138},
+								pos:  position{line: 1658, col: 25, offset: 64333},
 								name: "IndexTermContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1641, col: 43, offset: 62156},
+							pos:        position{line: 1658, col: 43, offset: 64351},
 							val:        "))",
 							ignoreCase: false,
 							want:       "\"))\"",
@@ -11865,47 +12061,47 @@ var g = &grammar{
 		},
 		{
 			name: "IndexTermContent",
-			pos:  position{line: 1645, col: 1, offset: 62221},
+			pos:  position{line: 1662, col: 1, offset: 64436},
 			expr: &actionExpr{
-				pos: position{line: 1645, col: 21, offset: 62241},
+				pos: position{line: 1662, col: 21, offset: 64456},
 				run: (*parser).callonIndexTermContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 1645, col: 21, offset: 62241},
+					pos:   position{line: 1662, col: 21, offset: 64456},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1645, col: 30, offset: 62250},
+						pos: position{line: 1662, col: 30, offset: 64465},
 						expr: &choiceExpr{
-							pos: position{line: 1645, col: 31, offset: 62251},
+							pos: position{line: 1662, col: 31, offset: 64466},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1645, col: 31, offset: 62251},
+									pos:  position{line: 1662, col: 31, offset: 64466},
 									name: "Word",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1645, col: 38, offset: 62258},
+									pos:  position{line: 1662, col: 38, offset: 64473},
 									name: "QuotedText",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1645, col: 51, offset: 62271},
+									pos:  position{line: 1662, col: 51, offset: 64486},
 									name: "Space",
 								},
 								&actionExpr{
-									pos: position{line: 1645, col: 59, offset: 62279},
+									pos: position{line: 1662, col: 59, offset: 64494},
 									run: (*parser).callonIndexTermContent8,
 									expr: &seqExpr{
-										pos: position{line: 1645, col: 60, offset: 62280},
+										pos: position{line: 1662, col: 60, offset: 64495},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1645, col: 60, offset: 62280},
+												pos: position{line: 1662, col: 60, offset: 64495},
 												expr: &litMatcher{
-													pos:        position{line: 1645, col: 61, offset: 62281},
+													pos:        position{line: 1662, col: 61, offset: 64496},
 													val:        "))",
 													ignoreCase: false,
 													want:       "\"))\"",
 												},
 											},
 											&anyMatcher{
-												line: 1645, col: 66, offset: 62286,
+												line: 1662, col: 66, offset: 64501,
 											},
 										},
 									},
@@ -11918,63 +12114,63 @@ var g = &grammar{
 		},
 		{
 			name: "ConcealedIndexTerm",
-			pos:  position{line: 1651, col: 1, offset: 62392},
+			pos:  position{line: 1668, col: 1, offset: 64607},
 			expr: &actionExpr{
-				pos: position{line: 1651, col: 23, offset: 62414},
+				pos: position{line: 1668, col: 23, offset: 64629},
 				run: (*parser).callonConcealedIndexTerm1,
 				expr: &seqExpr{
-					pos: position{line: 1651, col: 23, offset: 62414},
+					pos: position{line: 1668, col: 23, offset: 64629},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1651, col: 23, offset: 62414},
+							pos:        position{line: 1668, col: 23, offset: 64629},
 							val:        "(((",
 							ignoreCase: false,
 							want:       "\"(((\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1651, col: 29, offset: 62420},
+							pos:   position{line: 1668, col: 29, offset: 64635},
 							label: "term1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1651, col: 36, offset: 62427},
+								pos:  position{line: 1668, col: 36, offset: 64642},
 								name: "ConcealedIndexTermContent",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1652, col: 5, offset: 62459},
+							pos:   position{line: 1669, col: 5, offset: 64674},
 							label: "term2",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1652, col: 11, offset: 62465},
+								pos: position{line: 1669, col: 11, offset: 64680},
 								expr: &actionExpr{
-									pos: position{line: 1652, col: 12, offset: 62466},
+									pos: position{line: 1669, col: 12, offset: 64681},
 									run: (*parser).callonConcealedIndexTerm8,
 									expr: &seqExpr{
-										pos: position{line: 1652, col: 12, offset: 62466},
+										pos: position{line: 1669, col: 12, offset: 64681},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 1652, col: 12, offset: 62466},
+												pos: position{line: 1669, col: 12, offset: 64681},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1652, col: 12, offset: 62466},
+													pos:  position{line: 1669, col: 12, offset: 64681},
 													name: "Space",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 1652, col: 19, offset: 62473},
+												pos:        position{line: 1669, col: 19, offset: 64688},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 1652, col: 23, offset: 62477},
+												pos: position{line: 1669, col: 23, offset: 64692},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1652, col: 23, offset: 62477},
+													pos:  position{line: 1669, col: 23, offset: 64692},
 													name: "Space",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1652, col: 30, offset: 62484},
+												pos:   position{line: 1669, col: 30, offset: 64699},
 												label: "content",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1652, col: 39, offset: 62493},
+													pos:  position{line: 1669, col: 39, offset: 64708},
 													name: "ConcealedIndexTermContent",
 												},
 											},
@@ -11984,41 +12180,41 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1653, col: 5, offset: 62551},
+							pos:   position{line: 1670, col: 5, offset: 64766},
 							label: "term3",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1653, col: 11, offset: 62557},
+								pos: position{line: 1670, col: 11, offset: 64772},
 								expr: &actionExpr{
-									pos: position{line: 1653, col: 12, offset: 62558},
+									pos: position{line: 1670, col: 12, offset: 64773},
 									run: (*parser).callonConcealedIndexTerm19,
 									expr: &seqExpr{
-										pos: position{line: 1653, col: 12, offset: 62558},
+										pos: position{line: 1670, col: 12, offset: 64773},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 1653, col: 12, offset: 62558},
+												pos: position{line: 1670, col: 12, offset: 64773},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1653, col: 12, offset: 62558},
+													pos:  position{line: 1670, col: 12, offset: 64773},
 													name: "Space",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 1653, col: 19, offset: 62565},
+												pos:        position{line: 1670, col: 19, offset: 64780},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 1653, col: 23, offset: 62569},
+												pos: position{line: 1670, col: 23, offset: 64784},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1653, col: 23, offset: 62569},
+													pos:  position{line: 1670, col: 23, offset: 64784},
 													name: "Space",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1653, col: 30, offset: 62576},
+												pos:   position{line: 1670, col: 30, offset: 64791},
 												label: "content",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1653, col: 39, offset: 62585},
+													pos:  position{line: 1670, col: 39, offset: 64800},
 													name: "ConcealedIndexTermContent",
 												},
 											},
@@ -12028,7 +12224,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1654, col: 5, offset: 62643},
+							pos:        position{line: 1671, col: 5, offset: 64858},
 							val:        ")))",
 							ignoreCase: false,
 							want:       "\")))\"",
@@ -12039,21 +12235,21 @@ var g = &grammar{
 		},
 		{
 			name: "ConcealedIndexTermContent",
-			pos:  position{line: 1658, col: 1, offset: 62722},
+			pos:  position{line: 1675, col: 1, offset: 64957},
 			expr: &actionExpr{
-				pos: position{line: 1658, col: 30, offset: 62751},
+				pos: position{line: 1675, col: 30, offset: 64986},
 				run: (*parser).callonConcealedIndexTermContent1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1658, col: 30, offset: 62751},
+					pos: position{line: 1675, col: 30, offset: 64986},
 					expr: &choiceExpr{
-						pos: position{line: 1658, col: 31, offset: 62752},
+						pos: position{line: 1675, col: 31, offset: 64987},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1658, col: 31, offset: 62752},
+								pos:  position{line: 1675, col: 31, offset: 64987},
 								name: "Alphanum",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1658, col: 42, offset: 62763},
+								pos:  position{line: 1675, col: 42, offset: 64998},
 								name: "Space",
 							},
 						},
@@ -12063,29 +12259,29 @@ var g = &grammar{
 		},
 		{
 			name: "BlankLine",
-			pos:  position{line: 1665, col: 1, offset: 62912},
+			pos:  position{line: 1682, col: 1, offset: 65147},
 			expr: &actionExpr{
-				pos: position{line: 1665, col: 14, offset: 62925},
+				pos: position{line: 1682, col: 14, offset: 65160},
 				run: (*parser).callonBlankLine1,
 				expr: &seqExpr{
-					pos: position{line: 1665, col: 14, offset: 62925},
+					pos: position{line: 1682, col: 14, offset: 65160},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1665, col: 14, offset: 62925},
+							pos: position{line: 1682, col: 14, offset: 65160},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1665, col: 15, offset: 62926},
+								pos:  position{line: 1682, col: 15, offset: 65161},
 								name: "EOF",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1665, col: 19, offset: 62930},
+							pos: position{line: 1682, col: 19, offset: 65165},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1665, col: 19, offset: 62930},
+								pos:  position{line: 1682, col: 19, offset: 65165},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1665, col: 26, offset: 62937},
+							pos:  position{line: 1682, col: 26, offset: 65172},
 							name: "EOL",
 						},
 					},
@@ -12094,9 +12290,9 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanum",
-			pos:  position{line: 1672, col: 1, offset: 63084},
+			pos:  position{line: 1689, col: 1, offset: 65337},
 			expr: &charClassMatcher{
-				pos:        position{line: 1672, col: 13, offset: 63096},
+				pos:        position{line: 1689, col: 13, offset: 65349},
 				val:        "[\\pL0-9]",
 				ranges:     []rune{'0', '9'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -12106,42 +12302,42 @@ var g = &grammar{
 		},
 		{
 			name: "Parenthesis",
-			pos:  position{line: 1674, col: 1, offset: 63106},
+			pos:  position{line: 1691, col: 1, offset: 65359},
 			expr: &choiceExpr{
-				pos: position{line: 1674, col: 16, offset: 63121},
+				pos: position{line: 1691, col: 16, offset: 65374},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1674, col: 16, offset: 63121},
+						pos:        position{line: 1691, col: 16, offset: 65374},
 						val:        "(",
 						ignoreCase: false,
 						want:       "\"(\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1674, col: 22, offset: 63127},
+						pos:        position{line: 1691, col: 22, offset: 65380},
 						val:        ")",
 						ignoreCase: false,
 						want:       "\")\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1674, col: 28, offset: 63133},
+						pos:        position{line: 1691, col: 28, offset: 65386},
 						val:        "[",
 						ignoreCase: false,
 						want:       "\"[\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1674, col: 34, offset: 63139},
+						pos:        position{line: 1691, col: 34, offset: 65392},
 						val:        "]",
 						ignoreCase: false,
 						want:       "\"]\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1674, col: 40, offset: 63145},
+						pos:        position{line: 1691, col: 40, offset: 65398},
 						val:        "{",
 						ignoreCase: false,
 						want:       "\"{\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1674, col: 46, offset: 63151},
+						pos:        position{line: 1691, col: 46, offset: 65404},
 						val:        "}",
 						ignoreCase: false,
 						want:       "\"}\"",
@@ -12151,14 +12347,14 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanums",
-			pos:  position{line: 1676, col: 1, offset: 63157},
+			pos:  position{line: 1693, col: 1, offset: 65410},
 			expr: &actionExpr{
-				pos: position{line: 1676, col: 14, offset: 63170},
+				pos: position{line: 1693, col: 14, offset: 65423},
 				run: (*parser).callonAlphanums1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1676, col: 14, offset: 63170},
+					pos: position{line: 1693, col: 14, offset: 65423},
 					expr: &charClassMatcher{
-						pos:        position{line: 1676, col: 14, offset: 63170},
+						pos:        position{line: 1693, col: 14, offset: 65423},
 						val:        "[\\pL0-9]",
 						ranges:     []rune{'0', '9'},
 						classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -12170,20 +12366,20 @@ var g = &grammar{
 		},
 		{
 			name: "Word",
-			pos:  position{line: 1680, col: 1, offset: 63216},
+			pos:  position{line: 1697, col: 1, offset: 65469},
 			expr: &choiceExpr{
-				pos: position{line: 1684, col: 5, offset: 63544},
+				pos: position{line: 1701, col: 5, offset: 65797},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1684, col: 5, offset: 63544},
+						pos: position{line: 1701, col: 5, offset: 65797},
 						run: (*parser).callonWord2,
 						expr: &seqExpr{
-							pos: position{line: 1684, col: 5, offset: 63544},
+							pos: position{line: 1701, col: 5, offset: 65797},
 							exprs: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 1684, col: 5, offset: 63544},
+									pos: position{line: 1701, col: 5, offset: 65797},
 									expr: &charClassMatcher{
-										pos:        position{line: 1684, col: 5, offset: 63544},
+										pos:        position{line: 1701, col: 5, offset: 65797},
 										val:        "[\\pL0-9]",
 										ranges:     []rune{'0', '9'},
 										classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -12192,19 +12388,19 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 1684, col: 15, offset: 63554},
+									pos: position{line: 1701, col: 15, offset: 65807},
 									expr: &choiceExpr{
-										pos: position{line: 1684, col: 17, offset: 63556},
+										pos: position{line: 1701, col: 17, offset: 65809},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 1684, col: 17, offset: 63556},
+												pos:        position{line: 1701, col: 17, offset: 65809},
 												val:        "[\\r\\n ,\\]]",
 												chars:      []rune{'\r', '\n', ' ', ',', ']'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1684, col: 30, offset: 63569},
+												pos:  position{line: 1701, col: 30, offset: 65822},
 												name: "EOF",
 											},
 										},
@@ -12214,15 +12410,15 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1686, col: 9, offset: 63639},
+						pos: position{line: 1703, col: 9, offset: 65892},
 						run: (*parser).callonWord10,
 						expr: &seqExpr{
-							pos: position{line: 1686, col: 9, offset: 63639},
+							pos: position{line: 1703, col: 9, offset: 65892},
 							exprs: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 1686, col: 9, offset: 63639},
+									pos: position{line: 1703, col: 9, offset: 65892},
 									expr: &charClassMatcher{
-										pos:        position{line: 1686, col: 9, offset: 63639},
+										pos:        position{line: 1703, col: 9, offset: 65892},
 										val:        "[\\pL0-9]",
 										ranges:     []rune{'0', '9'},
 										classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -12231,21 +12427,21 @@ var g = &grammar{
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1686, col: 19, offset: 63649},
+									pos: position{line: 1703, col: 19, offset: 65902},
 									expr: &seqExpr{
-										pos: position{line: 1686, col: 20, offset: 63650},
+										pos: position{line: 1703, col: 20, offset: 65903},
 										exprs: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 1686, col: 20, offset: 63650},
+												pos:        position{line: 1703, col: 20, offset: 65903},
 												val:        "[=*_`]",
 												chars:      []rune{'=', '*', '_', '`'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&oneOrMoreExpr{
-												pos: position{line: 1686, col: 27, offset: 63657},
+												pos: position{line: 1703, col: 27, offset: 65910},
 												expr: &charClassMatcher{
-													pos:        position{line: 1686, col: 27, offset: 63657},
+													pos:        position{line: 1703, col: 27, offset: 65910},
 													val:        "[\\pL0-9]",
 													ranges:     []rune{'0', '9'},
 													classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -12264,20 +12460,20 @@ var g = &grammar{
 		},
 		{
 			name: "InlineWord",
-			pos:  position{line: 1690, col: 1, offset: 63733},
+			pos:  position{line: 1707, col: 1, offset: 65986},
 			expr: &choiceExpr{
-				pos: position{line: 1691, col: 5, offset: 63814},
+				pos: position{line: 1708, col: 5, offset: 66067},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1691, col: 5, offset: 63814},
+						pos: position{line: 1708, col: 5, offset: 66067},
 						run: (*parser).callonInlineWord2,
 						expr: &seqExpr{
-							pos: position{line: 1691, col: 5, offset: 63814},
+							pos: position{line: 1708, col: 5, offset: 66067},
 							exprs: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 1691, col: 5, offset: 63814},
+									pos: position{line: 1708, col: 5, offset: 66067},
 									expr: &charClassMatcher{
-										pos:        position{line: 1691, col: 5, offset: 63814},
+										pos:        position{line: 1708, col: 5, offset: 66067},
 										val:        "[\\pL0-9,.?!;]",
 										chars:      []rune{',', '.', '?', '!', ';'},
 										ranges:     []rune{'0', '9'},
@@ -12287,19 +12483,19 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 1691, col: 20, offset: 63829},
+									pos: position{line: 1708, col: 20, offset: 66082},
 									expr: &choiceExpr{
-										pos: position{line: 1691, col: 22, offset: 63831},
+										pos: position{line: 1708, col: 22, offset: 66084},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 1691, col: 22, offset: 63831},
+												pos:        position{line: 1708, col: 22, offset: 66084},
 												val:        "[\\r\\n ]",
 												chars:      []rune{'\r', '\n', ' '},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1691, col: 32, offset: 63841},
+												pos:  position{line: 1708, col: 32, offset: 66094},
 												name: "EOF",
 											},
 										},
@@ -12309,7 +12505,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1693, col: 9, offset: 63911},
+						pos:  position{line: 1710, col: 9, offset: 66164},
 						name: "Word",
 					},
 				},
@@ -12317,12 +12513,12 @@ var g = &grammar{
 		},
 		{
 			name: "AnyChar",
-			pos:  position{line: 1696, col: 1, offset: 64011},
+			pos:  position{line: 1713, col: 1, offset: 66264},
 			expr: &actionExpr{
-				pos: position{line: 1696, col: 12, offset: 64022},
+				pos: position{line: 1713, col: 12, offset: 66275},
 				run: (*parser).callonAnyChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1696, col: 12, offset: 64022},
+					pos:        position{line: 1713, col: 12, offset: 66275},
 					val:        "[^\\r\\n]",
 					chars:      []rune{'\r', '\n'},
 					ignoreCase: false,
@@ -12332,24 +12528,24 @@ var g = &grammar{
 		},
 		{
 			name: "FileLocation",
-			pos:  position{line: 1700, col: 1, offset: 64087},
+			pos:  position{line: 1717, col: 1, offset: 66340},
 			expr: &actionExpr{
-				pos: position{line: 1700, col: 17, offset: 64103},
+				pos: position{line: 1717, col: 17, offset: 66356},
 				run: (*parser).callonFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1700, col: 17, offset: 64103},
+					pos:   position{line: 1717, col: 17, offset: 66356},
 					label: "path",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1700, col: 22, offset: 64108},
+						pos: position{line: 1717, col: 22, offset: 66361},
 						expr: &choiceExpr{
-							pos: position{line: 1700, col: 23, offset: 64109},
+							pos: position{line: 1717, col: 23, offset: 66362},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1700, col: 23, offset: 64109},
+									pos:  position{line: 1717, col: 23, offset: 66362},
 									name: "FILENAME",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1700, col: 34, offset: 64120},
+									pos:  position{line: 1717, col: 34, offset: 66373},
 									name: "AttributeSubstitution",
 								},
 							},
@@ -12360,17 +12556,17 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedFileLocation",
-			pos:  position{line: 1704, col: 1, offset: 64204},
+			pos:  position{line: 1721, col: 1, offset: 66457},
 			expr: &actionExpr{
-				pos: position{line: 1704, col: 25, offset: 64228},
+				pos: position{line: 1721, col: 25, offset: 66481},
 				run: (*parser).callonResolvedFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1704, col: 25, offset: 64228},
+					pos:   position{line: 1721, col: 25, offset: 66481},
 					label: "path",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1704, col: 30, offset: 64233},
+						pos: position{line: 1721, col: 30, offset: 66486},
 						expr: &charClassMatcher{
-							pos:        position{line: 1704, col: 31, offset: 64234},
+							pos:        position{line: 1721, col: 31, offset: 66487},
 							val:        "[^\\r\\n []",
 							chars:      []rune{'\r', '\n', ' ', '['},
 							ignoreCase: false,
@@ -12382,38 +12578,38 @@ var g = &grammar{
 		},
 		{
 			name: "Location",
-			pos:  position{line: 1708, col: 1, offset: 64306},
+			pos:  position{line: 1725, col: 1, offset: 66559},
 			expr: &actionExpr{
-				pos: position{line: 1708, col: 13, offset: 64318},
+				pos: position{line: 1725, col: 13, offset: 66571},
 				run: (*parser).callonLocation1,
 				expr: &seqExpr{
-					pos: position{line: 1708, col: 13, offset: 64318},
+					pos: position{line: 1725, col: 13, offset: 66571},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1708, col: 13, offset: 64318},
+							pos:   position{line: 1725, col: 13, offset: 66571},
 							label: "scheme",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1708, col: 20, offset: 64325},
+								pos: position{line: 1725, col: 20, offset: 66578},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1708, col: 21, offset: 64326},
+									pos:  position{line: 1725, col: 21, offset: 66579},
 									name: "URL_SCHEME",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1708, col: 34, offset: 64339},
+							pos:   position{line: 1725, col: 34, offset: 66592},
 							label: "path",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1708, col: 39, offset: 64344},
+								pos: position{line: 1725, col: 39, offset: 66597},
 								expr: &choiceExpr{
-									pos: position{line: 1708, col: 40, offset: 64345},
+									pos: position{line: 1725, col: 40, offset: 66598},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1708, col: 40, offset: 64345},
+											pos:  position{line: 1725, col: 40, offset: 66598},
 											name: "FILENAME",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1708, col: 51, offset: 64356},
+											pos:  position{line: 1725, col: 51, offset: 66609},
 											name: "AttributeSubstitution",
 										},
 									},
@@ -12426,35 +12622,35 @@ var g = &grammar{
 		},
 		{
 			name: "LocationWithScheme",
-			pos:  position{line: 1712, col: 1, offset: 64444},
+			pos:  position{line: 1729, col: 1, offset: 66697},
 			expr: &actionExpr{
-				pos: position{line: 1712, col: 23, offset: 64466},
+				pos: position{line: 1729, col: 23, offset: 66719},
 				run: (*parser).callonLocationWithScheme1,
 				expr: &seqExpr{
-					pos: position{line: 1712, col: 23, offset: 64466},
+					pos: position{line: 1729, col: 23, offset: 66719},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1712, col: 23, offset: 64466},
+							pos:   position{line: 1729, col: 23, offset: 66719},
 							label: "scheme",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1712, col: 31, offset: 64474},
+								pos:  position{line: 1729, col: 31, offset: 66727},
 								name: "URL_SCHEME",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1712, col: 43, offset: 64486},
+							pos:   position{line: 1729, col: 43, offset: 66739},
 							label: "path",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1712, col: 48, offset: 64491},
+								pos: position{line: 1729, col: 48, offset: 66744},
 								expr: &choiceExpr{
-									pos: position{line: 1712, col: 49, offset: 64492},
+									pos: position{line: 1729, col: 49, offset: 66745},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1712, col: 49, offset: 64492},
+											pos:  position{line: 1729, col: 49, offset: 66745},
 											name: "FILENAME",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1712, col: 60, offset: 64503},
+											pos:  position{line: 1729, col: 60, offset: 66756},
 											name: "AttributeSubstitution",
 										},
 									},
@@ -12467,11 +12663,11 @@ var g = &grammar{
 		},
 		{
 			name: "FILENAME",
-			pos:  position{line: 1716, col: 1, offset: 64591},
+			pos:  position{line: 1733, col: 1, offset: 66844},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1716, col: 13, offset: 64603},
+				pos: position{line: 1733, col: 13, offset: 66856},
 				expr: &charClassMatcher{
-					pos:        position{line: 1716, col: 14, offset: 64604},
+					pos:        position{line: 1733, col: 14, offset: 66857},
 					val:        "[^\\r\\n{}[\\] ]",
 					chars:      []rune{'\r', '\n', '{', '}', '[', ']', ' '},
 					ignoreCase: false,
@@ -12481,26 +12677,26 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLocation",
-			pos:  position{line: 1718, col: 1, offset: 64738},
+			pos:  position{line: 1735, col: 1, offset: 66991},
 			expr: &actionExpr{
-				pos: position{line: 1718, col: 21, offset: 64758},
+				pos: position{line: 1735, col: 21, offset: 67011},
 				run: (*parser).callonResolvedLocation1,
 				expr: &seqExpr{
-					pos: position{line: 1718, col: 21, offset: 64758},
+					pos: position{line: 1735, col: 21, offset: 67011},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1718, col: 21, offset: 64758},
+							pos:   position{line: 1735, col: 21, offset: 67011},
 							label: "scheme",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1718, col: 29, offset: 64766},
+								pos:  position{line: 1735, col: 29, offset: 67019},
 								name: "URL_SCHEME",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1718, col: 41, offset: 64778},
+							pos:   position{line: 1735, col: 41, offset: 67031},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1718, col: 47, offset: 64784},
+								pos:  position{line: 1735, col: 47, offset: 67037},
 								name: "RESOLVED_FILENAME",
 							},
 						},
@@ -12510,11 +12706,11 @@ var g = &grammar{
 		},
 		{
 			name: "RESOLVED_FILENAME",
-			pos:  position{line: 1723, col: 1, offset: 65032},
+			pos:  position{line: 1740, col: 1, offset: 67285},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1723, col: 22, offset: 65053},
+				pos: position{line: 1740, col: 22, offset: 67306},
 				expr: &charClassMatcher{
-					pos:        position{line: 1723, col: 23, offset: 65054},
+					pos:        position{line: 1740, col: 23, offset: 67307},
 					val:        "[^\\r\\n[\\] ]",
 					chars:      []rune{'\r', '\n', '[', ']', ' '},
 					ignoreCase: false,
@@ -12524,14 +12720,14 @@ var g = &grammar{
 		},
 		{
 			name: "URL",
-			pos:  position{line: 1725, col: 1, offset: 65186},
+			pos:  position{line: 1742, col: 1, offset: 67439},
 			expr: &actionExpr{
-				pos: position{line: 1725, col: 9, offset: 65194},
+				pos: position{line: 1742, col: 9, offset: 67447},
 				run: (*parser).callonURL1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1725, col: 9, offset: 65194},
+					pos: position{line: 1742, col: 9, offset: 67447},
 					expr: &charClassMatcher{
-						pos:        position{line: 1725, col: 9, offset: 65194},
+						pos:        position{line: 1742, col: 9, offset: 67447},
 						val:        "[^\\r\\n[\\]]",
 						chars:      []rune{'\r', '\n', '[', ']'},
 						ignoreCase: false,
@@ -12542,36 +12738,36 @@ var g = &grammar{
 		},
 		{
 			name: "URL_SCHEME",
-			pos:  position{line: 1729, col: 1, offset: 65242},
+			pos:  position{line: 1746, col: 1, offset: 67495},
 			expr: &choiceExpr{
-				pos: position{line: 1729, col: 15, offset: 65256},
+				pos: position{line: 1746, col: 15, offset: 67509},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1729, col: 15, offset: 65256},
+						pos:        position{line: 1746, col: 15, offset: 67509},
 						val:        "http://",
 						ignoreCase: false,
 						want:       "\"http://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1729, col: 27, offset: 65268},
+						pos:        position{line: 1746, col: 27, offset: 67521},
 						val:        "https://",
 						ignoreCase: false,
 						want:       "\"https://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1729, col: 40, offset: 65281},
+						pos:        position{line: 1746, col: 40, offset: 67534},
 						val:        "ftp://",
 						ignoreCase: false,
 						want:       "\"ftp://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1729, col: 51, offset: 65292},
+						pos:        position{line: 1746, col: 51, offset: 67545},
 						val:        "irc://",
 						ignoreCase: false,
 						want:       "\"irc://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1729, col: 62, offset: 65303},
+						pos:        position{line: 1746, col: 62, offset: 67556},
 						val:        "mailto:",
 						ignoreCase: false,
 						want:       "\"mailto:\"",
@@ -12581,14 +12777,14 @@ var g = &grammar{
 		},
 		{
 			name: "ID",
-			pos:  position{line: 1731, col: 1, offset: 65314},
+			pos:  position{line: 1748, col: 1, offset: 67567},
 			expr: &actionExpr{
-				pos: position{line: 1731, col: 7, offset: 65320},
+				pos: position{line: 1748, col: 7, offset: 67573},
 				run: (*parser).callonID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1731, col: 7, offset: 65320},
+					pos: position{line: 1748, col: 7, offset: 67573},
 					expr: &charClassMatcher{
-						pos:        position{line: 1731, col: 7, offset: 65320},
+						pos:        position{line: 1748, col: 7, offset: 67573},
 						val:        "[^[\\]<>,]",
 						chars:      []rune{'[', ']', '<', '>', ','},
 						ignoreCase: false,
@@ -12599,12 +12795,12 @@ var g = &grammar{
 		},
 		{
 			name: "DIGIT",
-			pos:  position{line: 1735, col: 1, offset: 65445},
+			pos:  position{line: 1752, col: 1, offset: 67698},
 			expr: &actionExpr{
-				pos: position{line: 1735, col: 10, offset: 65454},
+				pos: position{line: 1752, col: 10, offset: 67707},
 				run: (*parser).callonDIGIT1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1735, col: 10, offset: 65454},
+					pos:        position{line: 1752, col: 10, offset: 67707},
 					val:        "[0-9]",
 					ranges:     []rune{'0', '9'},
 					ignoreCase: false,
@@ -12614,26 +12810,26 @@ var g = &grammar{
 		},
 		{
 			name: "NUMBER",
-			pos:  position{line: 1739, col: 1, offset: 65496},
+			pos:  position{line: 1756, col: 1, offset: 67749},
 			expr: &actionExpr{
-				pos: position{line: 1739, col: 11, offset: 65506},
+				pos: position{line: 1756, col: 11, offset: 67759},
 				run: (*parser).callonNUMBER1,
 				expr: &seqExpr{
-					pos: position{line: 1739, col: 11, offset: 65506},
+					pos: position{line: 1756, col: 11, offset: 67759},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 1739, col: 11, offset: 65506},
+							pos: position{line: 1756, col: 11, offset: 67759},
 							expr: &litMatcher{
-								pos:        position{line: 1739, col: 11, offset: 65506},
+								pos:        position{line: 1756, col: 11, offset: 67759},
 								val:        "-",
 								ignoreCase: false,
 								want:       "\"-\"",
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1739, col: 16, offset: 65511},
+							pos: position{line: 1756, col: 16, offset: 67764},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1739, col: 16, offset: 65511},
+								pos:  position{line: 1756, col: 16, offset: 67764},
 								name: "DIGIT",
 							},
 						},
@@ -12643,21 +12839,21 @@ var g = &grammar{
 		},
 		{
 			name: "Space",
-			pos:  position{line: 1743, col: 1, offset: 65563},
+			pos:  position{line: 1760, col: 1, offset: 67816},
 			expr: &choiceExpr{
-				pos: position{line: 1743, col: 10, offset: 65572},
+				pos: position{line: 1760, col: 10, offset: 67825},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1743, col: 10, offset: 65572},
+						pos:        position{line: 1760, col: 10, offset: 67825},
 						val:        " ",
 						ignoreCase: false,
 						want:       "\" \"",
 					},
 					&actionExpr{
-						pos: position{line: 1743, col: 16, offset: 65578},
+						pos: position{line: 1760, col: 16, offset: 67831},
 						run: (*parser).callonSpace3,
 						expr: &litMatcher{
-							pos:        position{line: 1743, col: 16, offset: 65578},
+							pos:        position{line: 1760, col: 16, offset: 67831},
 							val:        "\t",
 							ignoreCase: false,
 							want:       "\"\\t\"",
@@ -12668,24 +12864,24 @@ var g = &grammar{
 		},
 		{
 			name: "Newline",
-			pos:  position{line: 1747, col: 1, offset: 65619},
+			pos:  position{line: 1764, col: 1, offset: 67872},
 			expr: &choiceExpr{
-				pos: position{line: 1747, col: 12, offset: 65630},
+				pos: position{line: 1764, col: 12, offset: 67883},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1747, col: 12, offset: 65630},
+						pos:        position{line: 1764, col: 12, offset: 67883},
 						val:        "\r\n",
 						ignoreCase: false,
 						want:       "\"\\r\\n\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1747, col: 21, offset: 65639},
+						pos:        position{line: 1764, col: 21, offset: 67892},
 						val:        "\r",
 						ignoreCase: false,
 						want:       "\"\\r\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1747, col: 28, offset: 65646},
+						pos:        position{line: 1764, col: 28, offset: 67899},
 						val:        "\n",
 						ignoreCase: false,
 						want:       "\"\\n\"",
@@ -12695,26 +12891,26 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1749, col: 1, offset: 65652},
+			pos:  position{line: 1766, col: 1, offset: 67905},
 			expr: &notExpr{
-				pos: position{line: 1749, col: 8, offset: 65659},
+				pos: position{line: 1766, col: 8, offset: 67912},
 				expr: &anyMatcher{
-					line: 1749, col: 9, offset: 65660,
+					line: 1766, col: 9, offset: 67913,
 				},
 			},
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1751, col: 1, offset: 65663},
+			pos:  position{line: 1768, col: 1, offset: 67916},
 			expr: &choiceExpr{
-				pos: position{line: 1751, col: 8, offset: 65670},
+				pos: position{line: 1768, col: 8, offset: 67923},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1751, col: 8, offset: 65670},
+						pos:  position{line: 1768, col: 8, offset: 67923},
 						name: "Newline",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1751, col: 18, offset: 65680},
+						pos:  position{line: 1768, col: 18, offset: 67933},
 						name: "EOF",
 					},
 				},
@@ -12809,7 +13005,7 @@ func (p *parser) callonYamlFrontMatterContent1() (interface{}, error) {
 }
 
 func (c *current) onDocumentHeader1(title, id, authors, revision interface{}) (interface{}, error) {
-	return types.NewDocumentHeader(title.([]interface{}), authors, revision)
+	return types.NewDocumentHeader(title.([]interface{}), authors, revision, c.sourcePosition())
 }
 
 func (p *parser) callonDocumentHeader1() (interface{}, error) {
@@ -12963,7 +13159,7 @@ func (p *parser) callonAttributeDeclaration9() (interface{}, error) {
 }
 
 func (c *current) onAttributeDeclaration1(name, value interface{}) (interface{}, error) {
-	return types.NewAttributeDeclaration(name.(string), value)
+	return types.NewAttributeDeclaration(name.(string), value, c.sourcePosition())
 }
 
 func (p *parser) callonAttributeDeclaration1() (interface{}, error) {
@@ -12993,7 +13189,7 @@ func (p *parser) callonAttributeDeclarationValue1() (interface{}, error) {
 }
 
 func (c *current) onAttributeReset2(name interface{}) (interface{}, error) {
-	return types.NewAttributeReset(name.(string))
+	return types.NewAttributeReset(name.(string), c.sourcePosition())
 }
 
 func (p *parser) callonAttributeReset2() (interface{}, error) {
@@ -13003,7 +13199,7 @@ func (p *parser) callonAttributeReset2() (interface{}, error) {
 }
 
 func (c *current) onAttributeReset11(name interface{}) (interface{}, error) {
-	return types.NewAttributeReset(name.(string))
+	return types.NewAttributeReset(name.(string), c.sourcePosition())
 }
 
 func (p *parser) callonAttributeReset11() (interface{}, error) {
@@ -13012,14 +13208,54 @@ func (p *parser) callonAttributeReset11() (interface{}, error) {
 	return p.cur.onAttributeReset11(stack["name"])
 }
 
-func (c *current) onAttributeSubstitution1(name interface{}) (interface{}, error) {
-	return types.NewAttributeSubstitution(name.(string))
+func (c *current) onAttributeSubstitution3(name interface{}) (interface{}, error) {
+	return types.NewAttributeSubstitution(name.(string), c.sourcePosition())
+}
+
+func (p *parser) callonAttributeSubstitution3() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAttributeSubstitution3(stack["name"])
+}
+
+func (c *current) onInlineAttributeEntry2(name interface{}) (interface{}, error) {
+	return types.NewInlineAttributeEntry(name.(string), nil, true, c.sourcePosition())
+}
+
+func (p *parser) callonInlineAttributeEntry2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineAttributeEntry2(stack["name"])
+}
+
+func (c *current) onInlineAttributeEntry8(name, value interface{}) (interface{}, error) {
+	return types.NewInlineAttributeEntry(name.(string), value, false, c.sourcePosition())
+}
+
+func (p *parser) callonInlineAttributeEntry8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineAttributeEntry8(stack["name"], stack["value"])
+}
+
+func (c *current) onInlineAttributeEntryValue5() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonInlineAttributeEntryValue5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineAttributeEntryValue5()
+}
+
+func (c *current) onInlineAttributeEntryValue1(value interface{}) (interface{}, error) {
+	return value, nil
 }
 
-func (p *parser) callonAttributeSubstitution1() (interface{}, error) {
+func (p *parser) callonInlineAttributeEntryValue1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAttributeSubstitution1(stack["name"])
+	return p.cur.onInlineAttributeEntryValue1(stack["value"])
 }
 
 func (c *current) onAttributes1(attrs interface{}) (interface{}, error) {
@@ -13154,14 +13390,24 @@ func (p *parser) callonSourceAttributes6() (interface{}, error) {
 	return p.cur.onSourceAttributes6(stack["attr"])
 }
 
-func (c *current) onSourceAttributes14(attr interface{}) (interface{}, error) {
+func (c *current) onSourceAttributes15(attr interface{}) (interface{}, error) {
 	return attr, nil
 }
 
-func (p *parser) callonSourceAttributes14() (interface{}, error) {
+func (p *parser) callonSourceAttributes15() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSourceAttributes14(stack["attr"])
+	return p.cur.onSourceAttributes15(stack["attr"])
+}
+
+func (c *current) onSourceAttributes21(attr interface{}) (interface{}, error) {
+	return attr, nil
+}
+
+func (p *parser) callonSourceAttributes21() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSourceAttributes21(stack["attr"])
 }
 
 func (c *current) onSourceAttributes1(language, others interface{}) (interface{}, error) {
@@ -13216,15 +13462,36 @@ func (p *parser) callonAttributeKey1() (interface{}, error) {
 	return p.cur.onAttributeKey1(stack["key"])
 }
 
-func (c *current) onAttributeValue1(value interface{}) (interface{}, error) {
+func (c *current) onAttributeValue6() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonAttributeValue6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAttributeValue6()
+}
+
+func (c *current) onAttributeValue2(value interface{}) (interface{}, error) {
+	// quoted value, which may contain commas
+	return value, nil
+}
+
+func (p *parser) callonAttributeValue2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAttributeValue2(stack["value"])
+}
+
+func (c *current) onAttributeValue12(value interface{}) (interface{}, error) {
 
 	return string(c.text), nil
 }
 
-func (p *parser) callonAttributeValue1() (interface{}, error) {
+func (p *parser) callonAttributeValue12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAttributeValue1(stack["value"])
+	return p.cur.onAttributeValue12(stack["value"])
 }
 
 func (c *current) onStandaloneAttributeValue1(value interface{}) (interface{}, error) {
@@ -13315,7 +13582,7 @@ func (p *parser) callonSection10() (bool, error) {
 }
 
 func (c *current) onSection1(attributes, level, title, id interface{}) (interface{}, error) {
-	return types.NewSection(level.(int), title.([]interface{}), id.([]interface{}), attributes)
+	return types.NewSection(level.(int), title.([]interface{}), id.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonSection1() (interface{}, error) {
@@ -13345,8 +13612,18 @@ func (p *parser) callonTitleElement1() (interface{}, error) {
 	return p.cur.onTitleElement1(stack["element"])
 }
 
+func (c *current) onTableOfContentsPlaceHolder1() (interface{}, error) {
+	return types.TableOfContentsPlaceHolder{}, nil
+}
+
+func (p *parser) callonTableOfContentsPlaceHolder1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableOfContentsPlaceHolder1()
+}
+
 func (c *current) onUserMacroBlock1(name, value, attrs interface{}) (interface{}, error) {
-	return types.NewUserMacroBlock(name.(string), value.(string), attrs, string(c.text))
+	return types.NewUserMacroBlock(name.(string), value.(string), attrs, string(c.text), c.sourcePosition())
 }
 
 func (p *parser) callonUserMacroBlock1() (interface{}, error) {
@@ -13356,7 +13633,7 @@ func (p *parser) callonUserMacroBlock1() (interface{}, error) {
 }
 
 func (c *current) onInlineUserMacro1(name, value, attrs interface{}) (interface{}, error) {
-	return types.NewInlineUserMacro(name.(string), value.(string), attrs, string(c.text))
+	return types.NewInlineUserMacro(name.(string), value.(string), attrs, string(c.text), c.sourcePosition())
 }
 
 func (p *parser) callonInlineUserMacro1() (interface{}, error) {
@@ -13397,7 +13674,7 @@ func (p *parser) callonUserMacroAttributes1() (interface{}, error) {
 
 func (c *current) onFileInclusion4(path, inlineAttributes interface{}) (interface{}, error) {
 
-	return types.NewFileInclusion(path.(types.Location), inlineAttributes.(types.Attributes), string(c.text))
+	return types.NewFileInclusion(path.(types.Location), inlineAttributes.(types.Attributes), string(c.text), c.sourcePosition())
 
 }
 
@@ -13656,7 +13933,7 @@ func (p *parser) callonVerbatimFileLine6() (interface{}, error) {
 }
 
 func (c *current) onVerbatimFileLine1(content interface{}) (interface{}, error) {
-	return types.NewVerbatimFileLine(content.(string))
+	return types.NewVerbatimFileLine(content.(string), c.sourcePosition())
 }
 
 func (p *parser) callonVerbatimFileLine1() (interface{}, error) {
@@ -13737,7 +14014,7 @@ func (p *parser) callonListParagraph2() (interface{}, error) {
 }
 
 func (c *current) onListParagraph5(lines interface{}) (interface{}, error) {
-	return types.NewParagraph(lines.([]interface{}), nil)
+	return types.NewParagraph(lines.([]interface{}), nil, c.sourcePosition())
 
 }
 
@@ -13772,7 +14049,7 @@ func (p *parser) callonListParagraphLine1() (interface{}, error) {
 }
 
 func (c *current) onContinuedListItemElement1(element interface{}) (interface{}, error) {
-	return types.NewContinuedListItemElement(element) // offset is negative
+	return types.NewContinuedListItemElement(element, c.sourcePosition()) // offset is negative
 }
 
 func (p *parser) callonContinuedListItemElement1() (interface{}, error) {
@@ -13792,7 +14069,7 @@ func (p *parser) callonContinuedListItemContent1() (interface{}, error) {
 }
 
 func (c *current) onOrderedListItem1(attributes, prefix, content interface{}) (interface{}, error) {
-	return types.NewOrderedListItem(prefix.(types.OrderedListItemPrefix), content.([]interface{}), attributes)
+	return types.NewOrderedListItem(prefix.(types.OrderedListItemPrefix), content.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonOrderedListItem1() (interface{}, error) {
@@ -13930,7 +14207,7 @@ func (p *parser) callonOrderedListItemContent1() (interface{}, error) {
 }
 
 func (c *current) onUnorderedListItem1(attributes, prefix, checkstyle, content interface{}) (interface{}, error) {
-	return types.NewUnorderedListItem(prefix.(types.UnorderedListItemPrefix), checkstyle, content.([]interface{}), attributes)
+	return types.NewUnorderedListItem(prefix.(types.UnorderedListItemPrefix), checkstyle, content.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonUnorderedListItem1() (interface{}, error) {
@@ -14061,7 +14338,7 @@ func (p *parser) callonUnorderedListItemContent1() (interface{}, error) {
 }
 
 func (c *current) onLabeledListItem1(attributes, term, separator, description interface{}) (interface{}, error) {
-	return types.NewLabeledListItem(len(separator.(string))-1, term.([]interface{}), description, attributes)
+	return types.NewLabeledListItem(len(separator.(string))-1, term.([]interface{}), description, attributes, c.sourcePosition())
 }
 
 func (p *parser) callonLabeledListItem1() (interface{}, error) {
@@ -14213,7 +14490,7 @@ func (p *parser) callonAdmonitionKind10() (interface{}, error) {
 
 func (c *current) onParagraph2(attributes, t, lines interface{}) (interface{}, error) {
 
-	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes)
+	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes, c.sourcePosition())
 
 }
 
@@ -14224,7 +14501,7 @@ func (p *parser) callonParagraph2() (interface{}, error) {
 }
 
 func (c *current) onParagraph13(attributes, content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.MarkdownQuote, content.([]interface{}), attributes)
+	return types.NewDelimitedBlock(types.MarkdownQuote, content.([]interface{}), attributes, c.sourcePosition())
 
 }
 
@@ -14253,7 +14530,7 @@ func (p *parser) callonParagraph26() (bool, error) {
 
 func (c *current) onParagraph21(attributes, content interface{}) (interface{}, error) {
 
-	return types.NewDelimitedBlock(types.Passthrough, content.([]interface{}), attributes)
+	return types.NewDelimitedBlock(types.Passthrough, content.([]interface{}), attributes, c.sourcePosition())
 
 }
 
@@ -14265,7 +14542,7 @@ func (p *parser) callonParagraph21() (interface{}, error) {
 
 func (c *current) onParagraph31(attributes, lines interface{}) (interface{}, error) {
 
-	return types.NewParagraph(lines.([]interface{}), attributes)
+	return types.NewParagraph(lines.([]interface{}), attributes, c.sourcePosition())
 
 }
 
@@ -14336,7 +14613,7 @@ func (p *parser) callonSimpleParagraph6() (bool, error) {
 
 func (c *current) onSimpleParagraph1(attributes, firstLine, otherLines interface{}) (interface{}, error) {
 
-	return types.NewParagraph(append([]interface{}{firstLine}, otherLines.([]interface{})...), attributes)
+	return types.NewParagraph(append([]interface{}{firstLine}, otherLines.([]interface{})...), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonSimpleParagraph1() (interface{}, error) {
@@ -14368,7 +14645,7 @@ func (p *parser) callonOtherParagraphLine1() (interface{}, error) {
 
 func (c *current) onContinuedParagraph2(attributes, t, lines interface{}) (interface{}, error) {
 
-	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes)
+	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes, c.sourcePosition())
 
 }
 
@@ -14380,7 +14657,7 @@ func (p *parser) callonContinuedParagraph2() (interface{}, error) {
 
 func (c *current) onContinuedParagraph12(attributes, lines interface{}) (interface{}, error) {
 
-	return types.NewParagraph(lines.([]interface{}), attributes)
+	return types.NewParagraph(lines.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonContinuedParagraph12() (interface{}, error) {
@@ -14416,7 +14693,7 @@ func (p *parser) callonVerseParagraph7() (bool, error) {
 
 func (c *current) onVerseParagraph2(attributes, t, lines interface{}) (interface{}, error) {
 
-	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes)
+	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes, c.sourcePosition())
 
 }
 
@@ -14443,7 +14720,7 @@ func (p *parser) callonVerseParagraph19() (bool, error) {
 
 func (c *current) onVerseParagraph14(attributes, lines interface{}) (interface{}, error) {
 
-	return types.NewParagraph(lines.([]interface{}), attributes)
+	return types.NewParagraph(lines.([]interface{}), attributes, c.sourcePosition())
 
 }
 
@@ -14498,7 +14775,7 @@ func (p *parser) callonInlineElement1() (interface{}, error) {
 }
 
 func (c *current) onLineBreak1() (interface{}, error) {
-	return types.NewLineBreak()
+	return types.NewLineBreak(c.sourcePosition())
 }
 
 func (p *parser) callonLineBreak1() (interface{}, error) {
@@ -14551,7 +14828,7 @@ func (p *parser) callonTwoOrMoreBackslashes1() (interface{}, error) {
 
 func (c *current) onDoubleQuoteBoldText1(elements interface{}) (interface{}, error) {
 
-	return types.NewQuotedText(types.Bold, elements.([]interface{}))
+	return types.NewQuotedText(types.Bold, elements.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonDoubleQuoteBoldText1() (interface{}, error) {
@@ -14584,7 +14861,7 @@ func (p *parser) callonDoubleQuoteBoldTextFallbackCharacter3() (interface{}, err
 
 func (c *current) onSingleQuoteBoldText2(elements interface{}) (interface{}, error) {
 	// single punctuation cannot be followed by a character (needs '**' to emphazise a portion of a word)
-	return types.NewQuotedText(types.Bold, elements.([]interface{}))
+	return types.NewQuotedText(types.Bold, elements.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonSingleQuoteBoldText2() (interface{}, error) {
@@ -14595,7 +14872,7 @@ func (p *parser) callonSingleQuoteBoldText2() (interface{}, error) {
 
 func (c *current) onSingleQuoteBoldText16(elements interface{}) (interface{}, error) {
 	// unbalanced `**` vs `*` punctuation.
-	return types.NewQuotedText(types.Bold, elements.([]interface{})) // include the second heading `*` as a regular StringElement in the bold content
+	return types.NewQuotedText(types.Bold, elements.([]interface{}), c.sourcePosition()) // include the second heading `*` as a regular StringElement in the bold content
 }
 
 func (p *parser) callonSingleQuoteBoldText16() (interface{}, error) {
@@ -14664,7 +14941,7 @@ func (p *parser) callonEscapedBoldText18() (interface{}, error) {
 
 func (c *current) onDoubleQuoteItalicText1(elements interface{}) (interface{}, error) {
 	// double punctuation must be evaluated first
-	return types.NewQuotedText(types.Italic, elements.([]interface{}))
+	return types.NewQuotedText(types.Italic, elements.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonDoubleQuoteItalicText1() (interface{}, error) {
@@ -14697,7 +14974,7 @@ func (p *parser) callonDoubleQuoteItalicTextFallbackCharacter3() (interface{}, e
 
 func (c *current) onSingleQuoteItalicText2(elements interface{}) (interface{}, error) {
 	// single punctuation cannot be followed by a character (needs '__' to emphazise a portion of a word)
-	return types.NewQuotedText(types.Italic, elements.([]interface{}))
+	return types.NewQuotedText(types.Italic, elements.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonSingleQuoteItalicText2() (interface{}, error) {
@@ -14708,7 +14985,7 @@ func (p *parser) callonSingleQuoteItalicText2() (interface{}, error) {
 
 func (c *current) onSingleQuoteItalicText13(elements interface{}) (interface{}, error) {
 	// unbalanced `__` vs `_` punctuation.
-	return types.NewQuotedText(types.Italic, elements.([]interface{})) // include the second heading `_` as a regular StringElement in the italic content
+	return types.NewQuotedText(types.Italic, elements.([]interface{}), c.sourcePosition()) // include the second heading `_` as a regular StringElement in the italic content
 }
 
 func (p *parser) callonSingleQuoteItalicText13() (interface{}, error) {
@@ -14777,7 +15054,7 @@ func (p *parser) callonEscapedItalicText18() (interface{}, error) {
 
 func (c *current) onDoubleQuoteMonospaceText1(elements interface{}) (interface{}, error) {
 	// double punctuation must be evaluated first
-	return types.NewQuotedText(types.Monospace, elements.([]interface{}))
+	return types.NewQuotedText(types.Monospace, elements.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonDoubleQuoteMonospaceText1() (interface{}, error) {
@@ -14810,7 +15087,7 @@ func (p *parser) callonDoubleQuoteMonospaceTextFallbackCharacter3() (interface{}
 
 func (c *current) onSingleQuoteMonospaceText2(elements interface{}) (interface{}, error) {
 	// single punctuation cannot be followed by a character (needs "``" to emphazise a portion of a word)
-	return types.NewQuotedText(types.Monospace, elements.([]interface{}))
+	return types.NewQuotedText(types.Monospace, elements.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonSingleQuoteMonospaceText2() (interface{}, error) {
@@ -14821,7 +15098,7 @@ func (p *parser) callonSingleQuoteMonospaceText2() (interface{}, error) {
 
 func (c *current) onSingleQuoteMonospaceText13(elements interface{}) (interface{}, error) {
 	// unbalanced "``" vs "`" punctuation.
-	return types.NewQuotedText(types.Monospace, elements.([]interface{})) // include the second heading "`" as a regular StringElement in the monospace content
+	return types.NewQuotedText(types.Monospace, elements.([]interface{}), c.sourcePosition()) // include the second heading "`" as a regular StringElement in the monospace content
 }
 
 func (p *parser) callonSingleQuoteMonospaceText13() (interface{}, error) {
@@ -14890,7 +15167,7 @@ func (p *parser) callonEscapedMonospaceText18() (interface{}, error) {
 
 func (c *current) onSubscriptText1(element interface{}) (interface{}, error) {
 	// wraps a single word
-	return types.NewQuotedText(types.Subscript, element)
+	return types.NewQuotedText(types.Subscript, []interface{}{element}, c.sourcePosition())
 }
 
 func (p *parser) callonSubscriptText1() (interface{}, error) {
@@ -14923,7 +15200,7 @@ func (p *parser) callonEscapedSubscriptText1() (interface{}, error) {
 
 func (c *current) onSuperscriptText1(element interface{}) (interface{}, error) {
 	// wraps a single word
-	return types.NewQuotedText(types.Superscript, element)
+	return types.NewQuotedText(types.Superscript, []interface{}{element}, c.sourcePosition())
 }
 
 func (p *parser) callonSuperscriptText1() (interface{}, error) {
@@ -14955,7 +15232,7 @@ func (p *parser) callonEscapedSuperscriptText1() (interface{}, error) {
 }
 
 func (c *current) onSinglePlusPassthrough1(content interface{}) (interface{}, error) {
-	return types.NewInlinePassthrough(types.SinglePlusPassthrough, []interface{}{content})
+	return types.NewInlinePassthrough(types.SinglePlusPassthrough, []interface{}{content}, c.sourcePosition())
 }
 
 func (p *parser) callonSinglePlusPassthrough1() (interface{}, error) {
@@ -14987,7 +15264,7 @@ func (p *parser) callonSinglePlusPassthroughContent24() (interface{}, error) {
 }
 
 func (c *current) onTriplePlusPassthrough1(content interface{}) (interface{}, error) {
-	return types.NewInlinePassthrough(types.TriplePlusPassthrough, []interface{}{content})
+	return types.NewInlinePassthrough(types.TriplePlusPassthrough, []interface{}{content}, c.sourcePosition())
 }
 
 func (p *parser) callonTriplePlusPassthrough1() (interface{}, error) {
@@ -15019,7 +15296,7 @@ func (p *parser) callonTriplePlusPassthroughContent8() (interface{}, error) {
 }
 
 func (c *current) onPassthroughMacro2(content interface{}) (interface{}, error) {
-	return types.NewInlinePassthrough(types.PassthroughMacro, []interface{}{content})
+	return types.NewInlinePassthrough(types.PassthroughMacro, []interface{}{content}, c.sourcePosition())
 }
 
 func (p *parser) callonPassthroughMacro2() (interface{}, error) {
@@ -15029,7 +15306,7 @@ func (p *parser) callonPassthroughMacro2() (interface{}, error) {
 }
 
 func (c *current) onPassthroughMacro9(content interface{}) (interface{}, error) {
-	return types.NewInlinePassthrough(types.PassthroughMacro, content.([]interface{}))
+	return types.NewInlinePassthrough(types.PassthroughMacro, content.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonPassthroughMacro9() (interface{}, error) {
@@ -15049,7 +15326,7 @@ func (p *parser) callonPassthroughMacroCharacter1() (interface{}, error) {
 }
 
 func (c *current) onInternalCrossReference2(id, label interface{}) (interface{}, error) {
-	return types.NewInternalCrossReference(id.(string), label.(string))
+	return types.NewInternalCrossReference(id.(string), label.(string), c.sourcePosition())
 }
 
 func (p *parser) callonInternalCrossReference2() (interface{}, error) {
@@ -15059,7 +15336,7 @@ func (p *parser) callonInternalCrossReference2() (interface{}, error) {
 }
 
 func (c *current) onInternalCrossReference13(id interface{}) (interface{}, error) {
-	return types.NewInternalCrossReference(id.(string), nil)
+	return types.NewInternalCrossReference(id.(string), nil, c.sourcePosition())
 }
 
 func (p *parser) callonInternalCrossReference13() (interface{}, error) {
@@ -15069,7 +15346,7 @@ func (p *parser) callonInternalCrossReference13() (interface{}, error) {
 }
 
 func (c *current) onExternalCrossReference1(url, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewExternalCrossReference(url.(types.Location), inlineAttributes.(types.Attributes))
+	return types.NewExternalCrossReference(url.(types.Location), inlineAttributes.(types.Attributes), c.sourcePosition())
 }
 
 func (p *parser) callonExternalCrossReference1() (interface{}, error) {
@@ -15079,7 +15356,7 @@ func (p *parser) callonExternalCrossReference1() (interface{}, error) {
 }
 
 func (c *current) onRelativeLink1(url, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewInlineLink(url.(types.Location), inlineAttributes.(types.Attributes))
+	return types.NewInlineLink(url.(types.Location), inlineAttributes.(types.Attributes), c.sourcePosition())
 }
 
 func (p *parser) callonRelativeLink1() (interface{}, error) {
@@ -15089,7 +15366,7 @@ func (p *parser) callonRelativeLink1() (interface{}, error) {
 }
 
 func (c *current) onExternalLink1(url, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewInlineLink(url.(types.Location), inlineAttributes)
+	return types.NewInlineLink(url.(types.Location), inlineAttributes, c.sourcePosition())
 }
 
 func (p *parser) callonExternalLink1() (interface{}, error) {
@@ -15184,7 +15461,7 @@ func (p *parser) callonInlineLinks1() (interface{}, error) {
 }
 
 func (c *current) onResolvedRelativeLink1(url, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewInlineLink(url.(types.Location), inlineAttributes.(types.Attributes))
+	return types.NewInlineLink(url.(types.Location), inlineAttributes.(types.Attributes), c.sourcePosition())
 }
 
 func (p *parser) callonResolvedRelativeLink1() (interface{}, error) {
@@ -15194,7 +15471,7 @@ func (p *parser) callonResolvedRelativeLink1() (interface{}, error) {
 }
 
 func (c *current) onResolvedExternalLink1(url, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewInlineLink(url.(types.Location), inlineAttributes)
+	return types.NewInlineLink(url.(types.Location), inlineAttributes, c.sourcePosition())
 }
 
 func (p *parser) callonResolvedExternalLink1() (interface{}, error) {
@@ -15204,7 +15481,7 @@ func (p *parser) callonResolvedExternalLink1() (interface{}, error) {
 }
 
 func (c *current) onImageBlock1(attributes, path, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewImageBlock(path.(types.Location), inlineAttributes.(types.Attributes), attributes)
+	return types.NewImageBlock(path.(types.Location), inlineAttributes.(types.Attributes), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonImageBlock1() (interface{}, error) {
@@ -15214,7 +15491,7 @@ func (p *parser) callonImageBlock1() (interface{}, error) {
 }
 
 func (c *current) onInlineImage1(path, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewInlineImage(path.(types.Location), inlineAttributes.(types.Attributes))
+	return types.NewInlineImage(path.(types.Location), inlineAttributes.(types.Attributes), c.sourcePosition())
 }
 
 func (p *parser) callonInlineImage1() (interface{}, error) {
@@ -15234,7 +15511,7 @@ func (p *parser) callonImageAttributes1() (interface{}, error) {
 }
 
 func (c *current) onInlineFootnote2(content interface{}) (interface{}, error) {
-	return types.NewFootnote("", content.([]interface{}))
+	return types.NewFootnote("", content.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonInlineFootnote2() (interface{}, error) {
@@ -15244,7 +15521,7 @@ func (p *parser) callonInlineFootnote2() (interface{}, error) {
 }
 
 func (c *current) onInlineFootnote8(ref, content interface{}) (interface{}, error) {
-	return types.NewFootnote(ref.(string), content)
+	return types.NewFootnote(ref.(string), content, c.sourcePosition())
 }
 
 func (p *parser) callonInlineFootnote8() (interface{}, error) {
@@ -15275,7 +15552,7 @@ func (p *parser) callonDelimitedBlock1() (interface{}, error) {
 }
 
 func (c *current) onVerbatimLine1(content, callouts interface{}) (interface{}, error) {
-	return types.NewVerbatimLine(content.(string), callouts)
+	return types.NewVerbatimLine(content.(string), callouts, c.sourcePosition())
 }
 
 func (p *parser) callonVerbatimLine1() (interface{}, error) {
@@ -15306,7 +15583,7 @@ func (p *parser) callonCallout5() (interface{}, error) {
 }
 
 func (c *current) onCallout1(ref interface{}) (interface{}, error) {
-	return types.NewCallout(ref.(int))
+	return types.NewCallout(ref.(int), c.sourcePosition())
 }
 
 func (p *parser) callonCallout1() (interface{}, error) {
@@ -15316,7 +15593,7 @@ func (p *parser) callonCallout1() (interface{}, error) {
 }
 
 func (c *current) onCalloutListItem1(ref, description interface{}) (interface{}, error) {
-	return types.NewCalloutListItem(ref.(int), description.([]interface{}))
+	return types.NewCalloutListItem(ref.(int), description.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonCalloutListItem1() (interface{}, error) {
@@ -15346,7 +15623,7 @@ func (p *parser) callonCalloutListItemPrefix1() (interface{}, error) {
 }
 
 func (c *current) onFencedBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.Fenced, content.([]interface{}), attributes)
+	return types.NewDelimitedBlock(types.Fenced, content.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonFencedBlock1() (interface{}, error) {
@@ -15367,7 +15644,7 @@ func (p *parser) callonFencedBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onListingBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.Listing, content.([]interface{}), attributes)
+	return types.NewDelimitedBlock(types.Listing, content.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonListingBlock1() (interface{}, error) {
@@ -15388,7 +15665,7 @@ func (p *parser) callonListingBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onExampleBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.Example, content.([]interface{}), attributes)
+	return types.NewDelimitedBlock(types.Example, content.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonExampleBlock1() (interface{}, error) {
@@ -15409,7 +15686,7 @@ func (p *parser) callonExampleBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onQuoteBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.Quote, content.([]interface{}), attributes)
+	return types.NewDelimitedBlock(types.Quote, content.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonQuoteBlock1() (interface{}, error) {
@@ -15443,7 +15720,7 @@ func (p *parser) callonVerseBlock5() (bool, error) {
 }
 
 func (c *current) onVerseBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.Verse, content.([]interface{}), attributes)
+	return types.NewDelimitedBlock(types.Verse, content.([]interface{}), attributes, c.sourcePosition())
 
 }
 
@@ -15465,7 +15742,7 @@ func (p *parser) callonVerseBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onSidebarBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.Sidebar, content.([]interface{}), attributes)
+	return types.NewDelimitedBlock(types.Sidebar, content.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonSidebarBlock1() (interface{}, error) {
@@ -15486,7 +15763,7 @@ func (p *parser) callonSidebarBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onPassthroughBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.Passthrough, content.([]interface{}), attributes)
+	return types.NewDelimitedBlock(types.Passthrough, content.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonPassthroughBlock1() (interface{}, error) {
@@ -15528,7 +15805,7 @@ func (p *parser) callonVerseBlockElement1() (interface{}, error) {
 }
 
 func (c *current) onVerseBlockParagraph1(lines interface{}) (interface{}, error) {
-	return types.NewParagraph(lines.([]interface{}), nil)
+	return types.NewParagraph(lines.([]interface{}), nil, c.sourcePosition())
 }
 
 func (p *parser) callonVerseBlockParagraph1() (interface{}, error) {
@@ -15549,7 +15826,7 @@ func (p *parser) callonVerseBlockParagraphLine1() (interface{}, error) {
 
 func (c *current) onTable1(attributes, header, lines interface{}) (interface{}, error) {
 	// end delimiter or end of file
-	return types.NewTable(header, lines.([]interface{}), attributes)
+	return types.NewTable(header, lines.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonTable1() (interface{}, error) {
@@ -15589,7 +15866,7 @@ func (p *parser) callonTableCell1() (interface{}, error) {
 }
 
 func (c *current) onCommentBlock1(content interface{}) (interface{}, error) {
-	return types.NewDelimitedBlock(types.Comment, content.([]interface{}), nil)
+	return types.NewDelimitedBlock(types.Comment, content.([]interface{}), nil, c.sourcePosition())
 }
 
 func (p *parser) callonCommentBlock1() (interface{}, error) {
@@ -15610,7 +15887,7 @@ func (p *parser) callonCommentBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onSingleLineComment1(content interface{}) (interface{}, error) {
-	return types.NewSingleLineComment(content.(string))
+	return types.NewSingleLineComment(content.(string), c.sourcePosition())
 }
 
 func (p *parser) callonSingleLineComment1() (interface{}, error) {
@@ -15630,7 +15907,7 @@ func (p *parser) callonSingleLineCommentContent1() (interface{}, error) {
 }
 
 func (c *current) onParagraphWithHeadingSpaces1(attributes, lines interface{}) (interface{}, error) {
-	return types.NewLiteralBlock(types.LiteralBlockWithSpacesOnFirstLine, lines.([]interface{}), attributes)
+	return types.NewLiteralBlock(types.LiteralBlockWithSpacesOnFirstLine, lines.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonParagraphWithHeadingSpaces1() (interface{}, error) {
@@ -15684,7 +15961,7 @@ func (p *parser) callonParagraphWithHeadingSpacesLines1() (interface{}, error) {
 }
 
 func (c *current) onParagraphWithLiteralBlockDelimiter1(attributes, lines interface{}) (interface{}, error) {
-	return types.NewLiteralBlock(types.LiteralBlockWithDelimiter, lines.([]interface{}), attributes)
+	return types.NewLiteralBlock(types.LiteralBlockWithDelimiter, lines.([]interface{}), attributes, c.sourcePosition())
 }
 
 func (p *parser) callonParagraphWithLiteralBlockDelimiter1() (interface{}, error) {
@@ -15741,7 +16018,7 @@ func (p *parser) callonParagraphWithLiteralAttribute6() (bool, error) {
 }
 
 func (c *current) onParagraphWithLiteralAttribute1(attributes, lines interface{}) (interface{}, error) {
-	return types.NewLiteralBlock(types.LiteralBlockWithAttribute, lines.([]interface{}), attributes)
+	return types.NewLiteralBlock(types.LiteralBlockWithAttribute, lines.([]interface{}), attributes, c.sourcePosition())
 
 }
 
@@ -15793,7 +16070,7 @@ func (p *parser) callonParagraphWithLiteralAttributeLine1() (interface{}, error)
 }
 
 func (c *current) onIndexTerm1(term interface{}) (interface{}, error) {
-	return types.NewIndexTerm(term.([]interface{}))
+	return types.NewIndexTerm(term.([]interface{}), c.sourcePosition())
 }
 
 func (p *parser) callonIndexTerm1() (interface{}, error) {
@@ -15843,7 +16120,7 @@ func (p *parser) callonConcealedIndexTerm19() (interface{}, error) {
 }
 
 func (c *current) onConcealedIndexTerm1(term1, term2, term3 interface{}) (interface{}, error) {
-	return types.NewConcealedIndexTerm(term1, term2, term3)
+	return types.NewConcealedIndexTerm(term1, term2, term3, c.sourcePosition())
 
 }
 
@@ -15864,7 +16141,7 @@ func (p *parser) callonConcealedIndexTermContent1() (interface{}, error) {
 }
 
 func (c *current) onBlankLine1() (interface{}, error) {
-	return types.NewBlankLine()
+	return types.NewBlankLine(c.sourcePosition())
 }
 
 func (p *parser) callonBlankLine1() (interface{}, error) {
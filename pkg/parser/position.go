@@ -0,0 +1,94 @@
+package parser
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// sourceMapKey the key of the source map in the parser's global store
+const sourceMapKey = "sourceMap"
+
+// sourceMap maps the positions in the content being parsed to the positions in the source document(s)
+type sourceMap interface {
+	// resolve returns the file, line and column in the source document, or `false` if the position cannot be resolved
+	resolve(line, col int) (string, int, int, bool)
+}
+
+// fileSourceMap the source map used when the content of a single file is parsed
+type fileSourceMap struct {
+	file string
+}
+
+func (m fileSourceMap) resolve(line, col int) (string, int, int, bool) {
+	return m.file, line, col, true
+}
+
+// linesSourceMap the source map used when the content being parsed was assembled from lines
+// which may have been read in different files (eg: the content of a delimited block, with included files)
+type linesSourceMap []types.Position
+
+func (m linesSourceMap) resolve(line, col int) (string, int, int, bool) {
+	if line < 1 || line > len(m) || !m[line-1].IsSet() {
+		return "", 0, 0, false
+	}
+	l := m[line-1]
+	return l.File, l.Line, l.Column + col - 1, true
+}
+
+// offsetSourceMap the source map used when the content being parsed starts at the given (0-based) line
+// of the content mapped by the underlying source map (eg: when resuming the parsing after a malformed block)
+type offsetSourceMap struct {
+	sourceMap
+	offset int
+}
+
+func (m offsetSourceMap) resolve(line, col int) (string, int, int, bool) {
+	return m.sourceMap.resolve(line+m.offset, col)
+}
+
+// noSourceMap the source map used when the position of the content being parsed in the source document
+// is not known (eg: when parsing a fragment of content which was already processed)
+type noSourceMap struct{}
+
+func (m noSourceMap) resolve(line, col int) (string, int, int, bool) {
+	return "", 0, 0, false
+}
+
+// withSourceMap the option to set the given source map in the parser
+func withSourceMap(m sourceMap) Option {
+	return GlobalStore(sourceMapKey, m)
+}
+
+// sourcePosition returns the position of the text matched by the current rule, in the source document,
+// or an empty position if it cannot be resolved
+func (c *current) sourcePosition() types.Position {
+	m, ok := c.globalStore[sourceMapKey].(sourceMap)
+	if !ok {
+		m = fileSourceMap{}
+	}
+	file, line, col, ok := m.resolve(c.pos.line, c.pos.col)
+	if !ok {
+		return types.Position{}
+	}
+	// ignore the trailing EOL(s) to compute the end of the element
+	text := bytes.TrimRight(c.text, "\r\n")
+	endLine, endCol := c.pos.line, c.pos.col+utf8.RuneCount(text)
+	if i := bytes.LastIndexByte(text, '\n'); i >= 0 {
+		endLine += bytes.Count(text, []byte{'\n'})
+		endCol = 1 + utf8.RuneCount(text[i+1:])
+	}
+	_, endLine, endCol, ok = m.resolve(endLine, endCol)
+	if !ok {
+		// end of element is on a line which is not mapped
+		endLine, endCol = line, col
+	}
+	return types.Position{
+		File:      file,
+		Line:      line,
+		Column:    col,
+		EndLine:   endLine,
+		EndColumn: endCol,
+	}
+}
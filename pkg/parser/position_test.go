@@ -0,0 +1,185 @@
+package parser_test
+
+import (
+	"strings"
+	"testing/fstest"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("element positions", func() {
+
+	parse := func(source string, fsys fstest.MapFS) types.Document {
+		fsys["index.adoc"] = &fstest.MapFile{
+			Data: []byte(source),
+		}
+		doc, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(
+			configuration.WithFilename("index.adoc"),
+			configuration.WithFilesystem(fsys),
+		))
+		Expect(err).ToNot(HaveOccurred())
+		return doc
+	}
+
+	It("should set the position of blocks and inline elements", func() {
+		source := `a paragraph with *bold content*
+on two lines`
+		doc := parse(source, fstest.MapFS{})
+		p := doc.Elements[0].(types.Paragraph)
+		Expect(p.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      1,
+			Column:    1,
+			EndLine:   2,
+			EndColumn: 13,
+		}))
+		Expect(p.Lines[0][1].(types.QuotedText).Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      1,
+			Column:    18,
+			EndLine:   1,
+			EndColumn: 32,
+		}))
+	})
+
+	It("should set the position of sections after their rearrangement", func() {
+		source := `== Section A
+
+a paragraph
+
+=== Section A.a
+
+another paragraph
+
+== Section B`
+		doc := parse(source, fstest.MapFS{})
+		sectionA := doc.Elements[0].(types.Section)
+		Expect(sectionA.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      1,
+			Column:    1,
+			EndLine:   7,
+			EndColumn: 18,
+		}))
+		sectionAa := sectionA.Elements[1].(types.Section)
+		Expect(sectionAa.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      5,
+			Column:    1,
+			EndLine:   7,
+			EndColumn: 18,
+		}))
+		sectionB := doc.Elements[1].(types.Section)
+		Expect(sectionB.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      9,
+			Column:    1,
+			EndLine:   9,
+			EndColumn: 13,
+		}))
+	})
+
+	It("should set the position of lists after their rearrangement", func() {
+		source := `* item 1
+* item 2
+** nested item`
+		doc := parse(source, fstest.MapFS{})
+		list := doc.Elements[0].(types.UnorderedList)
+		Expect(list.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      1,
+			Column:    1,
+			EndLine:   3,
+			EndColumn: 15,
+		}))
+		item := list.Items[1]
+		Expect(item.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      2,
+			Column:    1,
+			EndLine:   3,
+			EndColumn: 15,
+		}))
+		nested := item.Elements[1].(types.UnorderedList)
+		Expect(nested.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      3,
+			Column:    1,
+			EndLine:   3,
+			EndColumn: 15,
+		}))
+	})
+
+	It("should set the position of elements within delimited blocks", func() {
+		source := `====
+first paragraph
+
+second *paragraph*
+====`
+		doc := parse(source, fstest.MapFS{})
+		block := doc.Elements[0].(types.DelimitedBlock)
+		Expect(block.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      1,
+			Column:    1,
+			EndLine:   5,
+			EndColumn: 5,
+		}))
+		p := block.Elements[2].(types.Paragraph)
+		Expect(p.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      4,
+			Column:    1,
+			EndLine:   4,
+			EndColumn: 19,
+		}))
+		Expect(p.Lines[0][1].(types.QuotedText).Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      4,
+			Column:    8,
+			EndLine:   4,
+			EndColumn: 19,
+		}))
+	})
+
+	It("should set the position of elements in included files", func() {
+		source := `include::chapter.adoc[lines=3..4]
+
+====
+include::chapter.adoc[lines=3]
+====`
+		doc := parse(source, fstest.MapFS{
+			"chapter.adoc": &fstest.MapFile{
+				Data: []byte("== Chapter\n\na paragraph\non 2 lines\n"),
+			},
+		})
+		p := doc.Elements[0].(types.Paragraph)
+		Expect(p.Position).To(Equal(types.Position{
+			File:      "chapter.adoc",
+			Line:      3,
+			Column:    1,
+			EndLine:   4,
+			EndColumn: 11,
+		}))
+		block := doc.Elements[1].(types.DelimitedBlock)
+		Expect(block.Position).To(Equal(types.Position{
+			File:      "index.adoc",
+			Line:      3,
+			Column:    1,
+			EndLine:   5,
+			EndColumn: 5,
+		}))
+		Expect(block.Elements[0].(types.Paragraph).Position).To(Equal(types.Position{
+			File:      "chapter.adoc",
+			Line:      3,
+			Column:    1,
+			EndLine:   3,
+			EndColumn: 12,
+		}))
+	})
+})
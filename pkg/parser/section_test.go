@@ -1562,7 +1562,7 @@ a paragraph`
 			}
 			expected := types.Document{
 				Attributes: types.Attributes{
-					types.AttrIDPrefix: "custom1b_", // overridden by second occurrence of attribute declaration
+					types.AttrIDPrefix: "custom1a_", // the value declared in the document header
 				},
 				ElementReferences: types.ElementReferences{
 					"custom1a_a_header":   doctitle,
@@ -1581,9 +1581,14 @@ a paragraph`
 								Attributes: types.Attributes{
 									types.AttrID: "custom1a_section_1a",
 								},
-								Level:    1,
-								Title:    section1aTitle,
-								Elements: []interface{}{},
+								Level: 1,
+								Title: section1aTitle,
+								Elements: []interface{}{
+									types.AttributeDeclaration{
+										Name:  types.AttrIDPrefix,
+										Value: "custom1b_",
+									},
+								},
 							},
 							types.Section{
 								Attributes: types.Attributes{
@@ -1620,9 +1625,7 @@ a paragraph`
 				types.StringElement{Content: "section 1b"},
 			}
 			expected := types.Document{
-				Attributes: types.Attributes{
-					"idprefix": "custom1b_", // overridden by second attribute declaration
-				},
+				// no attribute declared in the document header
 				ElementReferences: types.ElementReferences{
 					"_a_header":           doctitle,
 					"custom1a_section_1a": section1aTitle,
@@ -1636,13 +1639,22 @@ a paragraph`
 						Level: 0,
 						Title: doctitle,
 						Elements: []interface{}{
+							types.AttributeDeclaration{
+								Name:  types.AttrIDPrefix,
+								Value: "custom1a_",
+							},
 							types.Section{
 								Attributes: types.Attributes{
 									types.AttrID: "custom1a_section_1a",
 								},
-								Level:    1,
-								Title:    section1aTitle,
-								Elements: []interface{}{},
+								Level: 1,
+								Title: section1aTitle,
+								Elements: []interface{}{
+									types.AttributeDeclaration{
+										Name:  types.AttrIDPrefix,
+										Value: "custom1b_",
+									},
+								},
 							},
 							types.Section{
 								Attributes: types.Attributes{
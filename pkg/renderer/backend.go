@@ -0,0 +1,92 @@
+package renderer
+
+import (
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// DefaultBackend the name of the backend used when none is specified in the configuration or in the document
+const DefaultBackend = "html5"
+
+// Renderer renders a document in a given output format
+type Renderer interface {
+	// Render renders the given document and writes the result in the given `output`
+	Render(ctx Context, doc types.Document, output io.Writer) (types.Metadata, error)
+}
+
+// RenderFunc an adapter to use a function as a Renderer
+type RenderFunc func(ctx Context, doc types.Document, output io.Writer) (types.Metadata, error)
+
+// Render calls f(ctx, doc, output)
+func (f RenderFunc) Render(ctx Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	return f(ctx, doc, output)
+}
+
+// Backend a renderer registered with a name, along with its intrinsic attributes
+// (`backend`, `basebackend`, `outfilesuffix` and `filetype`)
+type Backend struct {
+	Renderer
+	Attributes map[string]string
+}
+
+// SupportsTemplates returns true if the backend supports the user-supplied templates (see `configuration.WithTemplates`),
+// ie, if its base backend is `html` (eg: `html5`, `xhtml5` and `epub3`, whose chapters are rendered in XHTML)
+func (b Backend) SupportsTemplates() bool {
+	return b.Attributes[types.AttrBaseBackend] == "html"
+}
+
+var (
+	backendsMutex sync.RWMutex
+	backends      = map[string]Backend{}
+)
+
+// Register registers the given renderer for the backend with the given name.
+// The given attributes are the intrinsic attributes of the backend (`basebackend`, `outfilesuffix` and `filetype`),
+// to which the `backend` attribute is added.
+// Registering a renderer with the name of an existing backend replaces the latter.
+func Register(name string, r Renderer, attributes map[string]string) {
+	backendsMutex.Lock()
+	defer backendsMutex.Unlock()
+	attrs := make(map[string]string, len(attributes)+1)
+	for k, v := range attributes {
+		attrs[k] = v
+	}
+	attrs[types.AttrBackend] = name
+	backends[name] = Backend{
+		Renderer:   r,
+		Attributes: attrs,
+	}
+}
+
+// Lookup returns the backend registered with the given name (or the default backend if the name is empty)
+func Lookup(name string) (Backend, error) {
+	if name == "" {
+		name = DefaultBackend
+	}
+	backendsMutex.RLock()
+	defer backendsMutex.RUnlock()
+	if b, found := backends[name]; found {
+		return b, nil
+	}
+	return Backend{}, errors.Errorf("unknown backend: '%s' (available backends: %v)", name, backendNames())
+}
+
+// Backends returns the names of the registered backends, in alphabetical order
+func Backends() []string {
+	backendsMutex.RLock()
+	defer backendsMutex.RUnlock()
+	return backendNames()
+}
+
+func backendNames() []string {
+	result := make([]string, 0, len(backends))
+	for name := range backends {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -20,21 +20,49 @@ type Context struct {
 	Footnotes            []types.Footnote
 	ElementReferences    types.ElementReferences
 	HasHeader            bool
+	// Templates the user-supplied templates which override the built-in templates of the backend (if any)
+	Templates Templates
 }
 
 // NewContext returns a new rendering context for the given document.
 func NewContext(doc types.Document, config configuration.Configuration) Context {
 	_, hasHeader := doc.Header()
+	// the attributes are copied, since the attribute declarations and resets of the document body are applied on them
+	// during the rendering
+	attrs := make(types.Attributes, len(doc.Attributes))
+	for k, v := range doc.Attributes {
+		attrs[k] = v
+	}
 	return Context{
 		Config:            config,
 		counters:          make(map[string]int),
-		Attributes:        doc.Attributes,
+		Attributes:        attrs,
 		ElementReferences: doc.ElementReferences,
 		Footnotes:         doc.Footnotes,
 		HasHeader:         hasHeader,
 	}
 }
 
+// ApplyAttributeEntry applies the given attribute declaration or reset of the document body on the attributes
+// of the context, so that only the elements which follow it are rendered with it (eg: `:icons: font` after the preamble).
+// As in the document attributes, a reset label (eg: `:figure-caption!:`) is set with an empty value, so that it is disabled.
+// Returns `false` if the given element is neither an attribute declaration nor an attribute reset
+func (ctx *Context) ApplyAttributeEntry(element interface{}) bool {
+	switch e := element.(type) {
+	case types.AttributeDeclaration:
+		ctx.Attributes = ctx.Attributes.Set(e.Name, e.Value)
+	case types.AttributeReset:
+		if types.IsLabel(e.Name) {
+			ctx.Attributes = ctx.Attributes.Set(e.Name, "")
+		} else {
+			delete(ctx.Attributes, e.Name)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
 const tableCounter = "tableCounter"
 
 // GetAndIncrementTableCounter returns the current value for the table counter after internally incrementing it.
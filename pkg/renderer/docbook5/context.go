@@ -0,0 +1,45 @@
+package docbook5
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// context the rendering context of a DocBook document.
+// Besides the common rendering context, it keeps track of the callouts of the last rendered listing,
+// so that the items of the following callout list can refer to them.
+type context struct {
+	renderer.Context
+	book     bool
+	listings int
+	callouts map[int][]string
+}
+
+func newContext(ctx renderer.Context) *context {
+	return &context{
+		Context: ctx,
+		book:    ctx.Attributes.GetAsStringWithDefault(types.AttrDocType, "article") == "book",
+	}
+}
+
+// startListing resets the callouts before rendering a new listing
+func (ctx *context) startListing() {
+	ctx.listings++
+	ctx.callouts = map[int][]string{}
+}
+
+// newCallout returns the ID of a new callout with the given reference in the current listing
+func (ctx *context) newCallout(ref int) string {
+	if ctx.callouts == nil {
+		ctx.startListing()
+	}
+	count := 0
+	for _, ids := range ctx.callouts {
+		count += len(ids)
+	}
+	id := fmt.Sprintf("CO%d-%d", ctx.listings, count+1)
+	ctx.callouts[ref] = append(ctx.callouts[ref], id)
+	return id
+}
@@ -0,0 +1,174 @@
+package docbook5
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderDelimitedBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	switch b.Kind {
+	case types.Fenced, types.Source:
+		return renderProgramListing(ctx, b.Attributes, verbatimLines(b.Elements))
+	case types.Listing:
+		return renderVerbatimBlock(ctx, "screen", b.Attributes, verbatimLines(b.Elements)), nil
+	case types.Literal:
+		return renderVerbatimBlock(ctx, `literallayout class="monospaced"`, b.Attributes, verbatimLines(b.Elements)), nil
+	case types.Example:
+		return renderExampleBlock(ctx, b)
+	case types.Quote, types.MarkdownQuote:
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render quote block")
+		}
+		return renderBlockQuote(b.Attributes, string(content)), nil
+	case types.Verse:
+		return renderVerseBlock(ctx, b)
+	case types.Sidebar:
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render sidebar block")
+		}
+		return []byte(fmt.Sprintf("<sidebar%s>\n%s%s\n</sidebar>", renderElementID(b.Attributes), renderElementTitle(b.Attributes), content)), nil
+	case types.Passthrough:
+		lines := verbatimLines(b.Elements)
+		result := make([]string, len(lines))
+		for i, l := range lines {
+			result[i] = l.Content
+		}
+		return []byte(strings.Join(result, "\n")), nil
+	case types.Comment:
+		return []byte{}, nil
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+// renderProgramListing renders the given lines in a `programlisting` element,
+// with the language and the line numbering set in the given attributes.
+func renderProgramListing(ctx *context, attrs types.Attributes, lines []types.VerbatimLine) ([]byte, error) {
+	name := "programlisting"
+	if language, found := attrs.GetAsString(types.AttrLanguage); found && language != "" {
+		name += fmt.Sprintf(` language="%s"`, escapeAttribute(language))
+	}
+	if attrs.Has(types.AttrLineNums) {
+		name += ` linenumbering="numbered"`
+		if start, found := attrs.GetAsString(types.AttrStart); found {
+			name += fmt.Sprintf(` startinglinenumber="%s"`, escapeAttribute(start))
+		}
+	} else {
+		name += ` linenumbering="unnumbered"`
+	}
+	return renderVerbatimBlock(ctx, name, attrs, lines), nil
+}
+
+// renderVerbatimBlock renders the given lines "as-is" in an element with the given name (and attributes),
+// in which the callouts are rendered as `co` elements.
+// If the block has a title, the element is wrapped in a `formalpara` element.
+func renderVerbatimBlock(ctx *context, element string, attrs types.Attributes, lines []types.VerbatimLine) []byte {
+	ctx.startListing()
+	result := bytes.NewBuffer(nil)
+	title := renderElementTitle(attrs)
+	if title != "" {
+		result.WriteString("<formalpara" + renderElementID(attrs) + ">\n" + title + "<para>\n")
+		result.WriteString("<" + element + ">")
+	} else {
+		result.WriteString("<" + element + renderElementID(attrs) + ">")
+	}
+	for i, l := range lines {
+		result.WriteString(escape(strings.TrimRight(l.Content, " ")))
+		for _, c := range l.Callouts {
+			result.WriteString(fmt.Sprintf(` <co xml:id="%s"/>`, ctx.newCallout(c.Ref)))
+		}
+		if i < len(lines)-1 {
+			result.WriteString("\n")
+		}
+	}
+	// the name of the closing tag is the first word of the element (i.e., without the attributes)
+	result.WriteString("</" + strings.Fields(element)[0] + ">")
+	if title != "" {
+		result.WriteString("\n</para>\n</formalpara>")
+	}
+	return result.Bytes()
+}
+
+func renderExampleBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	content, err := renderer.RenderElements(ctx, b.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render example block")
+	}
+	name := "informalexample"
+	if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		name = string(k)
+	} else if b.Attributes.Has(types.AttrTitle) {
+		name = "example"
+	}
+	return []byte(fmt.Sprintf("<%[1]s%[2]s>\n%[3]s%[4]s\n</%[1]s>", name, renderElementID(b.Attributes), renderElementTitle(b.Attributes), content)), nil
+}
+
+func renderVerseBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	paragraphs := []string{}
+	for _, e := range b.Elements {
+		if p, ok := e.(types.Paragraph); ok {
+			content, err := renderParagraphContent(ctx, p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render verse block")
+			}
+			paragraphs = append(paragraphs, string(content))
+		}
+	}
+	return renderBlockQuote(b.Attributes, "<literallayout>"+strings.Join(paragraphs, "\n\n")+"</literallayout>"), nil
+}
+
+func renderLiteralBlock(ctx *context, b types.LiteralBlock) ([]byte, error) {
+	lines := make([]types.VerbatimLine, len(b.Lines))
+	for i, l := range b.Lines {
+		lines[i] = types.VerbatimLine{
+			Content: l,
+		}
+	}
+	if t, found := b.Attributes.GetAsString(types.AttrLiteralBlockType); found && t == types.LiteralBlockWithSpacesOnFirstLine {
+		trimIndentation(lines)
+	}
+	return renderVerbatimBlock(ctx, `literallayout class="monospaced"`, b.Attributes, lines), nil
+}
+
+// trimIndentation removes the common leading spaces of the given lines
+func trimIndentation(lines []types.VerbatimLine) {
+	indent := -1
+	for _, l := range lines {
+		if n := len(l.Content) - len(strings.TrimLeft(l.Content, " ")); indent == -1 || n < indent {
+			indent = n
+		}
+	}
+	for i := range lines {
+		lines[i].Content = lines[i].Content[indent:]
+	}
+}
+
+// verbatimLines returns the verbatim lines of a listing (blank lines are returned as empty verbatim lines),
+// without the trailing blank lines
+func verbatimLines(elements []interface{}) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, 0, len(elements))
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.VerbatimLine:
+			result = append(result, e)
+		case types.BlankLine:
+			result = append(result, types.VerbatimLine{})
+		default:
+			log.Warnf("unexpected element of type '%T' in listing", e)
+		}
+	}
+	for len(result) > 0 && result[len(result)-1].IsEmpty() {
+		result = result[:len(result)-1]
+	}
+	return result
+}
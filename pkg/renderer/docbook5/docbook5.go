@@ -0,0 +1,243 @@
+package docbook5
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var documentTmpl texttemplate.Template
+
+func init() {
+	documentTmpl = newTextTemplate("document", `<?xml version="1.0" encoding="UTF-8"?>{{ if .TableOfContents }}
+<?asciidoc-toc?>{{ end }}
+<{{ .Root }} xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="{{ .Lang }}">
+<info>
+<title>{{ .Title }}</title>{{ if .Date }}
+<date>{{ escape .Date }}</date>{{ end }}{{ range .Authors }}
+<author>
+<personname>{{ if .FirstName }}
+<firstname>{{ escape .FirstName }}</firstname>{{ end }}{{ if .OtherName }}
+<othername>{{ escape .OtherName }}</othername>{{ end }}{{ if .Surname }}
+<surname>{{ escape .Surname }}</surname>{{ end }}
+</personname>{{ if .Email }}
+<email>{{ escape .Email }}</email>{{ end }}
+</author>{{ end }}{{ if .Initials }}
+<authorinitials>{{ escape .Initials }}</authorinitials>{{ end }}{{ with .Revision }}
+<revhistory>
+<revision>
+<revnumber>{{ escape .Revnumber }}</revnumber>
+<date>{{ escape .Revdate }}</date>{{ if $.Initials }}
+<authorinitials>{{ escape $.Initials }}</authorinitials>{{ end }}{{ if .Revremark }}
+<revremark>{{ escape .Revremark }}</revremark>{{ end }}
+</revision>
+</revhistory>{{ end }}
+</info>{{ if .Content }}
+{{ .Content }}{{ end }}
+</{{ .Root }}>`,
+		texttemplate.FuncMap{
+			"escape": escape,
+		})
+}
+
+// registers the `docbook5` backend
+func init() {
+	renderer.Register("docbook5", renderer.RenderFunc(Render), map[string]string{
+		types.AttrBaseBackend:   "docbook",
+		types.AttrOutFileSuffix: ".xml",
+		types.AttrFileType:      "xml",
+	})
+}
+
+// Render renders the given document in DocBook 5 and writes the result in the given `writer`
+func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	c := newContext(ctx)
+	header, hasHeader := doc.Header()
+	title := []byte{}
+	elements := doc.Elements
+	if hasHeader {
+		var err error
+		if title, err = renderInlineElements(c, header.Title); err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+		}
+		// the title of the document is rendered in the `info` element, and the other sections (if any) are "parts" of a book
+		elements = append(append([]interface{}{}, header.Elements...), doc.Elements[1:]...)
+	}
+	if c.book {
+		elements = withPreface(elements)
+	}
+	renderedContent, err := renderer.RenderElements(c, elements)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	if ctx.Config.IncludeHeaderFooter {
+		log.Debugf("Rendering full document...")
+		authors, _ := doc.Authors()
+		var revision *types.DocumentRevision
+		if r, found := doc.Revision(); found && r.Revnumber != "" {
+			revision = &r
+		}
+		err = documentTmpl.Execute(output, struct {
+			Root            string
+			Lang            string
+			TableOfContents bool
+			Title           string
+			Date            string
+			Authors         []author
+			Initials        string
+			Revision        *types.DocumentRevision
+			Content         string
+		}{
+			Root:            rootElement(c),
+			Lang:            doc.Attributes.GetLang(),
+			TableOfContents: doc.Attributes.Has(types.AttrTableOfContents),
+			Title:           string(title),
+			Date:            documentDate(ctx, doc),
+			Authors:         newAuthors(authors),
+			Initials:        authorInitials(authors),
+			Revision:        revision,
+			Content:         string(renderedContent),
+		})
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+		}
+	} else if _, err = output.Write(renderedContent); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	authors, _ := doc.Authors()
+	revision, _ := doc.Revision()
+	return types.Metadata{
+		Title:    string(plainText(header.Title)),
+		Authors:  authors,
+		Revision: revision,
+		Warnings: doc.Warnings,
+	}, nil
+}
+
+// rootElement returns the name of the root element of the document, based on its `doctype`
+func rootElement(ctx *context) string {
+	if ctx.book {
+		return "book"
+	}
+	return "article"
+}
+
+// withPreface wraps the blocks before the first section of a book in a preamble (if there is none yet),
+// so that they are rendered in a preface
+func withPreface(elements []interface{}) []interface{} {
+	for i, e := range elements {
+		switch e.(type) {
+		case types.Preamble:
+			return elements
+		case types.Section:
+			if i == 0 {
+				return elements
+			}
+			return append([]interface{}{
+				types.Preamble{
+					Elements: elements[:i],
+				},
+			}, elements[i:]...)
+		}
+	}
+	if len(elements) > 0 {
+		return []interface{}{
+			types.Preamble{
+				Elements: elements,
+			},
+		}
+	}
+	return elements
+}
+
+// documentDate returns the date of the document, i.e., the date of its revision (if available),
+// or the date of its last update otherwise (unless the document is `reproducible`)
+func documentDate(ctx renderer.Context, doc types.Document) string {
+	if r, found := doc.Revision(); found && r.Revdate != "" {
+		return r.Revdate
+	}
+	if doc.Attributes.Has(types.AttrReproducible) || ctx.Config.LastUpdated.IsZero() {
+		return ""
+	}
+	return ctx.Config.LastUpdated.Format("2006-01-02")
+}
+
+// author an author of the document, with its full name split into a first name, an optional middle name and a surname
+type author struct {
+	FirstName string
+	OtherName string
+	Surname   string
+	Email     string
+}
+
+func newAuthors(authors []types.DocumentAuthor) []author {
+	result := make([]author, 0, len(authors))
+	for _, a := range authors {
+		names := strings.Fields(a.FullName)
+		r := author{
+			Email: a.Email,
+		}
+		switch len(names) {
+		case 0:
+		case 1:
+			r.FirstName = names[0]
+		default:
+			r.FirstName = names[0]
+			r.OtherName = strings.Join(names[1:len(names)-1], " ")
+			r.Surname = names[len(names)-1]
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// authorInitials returns the initials of the first author of the document
+func authorInitials(authors []types.DocumentAuthor) string {
+	if len(authors) == 0 {
+		return ""
+	}
+	result := &strings.Builder{}
+	for _, name := range strings.Fields(authors[0].FullName) {
+		result.WriteString(string([]rune(name)[0:1]))
+	}
+	return result.String()
+}
+
+func newTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttemplate.Template {
+	t := texttemplate.New(name)
+	for _, f := range funcs {
+		t.Funcs(f)
+	}
+	return *texttemplate.Must(t.Parse(src))
+}
+
+// plainText returns the text of the given inline elements, without any markup
+func plainText(elements []interface{}) []byte {
+	result := bytes.NewBuffer(nil)
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.QuotedText:
+			result.Write(plainText(e.Elements))
+		case types.InlinePassthrough:
+			result.Write(plainText(e.Elements))
+		case types.IndexTerm:
+			result.Write(plainText(e.Term))
+		case types.InlineLink:
+			if text, ok := e.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+				result.Write(plainText(text))
+			} else {
+				result.WriteString(e.Location.String())
+			}
+		}
+	}
+	return result.Bytes()
+}
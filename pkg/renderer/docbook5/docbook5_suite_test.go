@@ -0,0 +1,15 @@
+package docbook5_test
+
+import (
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestDocBook5(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DocBook5 Suite")
+}
@@ -0,0 +1,491 @@
+package docbook5_test
+
+import (
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("docbook5 documents", func() {
+
+	lastUpdated := time.Date(2020, 4, 23, 12, 0, 0, 0, time.UTC)
+
+	It("article with header, authors and revision", func() {
+		source := `= The Title
+John Foo Doe <john@example.com>
+v1.0, 2020-01-01: first draft
+:toc:
+
+== Section A
+
+some content`
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<?asciidoc-toc?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>
+<title>The Title</title>
+<date>2020-01-01</date>
+<author>
+<personname>
+<firstname>John</firstname>
+<othername>Foo</othername>
+<surname>Doe</surname>
+</personname>
+<email>john@example.com</email>
+</author>
+<authorinitials>JFD</authorinitials>
+<revhistory>
+<revision>
+<revnumber>1.0</revnumber>
+<date>2020-01-01</date>
+<authorinitials>JFD</authorinitials>
+<revremark>first draft</revremark>
+</revision>
+</revhistory>
+</info>
+<section xml:id="_section_a">
+<title>Section A</title>
+<simpara>some content</simpara>
+</section>
+</article>`
+		result, err := RenderDocBook5(source, configuration.WithLastUpdated(lastUpdated))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(expected))
+		Expect(result).To(MatchDocBookStructure())
+	})
+
+	It("article without revision uses the document date", func() {
+		source := `= The Title
+:lang: fr
+
+some content`
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="fr">
+<info>
+<title>The Title</title>
+<date>2020-04-23</date>
+</info>
+<simpara>some content</simpara>
+</article>`
+		result, err := RenderDocBook5(source, configuration.WithLastUpdated(lastUpdated))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(expected))
+		Expect(result).To(MatchDocBookStructure())
+	})
+
+	It("book with preface, parts and chapters", func() {
+		source := `= The Book
+:doctype: book
+
+a preamble
+
+= Part 1
+
+== Chapter 1
+
+=== Section 1.1
+
+some content`
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<book xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>
+<title>The Book</title>
+<date>2020-04-23</date>
+</info>
+<preface>
+<title></title>
+<simpara>a preamble</simpara>
+</preface>
+<part xml:id="_part_1">
+<title>Part 1</title>
+<chapter xml:id="_chapter_1">
+<title>Chapter 1</title>
+<section xml:id="_section_1_1">
+<title>Section 1.1</title>
+<simpara>some content</simpara>
+</section>
+</chapter>
+</part>
+</book>`
+		result, err := RenderDocBook5(source, configuration.WithLastUpdated(lastUpdated))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(expected))
+		Expect(result).To(MatchDocBookStructure())
+	})
+
+	It("document with all kinds of blocks", func() {
+		source := `= The Title
+
+== Lists
+
+* item 1
+** nested <<_tables>>
+* [x] done
+
+[start=3,loweralpha]
+. three
+. four
+
+term:: definition
+
+[qanda]
+What?:: This.
+
+== Tables
+
+.A table
+|===
+| a | b
+
+| *c* | d
+|===
+
+|===
+| e | f | g
+|===
+
+== Blocks
+
+TIP: a tip with a footnote:[a *note*]
+
+[WARNING]
+.Careful
+====
+a warning
+====
+
+.Lines
+[source,go,linenums]
+----
+fmt.Println("a") <1>
+fmt.Println("b") <2>
+----
+<1> first
+<2> second
+
+.Foo
+image::foo.png[Foo, 200, 100]
+
+[quote, John, Book]
+____
+quoted
+____
+
+[verse, Someone]
+____
+roses are red
+violets are blue
+____
+
+.An example
+====
+example with (((index, term))) and ((visible)) terms
+====
+
+.A sidebar
+****
+sidebar with https://example.com[a link] and an image:bar.png[Bar]
+****
+
+....
+literal <x>
+....`
+		result, err := RenderDocBook5(source, configuration.WithLastUpdated(lastUpdated))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(MatchDocBookStructure())
+	})
+})
+
+var _ = Describe("docbook5 elements", func() {
+
+	It("sections and paragraphs", func() {
+		source := `== Section A
+
+.Title
+a *strong* and _emphasized_ paragraph with ` + "`code`" + `, ~sub~ and ^sup^ text
+
+[discrete]
+=== Discrete`
+		expected := `<section xml:id="_section_a">
+<title>Section A</title>
+<formalpara>
+<title>Title</title>
+<para>a <emphasis role="strong">strong</emphasis> and <emphasis>emphasized</emphasis> paragraph with <literal>code</literal>, <subscript>sub</subscript> and <superscript>sup</superscript> text</para>
+</formalpara>
+<bridgehead xml:id="_discrete" renderas="sect2">Discrete</bridgehead>
+</section>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("unordered, ordered and labeled lists", func() {
+		source := `* item 1
+** nested
+* item 2
+
+[start=3,loweralpha]
+. three
+. four
+
+[[terms]]
+.Terms
+term 1:: definition 1
+term 2:: definition 2`
+		expected := `<itemizedlist>
+<listitem>
+<simpara>item 1</simpara>
+<itemizedlist>
+<listitem>
+<simpara>nested</simpara>
+</listitem>
+</itemizedlist>
+</listitem>
+<listitem>
+<simpara>item 2</simpara>
+</listitem>
+</itemizedlist>
+<orderedlist numeration="loweralpha" startingnumber="3">
+<listitem>
+<simpara>three</simpara>
+</listitem>
+<listitem>
+<simpara>four</simpara>
+</listitem>
+</orderedlist>
+<variablelist xml:id="terms">
+<title>Terms</title>
+<varlistentry>
+<term>term 1</term>
+<listitem>
+<simpara>definition 1</simpara>
+</listitem>
+</varlistentry>
+<varlistentry>
+<term>term 2</term>
+<listitem>
+<simpara>definition 2</simpara>
+</listitem>
+</varlistentry>
+</variablelist>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("checklist and Q&A list", func() {
+		source := `* [x] done
+* [ ] todo
+
+[qanda]
+What?:: This.`
+		expected := `<itemizedlist role="checklist">
+<listitem>
+<simpara>&#10003; done</simpara>
+</listitem>
+<listitem>
+<simpara>&#10063; todo</simpara>
+</listitem>
+</itemizedlist>
+<qandaset>
+<qandaentry>
+<question>
+<simpara>What?</simpara>
+</question>
+<answer>
+<simpara>This.</simpara>
+</answer>
+</qandaentry>
+</qandaset>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("tables with colspecs", func() {
+		source := `.A table
+|===
+| a | b | c
+
+| *d* | e | f
+|===
+
+|===
+| g
+|===`
+		expected := `<table frame="all" rowsep="1" colsep="1">
+<title>A table</title>
+<tgroup cols="3">
+<colspec colname="col_1" colwidth="33.3333*"/>
+<colspec colname="col_2" colwidth="33.3333*"/>
+<colspec colname="col_3" colwidth="33.3333*"/>
+<thead>
+<row>
+<entry align="left" valign="top">a</entry>
+<entry align="left" valign="top">b</entry>
+<entry align="left" valign="top">c</entry>
+</row>
+</thead>
+<tbody>
+<row>
+<entry align="left" valign="top"><simpara><emphasis role="strong">d</emphasis></simpara></entry>
+<entry align="left" valign="top"><simpara>e</simpara></entry>
+<entry align="left" valign="top"><simpara>f</simpara></entry>
+</row>
+</tbody>
+</tgroup>
+</table>
+<informaltable frame="all" rowsep="1" colsep="1">
+<tgroup cols="1">
+<colspec colname="col_1" colwidth="100*"/>
+<tbody>
+<row>
+<entry align="left" valign="top"><simpara>g</simpara></entry>
+</row>
+</tbody>
+</tgroup>
+</informaltable>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("admonitions", func() {
+		source := `NOTE: a note
+
+[CAUTION]
+.Careful
+====
+a caution
+====`
+		expected := `<note>
+<simpara>a note</simpara>
+</note>
+<caution>
+<title>Careful</title>
+<simpara>a caution</simpara>
+</caution>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("listings with callouts", func() {
+		source := `[source,go]
+----
+fmt.Println("<a>") <1>
+fmt.Println("b") <2> <3>
+----
+<1> first
+<2> second
+<3> third
+
+----
+echo "hello" <1>
+----
+<1> prints hello`
+		expected := `<programlisting language="go" linenumbering="unnumbered">fmt.Println("&lt;a&gt;") <co xml:id="CO1-1"/>
+fmt.Println("b") <co xml:id="CO1-2"/> <co xml:id="CO1-3"/></programlisting>
+<calloutlist>
+<callout arearefs="CO1-1">
+<simpara>first</simpara>
+</callout>
+<callout arearefs="CO1-2">
+<simpara>second</simpara>
+</callout>
+<callout arearefs="CO1-3">
+<simpara>third</simpara>
+</callout>
+</calloutlist>
+<screen>echo "hello" <co xml:id="CO2-1"/></screen>
+<calloutlist>
+<callout arearefs="CO2-1">
+<simpara>prints hello</simpara>
+</callout>
+</calloutlist>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("titled source block with line numbers", func() {
+		source := `.Lines
+[source,go,linenums,start=10]
+----
+a := 1
+----`
+		expected := `<formalpara>
+<title>Lines</title>
+<para>
+<programlisting language="go" linenumbering="numbered" startinglinenumber="10">a := 1</programlisting>
+</para>
+</formalpara>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("footnotes", func() {
+		source := `a footnote:[a *note*], a footnote:disclaimer[Disclaimer.] and again footnote:disclaimer[].`
+		expected := `<simpara>a <footnote><simpara>a <emphasis role="strong">note</emphasis></simpara></footnote>, a <footnote xml:id="_footnote_disclaimer"><simpara>Disclaimer.</simpara></footnote> and again <footnoteref linkend="_footnote_disclaimer"/>.</simpara>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("cross references and links", func() {
+		source := `== Section A
+
+See <<_section_a>>, <<_section_a,this section>>, xref:other.adoc[the other doc] and https://example.com[Example].`
+		expected := `<section xml:id="_section_a">
+<title>Section A</title>
+<simpara>See <xref linkend="_section_a"/>, <link linkend="_section_a">this section</link>, <link xl:href="other.xml">the other doc</link> and <link xl:href="https://example.com">Example</link>.</simpara>
+</section>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("images", func() {
+		source := `.Foo
+image::foo.png[Foo, 200, 100]
+
+an image:bar.png[Bar] inline`
+		expected := `<figure>
+<title>Foo</title>
+<mediaobject>
+<imageobject>
+<imagedata fileref="foo.png" contentwidth="200" contentdepth="100"/>
+</imageobject>
+<textobject><phrase>Foo</phrase></textobject>
+</mediaobject>
+</figure>
+<simpara>an <inlinemediaobject>
+<imageobject>
+<imagedata fileref="bar.png"/>
+</imageobject>
+<textobject><phrase>Bar</phrase></textobject>
+</inlinemediaobject> inline</simpara>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("index terms", func() {
+		source := `a ((visible)) term and (((primary, secondary, tertiary)))concealed terms`
+		expected := `<simpara>a <indexterm><primary>visible</primary></indexterm>visible term and <indexterm><primary>primary</primary><secondary>secondary</secondary><tertiary>tertiary</tertiary></indexterm>concealed terms</simpara>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+
+	It("quote and verse blocks", func() {
+		source := `[quote, John, Book]
+____
+quoted
+____
+
+[verse, Someone]
+____
+roses are red
+violets are blue
+____`
+		expected := `<blockquote>
+<attribution>
+John
+<citetitle>Book</citetitle>
+</attribution>
+<simpara>quoted</simpara>
+</blockquote>
+<blockquote>
+<attribution>
+Someone
+</attribution>
+<literallayout>roses are red
+violets are blue</literallayout>
+</blockquote>`
+		Expect(RenderDocBook5Content(source)).To(Equal(expected))
+	})
+})
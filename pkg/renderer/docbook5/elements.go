@@ -0,0 +1,133 @@
+package docbook5
+
+import (
+	"bytes"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// the methods of the `renderer.BlockRenderer` interface, which render the blocks traversed by `renderer.RenderElements`
+
+// BlockSeparator returns a newline
+func (ctx *context) BlockSeparator() string {
+	return "\n"
+}
+
+func (ctx *context) RenderSection(s types.Section) ([]byte, error) {
+	return renderSection(ctx, s)
+}
+
+func (ctx *context) RenderPreamble(p types.Preamble) ([]byte, error) {
+	return renderPreamble(ctx, p)
+}
+
+func (ctx *context) RenderLabeledList(l types.LabeledList) ([]byte, error) {
+	return renderLabeledList(ctx, l)
+}
+
+func (ctx *context) RenderOrderedList(l types.OrderedList) ([]byte, error) {
+	return renderOrderedList(ctx, l)
+}
+
+func (ctx *context) RenderUnorderedList(l types.UnorderedList) ([]byte, error) {
+	return renderUnorderedList(ctx, l)
+}
+
+func (ctx *context) RenderCalloutList(l types.CalloutList) ([]byte, error) {
+	return renderCalloutList(ctx, l)
+}
+
+func (ctx *context) RenderParagraph(p types.Paragraph) ([]byte, error) {
+	return renderParagraph(ctx, p)
+}
+
+func (ctx *context) RenderImageBlock(img types.ImageBlock) ([]byte, error) {
+	return renderImageBlock(ctx, img)
+}
+
+func (ctx *context) RenderDelimitedBlock(b types.DelimitedBlock) ([]byte, error) {
+	return renderDelimitedBlock(ctx, b)
+}
+
+func (ctx *context) RenderTable(t types.Table) ([]byte, error) {
+	return renderTable(ctx, t)
+}
+
+func (ctx *context) RenderLiteralBlock(b types.LiteralBlock) ([]byte, error) {
+	return renderLiteralBlock(ctx, b)
+}
+
+func (ctx *context) RenderBlockMacro(m types.UserMacro) ([]byte, error) {
+	return []byte("<simpara>" + escape(m.RawText) + "</simpara>"), nil
+}
+
+func (ctx *context) RenderInlineElement(element interface{}) ([]byte, error) {
+	return renderInlineElement(ctx, element)
+}
+
+// renderInlineElements renders the given inline elements, without any separator
+func renderInlineElements(ctx *context, elements []interface{}) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderInlineElement(ctx, element)
+		if err != nil {
+			return nil, err
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// nolint: gocyclo
+func renderInlineElement(ctx *context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderInlineElements(ctx, e)
+	case types.StringElement:
+		return renderStringElement(e), nil
+	case types.QuotedText:
+		return renderQuotedText(ctx, e)
+	case types.InlinePassthrough:
+		return renderInlinePassthrough(ctx, e)
+	case types.InternalCrossReference:
+		return renderInternalCrossReference(ctx, e)
+	case types.ExternalCrossReference:
+		return renderExternalCrossReference(ctx, e)
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.InlineImage:
+		return renderInlineImage(e), nil
+	case types.FootnoteReference:
+		return renderFootnoteReference(ctx, e)
+	case types.IndexTerm:
+		return renderIndexTerm(ctx, e)
+	case types.ConcealedIndexTerm:
+		return renderConcealedIndexTerm(e), nil
+	case types.LineBreak:
+		return []byte("<?asciidoc-br?>"), nil
+	case types.UserMacro:
+		return []byte(escape(e.RawText)), nil
+	case types.VerbatimLine:
+		return []byte(escape(e.Content)), nil
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// renderElementID returns the `xml:id` attribute of an element with an ID, or an empty string otherwise
+func renderElementID(attrs types.Attributes) string {
+	if id, ok := attrs[types.AttrID].(string); ok && id != "" {
+		return ` xml:id="` + escapeAttribute(id) + `"`
+	}
+	return ""
+}
+
+// renderElementTitle returns the `title` element of an element with a title, or an empty string otherwise
+func renderElementTitle(attrs types.Attributes) string {
+	if title, found := attrs.GetAsString(types.AttrTitle); found && title != "" {
+		return "<title>" + escape(title) + "</title>\n"
+	}
+	return ""
+}
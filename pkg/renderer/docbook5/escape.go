@@ -0,0 +1,34 @@
+package docbook5
+
+import (
+	"strings"
+)
+
+// escape escapes the XML special characters of the given string,
+// but keeps the character references (eg: `&#8217;`) and the predefined entities as-is
+func escape(s string) string {
+	return xmlEscaper.Replace(s)
+}
+
+// escapeAttribute escapes the XML special characters of the given attribute value, including the double quotes
+func escapeAttribute(s string) string {
+	return strings.Replace(escape(s), `"`, "&quot;", -1)
+}
+
+var xmlEscaper = strings.NewReplacer(
+	`&lt;`, "&lt;", // keep as-is (we do not want `&amp;lt;`)
+	`&gt;`, "&gt;",
+	`&amp;`, "&amp;",
+	`&#`, "&#", // assume this is for an character reference and this keep as-is
+	`&`, "&amp;",
+	`<`, "&lt;",
+	`>`, "&gt;",
+)
+
+// replacements the textual replacements applied on the strings (copyright, trademark, etc.)
+var replacements = strings.NewReplacer(
+	"...", "&#8230;&#8203;",
+	"(C)", "&#169;",
+	"(TM)", "&#8482;",
+	"(R)", "&#174;",
+)
@@ -0,0 +1,40 @@
+package docbook5
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+func renderImageBlock(ctx *context, img types.ImageBlock) ([]byte, error) {
+	name := "informalfigure"
+	if img.Attributes.Has(types.AttrTitle) {
+		name = "figure"
+	}
+	return []byte(fmt.Sprintf("<%[1]s%[2]s>\n%[3]s<mediaobject>\n<imageobject>\n%[4]s\n</imageobject>\n<textobject><phrase>%[5]s</phrase></textobject>\n</mediaobject>\n</%[1]s>",
+		name,
+		renderElementID(img.Attributes),
+		renderElementTitle(img.Attributes),
+		renderImageData(img.Location, img.Attributes),
+		escape(img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, "")),
+	)), nil
+}
+
+func renderInlineImage(img types.InlineImage) []byte {
+	return []byte(fmt.Sprintf("<inlinemediaobject>\n<imageobject>\n%s\n</imageobject>\n<textobject><phrase>%s</phrase></textobject>\n</inlinemediaobject>",
+		renderImageData(img.Location, img.Attributes),
+		escape(img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, "")),
+	))
+}
+
+// renderImageData renders the `imagedata` element, with the optional width and height of the image
+func renderImageData(location types.Location, attrs types.Attributes) string {
+	result := fmt.Sprintf(`<imagedata fileref="%s"`, escapeAttribute(location.String()))
+	if width, found := attrs.GetAsString(types.AttrImageWidth); found && width != "" {
+		result += fmt.Sprintf(` contentwidth="%s"`, escapeAttribute(width))
+	}
+	if height, found := attrs.GetAsString(types.AttrImageHeight); found && height != "" {
+		result += fmt.Sprintf(` contentdepth="%s"`, escapeAttribute(height))
+	}
+	return result + "/>"
+}
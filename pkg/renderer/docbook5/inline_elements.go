@@ -0,0 +1,143 @@
+package docbook5
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderStringElement(s types.StringElement) []byte {
+	return []byte(replacements.Replace(escape(s.Content)))
+}
+
+func renderQuotedText(ctx *context, t types.QuotedText) ([]byte, error) {
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	var start, end string
+	switch t.Kind {
+	case types.Bold:
+		start, end = `<emphasis role="strong">`, "</emphasis>"
+	case types.Italic:
+		start, end = "<emphasis>", "</emphasis>"
+	case types.Monospace:
+		start, end = "<literal>", "</literal>"
+	case types.Subscript:
+		start, end = "<subscript>", "</subscript>"
+	case types.Superscript:
+		start, end = "<superscript>", "</superscript>"
+	default:
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+	return []byte(start + string(content) + end), nil
+}
+
+func renderInlinePassthrough(ctx *context, p types.InlinePassthrough) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch e := element.(type) {
+		case types.StringElement:
+			if p.Kind == types.SinglePlusPassthrough {
+				result.WriteString(escape(e.Content))
+			} else {
+				// "string" elements must be rendered as-is, ie, without any escaping.
+				result.WriteString(e.Content)
+			}
+		default:
+			renderedElement, err := renderInlineElement(ctx, e)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			result.Write(renderedElement)
+		}
+	}
+	return result.Bytes(), nil
+}
+
+func renderInternalCrossReference(ctx *context, xref types.InternalCrossReference) ([]byte, error) {
+	log.Debugf("rendering cross reference with ID: %s", xref.ID)
+	if xref.Label != "" {
+		return []byte(fmt.Sprintf(`<link linkend="%s">%s</link>`, escapeAttribute(xref.ID), escape(xref.Label))), nil
+	}
+	// the label is computed by the DocBook toolchain, from the title of the target
+	return []byte(fmt.Sprintf(`<xref linkend="%s"/>`, escapeAttribute(xref.ID))), nil
+}
+
+func renderExternalCrossReference(ctx *context, xref types.ExternalCrossReference) ([]byte, error) {
+	label, err := renderInlineElements(ctx, xref.Label)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render external cross reference")
+	}
+	// the target document is expected to be converted with the same backend
+	loc := xref.Location.String()
+	href := strings.TrimSuffix(loc, filepath.Ext(loc)) + ctx.Attributes.GetAsStringWithDefault(types.AttrOutFileSuffix, ".xml")
+	return []byte(fmt.Sprintf(`<link xl:href="%s">%s</link>`, escapeAttribute(href), label)), nil
+}
+
+func renderLink(ctx *context, l types.InlineLink) ([]byte, error) {
+	href := l.Location.String()
+	text := []byte(escape(href))
+	if t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+		var err error
+		if text, err = renderInlineElements(ctx, t); err != nil {
+			return nil, errors.Wrapf(err, "unable to render link")
+		}
+	}
+	return []byte(fmt.Sprintf(`<link xl:href="%s">%s</link>`, escapeAttribute(href), text)), nil
+}
+
+func renderFootnoteReference(ctx *context, note types.FootnoteReference) ([]byte, error) {
+	if note.ID == types.InvalidFootnoteReference {
+		log.Warnf("invalid footnote reference: '%s'", note.Ref)
+		return []byte("[" + escape(note.Ref) + "]"), nil
+	}
+	if note.Duplicate {
+		return []byte(fmt.Sprintf(`<footnoteref linkend="%s"/>`, footnoteID(note.Ref))), nil
+	}
+	for _, f := range ctx.Footnotes {
+		if f.ID != note.ID {
+			continue
+		}
+		content, err := renderInlineElements(ctx, f.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnote")
+		}
+		id := ""
+		if note.Ref != "" {
+			id = fmt.Sprintf(` xml:id="%s"`, footnoteID(note.Ref))
+		}
+		return []byte(fmt.Sprintf("<footnote%s><simpara>%s</simpara></footnote>", id, strings.TrimSpace(string(content)))), nil
+	}
+	return nil, errors.Errorf("unable to render footnote: no footnote with ID %d", note.ID)
+}
+
+func footnoteID(ref string) string {
+	return "_footnote_" + escapeAttribute(ref)
+}
+
+func renderIndexTerm(ctx *context, t types.IndexTerm) ([]byte, error) {
+	term, err := renderInlineElements(ctx, t.Term)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render index term")
+	}
+	return []byte(fmt.Sprintf("<indexterm><primary>%[1]s</primary></indexterm>%[1]s", term)), nil
+}
+
+func renderConcealedIndexTerm(t types.ConcealedIndexTerm) []byte {
+	result := bytes.NewBufferString("<indexterm>")
+	for i, term := range []interface{}{t.Term1, t.Term2, t.Term3} {
+		if term, ok := term.(string); ok {
+			name := []string{"primary", "secondary", "tertiary"}[i]
+			result.WriteString(fmt.Sprintf("<%[1]s>%[2]s</%[1]s>", name, escape(strings.TrimSpace(term))))
+		}
+	}
+	result.WriteString("</indexterm>")
+	return result.Bytes()
+}
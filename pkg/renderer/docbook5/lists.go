@@ -0,0 +1,136 @@
+package docbook5
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderUnorderedList(ctx *context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	role := ""
+	if len(l.Items) > 0 && l.Items[0].CheckStyle != types.NoCheck {
+		role = ` role="checklist"`
+	}
+	result.WriteString("<itemizedlist" + renderElementID(l.Attributes) + role + ">\n")
+	result.WriteString(renderElementTitle(l.Attributes))
+	for _, item := range l.Items {
+		if err := renderListItem(ctx, result, item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render unordered list")
+		}
+	}
+	result.WriteString("</itemizedlist>")
+	return result.Bytes(), nil
+}
+
+func renderOrderedList(ctx *context, l types.OrderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	numeration := types.Arabic
+	if s, found := l.Attributes.GetAsString(types.AttrNumberingStyle); found {
+		numeration = types.NumberingStyle(s)
+	} else if len(l.Items) > 0 {
+		numeration = l.Items[0].NumberingStyle
+	}
+	start := ""
+	if s, found := l.Attributes.GetAsString(types.AttrStart); found {
+		start = fmt.Sprintf(` startingnumber="%s"`, escapeAttribute(s))
+	}
+	result.WriteString(fmt.Sprintf(`<orderedlist%s numeration="%s"%s>`+"\n", renderElementID(l.Attributes), numerationOf(numeration), start))
+	result.WriteString(renderElementTitle(l.Attributes))
+	for _, item := range l.Items {
+		if err := renderListItem(ctx, result, item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render ordered list")
+		}
+	}
+	result.WriteString("</orderedlist>")
+	return result.Bytes(), nil
+}
+
+// numerationOf returns the DocBook numeration for the given numbering style
+// (the greek numberings are not supported by DocBook, so they fall back to arabic)
+func numerationOf(s types.NumberingStyle) string {
+	switch s {
+	case types.LowerAlpha, types.UpperAlpha, types.LowerRoman, types.UpperRoman:
+		return string(s)
+	default:
+		return string(types.Arabic)
+	}
+}
+
+func renderLabeledList(ctx *context, l types.LabeledList) ([]byte, error) {
+	if l.Attributes.Has(types.AttrQandA) {
+		return renderQandAList(ctx, l)
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString("<variablelist" + renderElementID(l.Attributes) + ">\n")
+	result.WriteString(renderElementTitle(l.Attributes))
+	for _, item := range l.Items {
+		term, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+		result.WriteString("<varlistentry>\n<term>" + strings.TrimSpace(string(term)) + "</term>\n")
+		if err := renderListItem(ctx, result, item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+		result.WriteString("</varlistentry>\n")
+	}
+	result.WriteString("</variablelist>")
+	return result.Bytes(), nil
+}
+
+func renderQandAList(ctx *context, l types.LabeledList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString("<qandaset" + renderElementID(l.Attributes) + ">\n")
+	result.WriteString(renderElementTitle(l.Attributes))
+	for _, item := range l.Items {
+		question, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render Q&A list")
+		}
+		answer, err := renderer.RenderElements(ctx, item.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render Q&A list")
+		}
+		result.WriteString("<qandaentry>\n<question>\n<simpara>" + strings.TrimSpace(string(question)) + "</simpara>\n</question>\n")
+		result.WriteString("<answer>\n" + string(answer) + "\n</answer>\n</qandaentry>\n")
+	}
+	result.WriteString("</qandaset>")
+	return result.Bytes(), nil
+}
+
+func renderCalloutList(ctx *context, l types.CalloutList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString("<calloutlist" + renderElementID(l.Attributes) + ">\n")
+	result.WriteString(renderElementTitle(l.Attributes))
+	for _, item := range l.Items {
+		content, err := renderer.RenderElements(ctx, item.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render callout list")
+		}
+		// refers to the callouts of the previous listing
+		result.WriteString(fmt.Sprintf(`<callout arearefs="%s">`+"\n%s\n</callout>\n", strings.Join(ctx.callouts[item.Ref], " "), content))
+	}
+	result.WriteString("</calloutlist>")
+	return result.Bytes(), nil
+}
+
+// renderListItem renders a `listitem` element with the given elements
+func renderListItem(ctx *context, result *bytes.Buffer, elements []interface{}) error {
+	content, err := renderer.RenderElements(ctx, elements)
+	if err != nil {
+		return err
+	}
+	result.WriteString("<listitem>\n")
+	if len(content) > 0 {
+		result.Write(content)
+		result.WriteString("\n")
+	}
+	result.WriteString("</listitem>\n")
+	return nil
+}
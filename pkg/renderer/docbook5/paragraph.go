@@ -0,0 +1,104 @@
+package docbook5
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderParagraph(ctx *context, p types.Paragraph) ([]byte, error) {
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		content, err := renderParagraphContent(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(fmt.Sprintf("<%[1]s%[2]s>\n%[3]s<simpara>%[4]s</simpara>\n</%[1]s>", k, renderElementID(p.Attributes), renderElementTitle(p.Attributes), content)), nil
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		return renderProgramListing(ctx, p.Attributes, paragraphLines(p))
+	case types.Verse:
+		content, err := renderParagraphContent(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return renderBlockQuote(p.Attributes, "<literallayout>"+string(content)+"</literallayout>"), nil
+	case types.Quote:
+		content, err := renderParagraphContent(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return renderBlockQuote(p.Attributes, "<simpara>"+string(content)+"</simpara>"), nil
+	}
+	content, err := renderParagraphContent(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if title := renderElementTitle(p.Attributes); title != "" {
+		return []byte(fmt.Sprintf("<formalpara%s>\n%s<para>%s</para>\n</formalpara>", renderElementID(p.Attributes), title, content)), nil
+	}
+	return []byte(fmt.Sprintf("<simpara%s>%s</simpara>", renderElementID(p.Attributes), content)), nil
+}
+
+// renderParagraphContent renders the lines of the given paragraph, separated by a newline
+// (and a line break if the `hardbreaks` option or attribute is set)
+func renderParagraphContent(ctx *context, p types.Paragraph) ([]byte, error) {
+	hardbreaks := p.Attributes.Has(types.AttrHardBreaks) || ctx.Attributes.Has(types.DocumentAttrHardBreaks)
+	result := bytes.NewBuffer(nil)
+	switch p.Attributes[types.AttrCheckStyle] {
+	case types.Unchecked:
+		result.WriteString("&#10063; ")
+	case types.Checked:
+		result.WriteString("&#10003; ")
+	}
+	for i, line := range p.Lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render paragraph")
+		}
+		result.Write(renderedLine)
+		if i < len(p.Lines)-1 {
+			if hardbreaks {
+				result.WriteString("<?asciidoc-br?>")
+			}
+			result.WriteString("\n")
+		}
+	}
+	return result.Bytes(), nil
+}
+
+// paragraphLines returns the lines of the given paragraph as verbatim lines
+func paragraphLines(p types.Paragraph) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, len(p.Lines))
+	for i, line := range p.Lines {
+		result[i] = types.VerbatimLine{
+			Content: string(plainText(line)),
+		}
+	}
+	return result
+}
+
+// renderBlockQuote renders a quote or a verse, with its optional attribution
+func renderBlockQuote(attrs types.Attributes, content string) []byte {
+	result := bytes.NewBuffer(nil)
+	result.WriteString("<blockquote" + renderElementID(attrs) + ">\n")
+	result.WriteString(renderElementTitle(attrs))
+	author, hasAuthor := attrs.GetAsString(types.AttrQuoteAuthor)
+	title, hasTitle := attrs.GetAsString(types.AttrQuoteTitle)
+	if hasAuthor || hasTitle {
+		result.WriteString("<attribution>")
+		if hasAuthor {
+			result.WriteString("\n" + escape(author))
+		}
+		if hasTitle {
+			result.WriteString("\n<citetitle>" + escape(title) + "</citetitle>")
+		}
+		result.WriteString("\n</attribution>\n")
+	}
+	result.WriteString(content)
+	result.WriteString("\n</blockquote>")
+	return result.Bytes()
+}
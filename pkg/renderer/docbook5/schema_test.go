@@ -0,0 +1,204 @@
+package docbook5_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/onsi/gomega/types"
+)
+
+// the content models of the DocBook 5 elements emitted by the backend,
+// i.e., a subset of the DocBook 5.0 schema (http://docbook.org/xml/5.0/rng/docbook.rng)
+var (
+	inlines = []string{"emphasis", "literal", "subscript", "superscript", "link", "xref", "footnote", "footnoteref", "indexterm", "inlinemediaobject"}
+	blocks  = []string{"simpara", "para", "formalpara", "bridgehead",
+		"itemizedlist", "orderedlist", "variablelist", "qandaset", "calloutlist",
+		"programlisting", "screen", "literallayout",
+		"table", "informaltable", "figure", "informalfigure",
+		"note", "tip", "important", "warning", "caution",
+		"blockquote", "sidebar", "example", "informalexample"}
+	verbatim = append([]string{"co"}, inlines...)
+	// the allowed children of each element, and whether text is allowed (mixed content)
+	contentModels = map[string]contentModel{
+		"article":           {children: append([]string{"info", "section"}, blocks...)},
+		"book":              {children: []string{"info", "preface", "chapter", "part"}},
+		"part":              {children: []string{"title", "chapter"}, requiresTitle: true},
+		"chapter":           {children: append([]string{"title", "section"}, blocks...), requiresTitle: true},
+		"preface":           {children: append([]string{"title", "section"}, blocks...), requiresTitle: true},
+		"section":           {children: append([]string{"title", "section"}, blocks...), requiresTitle: true},
+		"info":              {children: []string{"title", "date", "author", "authorinitials", "revhistory"}},
+		"author":            {children: []string{"personname", "email"}},
+		"personname":        {children: []string{"firstname", "othername", "surname"}},
+		"revhistory":        {children: []string{"revision"}},
+		"revision":          {children: []string{"revnumber", "date", "authorinitials", "revremark"}},
+		"title":             {children: inlines, mixed: true},
+		"date":              {mixed: true},
+		"authorinitials":    {mixed: true},
+		"firstname":         {mixed: true},
+		"othername":         {mixed: true},
+		"surname":           {mixed: true},
+		"email":             {mixed: true},
+		"revnumber":         {mixed: true},
+		"revremark":         {mixed: true},
+		"simpara":           {children: inlines, mixed: true},
+		"para":              {children: append(append([]string{}, inlines...), blocks...), mixed: true},
+		"formalpara":        {children: []string{"title", "para"}, requiresTitle: true},
+		"bridgehead":        {children: inlines, mixed: true},
+		"itemizedlist":      {children: []string{"title", "listitem"}},
+		"orderedlist":       {children: []string{"title", "listitem"}},
+		"listitem":          {children: blocks},
+		"variablelist":      {children: []string{"title", "varlistentry"}},
+		"varlistentry":      {children: []string{"term", "listitem"}},
+		"term":              {children: inlines, mixed: true},
+		"qandaset":          {children: []string{"title", "qandaentry"}},
+		"qandaentry":        {children: []string{"question", "answer"}},
+		"question":          {children: blocks},
+		"answer":            {children: blocks},
+		"calloutlist":       {children: []string{"title", "callout"}},
+		"callout":           {children: blocks},
+		"programlisting":    {children: verbatim, mixed: true},
+		"screen":            {children: verbatim, mixed: true},
+		"literallayout":     {children: verbatim, mixed: true},
+		"co":                {},
+		"table":             {children: []string{"title", "tgroup"}, requiresTitle: true},
+		"informaltable":     {children: []string{"tgroup"}},
+		"tgroup":            {children: []string{"colspec", "thead", "tbody"}},
+		"colspec":           {},
+		"thead":             {children: []string{"row"}},
+		"tbody":             {children: []string{"row"}},
+		"row":               {children: []string{"entry"}},
+		"entry":             {children: append([]string{"simpara"}, inlines...), mixed: true},
+		"figure":            {children: []string{"title", "mediaobject"}, requiresTitle: true},
+		"informalfigure":    {children: []string{"mediaobject"}},
+		"mediaobject":       {children: []string{"imageobject", "textobject"}},
+		"inlinemediaobject": {children: []string{"imageobject", "textobject"}},
+		"imageobject":       {children: []string{"imagedata"}},
+		"imagedata":         {},
+		"textobject":        {children: []string{"phrase"}},
+		"phrase":            {mixed: true},
+		"note":              {children: append([]string{"title"}, blocks...)},
+		"tip":               {children: append([]string{"title"}, blocks...)},
+		"important":         {children: append([]string{"title"}, blocks...)},
+		"warning":           {children: append([]string{"title"}, blocks...)},
+		"caution":           {children: append([]string{"title"}, blocks...)},
+		"blockquote":        {children: append([]string{"title", "attribution"}, blocks...)},
+		"attribution":       {children: []string{"citetitle"}, mixed: true},
+		"citetitle":         {children: inlines, mixed: true},
+		"sidebar":           {children: append([]string{"title"}, blocks...)},
+		"example":           {children: append([]string{"title"}, blocks...), requiresTitle: true},
+		"informalexample":   {children: blocks},
+		"emphasis":          {children: inlines, mixed: true},
+		"literal":           {children: inlines, mixed: true},
+		"subscript":         {children: inlines, mixed: true},
+		"superscript":       {children: inlines, mixed: true},
+		"link":              {children: inlines, mixed: true},
+		"xref":              {},
+		"footnote":          {children: blocks},
+		"footnoteref":       {},
+		"indexterm":         {children: []string{"primary", "secondary", "tertiary"}},
+		"primary":           {children: inlines, mixed: true},
+		"secondary":         {children: inlines, mixed: true},
+		"tertiary":          {children: inlines, mixed: true},
+	}
+)
+
+type contentModel struct {
+	children      []string
+	mixed         bool
+	requiresTitle bool
+}
+
+func (m contentModel) allows(child string) bool {
+	for _, c := range m.children {
+		if c == child {
+			return true
+		}
+	}
+	return false
+}
+
+// validateStructure verifies that the given document is well-formed, and that its elements
+// are in the DocBook namespace and match the content models above
+func validateStructure(doc string) error {
+	decoder := xml.NewDecoder(strings.NewReader(doc))
+	type element struct {
+		name     string
+		children int
+		hasTitle bool
+	}
+	stack := []*element{}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space != "http://docbook.org/ns/docbook" {
+				return fmt.Errorf("element '%s' is not in the DocBook namespace", t.Name.Local)
+			}
+			if _, found := contentModels[t.Name.Local]; !found {
+				return fmt.Errorf("unknown element '%s'", t.Name.Local)
+			}
+			if len(stack) == 0 {
+				if t.Name.Local != "article" && t.Name.Local != "book" {
+					return fmt.Errorf("unexpected root element '%s'", t.Name.Local)
+				}
+			} else {
+				parent := stack[len(stack)-1]
+				if !contentModels[parent.name].allows(t.Name.Local) {
+					return fmt.Errorf("element '%s' is not allowed in '%s'", t.Name.Local, parent.name)
+				}
+				if t.Name.Local == "title" {
+					if parent.children > 0 {
+						return fmt.Errorf("the title of '%s' is not its first child", parent.name)
+					}
+					parent.hasTitle = true
+				}
+				parent.children++
+			}
+			stack = append(stack, &element{name: t.Name.Local})
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			if contentModels[e.name].requiresTitle && !e.hasTitle {
+				return fmt.Errorf("element '%s' has no title", e.name)
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 && !contentModels[stack[len(stack)-1].name].mixed && strings.TrimSpace(string(t)) != "" {
+				return fmt.Errorf("unexpected text in '%s': '%s'", stack[len(stack)-1].name, string(t))
+			}
+		}
+	}
+	return nil
+}
+
+// MatchDocBookStructure a custom matcher to verify that a full DocBook document matches the structure of the schema
+func MatchDocBookStructure() types.GomegaMatcher {
+	return &docbookStructureMatcher{}
+}
+
+type docbookStructureMatcher struct {
+	err error
+}
+
+func (m *docbookStructureMatcher) Match(actual interface{}) (bool, error) {
+	doc, ok := actual.(string)
+	if !ok {
+		return false, fmt.Errorf("MatchDocBookStructure matcher expects a string (actual: %T)", actual)
+	}
+	m.err = validateStructure(doc)
+	return m.err == nil, nil
+}
+
+func (m *docbookStructureMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("expected document to match the DocBook structure: %v\n%s", m.err, actual)
+}
+
+func (m *docbookStructureMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("expected document not to match the DocBook structure\n%s", actual)
+}
@@ -0,0 +1,64 @@
+package docbook5
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderPreamble(ctx *context, p types.Preamble) ([]byte, error) {
+	content, err := renderer.RenderElements(ctx, p.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render preamble")
+	}
+	if len(content) == 0 {
+		return []byte{}, nil
+	}
+	if ctx.book {
+		// in a book, the preamble is rendered as a preface without title
+		return []byte("<preface>\n<title></title>\n" + string(content) + "\n</preface>"), nil
+	}
+	return content, nil
+}
+
+func renderSection(ctx *context, s types.Section) ([]byte, error) {
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section title")
+	}
+	content, err := renderer.RenderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section content")
+	}
+	if s.Attributes.Has(types.AttrDiscrete) {
+		// discrete headings are not part of the outline
+		result := fmt.Sprintf(`<bridgehead%s renderas="sect%d">%s</bridgehead>`, renderElementID(s.Attributes), s.Level, title)
+		if len(content) > 0 {
+			result += "\n" + string(content)
+		}
+		return []byte(result), nil
+	}
+	name := sectionElement(ctx, s)
+	result := fmt.Sprintf("<%s%s>\n<title>%s</title>", name, renderElementID(s.Attributes), title)
+	if len(content) > 0 {
+		result += "\n" + string(content)
+	}
+	return []byte(result + "\n</" + name + ">"), nil
+}
+
+// sectionElement returns the name of the element of the given section:
+// `part` and `chapter` for the sections of level 0 and 1 in a book, `section` otherwise
+func sectionElement(ctx *context, s types.Section) string {
+	if ctx.book {
+		switch s.Level {
+		case 0:
+			return "part"
+		case 1:
+			return "chapter"
+		}
+	}
+	return "section"
+}
@@ -0,0 +1,71 @@
+package docbook5
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderTable(ctx *context, t types.Table) ([]byte, error) {
+	cols := len(t.Header.Cells)
+	if len(t.Lines) > 0 {
+		cols = len(t.Lines[0].Cells)
+	}
+	result := bytes.NewBuffer(nil)
+	name := "informaltable"
+	if t.Attributes.Has(types.AttrTitle) {
+		name = "table"
+	}
+	result.WriteString(fmt.Sprintf(`<%s%s frame="all" rowsep="1" colsep="1">`+"\n", name, renderElementID(t.Attributes)))
+	result.WriteString(renderElementTitle(t.Attributes))
+	result.WriteString(fmt.Sprintf(`<tgroup cols="%d">`+"\n", cols))
+	// all columns have the same width
+	for i := 1; i <= cols; i++ {
+		result.WriteString(fmt.Sprintf(`<colspec colname="col_%d" colwidth="%s*"/>`+"\n", i, formatColumnWidth(100/float64(cols))))
+	}
+	if len(t.Header.Cells) > 0 {
+		result.WriteString("<thead>\n")
+		if err := renderTableRow(ctx, result, t.Header, false); err != nil {
+			return nil, err
+		}
+		result.WriteString("</thead>\n")
+	}
+	result.WriteString("<tbody>\n")
+	for _, l := range t.Lines {
+		if err := renderTableRow(ctx, result, l, true); err != nil {
+			return nil, err
+		}
+	}
+	result.WriteString("</tbody>\n</tgroup>\n</" + name + ">")
+	return result.Bytes(), nil
+}
+
+// renderTableRow renders a `row` of the table. The content of the cells of the body
+// is wrapped in a `simpara` element, whereas the content of the header cells is not.
+func renderTableRow(ctx *context, result *bytes.Buffer, l types.TableLine, body bool) error {
+	result.WriteString("<row>\n")
+	for _, cell := range l.Cells {
+		content, err := renderInlineElements(ctx, cell)
+		if err != nil {
+			return errors.Wrapf(err, "unable to render table")
+		}
+		c := strings.TrimSpace(string(content))
+		if body {
+			c = "<simpara>" + c + "</simpara>"
+		}
+		result.WriteString(`<entry align="left" valign="top">` + c + "</entry>\n")
+	}
+	result.WriteString("</row>\n")
+	return nil
+}
+
+// formatColumnWidth formats the given width with up to 4 decimals
+func formatColumnWidth(w float64) string {
+	return strconv.FormatFloat(math.Round(w*10000)/10000, 'f', -1, 64)
+}
@@ -0,0 +1,101 @@
+package renderer
+
+import (
+	"bytes"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// BlockRenderer renders the blocks of a document in the format of a backend which does not rely on templates
+// (eg: `docbook5` or `latex`), while `RenderElements` traverses the blocks and dispatches them to the methods
+// of the renderer, by type
+type BlockRenderer interface {
+	// ApplyAttributeEntry applies the given attribute declaration or reset of the document body
+	// on the attributes used to render the elements which follow
+	ApplyAttributeEntry(element interface{}) bool
+	// BlockSeparator returns the separator of the rendered blocks (eg: a newline)
+	BlockSeparator() string
+	RenderSection(s types.Section) ([]byte, error)
+	RenderPreamble(p types.Preamble) ([]byte, error)
+	RenderLabeledList(l types.LabeledList) ([]byte, error)
+	RenderOrderedList(l types.OrderedList) ([]byte, error)
+	RenderUnorderedList(l types.UnorderedList) ([]byte, error)
+	RenderCalloutList(l types.CalloutList) ([]byte, error)
+	RenderParagraph(p types.Paragraph) ([]byte, error)
+	RenderImageBlock(img types.ImageBlock) ([]byte, error)
+	RenderDelimitedBlock(b types.DelimitedBlock) ([]byte, error)
+	RenderTable(t types.Table) ([]byte, error)
+	RenderLiteralBlock(b types.LiteralBlock) ([]byte, error)
+	RenderBlockMacro(m types.UserMacro) ([]byte, error)
+	// RenderInlineElement renders the elements which are not blocks (eg: a string or an inline macro)
+	RenderInlineElement(element interface{}) ([]byte, error)
+}
+
+// RenderElements renders the given block elements with the given renderer, joined with its block separator.
+// The elements which are rendered as an empty content are skipped.
+func RenderElements(r BlockRenderer, elements []interface{}) ([]byte, error) {
+	log.Debugf("rendering %d elements(s)...", len(elements))
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := RenderElement(r, element)
+		if err != nil {
+			return nil, err // no need to wrap the error here
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if buff.Len() > 0 {
+			buff.WriteString(r.BlockSeparator())
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// RenderElement renders the given element with the method of the given renderer which matches its type.
+// The attribute entries are applied on the context of the renderer, and the table of contents placeholder,
+// the blank lines and the single-line comments are not rendered.
+// nolint: gocyclo
+func RenderElement(r BlockRenderer, element interface{}) ([]byte, error) {
+	log.Debugf("rendering element of type `%T`", element)
+	switch e := element.(type) {
+	case []interface{}:
+		return RenderElements(r, e)
+	case types.AttributeDeclaration, types.AttributeReset:
+		r.ApplyAttributeEntry(e)
+		return []byte{}, nil
+	case types.TableOfContentsPlaceHolder, types.BlankLine, types.SingleLineComment:
+		return []byte{}, nil
+	case types.Section:
+		return r.RenderSection(e)
+	case types.Preamble:
+		return r.RenderPreamble(e)
+	case types.LabeledList:
+		return r.RenderLabeledList(e)
+	case types.OrderedList:
+		return r.RenderOrderedList(e)
+	case types.UnorderedList:
+		return r.RenderUnorderedList(e)
+	case types.CalloutList:
+		return r.RenderCalloutList(e)
+	case types.Paragraph:
+		return r.RenderParagraph(e)
+	case types.ImageBlock:
+		return r.RenderImageBlock(e)
+	case types.DelimitedBlock:
+		return r.RenderDelimitedBlock(e)
+	case types.Table:
+		return r.RenderTable(e)
+	case types.LiteralBlock:
+		return r.RenderLiteralBlock(e)
+	case types.UserMacro:
+		if e.Kind == types.BlockMacro {
+			return r.RenderBlockMacro(e)
+		}
+		return r.RenderInlineElement(e)
+	default:
+		return r.RenderInlineElement(element)
+	}
+}
@@ -0,0 +1,138 @@
+package renderer_test
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("elements rendering", func() {
+
+	It("should render the blocks separated by the separator of the renderer", func() {
+		r := newBlockRenderer()
+		result, err := renderer.RenderElements(r, []interface{}{
+			types.Paragraph{},
+			types.BlankLine{},
+			types.Section{},
+			[]interface{}{
+				types.Table{},
+				types.StringElement{Content: "cookie"},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(result)).To(Equal("paragraph|section|table|inline:types.StringElement"))
+	})
+
+	It("should skip the table of contents placeholder, the blank lines and the single-line comments", func() {
+		r := newBlockRenderer()
+		result, err := renderer.RenderElements(r, []interface{}{
+			types.TableOfContentsPlaceHolder{},
+			types.BlankLine{},
+			types.SingleLineComment{Content: "a comment"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeEmpty())
+	})
+
+	It("should render the block and inline macros", func() {
+		r := newBlockRenderer()
+		result, err := renderer.RenderElements(r, []interface{}{
+			types.UserMacro{Kind: types.BlockMacro, Name: "block"},
+			types.UserMacro{Kind: types.InlineMacro, Name: "inline"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(result)).To(Equal("macro:block|inline:types.UserMacro"))
+	})
+
+	It("should apply the attribute entries on the elements which follow", func() {
+		r := newBlockRenderer()
+		r.Attributes = types.Attributes{
+			"foo": "bar",
+		}
+		result, err := renderer.RenderElements(r, []interface{}{
+			types.Paragraph{},
+			types.AttributeDeclaration{Name: "foo", Value: "baz"},
+			types.Paragraph{},
+			types.AttributeReset{Name: "foo"},
+			types.Paragraph{},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(result)).To(Equal("paragraph|paragraph|paragraph"))
+		Expect(r.paragraphs).To(Equal([]interface{}{"bar", "baz", nil}))
+	})
+})
+
+// blockRenderer a renderer which renders the name of the type of the blocks,
+// and records the value of the `foo` attribute when it renders a paragraph
+type blockRenderer struct {
+	renderer.Context
+	paragraphs []interface{}
+}
+
+func newBlockRenderer() *blockRenderer {
+	return &blockRenderer{
+		Context: renderer.NewContext(types.Document{}, configuration.NewConfiguration()),
+	}
+}
+
+func (r *blockRenderer) BlockSeparator() string {
+	return "|"
+}
+
+func (r *blockRenderer) RenderSection(s types.Section) ([]byte, error) {
+	return []byte("section"), nil
+}
+
+func (r *blockRenderer) RenderPreamble(p types.Preamble) ([]byte, error) {
+	return []byte("preamble"), nil
+}
+
+func (r *blockRenderer) RenderLabeledList(l types.LabeledList) ([]byte, error) {
+	return []byte("labeled list"), nil
+}
+
+func (r *blockRenderer) RenderOrderedList(l types.OrderedList) ([]byte, error) {
+	return []byte("ordered list"), nil
+}
+
+func (r *blockRenderer) RenderUnorderedList(l types.UnorderedList) ([]byte, error) {
+	return []byte("unordered list"), nil
+}
+
+func (r *blockRenderer) RenderCalloutList(l types.CalloutList) ([]byte, error) {
+	return []byte("callout list"), nil
+}
+
+func (r *blockRenderer) RenderParagraph(p types.Paragraph) ([]byte, error) {
+	r.paragraphs = append(r.paragraphs, r.Attributes["foo"])
+	return []byte("paragraph"), nil
+}
+
+func (r *blockRenderer) RenderImageBlock(img types.ImageBlock) ([]byte, error) {
+	return []byte("image"), nil
+}
+
+func (r *blockRenderer) RenderDelimitedBlock(b types.DelimitedBlock) ([]byte, error) {
+	return []byte("delimited block"), nil
+}
+
+func (r *blockRenderer) RenderTable(t types.Table) ([]byte, error) {
+	return []byte("table"), nil
+}
+
+func (r *blockRenderer) RenderLiteralBlock(b types.LiteralBlock) ([]byte, error) {
+	return []byte("literal block"), nil
+}
+
+func (r *blockRenderer) RenderBlockMacro(m types.UserMacro) ([]byte, error) {
+	return []byte("macro:" + m.Name), nil
+}
+
+func (r *blockRenderer) RenderInlineElement(element interface{}) ([]byte, error) {
+	return []byte(fmt.Sprintf("inline:%T", element)), nil
+}
@@ -0,0 +1,233 @@
+package epub3
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// chapter a content document of the publication
+type chapter struct {
+	File     string
+	Title    string
+	IDs      map[string]bool // the IDs of the elements of the chapter, for the links from the other chapters
+	Elements []interface{}
+	Part     *types.Section // the part of a book, whose title is rendered at the beginning of the chapter
+	Content  []byte
+}
+
+var chapterTmpl texttemplate.Template
+
+func init() {
+	chapterTmpl = newTextTemplate("chapter", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{ .Lang }}" lang="{{ .Lang }}">
+<head>
+<meta charset="UTF-8"/>
+<title>{{ escape .Title }}</title>
+<link rel="stylesheet" type="text/css" href="{{ .Stylesheet }}"/>
+</head>
+<body class="{{ .Doctype }}">
+<section epub:type="{{ .Type }}">
+{{ .Content }}
+</section>
+</body>
+</html>
+`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+// splitChapters splits the content of the document in chapters: the preamble (if any), and each section of level 1.
+// In a book, the parts (i.e., the sections of level 0 after the header) start with a chapter containing their title
+// and their content before the first chapter of the part.
+func splitChapters(doc types.Document) []*chapter {
+	header, hasHeader := doc.Header()
+	elements := doc.Elements
+	if hasHeader {
+		elements = append(append([]interface{}{}, header.Elements...), doc.Elements[1:]...)
+	}
+	result := []*chapter{}
+	var current *chapter // the chapter with the elements before the next section
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.Section:
+			if e.Level == 0 {
+				part := e
+				current = &chapter{
+					Title: string(plainText(e.Title)),
+					Part:  &part,
+				}
+				result = append(result, current)
+				for _, pe := range e.Elements {
+					if s, ok := pe.(types.Section); ok {
+						result = append(result, &chapter{
+							Title:    string(plainText(s.Title)),
+							Elements: []interface{}{s},
+						})
+						continue
+					}
+					current.Elements = append(current.Elements, pe)
+				}
+				current = nil
+				continue
+			}
+			result = append(result, &chapter{
+				Title:    string(plainText(e.Title)),
+				Elements: []interface{}{e},
+			})
+			current = nil
+		case types.TableOfContentsPlaceHolder, types.BlankLine:
+			// the table of contents is in the navigation document
+			continue
+		default:
+			if current == nil {
+				current = &chapter{
+					Title: documentTitle(doc),
+				}
+				result = append(result, current)
+			}
+			current.Elements = append(current.Elements, e)
+		}
+	}
+	for i, c := range result {
+		c.File = fmt.Sprintf("chapter-%02d.xhtml", i+1)
+	}
+	return result
+}
+
+// renderChapters renders the chapters of the document in XHTML, in which the links to the elements of the other chapters
+// refer to the file of the chapter
+func renderChapters(ctx renderer.Context, doc types.Document) ([]*chapter, error) {
+	chapters := splitChapters(doc)
+	for _, c := range chapters {
+		content, err := renderChapterContent(ctx, doc, c)
+		if err != nil {
+			return nil, err
+		}
+		c.Content = content
+		c.IDs = map[string]bool{}
+		for _, m := range idRegexp.FindAllSubmatch(content, -1) {
+			c.IDs[string(m[1])] = true
+		}
+	}
+	for _, c := range chapters {
+		c.Content = hrefRegexp.ReplaceAllFunc(c.Content, func(m []byte) []byte {
+			id := string(hrefRegexp.FindSubmatch(m)[1])
+			if c.IDs[id] {
+				return m
+			}
+			if file, found := chapterFile(chapters, id); found {
+				return []byte(`href="` + file + `#` + id + `"`)
+			}
+			return m
+		})
+		result := bytes.NewBuffer(nil)
+		typ := "chapter"
+		if c.Part != nil {
+			typ = "part"
+		}
+		if err := chapterTmpl.Execute(result, struct {
+			Lang       string
+			Title      string
+			Stylesheet string
+			Doctype    string
+			Type       string
+			Content    string
+		}{
+			Lang:       doc.Attributes.GetLang(),
+			Title:      c.Title,
+			Stylesheet: stylesheetPath,
+			Doctype:    doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article"),
+			Type:       typ,
+			Content:    string(c.Content),
+		}); err != nil {
+			return nil, errors.Wrapf(err, "unable to render chapter")
+		}
+		c.Content = result.Bytes()
+	}
+	return chapters, nil
+}
+
+var idRegexp = regexp.MustCompile(`\sid="([^"]+)"`)
+
+var hrefRegexp = regexp.MustCompile(`href="#([^"]+)"`)
+
+// chapterFile returns the file of the chapter which contains the element with the given ID
+func chapterFile(chapters []*chapter, id string) (string, bool) {
+	for _, c := range chapters {
+		if c.IDs[id] {
+			return c.File, true
+		}
+	}
+	return "", false
+}
+
+// renderChapterContent renders the elements of the chapter with the `html5` backend (in XHTML),
+// along with the footnotes which are referenced in the chapter
+func renderChapterContent(ctx renderer.Context, doc types.Document, c *chapter) ([]byte, error) {
+	attrs := types.Attributes{}
+	for k, v := range doc.Attributes {
+		attrs[k] = v
+	}
+	// the elements are rendered as part of a regular article, without table of contents
+	attrs[types.AttrDocType] = "article"
+	delete(attrs, types.AttrTableOfContents)
+	attrs[types.AttrHTMLSyntax] = "xml"
+	ctx.Config.IncludeHeaderFooter = false
+	ctx.Attributes = attrs
+	ctx.HasHeader = false
+	result := bytes.NewBuffer(nil)
+	if c.Part != nil {
+		result.WriteString(renderPartTitle(*c.Part))
+		if len(c.Elements) > 0 {
+			result.WriteString("\n")
+		}
+	}
+	if len(c.Elements) > 0 {
+		if _, err := html5.Render(ctx, types.Document{
+			Attributes:        attrs,
+			Elements:          c.Elements,
+			ElementReferences: doc.ElementReferences,
+			Footnotes:         chapterFootnotes(doc.Footnotes, c.Elements),
+		}, result); err != nil {
+			return nil, errors.Wrapf(err, "unable to render chapter")
+		}
+	}
+	return result.Bytes(), nil
+}
+
+// renderPartTitle renders the title of the part of a book
+func renderPartTitle(part types.Section) string {
+	title := html.EscapeString(string(plainText(part.Title)))
+	if id := part.Attributes.GetAsStringWithDefault(types.AttrID, ""); id != "" {
+		return fmt.Sprintf(`<h1 id="%s" class="sect0">%s</h1>`, html.EscapeString(id), title)
+	}
+	return fmt.Sprintf(`<h1 class="sect0">%s</h1>`, title)
+}
+
+// chapterFootnotes returns the footnotes which are referenced in the given elements
+func chapterFootnotes(footnotes []types.Footnote, elements []interface{}) []types.Footnote {
+	ids := map[int]bool{}
+	walk(elements, func(e interface{}) {
+		if r, ok := e.(types.FootnoteReference); ok {
+			ids[r.ID] = true
+		}
+	})
+	result := []types.Footnote{}
+	for _, f := range footnotes {
+		if ids[f.ID] {
+			result = append(result, f)
+		}
+	}
+	return result
+}
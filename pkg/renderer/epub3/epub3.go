@@ -0,0 +1,149 @@
+package epub3
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// registers the `epub3` backend
+func init() {
+	renderer.Register("epub3", renderer.RenderFunc(Render), map[string]string{
+		types.AttrBaseBackend:   "html",
+		types.AttrOutFileSuffix: ".epub",
+		types.AttrFileType:      "epub",
+		types.AttrHTMLSyntax:    "xml",
+	})
+}
+
+const (
+	// the directory of the publication in the container
+	rootDir = "EPUB/"
+	// the location of the package document in the container
+	packagePath = rootDir + "content.opf"
+	// the location of the stylesheet, relatively to the package document
+	stylesheetPath = "styles/" + html5.DefaultStylesheetName
+)
+
+var containerTmpl texttemplate.Template
+
+func init() {
+	containerTmpl = newTextTemplate("container", `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+<rootfiles>
+<rootfile full-path="{{ . }}" media-type="application/oebps-package+xml"/>
+</rootfiles>
+</container>
+`)
+}
+
+// Render renders the given document in an EPUB 3 publication, i.e., a ZIP archive which contains a chapter in XHTML
+// for each section of level 1 (or for each chapter of a book), along with the package document, the navigation document
+// and the images, and writes the result in the given `writer`
+func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	chapters, err := renderChapters(ctx, doc)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	toc, err := html5.NewTableOfContents(ctx, doc)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	images := loadImages(ctx, doc)
+	pub := newPublication(ctx, doc, chapters, images)
+	nav, err := renderNavigation(ctx, pub, toc, chapters)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	result := bytes.NewBuffer(nil)
+	w := zip.NewWriter(result)
+	// the `mimetype` file must be the first entry of the archive, without compression
+	if err := writeEntry(w, "mimetype", []byte("application/epub+zip"), zip.Store); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	container := bytes.NewBuffer(nil)
+	if err := containerTmpl.Execute(container, packagePath); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	if err := writeEntry(w, "META-INF/container.xml", container.Bytes(), zip.Deflate); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	opf, err := renderPackage(pub)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	entries := []entry{
+		{path: packagePath, content: opf},
+		{path: rootDir + navPath, content: nav},
+		{path: rootDir + stylesheetPath, content: []byte(html5.DefaultStylesheet)},
+	}
+	if pub.Cover != nil {
+		cover, err := renderCover(pub)
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+		}
+		entries = append(entries, entry{path: rootDir + coverPath, content: cover})
+	}
+	for _, c := range chapters {
+		entries = append(entries, entry{path: rootDir + c.File, content: c.Content})
+	}
+	for _, img := range images {
+		entries = append(entries, entry{path: rootDir + img.Href, content: img.Content})
+	}
+	for _, e := range entries {
+		if err := writeEntry(w, e.path, e.content, zip.Deflate); err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+		}
+	}
+	if err := w.Close(); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	if _, err := output.Write(result.Bytes()); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render EPUB publication")
+	}
+	log.Debugf("rendered EPUB publication with %d chapter(s) and %d image(s)", len(chapters), len(images))
+	authors, _ := doc.Authors()
+	revision, _ := doc.Revision()
+	return types.Metadata{
+		Title:           pub.Title,
+		Authors:         authors,
+		Revision:        revision,
+		TableOfContents: toc,
+		Warnings:        doc.Warnings,
+	}, nil
+}
+
+// entry an entry of the archive
+type entry struct {
+	path    string
+	content []byte
+}
+
+// writeEntry writes an entry with the given path and content in the archive
+func writeEntry(w *zip.Writer, path string, content []byte, method uint16) error {
+	f, err := w.CreateHeader(&zip.FileHeader{
+		Name:   path,
+		Method: method,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	return err
+}
+
+func newTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttemplate.Template {
+	t := texttemplate.New(name)
+	for _, f := range funcs {
+		t.Funcs(f)
+	}
+	return *texttemplate.Must(t.Parse(src))
+}
@@ -0,0 +1,191 @@
+package epub3_test
+
+import (
+	"testing/fstest"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("epub3 publications", func() {
+
+	lastUpdated := configuration.WithLastUpdated(time.Date(2021, 3, 7, 10, 30, 0, 0, time.FixedZone("CET", 3600)))
+
+	It("article with preamble and sections", func() {
+		source := `= Document Title
+John Doe <john@example.com>; Jane Roe
+v1.2, 2021-03-01: First release
+
+An introduction.footnote:[A note in the preamble.]
+
+== Section A
+
+See <<_section_b>>.
+
+=== Section A.1
+
+Some content.footnote:[A note in section A.]
+
+== Section B
+
+Back to <<_section_a>>.`
+		files, err := RenderEPUB(source, lastUpdated)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveKey("EPUB/chapter-01.xhtml"))
+		Expect(files).To(HaveKey("EPUB/chapter-02.xhtml"))
+		Expect(files).To(HaveKey("EPUB/chapter-03.xhtml"))
+		Expect(files).NotTo(HaveKey("EPUB/chapter-04.xhtml"))
+		Expect(files).To(HaveKey("EPUB/styles/libasciidoc.css"))
+		// package document
+		opf := files["EPUB/content.opf"]
+		Expect(opf).To(MatchRegexp(`<dc:identifier id="pub-id">urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}</dc:identifier>`))
+		Expect(opf).To(ContainSubstring(`<dc:title>Document Title</dc:title>`))
+		Expect(opf).To(ContainSubstring(`<dc:language>en</dc:language>`))
+		Expect(opf).To(ContainSubstring(`<dc:creator id="creator-1">John Doe</dc:creator>`))
+		Expect(opf).To(ContainSubstring(`<dc:creator id="creator-2">Jane Roe</dc:creator>`))
+		Expect(opf).To(ContainSubstring(`<dc:date>2021-03-01</dc:date>`))
+		Expect(opf).To(ContainSubstring(`<meta property="dcterms:hasVersion">1.2</meta>`))
+		Expect(opf).To(ContainSubstring(`<meta property="dcterms:modified">2021-03-07T09:30:00Z</meta>`))
+		Expect(opf).To(ContainSubstring(`<spine>
+<itemref idref="chapter-1"/>
+<itemref idref="chapter-2"/>
+<itemref idref="chapter-3"/>
+</spine>`))
+		// navigation document
+		Expect(files["EPUB/nav.xhtml"]).To(ContainSubstring(`<nav epub:type="toc" id="toc">
+<h1>Table of Contents</h1>
+<ol>
+<li><a href="chapter-02.xhtml#_section_a">Section A</a>
+<ol>
+<li><a href="chapter-02.xhtml#_section_a_1">Section A.1</a></li>
+</ol></li>
+<li><a href="chapter-03.xhtml#_section_b">Section B</a></li>
+</ol>
+</nav>`))
+		// chapters, with the links to the other chapters and their own footnotes
+		Expect(files["EPUB/chapter-01.xhtml"]).To(ContainSubstring(`<p>An introduction.<sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></p>`))
+		Expect(files["EPUB/chapter-01.xhtml"]).To(ContainSubstring(`<div class="footnote" id="_footnotedef_1">`))
+		Expect(files["EPUB/chapter-01.xhtml"]).NotTo(ContainSubstring(`_footnotedef_2`))
+		Expect(files["EPUB/chapter-02.xhtml"]).To(ContainSubstring(`<title>Section A</title>`))
+		Expect(files["EPUB/chapter-02.xhtml"]).To(ContainSubstring(`<p>See <a href="chapter-03.xhtml#_section_b">Section B</a>.</p>`))
+		Expect(files["EPUB/chapter-02.xhtml"]).To(ContainSubstring(`<div class="footnote" id="_footnotedef_2">
+<a href="#_footnoteref_2">2</a>. A note in section A.
+</div>`))
+		Expect(files["EPUB/chapter-02.xhtml"]).NotTo(ContainSubstring(`_footnotedef_1`))
+		Expect(files["EPUB/chapter-03.xhtml"]).To(ContainSubstring(`<p>Back to <a href="chapter-02.xhtml#_section_a">Section A</a>.</p>`))
+		Expect(files["EPUB/chapter-03.xhtml"]).NotTo(ContainSubstring(`id="footnotes"`))
+	})
+
+	It("identifier, language and title of the publication", func() {
+		source := `= Le Titre
+:lang: fr
+:uuid: 0b71e0ae-6f3c-4f5e-8a1f-0d0a2c5b9e41
+
+Un paragraphe.`
+		files, err := RenderEPUB(source)
+		Expect(err).NotTo(HaveOccurred())
+		opf := files["EPUB/content.opf"]
+		Expect(opf).To(ContainSubstring(`<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="pub-id" xml:lang="fr">`))
+		Expect(opf).To(ContainSubstring(`<dc:identifier id="pub-id">urn:uuid:0b71e0ae-6f3c-4f5e-8a1f-0d0a2c5b9e41</dc:identifier>`))
+		Expect(opf).To(ContainSubstring(`<dc:title>Le Titre</dc:title>`))
+		Expect(opf).To(ContainSubstring(`<dc:language>fr</dc:language>`))
+		Expect(opf).NotTo(ContainSubstring(`<dc:creator`))
+		Expect(files["EPUB/chapter-01.xhtml"]).To(ContainSubstring(`xml:lang="fr" lang="fr"`))
+		// without sections, the navigation document refers to the chapters
+		Expect(files["EPUB/nav.xhtml"]).To(ContainSubstring(`<h1>Table des matières</h1>
+<ol>
+<li><a href="chapter-01.xhtml">Le Titre</a></li>
+</ol>`))
+	})
+
+	It("same identifier when rendering the same document", func() {
+		source := `= Document Title
+v1.0
+
+content`
+		// with the same `last updated` value (used in the `dcterms:modified` property)
+		first, err := RenderEPUB(source, lastUpdated)
+		Expect(err).NotTo(HaveOccurred())
+		second, err := RenderEPUB(source, lastUpdated)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first["EPUB/content.opf"]).To(MatchRegexp(`<dc:identifier id="pub-id">urn:uuid:`))
+		Expect(first["EPUB/content.opf"]).To(Equal(second["EPUB/content.opf"]))
+	})
+
+	It("book with parts", func() {
+		source := `= Book Title
+:doctype: book
+
+= Part One
+
+The first part.
+
+== Chapter One
+
+content
+
+== Chapter Two
+
+content`
+		files, err := RenderEPUB(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files["EPUB/chapter-01.xhtml"]).To(ContainSubstring(`<body class="book">
+<section epub:type="part">
+<h1 id="_part_one" class="sect0">Part One</h1>
+<div class="paragraph">
+<p>The first part.</p>
+</div>
+</section>`))
+		Expect(files["EPUB/chapter-02.xhtml"]).To(ContainSubstring(`<section epub:type="chapter">
+<div class="sect1">
+<h2 id="_chapter_one">Chapter One</h2>`))
+		Expect(files["EPUB/chapter-03.xhtml"]).To(ContainSubstring(`<h2 id="_chapter_two">Chapter Two</h2>`))
+		Expect(files["EPUB/nav.xhtml"]).To(ContainSubstring(`<ol>
+<li><a href="chapter-01.xhtml#_part_one">Part One</a>
+<ol>
+<li><a href="chapter-02.xhtml#_chapter_one">Chapter One</a></li>
+<li><a href="chapter-03.xhtml#_chapter_two">Chapter Two</a></li>
+</ol></li>
+</ol>`))
+	})
+
+	It("images and front cover image", func() {
+		source := `= Document Title
+:front-cover-image: image:cover.png[Cover,1050,1600]
+:imagesdir: images
+
+image::diagram.svg[Diagram]
+
+A logo: image:logo.png[Logo] and a remote image: image:https://example.com/remote.png[Remote].
+
+image::../../outside.png[Outside]`
+		fsys := fstest.MapFS{
+			"doc/images/cover.png":   {Data: []byte("cover")},
+			"doc/images/diagram.svg": {Data: []byte("<svg/>")},
+			"doc/images/logo.png":    {Data: []byte("logo")},
+			"outside.png":            {Data: []byte("outside")},
+		}
+		files, err := RenderEPUB(source, configuration.WithFilesystem(fsys), configuration.WithFilename("doc/doc.adoc"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files["EPUB/images/cover.png"]).To(Equal("cover"))
+		Expect(files["EPUB/images/diagram.svg"]).To(Equal("<svg/>"))
+		Expect(files["EPUB/images/logo.png"]).To(Equal("logo"))
+		Expect(files).To(HaveLen(10)) // mimetype, container, package, nav, stylesheet, cover page, chapter and 3 images (but not `outside.png`)
+		opf := files["EPUB/content.opf"]
+		Expect(opf).To(ContainSubstring(`<meta name="cover" content="image-1"/>`))
+		Expect(opf).To(ContainSubstring(`<item id="image-1" href="images/cover.png" media-type="image/png" properties="cover-image"/>
+<item id="image-2" href="images/diagram.svg" media-type="image/svg+xml"/>
+<item id="image-3" href="images/logo.png" media-type="image/png"/>
+</manifest>`))
+		Expect(opf).To(ContainSubstring(`<spine>
+<itemref idref="cover"/>
+<itemref idref="chapter-1"/>
+</spine>`))
+		Expect(files["EPUB/cover.xhtml"]).To(ContainSubstring(`<img src="images/cover.png" alt="Document Title"/>`))
+		Expect(files["EPUB/chapter-01.xhtml"]).To(ContainSubstring(`<img src="images/diagram.svg" alt="Diagram"/>`))
+		Expect(files["EPUB/chapter-01.xhtml"]).To(ContainSubstring(`<img src="https://example.com/remote.png" alt="Remote"/>`))
+	})
+})
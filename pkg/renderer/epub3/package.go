@@ -0,0 +1,316 @@
+package epub3
+
+import (
+	"bytes"
+	"crypto/sha1" // nolint:gosec
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// the location of the navigation document, relatively to the package document
+	navPath = "nav.xhtml"
+	// the location of the cover page, relatively to the package document
+	coverPath = "cover.xhtml"
+	// the format of the `dcterms:modified` property
+	modifiedFormat = "2006-01-02T15:04:05Z"
+)
+
+// publication the metadata and the resources of the publication, as listed in the package document
+type publication struct {
+	Identifier string
+	Title      string
+	Lang       string
+	Creators   []string
+	Date       string
+	Version    string
+	Modified   string
+	Stylesheet string
+	Nav        string
+	CoverPage  string
+	Cover      *image
+	Chapters   []*chapter
+	Images     []image
+}
+
+var packageTmpl texttemplate.Template
+
+func init() {
+	packageTmpl = newTextTemplate("package", `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="pub-id" xml:lang="{{ .Lang }}">
+<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dc:identifier id="pub-id">{{ escape .Identifier }}</dc:identifier>
+<dc:title>{{ escape .Title }}</dc:title>
+<dc:language>{{ escape .Lang }}</dc:language>
+{{ range $i, $creator := .Creators }}<dc:creator id="creator-{{ inc $i }}">{{ escape $creator }}</dc:creator>
+{{ end }}{{ if .Date }}<dc:date>{{ .Date }}</dc:date>
+{{ end }}{{ if .Version }}<meta property="dcterms:hasVersion">{{ escape .Version }}</meta>
+{{ end }}<meta property="dcterms:modified">{{ .Modified }}</meta>
+{{ if .Cover }}<meta name="cover" content="{{ .Cover.ID }}"/>
+{{ end }}</metadata>
+<manifest>
+<item id="nav" href="{{ .Nav }}" media-type="application/xhtml+xml" properties="nav"/>
+<item id="stylesheet" href="{{ .Stylesheet }}" media-type="text/css"/>
+{{ if .Cover }}<item id="cover" href="{{ .CoverPage }}" media-type="application/xhtml+xml"/>
+{{ end }}{{ range $i, $chapter := .Chapters }}<item id="chapter-{{ inc $i }}" href="{{ $chapter.File }}" media-type="application/xhtml+xml"/>
+{{ end }}{{ range .Images }}<item id="{{ .ID }}" href="{{ escape .Href }}" media-type="{{ .MediaType }}"{{ if .Cover }} properties="cover-image"{{ end }}/>
+{{ end }}</manifest>
+<spine>
+{{ if .Cover }}<itemref idref="cover"/>
+{{ end }}{{ range $i, $chapter := .Chapters }}<itemref idref="chapter-{{ inc $i }}"/>
+{{ end }}</spine>
+</package>
+`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+			"inc": func(i int) int {
+				return i + 1
+			},
+		})
+}
+
+// newPublication initializes the publication from the metadata of the document (title, authors, revision, language
+// and front cover image), its chapters and its images
+func newPublication(ctx renderer.Context, doc types.Document, chapters []*chapter, images []image) publication {
+	title := documentTitle(doc)
+	creators := []string{}
+	authors, _ := doc.Authors()
+	for _, a := range authors {
+		creators = append(creators, a.FullName)
+	}
+	revision, _ := doc.Revision()
+	var date string
+	if dateRegexp.MatchString(revision.Revdate) {
+		date = revision.Revdate
+	}
+	modified := ctx.Config.LastUpdated
+	if modified.IsZero() {
+		modified = time.Now()
+	}
+	var cover *image
+	for i := range images {
+		if images[i].Cover {
+			cover = &images[i]
+			break
+		}
+	}
+	return publication{
+		Identifier: identifier(doc.Attributes, title, revision),
+		Title:      title,
+		Lang:       doc.Attributes.GetLang(),
+		Creators:   creators,
+		Date:       date,
+		Version:    revision.Revnumber,
+		Modified:   modified.UTC().Format(modifiedFormat),
+		Stylesheet: stylesheetPath,
+		Nav:        navPath,
+		CoverPage:  coverPath,
+		Cover:      cover,
+		Chapters:   chapters,
+		Images:     images,
+	}
+}
+
+// documentTitle returns the title of the document, in plain text (or `Untitled` if the document has no title)
+func documentTitle(doc types.Document) string {
+	if header, found := doc.Header(); found {
+		if title := strings.TrimSpace(string(plainText(header.Title))); title != "" {
+			return title
+		}
+	}
+	return "Untitled"
+}
+
+// the dates supported by the `dc:date` element (eg: `2021`, `2021-03` or `2021-03-07`)
+var dateRegexp = regexp.MustCompile(`^\d{4}(-\d{2}(-\d{2})?)?$`)
+
+// identifier returns the unique identifier of the publication, i.e., the value of the `uuid` attribute
+// or a name-based UUID computed from the title and the revision number of the document, so that
+// the identifier is the same when the document is rendered again
+func identifier(attrs types.Attributes, title string, revision types.DocumentRevision) string {
+	if uuid := attrs.GetAsStringWithDefault(types.AttrUUID, ""); uuid != "" {
+		if strings.HasPrefix(uuid, "urn:") {
+			return uuid
+		}
+		return "urn:uuid:" + uuid
+	}
+	h := sha1.Sum([]byte(title + "\n" + revision.Revnumber)) // nolint:gosec
+	h[6] = (h[6] & 0x0f) | 0x50                              // version 5
+	h[8] = (h[8] & 0x3f) | 0x80                              // RFC 4122 variant
+	return fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", h[0:4], h[4:6], h[6:8], h[8:10], h[10:16])
+}
+
+// renderPackage renders the package document of the publication
+func renderPackage(pub publication) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if err := packageTmpl.Execute(result, pub); err != nil {
+		return nil, errors.Wrap(err, "unable to render package document")
+	}
+	return result.Bytes(), nil
+}
+
+var coverTmpl texttemplate.Template
+
+func init() {
+	coverTmpl = newTextTemplate("cover", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{ .Lang }}" lang="{{ .Lang }}">
+<head>
+<meta charset="UTF-8"/>
+<title>{{ escape .Title }}</title>
+<style type="text/css">body { margin: 0; text-align: center; } img { max-width: 100%; max-height: 100vh; }</style>
+</head>
+<body>
+<section epub:type="cover">
+<img src="{{ escape .Cover.Href }}" alt="{{ escape .Title }}"/>
+</section>
+</body>
+</html>
+`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+// renderCover renders the page with the front cover image of the publication
+func renderCover(pub publication) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if err := coverTmpl.Execute(result, pub); err != nil {
+		return nil, errors.Wrap(err, "unable to render cover page")
+	}
+	return result.Bytes(), nil
+}
+
+// navEntry an entry of the table of contents in the navigation document
+type navEntry struct {
+	Href     string
+	Title    string // the title, already escaped
+	Children []navEntry
+}
+
+var navTmpl texttemplate.Template
+
+func init() {
+	navTmpl = newTextTemplate("nav", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{ .Lang }}" lang="{{ .Lang }}">
+<head>
+<meta charset="UTF-8"/>
+<title>{{ escape .Title }}</title>
+</head>
+<body>
+<nav epub:type="toc" id="toc">
+<h1>{{ escape .Label }}</h1>
+{{ template "entries" .Entries }}
+</nav>
+</body>
+</html>
+{{ define "entries" }}<ol>
+{{ range . }}<li><a href="{{ escape .Href }}">{{ .Title }}</a>{{ if .Children }}
+{{ template "entries" .Children }}{{ end }}</li>
+{{ end }}</ol>{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+// renderNavigation renders the navigation document of the publication, with the sections of the table of contents
+// (grouped by part, in a book). If the table of contents is empty, the navigation document lists the chapters
+func renderNavigation(ctx renderer.Context, pub publication, toc types.TableOfContents, chapters []*chapter) ([]byte, error) {
+	entries := navEntries(toc.Sections, chapters)
+	if len(entries) == 0 {
+		for _, c := range chapters {
+			entries = append(entries, navEntry{
+				Href:  c.File,
+				Title: html.EscapeString(c.Title),
+			})
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	if err := navTmpl.Execute(result, struct {
+		Lang    string
+		Title   string
+		Label   string
+		Entries []navEntry
+	}{
+		Lang:    pub.Lang,
+		Title:   pub.Title,
+		Label:   ctx.Attributes.GetLabel(types.AttrTableOfContentsTitle),
+		Entries: entries,
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to render navigation document")
+	}
+	return result.Bytes(), nil
+}
+
+// navEntries returns the entries of the navigation document for the given sections. In a book, the sections are
+// nested in an entry for their part
+func navEntries(sections []types.ToCSection, chapters []*chapter) []navEntry {
+	byChapter := map[int][]navEntry{}
+	for _, s := range sections {
+		i := chapterIndex(chapters, s.ID)
+		byChapter[i] = append(byChapter[i], sectionEntry(s, chapters))
+	}
+	result := []navEntry{}
+	var part *navEntry // the entry of the current part
+	for i, c := range chapters {
+		if c.Part != nil {
+			if part != nil {
+				result = append(result, *part)
+			}
+			part = &navEntry{
+				Href:  c.File + fragment(c.Part.Attributes.GetAsStringWithDefault(types.AttrID, "")),
+				Title: html.EscapeString(c.Title),
+			}
+		}
+		if part != nil {
+			part.Children = append(part.Children, byChapter[i]...)
+			continue
+		}
+		result = append(result, byChapter[i]...)
+	}
+	if part != nil {
+		result = append(result, *part)
+	}
+	// sections which were not found in the chapters
+	return append(result, byChapter[-1]...)
+}
+
+// sectionEntry returns the entry of the navigation document for the given section and its children
+func sectionEntry(s types.ToCSection, chapters []*chapter) navEntry {
+	file, _ := chapterFile(chapters, s.ID)
+	entry := navEntry{
+		Href:  file + fragment(s.ID),
+		Title: s.Title,
+	}
+	for _, child := range s.Children {
+		entry.Children = append(entry.Children, sectionEntry(child, chapters))
+	}
+	return entry
+}
+
+func fragment(id string) string {
+	if id == "" {
+		return ""
+	}
+	return "#" + id
+}
+
+// chapterIndex returns the index of the chapter which contains the element with the given ID (or `-1` if none was found)
+func chapterIndex(chapters []*chapter, id string) int {
+	for i, c := range chapters {
+		if c.IDs[id] {
+			return i
+		}
+	}
+	return -1
+}
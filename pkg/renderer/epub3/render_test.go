@@ -0,0 +1,142 @@
+package epub3_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"path"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+// RenderEPUB renders the given source as an EPUB publication, and returns the content of its files
+// after verifying the structure of the archive
+func RenderEPUB(source string, settings ...configuration.Setting) (map[string]string, error) {
+	result, err := testsupport.Render(source, append(settings, configuration.WithBackend("epub3"), configuration.WithHeaderFooter(true))...)
+	if err != nil {
+		return nil, err
+	}
+	return validate([]byte(result)), nil
+}
+
+type containerDocument struct {
+	Rootfiles []struct {
+		FullPath  string `xml:"full-path,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+type packageDocument struct {
+	Version          string `xml:"version,attr"`
+	UniqueIdentifier string `xml:"unique-identifier,attr"`
+	Metadata         struct {
+		Identifiers []struct {
+			ID    string `xml:"id,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"identifier"`
+		Titles    []string `xml:"title"`
+		Languages []string `xml:"language"`
+		Metas     []struct {
+			Property string `xml:"property,attr"`
+			Value    string `xml:",chardata"`
+		} `xml:"meta"`
+	} `xml:"metadata"`
+	Items []struct {
+		ID         string `xml:"id,attr"`
+		Href       string `xml:"href,attr"`
+		MediaType  string `xml:"media-type,attr"`
+		Properties string `xml:"properties,attr"`
+	} `xml:"manifest>item"`
+	Itemrefs []struct {
+		IDRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+}
+
+// validate verifies the structure of the given EPUB publication, and returns the content of its files
+func validate(data []byte) map[string]string {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	Expect(err).NotTo(HaveOccurred())
+	files := map[string]string{}
+	for _, f := range r.File {
+		rc, err := f.Open()
+		Expect(err).NotTo(HaveOccurred())
+		content, err := io.ReadAll(rc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rc.Close()).To(Succeed())
+		files[f.Name] = string(content)
+	}
+	// the `mimetype` file is the first entry, without compression
+	Expect(r.File).NotTo(BeEmpty())
+	Expect(r.File[0].Name).To(Equal("mimetype"))
+	Expect(r.File[0].Method).To(Equal(zip.Store))
+	Expect(files["mimetype"]).To(Equal("application/epub+zip"))
+	// the container refers to the package document
+	container := containerDocument{}
+	Expect(xml.Unmarshal([]byte(files["META-INF/container.xml"]), &container)).To(Succeed())
+	Expect(container.Rootfiles).To(HaveLen(1))
+	Expect(container.Rootfiles[0].MediaType).To(Equal("application/oebps-package+xml"))
+	opfPath := container.Rootfiles[0].FullPath
+	Expect(files).To(HaveKey(opfPath))
+	pkg := packageDocument{}
+	Expect(xml.Unmarshal([]byte(files[opfPath]), &pkg)).To(Succeed())
+	// required metadata
+	Expect(pkg.Version).To(Equal("3.0"))
+	Expect(pkg.Metadata.Identifiers).To(HaveLen(1))
+	Expect(pkg.Metadata.Identifiers[0].ID).To(Equal(pkg.UniqueIdentifier))
+	Expect(pkg.Metadata.Identifiers[0].Value).NotTo(BeEmpty())
+	Expect(pkg.Metadata.Titles).To(HaveLen(1))
+	Expect(pkg.Metadata.Languages).To(HaveLen(1))
+	modified := false
+	for _, m := range pkg.Metadata.Metas {
+		if m.Property == "dcterms:modified" {
+			Expect(m.Value).To(MatchRegexp(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))
+			modified = true
+		}
+	}
+	Expect(modified).To(BeTrue())
+	// the files of the manifest exist, and all files are in the manifest
+	dir := path.Dir(opfPath)
+	ids := map[string]bool{}
+	hrefs := map[string]bool{"mimetype": true, "META-INF/container.xml": true, opfPath: true}
+	nav := 0
+	for _, item := range pkg.Items {
+		p := path.Join(dir, item.Href)
+		Expect(files).To(HaveKey(p))
+		Expect(ids).NotTo(HaveKey(item.ID))
+		ids[item.ID] = true
+		hrefs[p] = true
+		if item.Properties == "nav" {
+			nav++
+		}
+		if item.MediaType == "application/xhtml+xml" {
+			Expect(wellFormed(files[p])).To(Succeed(), p)
+		}
+	}
+	Expect(nav).To(Equal(1))
+	for name := range files {
+		Expect(hrefs).To(HaveKey(name))
+	}
+	// the spine refers to the items of the manifest
+	Expect(pkg.Itemrefs).NotTo(BeEmpty())
+	for _, ref := range pkg.Itemrefs {
+		Expect(ids).To(HaveKey(ref.IDRef))
+	}
+	return files
+}
+
+// wellFormed returns an error if the given content is not a well-formed XML document
+func wellFormed(content string) error {
+	decoder := xml.NewDecoder(bytes.NewReader([]byte(content)))
+	decoder.Strict = true
+	for {
+		if _, err := decoder.Token(); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
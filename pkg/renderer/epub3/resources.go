@@ -0,0 +1,188 @@
+package epub3
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// image an image which is copied in the publication
+type image struct {
+	ID        string
+	Href      string // the location of the image, relatively to the package document
+	MediaType string
+	Cover     bool
+	Content   []byte
+}
+
+var mediaTypes = map[string]string{
+	".gif":  "image/gif",
+	".jpeg": "image/jpeg",
+	".jpg":  "image/jpeg",
+	".png":  "image/png",
+	".svg":  "image/svg+xml",
+	".webp": "image/webp",
+}
+
+// loadImages loads the images referenced in the document (and the front cover image, if any).
+// Only the local images whose location is relative (and inside the directory of the document) can be copied in
+// the publication, at the same location: the other images are skipped, with a warning
+func loadImages(ctx renderer.Context, doc types.Document) []image {
+	result := []image{}
+	hrefs := map[string]bool{}
+	add := func(location string, cover bool) {
+		href, ok := localPath(location)
+		if !ok {
+			log.Warnf("skipping image '%s' which cannot be included in the EPUB publication", location)
+			return
+		}
+		if hrefs[href] {
+			return
+		}
+		mediaType, ok := mediaTypes[strings.ToLower(path.Ext(href))]
+		if !ok {
+			log.Warnf("skipping image '%s' with unsupported media type", location)
+			return
+		}
+		content, err := readFile(ctx, location)
+		if err != nil {
+			log.Warnf("unable to read image '%s': %v", location, err)
+			return
+		}
+		hrefs[href] = true
+		result = append(result, image{
+			ID:        fmt.Sprintf("image-%d", len(result)+1),
+			Href:      href,
+			MediaType: mediaType,
+			Cover:     cover,
+			Content:   content,
+		})
+	}
+	if location, found := frontCoverImage(doc.Attributes); found {
+		add(location, true)
+	}
+	walk(doc.Elements, func(e interface{}) {
+		switch e := e.(type) {
+		case types.ImageBlock:
+			add(e.Location.String(), false)
+		case types.InlineImage:
+			add(e.Location.String(), false)
+		}
+	})
+	return result
+}
+
+// localPath returns the clean path of the given location if it refers to a local file, relatively to
+// the directory of the document
+func localPath(location string) (string, bool) {
+	if location == "" || strings.Contains(location, "://") || strings.HasPrefix(location, "data:") {
+		return "", false
+	}
+	p := path.Clean(location)
+	if path.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") {
+		return "", false
+	}
+	return p, true
+}
+
+func readFile(ctx renderer.Context, location string) ([]byte, error) {
+	p, err := ctx.Config.ResolvePath(location)
+	if err != nil {
+		return nil, err
+	}
+	f, err := ctx.Config.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+var coverImageMacroRegexp = regexp.MustCompile(`^image::?([^\[]+)\[.*\]$`)
+
+// frontCoverImage returns the location of the image set in the `front-cover-image` attribute,
+// given as a path (relative to the directory of the document) or as an image macro (relative to the `imagesdir`)
+func frontCoverImage(attrs types.Attributes) (string, bool) {
+	value := strings.TrimSpace(attrs.GetAsStringWithDefault(types.AttrFrontCoverImage, ""))
+	if value == "" {
+		return "", false
+	}
+	if m := coverImageMacroRegexp.FindStringSubmatch(value); m != nil {
+		value = m[1]
+		if imagesdir := attrs.GetAsStringWithDefault("imagesdir", ""); imagesdir != "" && !path.IsAbs(value) && !strings.Contains(value, "://") {
+			value = path.Join(imagesdir, value)
+		}
+	}
+	return value, true
+}
+
+// walk calls the given func on each element of the document, including the nested elements
+// (eg: the elements of a section, of a list item or of a table cell)
+func walk(element interface{}, f func(interface{})) {
+	walkValue(reflect.ValueOf(element), f)
+}
+
+func walkValue(v reflect.Value, f func(interface{})) {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if !v.IsNil() {
+			walkValue(v.Elem(), f)
+		}
+	case reflect.Struct:
+		if v.CanInterface() {
+			f(v.Interface())
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" { // exported field
+				walkValue(v.Field(i), f)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkValue(v.Index(i), f)
+		}
+	case reflect.Map:
+		// visit the entries in a stable order (eg: the attributes)
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+		})
+		for _, k := range keys {
+			walkValue(v.MapIndex(k), f)
+		}
+	}
+}
+
+// plainText returns the text of the given inline elements, without any markup
+func plainText(elements []interface{}) []byte {
+	result := bytes.NewBuffer(nil)
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.QuotedText:
+			result.Write(plainText(e.Elements))
+		case types.InlinePassthrough:
+			result.Write(plainText(e.Elements))
+		case types.IndexTerm:
+			result.Write(plainText(e.Term))
+		case types.InlineLink:
+			if text, ok := e.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+				result.Write(plainText(text))
+			} else {
+				result.WriteString(e.Location.String())
+			}
+		}
+	}
+	return result.Bytes()
+}
@@ -15,6 +15,6 @@ func renderBlankLine(ctx renderer.Context, l types.BlankLine) ([]byte, error) {
 	return []byte{}, nil
 }
 
-func renderLineBreak() ([]byte, error) {
-	return []byte("<br>"), nil
+func renderLineBreak(ctx renderer.Context) ([]byte, error) {
+	return []byte("<br" + voidTagEnd(ctx)), nil
 }
@@ -0,0 +1,299 @@
+package html5
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// TemplateKinds the kinds of elements whose built-in template can be overridden by a user-supplied template
+// named after the kind (eg: `paragraph.tmpl`)
+var TemplateKinds = []string{
+	"document",   // the full document, with its header and footer (DocumentData)
+	"section",    // the sections (SectionData)
+	"paragraph",  // the regular paragraphs (ParagraphData)
+	"admonition", // the admonition paragraphs (AdmonitionData)
+	"image",      // the image blocks (ImageData)
+	"table",      // the tables (TableData)
+	"ulist",      // the unordered lists (ListData)
+	"olist",      // the ordered lists (ListData)
+}
+
+// templateFuncs the functions available in the user-supplied templates
+var templateFuncs = texttemplate.FuncMap{
+	"escape": EscapeString,
+}
+
+// loadTemplates loads the user-supplied templates from the filesystem set in the configuration (if any)
+func loadTemplates(ctx renderer.Context) (renderer.Templates, error) {
+	return renderer.LoadTemplates(ctx.Config.Templates, TemplateKinds, templateFuncs)
+}
+
+// Block the data common to all the blocks passed to the user-supplied templates.
+// As in all the data passed to the user-supplied templates, the `Title` and `Content` fields are already rendered
+// in HTML, whereas the other fields are raw values, which should be escaped (with the `escape` function) when needed.
+type Block struct {
+	ID         string            // the ID of the block (empty if none)
+	Title      string            // the title of the block, including its caption (eg: `Table 1. `) if any
+	Role       string            // the role of the block (empty if none)
+	Attributes map[string]string // the attributes of the block which have a string value (eg: `language`)
+	VoidTagEnd string            // the end of the start tag of the void elements: `/>` if the `htmlsyntax` attribute is `xml`, `>` otherwise
+}
+
+// DocumentData the data passed to the `document` template, which is only used when the header and footer are included
+type DocumentData struct {
+	Title         string            // the title of the document (empty if none)
+	Authors       []string          // the full names of the authors
+	Doctype       string            // the document type (`article`, `book`, `manpage`, etc.)
+	Lang          string            // the language of the document (eg: `en`)
+	RevNumber     string            // the revision number (empty if none)
+	LastUpdated   string            // the formatted "last updated" timestamp (empty if none)
+	Stylesheets   []Stylesheet      // the stylesheets of the document
+	Header        string            // the header of the document (its title, details and table of contents)
+	Content       string            // the content of the document
+	DocInfoHead   string            // the content of the docinfo files to inject in the head (empty if none)
+	DocInfoFooter string            // the content of the docinfo files to inject at the end of the body (empty if none)
+	Attributes    map[string]string // the attributes of the document which have a string value
+	VoidTagEnd    string            // the end of the start tag of the void elements: `/>` if the `htmlsyntax` attribute is `xml`, `>` otherwise
+}
+
+// Stylesheet a stylesheet of the document, which is either linked (`Href`) or embedded (`Content`)
+type Stylesheet struct {
+	Href    string
+	Content string
+}
+
+// SectionData the data passed to the `section` template
+type SectionData struct {
+	Block
+	Level   int    // the level of the section (eg: `1` for `== Section`)
+	Content string // the elements of the section, including its subsections
+}
+
+// ParagraphData the data passed to the `paragraph` template
+type ParagraphData struct {
+	Block
+	Content string // the lines of the paragraph
+}
+
+// AdmonitionData the data passed to the `admonition` template
+type AdmonitionData struct {
+	Block
+	Kind    string // the kind of admonition (`note`, `tip`, `important`, `warning` or `caution`)
+	Label   string // the label of the admonition (eg: `Note`, or the value of the `note-caption` attribute)
+	Content string // the lines of the paragraph
+}
+
+// ImageData the data passed to the `image` template
+type ImageData struct {
+	Block
+	Path   string // the path or URL of the image
+	Alt    string // the alternate text of the image
+	Width  string // the width of the image (empty if none)
+	Height string // the height of the image (empty if none)
+	Link   string // the target of the link on the image (empty if none)
+}
+
+// TableData the data passed to the `table` template
+type TableData struct {
+	Block
+	ColumnWidths []string   // the width of each column, as a percentage of the width of the table (eg: `33.3333`)
+	Header       []string   // the cells of the header (empty if the table has no header)
+	Rows         [][]string // the cells of each row of the body
+}
+
+// ListData the data passed to the `ulist` and `olist` templates
+type ListData struct {
+	Block
+	Style string         // the numbering style of an ordered list (eg: `arabic`, `loweralpha`), empty for an unordered list
+	Start string         // the start number of an ordered list (empty if none)
+	Items []ListItemData // the items of the list
+}
+
+// ListItemData an item of a list
+type ListItemData struct {
+	CheckStyle string // the check style of the item in a checklist (`checked` or `unchecked`), empty if none
+	Content    string // the elements of the item
+}
+
+// newBlock returns the data of a block with the given attributes and rendered title
+func newBlock(ctx renderer.Context, attrs types.Attributes, title string) Block {
+	return Block{
+		ID:         renderElementID(attrs),
+		Title:      title,
+		Role:       attrs.GetAsStringWithDefault(types.AttrRole, ""),
+		Attributes: stringAttributes(attrs),
+		VoidTagEnd: voidTagEnd(ctx),
+	}
+}
+
+// stringAttributes returns the attributes which have a string value
+func stringAttributes(attrs types.Attributes) map[string]string {
+	result := make(map[string]string, len(attrs))
+	for k, v := range attrs {
+		if s, ok := v.(string); ok {
+			result[k] = s
+		}
+	}
+	return result
+}
+
+// executeTemplate executes the given user-supplied template with the given data
+func executeTemplate(t *texttemplate.Template, data interface{}) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if err := t.Execute(result, data); err != nil {
+		return nil, errors.Wrapf(err, "unable to render with the '%s' template", t.Name())
+	}
+	return result.Bytes(), nil
+}
+
+// renderListItem renders the elements of a list item
+func renderListItem(ctx renderer.Context, elements []interface{}, checkStyle string) (ListItemData, error) {
+	content, err := renderListElements(ctx, elements)
+	if err != nil {
+		return ListItemData{}, err
+	}
+	return ListItemData{
+		CheckStyle: checkStyle,
+		Content:    string(content),
+	}, nil
+}
+
+func renderDocumentWithTemplate(ctx renderer.Context, t *texttemplate.Template, doc types.Document, output io.Writer, title, header, content string, stylesheets []stylesheet) error {
+	data := DocumentData{
+		Title:         EscapeString(title),
+		Authors:       []string{},
+		Doctype:       doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article"),
+		Lang:          doc.Attributes.GetLang(),
+		RevNumber:     doc.Attributes.GetAsStringWithDefault("revnumber", ""),
+		LastUpdated:   renderLastUpdated(ctx, doc),
+		Stylesheets:   make([]Stylesheet, len(stylesheets)),
+		Header:        header,
+		Content:       content,
+		DocInfoHead:   doc.DocInfo.Head,
+		DocInfoFooter: doc.DocInfo.Footer,
+		Attributes:    stringAttributes(doc.Attributes),
+		VoidTagEnd:    voidTagEnd(ctx),
+	}
+	if authors, found := doc.Authors(); found {
+		for _, a := range authors {
+			data.Authors = append(data.Authors, a.FullName)
+		}
+	}
+	for i, s := range stylesheets {
+		data.Stylesheets[i] = Stylesheet(s)
+	}
+	return t.Execute(output, data)
+}
+
+func renderParagraphWithTemplate(ctx renderer.Context, t *texttemplate.Template, p types.Paragraph) ([]byte, error) {
+	content, err := renderLines(ctx, p.Lines, WithHardBreaks(p.Attributes.Has(types.AttrHardBreaks) || ctx.Attributes.Has(types.DocumentAttrHardBreaks)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	return executeTemplate(t, ParagraphData{
+		Block:   newBlock(ctx, p.Attributes, EscapeString(renderElementTitle(p.Attributes))),
+		Content: string(content),
+	})
+}
+
+func renderAdmonitionWithTemplate(ctx renderer.Context, t *texttemplate.Template, k types.AdmonitionKind, p types.Paragraph) ([]byte, error) {
+	content, err := renderLines(ctx, p.Lines)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render admonition")
+	}
+	return executeTemplate(t, AdmonitionData{
+		Block:   newBlock(ctx, p.Attributes, EscapeString(renderElementTitle(p.Attributes))),
+		Kind:    string(k),
+		Label:   renderIconTitle(ctx, k),
+		Content: string(content),
+	})
+}
+
+func renderSectionWithTemplate(ctx renderer.Context, t *texttemplate.Template, s types.Section) ([]byte, error) {
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	content, err := renderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	return executeTemplate(t, SectionData{
+		Block:   newBlock(ctx, s.Attributes, strings.TrimSpace(string(title))),
+		Level:   s.Level,
+		Content: string(content),
+	})
+}
+
+func renderTableWithTemplate(ctx renderer.Context, tmpl *texttemplate.Template, t types.Table, title string, widths []string) ([]byte, error) {
+	header, err := renderCells(ctx, t.Header.Cells)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render table")
+	}
+	rows := make([][]string, len(t.Lines))
+	for i, l := range t.Lines {
+		if rows[i], err = renderCells(ctx, l.Cells); err != nil {
+			return nil, errors.Wrapf(err, "failed to render table")
+		}
+	}
+	return executeTemplate(tmpl, TableData{
+		Block:        newBlock(ctx, t.Attributes, title),
+		ColumnWidths: widths,
+		Header:       header,
+		Rows:         rows,
+	})
+}
+
+func renderUnorderedListWithTemplate(ctx renderer.Context, t *texttemplate.Template, l types.UnorderedList) ([]byte, error) {
+	items := make([]ListItemData, len(l.Items))
+	for i, item := range l.Items {
+		checkStyle := ""
+		if item.CheckStyle != types.NoCheck {
+			checkStyle = string(item.CheckStyle)
+		}
+		var err error
+		if items[i], err = renderListItem(ctx, item.Elements, checkStyle); err != nil {
+			return nil, errors.Wrapf(err, "unable to render unordered list")
+		}
+	}
+	return executeTemplate(t, ListData{
+		Block: newBlock(ctx, l.Attributes, EscapeString(renderElementTitle(l.Attributes))),
+		Items: items,
+	})
+}
+
+func renderOrderedListWithTemplate(ctx renderer.Context, t *texttemplate.Template, l types.OrderedList) ([]byte, error) {
+	items := make([]ListItemData, len(l.Items))
+	for i, item := range l.Items {
+		var err error
+		if items[i], err = renderListItem(ctx, item.Elements, ""); err != nil {
+			return nil, errors.Wrapf(err, "unable to render ordered list")
+		}
+	}
+	return executeTemplate(t, ListData{
+		Block: newBlock(ctx, l.Attributes, EscapeString(renderElementTitle(l.Attributes))),
+		Style: getNumberingStyle(l),
+		Start: l.Attributes.GetAsStringWithDefault(types.AttrStart, ""),
+		Items: items,
+	})
+}
+
+// renderCells renders the given cells of a table
+func renderCells(ctx renderer.Context, cells [][]interface{}) ([]string, error) {
+	result := make([]string, len(cells))
+	for i, cell := range cells {
+		content, err := renderInlineElements(ctx, cell)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = string(content)
+	}
+	return result, nil
+}
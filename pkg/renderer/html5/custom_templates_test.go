@@ -0,0 +1,193 @@
+package html5_test
+
+import (
+	"testing/fstest"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("user-supplied templates", func() {
+
+	templates := func(files map[string]string) configuration.Setting {
+		fsys := fstest.MapFS{}
+		for name, content := range files {
+			fsys[name] = &fstest.MapFile{
+				Data: []byte(content),
+			}
+		}
+		return configuration.WithTemplates(fsys)
+	}
+
+	It("paragraph with id, role and title", func() {
+		source := `[[intro]]
+[role=lead]
+.Introduction
+some *bold* content`
+		expected := `<p id="intro" class="lead" title="Introduction">some <strong>bold</strong> content</p>`
+		Expect(RenderHTML(source, templates(map[string]string{
+			"paragraph.tmpl": `<p{{ if .ID }} id="{{ .ID }}"{{ end }}{{ if .Role }} class="{{ .Role }}"{{ end }}{{ if .Title }} title="{{ .Title }}"{{ end }}>{{ .Content }}</p>`,
+		}))).To(Equal(expected))
+	})
+
+	It("sections with their content", func() {
+		source := `== Section A
+
+content
+
+=== Section B`
+		expected := `<section id="_section_a" class="level-1">
+<h2>Section A</h2>
+<p>content</p>
+<section id="_section_b" class="level-2">
+<h2>Section B</h2>
+</section>
+</section>`
+		Expect(RenderHTML(source, templates(map[string]string{
+			"section.tmpl":   "<section id=\"{{ .ID }}\" class=\"level-{{ .Level }}\">\n<h2>{{ .Title }}</h2>{{ if .Content }}\n{{ .Content }}{{ end }}\n</section>",
+			"paragraph.tmpl": `<p>{{ .Content }}</p>`,
+		}))).To(Equal(expected))
+	})
+
+	It("admonition with its label", func() {
+		source := `NOTE: some content`
+		expected := `<aside class="note"><strong>Note</strong> some content</aside>`
+		Expect(RenderHTML(source, templates(map[string]string{
+			"admonition.tmpl": `<aside class="{{ .Kind }}"><strong>{{ .Label }}</strong> {{ .Content }}</aside>`,
+		}))).To(Equal(expected))
+	})
+
+	It("image with caption", func() {
+		source := `.A & B
+image::foo.png[Foo, 200, 100, link=https://example.com]`
+		expected := `<figure><a href="https://example.com"><img src="foo.png" alt="Foo" width="200" height="100"></a><figcaption>Figure 1. A &amp; B</figcaption></figure>`
+		Expect(RenderHTML(source, templates(map[string]string{
+			"image.tmpl": `<figure>{{ if .Link }}<a href="{{ .Link }}">{{ end }}<img src="{{ .Path }}" alt="{{ escape .Alt }}" width="{{ .Width }}" height="{{ .Height }}">{{ if .Link }}</a>{{ end }}<figcaption>{{ .Title }}</figcaption></figure>`,
+		}))).To(Equal(expected))
+	})
+
+	It("table with header and rows", func() {
+		source := `.The table
+|===
+|Name |Value
+
+|a |*b*
+|c |d
+|===`
+		expected := `<table data-widths="50,50">
+<caption>Table 1. The table</caption>
+<tr><th>Name</th><th>Value</th></tr>
+<tr><td>a</td><td><strong>b</strong></td></tr>
+<tr><td>c</td><td>d</td></tr>
+</table>`
+		Expect(RenderHTML(source, templates(map[string]string{
+			"table.tmpl": `<table data-widths="{{ range $i, $w := .ColumnWidths }}{{ if $i }},{{ end }}{{ $w }}{{ end }}">
+<caption>{{ .Title }}</caption>{{ if .Header }}
+<tr>{{ range .Header }}<th>{{ . }}</th>{{ end }}</tr>{{ end }}{{ range .Rows }}
+<tr>{{ range . }}<td>{{ . }}</td>{{ end }}</tr>{{ end }}
+</table>`,
+		}))).To(Equal(expected))
+	})
+
+	It("unordered and ordered lists", func() {
+		source := `* [x] done
+* [ ] todo
+
+[loweralpha, start=2]
+. first
+. second`
+		expected := `<ul>
+<li data-check="checked">done</li>
+<li data-check="unchecked">todo</li>
+</ul>
+<ol class="loweralpha" start="2">
+<li>first</li>
+<li>second</li>
+</ol>`
+		Expect(RenderHTML(source, templates(map[string]string{
+			"ulist.tmpl":     "<ul>{{ range .Items }}\n<li{{ if .CheckStyle }} data-check=\"{{ .CheckStyle }}\"{{ end }}>{{ .Content }}</li>{{ end }}\n</ul>",
+			"olist.tmpl":     "<ol class=\"{{ .Style }}\"{{ if .Start }} start=\"{{ .Start }}\"{{ end }}>{{ range .Items }}\n<li>{{ .Content }}</li>{{ end }}\n</ol>",
+			"paragraph.tmpl": `{{ .Content }}`,
+		}))).To(Equal(expected))
+	})
+
+	It("full document", func() {
+		source := `= The Title
+John Doe; Jane Roe
+:description: a document
+
+content`
+		expected := `<html lang="en">
+<head><title>The Title</title><meta name="description" content="a document"><meta name="author" content="John Doe, Jane Roe"></head>
+<body class="article">
+<p>content</p>
+</body>
+</html>`
+		Expect(RenderHTML(source, configuration.WithHeaderFooter(true), templates(map[string]string{
+			"document.tmpl": `<html lang="{{ .Lang }}">
+<head><title>{{ .Title }}</title><meta name="description" content="{{ escape .Attributes.description }}"><meta name="author" content="{{ range $i, $a := .Authors }}{{ if $i }}, {{ end }}{{ escape $a }}{{ end }}"></head>
+<body class="{{ .Doctype }}">
+{{ .Content }}
+</body>
+</html>`,
+			"paragraph.tmpl": `<p>{{ .Content }}</p>`,
+		}))).To(Equal(expected))
+	})
+
+	It("full document with docinfo files", func() {
+		source := `= The Title
+:docinfo: shared
+
+content`
+		expected := `<html>
+<head><title>The Title</title>
+<meta name="description" content="The Title"></head>
+<body>
+<p>content</p>
+<script src="shared.js"></script>
+</body>
+</html>`
+		fsys := fstest.MapFS{
+			"docinfo.html": &fstest.MapFile{
+				Data: []byte(`<meta name="description" content="{doctitle}">`),
+			},
+			"docinfo-footer.html": &fstest.MapFile{
+				Data: []byte(`<script src="shared.js"></script>`),
+			},
+		}
+		Expect(RenderHTML(source, configuration.WithHeaderFooter(true), configuration.WithFilename("doc.adoc"), configuration.WithFilesystem(fsys), templates(map[string]string{
+			"document.tmpl": `<html>
+<head><title>{{ .Title }}</title>{{ if .DocInfoHead }}
+{{ .DocInfoHead }}{{ end }}</head>
+<body>
+{{ .Content }}{{ if .DocInfoFooter }}
+{{ .DocInfoFooter }}{{ end }}
+</body>
+</html>`,
+			"paragraph.tmpl": `<p>{{ .Content }}</p>`,
+		}))).To(Equal(expected))
+	})
+
+	It("built-in templates for the other elements", func() {
+		source := `some content`
+		expected := `<div class="paragraph">
+<p>some content</p>
+</div>`
+		Expect(RenderHTML(source, templates(map[string]string{
+			"section.tmpl": `<section>{{ .Content }}</section>`,
+			"unknown.tmpl": `unknown`,
+			"README.md":    `not a template`,
+		}))).To(Equal(expected))
+	})
+
+	It("invalid template", func() {
+		source := `some content`
+		_, err := RenderHTML(source, templates(map[string]string{
+			"paragraph.tmpl": `{{ .Content `,
+		}))
+		Expect(err).To(MatchError(ContainSubstring("unable to parse the 'paragraph.tmpl' template")))
+	})
+})
@@ -5,10 +5,6 @@ import (
 	"strconv"
 	texttemplate "text/template"
 
-	"github.com/alecthomas/chroma"
-	"github.com/alecthomas/chroma/formatters/html"
-	"github.com/alecthomas/chroma/lexers"
-	"github.com/alecthomas/chroma/styles"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
@@ -55,7 +51,7 @@ func init() {
 		`<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <div class="content">
-<pre class="{{ if .SyntaxHighlighter }}{{ .SyntaxHighlighter }} {{ end }}highlight"><code{{ if .Language }}{{ if not .SyntaxHighlighter }} class="language-{{ .Language}}"{{ end }} data-lang="{{ .Language}}"{{ end }}>{{ .Content }}</code></pre>
+{{ if .LineNumbers }}<table class="linenotable"><tbody><tr><td class="linenos gl"><pre class="lineno">{{ .LineNumbers }}</pre></td><td class="code">{{ end }}<pre class="{{ if .SyntaxHighlighter }}{{ .SyntaxHighlighter }} {{ end }}highlight"><code{{ if .Language }}{{ if not .SyntaxHighlighter }} class="language-{{ .Language}}"{{ end }} data-lang="{{ .Language}}"{{ end }}>{{ .Content }}</code></pre>{{ if .LineNumbers }}</td></tr></tbody></table>{{ end }}
 </div>
 </div>`,
 		texttemplate.FuncMap{
@@ -85,12 +81,13 @@ func init() {
 {{ renderElements $ctx .Elements | printf "%s" }}
 </blockquote>{{ if .Attribution.First }}
 <div class="attribution">
-&#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br>
+&#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br{{ voidTagEnd $ctx }}
 <cite>{{ .Attribution.Second }}</cite>{{ end }}
 </div>{{ end }}
 </div>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderElements": renderElements,
+			"voidTagEnd":     voidTagEnd,
 			"escape":         EscapeString,
 		})
 
@@ -98,12 +95,13 @@ func init() {
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <pre class="content">{{ range $index, $element := .Elements }}{{ renderElement $ctx $element | printf "%s" }}{{ end }}</pre>{{ if .Attribution.First }}
 <div class="attribution">
-&#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br>
+&#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br{{ voidTagEnd $ctx }}
 <cite>{{ .Attribution.Second }}</cite>{{ end }}
 </div>{{ end }}
 </div>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderElement": renderVerseBlockElement,
+			"voidTagEnd":    voidTagEnd,
 			"escape":        EscapeString,
 		})
 
@@ -233,50 +231,28 @@ func renderSourceBlock(ctx renderer.Context, b types.DelimitedBlock) ([]byte, er
 	}()
 	ctx.WithinDelimitedBlock = true
 	ctx.IncludeBlankLine = true
-	// first, render the content
-	contentBuf := bytes.NewBuffer(nil)
-	err := sourceBlockContentTmpl.Execute(contentBuf, ContextualPipeline{
-		Context: ctx,
-		Data: struct {
-			Elements []interface{}
-		}{
-			Elements: discardTrailingBlankLines(b.Elements),
-		}})
-	if err != nil {
-		return []byte{}, err
-	}
-	content := contentBuf.String()
-
 	highligher, _ := ctx.Attributes.GetAsString(types.AttrSyntaxHighlighter)
 	language, found := b.Attributes.GetAsString(types.AttrLanguage)
-	if found && highligher == "pygments" {
+	elements := discardTrailingBlankLines(b.Elements)
+	var content, lineNumbers string
+	if h, supported := syntaxHighlighters[highligher]; found && supported {
 		// using github.com/alecthomas/chroma to highlight the content
-		contentBuf = bytes.NewBuffer(nil)
-		lexer := lexers.Get(language)
-		lexer = chroma.Coalesce(lexer)
-		style := styles.Fallback
-		if s, found := ctx.Attributes.GetAsString("pygments-style"); found {
-			style = styles.Get(s)
-		}
-		iterator, err := lexer.Tokenise(nil, content)
+		c, n, err := highlightSourceLines(ctx, b, h, language, verbatimLines(elements))
 		if err != nil {
 			return []byte{}, err
 		}
-		options := []html.Option{
-			html.ClassPrefix("tok-"),
-			html.PreventSurroundingPre(true),
-		}
-		// extra option: inline CSS instead of classes
-		if ctx.Attributes.GetAsStringWithDefault("pygments-css", "classes") == "style" {
-			options = append(options, html.WithClasses(false))
-		} else {
-			options = append(options, html.WithClasses(true))
-		}
-		// extra option: line numbers
-		if b.Attributes.Has(types.AttrLineNums) {
-			options = append(options, html.WithLineNumbers(true))
-		}
-		err = html.New(options...).Format(contentBuf, style, iterator)
+		content = c
+		lineNumbers = n
+	} else {
+		// otherwise, just render the content
+		contentBuf := bytes.NewBuffer(nil)
+		err := sourceBlockContentTmpl.Execute(contentBuf, ContextualPipeline{
+			Context: ctx,
+			Data: struct {
+				Elements []interface{}
+			}{
+				Elements: elements,
+			}})
 		if err != nil {
 			return []byte{}, err
 		}
@@ -284,18 +260,20 @@ func renderSourceBlock(ctx renderer.Context, b types.DelimitedBlock) ([]byte, er
 	}
 
 	result := bytes.NewBuffer(nil)
-	err = sourceBlockTmpl.Execute(result, struct {
+	err := sourceBlockTmpl.Execute(result, struct {
 		ID                string
 		Title             string
 		Language          string
 		SyntaxHighlighter string
 		Content           string
+		LineNumbers       string
 	}{
 		ID:                renderElementID(b.Attributes),
 		Title:             renderElementTitle(b.Attributes),
 		SyntaxHighlighter: highligher,
 		Language:          language,
 		Content:           content,
+		LineNumbers:       lineNumbers,
 	})
 	return result.Bytes(), err
 }
@@ -316,7 +294,7 @@ func renderExampleBlock(ctx renderer.Context, b types.DelimitedBlock) ([]byte, e
 				ID:        renderElementID(b.Attributes),
 				Class:     renderClass(k),
 				IconClass: renderIconClass(ctx, k),
-				IconTitle: renderIconTitle(k),
+				IconTitle: renderIconTitle(ctx, k),
 				Title:     renderElementTitle(b.Attributes),
 				Elements:  discardTrailingBlankLines(b.Elements),
 			},
@@ -326,7 +304,10 @@ func renderExampleBlock(ctx renderer.Context, b types.DelimitedBlock) ([]byte, e
 	// default, example block
 	var title string
 	if b.Attributes.Has(types.AttrTitle) {
-		title = "Example " + strconv.Itoa(ctx.GetAndIncrementExampleBlockCounter()) + ". " + renderElementTitle(b.Attributes)
+		title = renderElementTitle(b.Attributes)
+		if caption := ctx.Attributes.GetLabel(types.AttrExampleCaption); caption != "" {
+			title = caption + " " + strconv.Itoa(ctx.GetAndIncrementExampleBlockCounter()) + ". " + title
+		}
 	}
 	err := exampleBlockTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
@@ -443,6 +424,22 @@ func renderPassthrough(ctx renderer.Context, b types.DelimitedBlock) ([]byte, er
 	return result.Bytes(), err
 }
 
+// verbatimLines returns the verbatim lines of a source block (blank lines are returned as empty verbatim lines)
+func verbatimLines(elements []interface{}) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, 0, len(elements))
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.VerbatimLine:
+			result = append(result, e)
+		case types.BlankLine:
+			result = append(result, types.VerbatimLine{})
+		default:
+			log.Warnf("unexpected element of type '%T' in source block", e)
+		}
+	}
+	return result
+}
+
 func discardTrailingBlankLines(elements []interface{}) []interface{} {
 	// discard blank elements at the end
 	log.Debugf("discarding trailing blank lines on %d elements...", len(elements))
@@ -656,6 +656,56 @@ an admonition text on
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
 
+		It("admonition paragraphs with icons set and unset in the document body", func() {
+			source := `TIP: before
+
+:icons: font
+
+TIP: with icons
+
+:icons!:
+
+TIP: after`
+			// the attribute entries only apply to the blocks which follow them
+			expected := `<div class="admonitionblock tip">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Tip</div>
+</td>
+<td class="content">
+before
+</td>
+</tr>
+</table>
+</div>
+<div class="admonitionblock tip">
+<table>
+<tr>
+<td class="icon">
+<i class="fa icon-tip" title="Tip"></i>
+</td>
+<td class="content">
+with icons
+</td>
+</tr>
+</table>
+</div>
+<div class="admonitionblock tip">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Tip</div>
+</td>
+<td class="content">
+after
+</td>
+</tr>
+</table>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
 		It("admonition paragraph with ID, title and icon", func() {
 			source := `:icons: font
 
@@ -1219,6 +1269,87 @@ type Foo struct{
 		})
 	})
 
+	Context("syntax highlighting with chroma", func() {
+
+		It("should render source block with callouts", func() {
+			source := `:source-highlighter: chroma
+
+[source,go]
+----
+import "fmt" <1>
+
+/* a
+b */ <2>
+func foo() {} // <3>
+----`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre class="chroma highlight"><code data-lang="go"><span class="tok-kn">import</span> <span class="tok-s">&#34;fmt&#34;</span> <b class="conum">(1)</b>
+
+<span class="tok-cm">/* a</span>
+<span class="tok-cm">b */</span> <b class="conum">(2)</b>
+<span class="tok-kd">func</span> <span class="tok-nf">foo</span><span class="tok-p">()</span> <span class="tok-p">{}</span> <span class="tok-c1">// </span><b class="conum">(3)</b></code></pre>
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("should render source block with highlighted lines and inline line numbers from start", func() {
+			source := `:source-highlighter: chroma
+:chroma-linenums-mode: inline
+
+[source,go,linenums,start=10,highlight="11,12..13"]
+----
+a := 1
+b := 2
+c := a < b
+d := 4
+----`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre class="chroma highlight"><code data-lang="go"><span class="tok-ln">10</span><span class="tok-nx">a</span> <span class="tok-o">:=</span> <span class="tok-mi">1</span>
+<span class="tok-hl"><span class="tok-ln">11</span><span class="tok-nx">b</span> <span class="tok-o">:=</span> <span class="tok-mi">2</span></span>
+<span class="tok-hl"><span class="tok-ln">12</span><span class="tok-nx">c</span> <span class="tok-o">:=</span> <span class="tok-nx">a</span> <span class="tok-p">&lt;</span> <span class="tok-nx">b</span></span>
+<span class="tok-hl"><span class="tok-ln">13</span><span class="tok-nx">d</span> <span class="tok-o">:=</span> <span class="tok-mi">4</span></span></code></pre>
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("should render source block with line numbers in a table", func() {
+			source := `:source-highlighter: chroma
+
+[source,go,linenums,start=10]
+----
+a := 1
+b := 2
+----`
+			expected := `<div class="listingblock">
+<div class="content">
+<table class="linenotable"><tbody><tr><td class="linenos gl"><pre class="lineno">10
+11</pre></td><td class="code"><pre class="chroma highlight"><code data-lang="go"><span class="tok-nx">a</span> <span class="tok-o">:=</span> <span class="tok-mi">1</span>
+<span class="tok-nx">b</span> <span class="tok-o">:=</span> <span class="tok-mi">2</span></code></pre></td></tr></tbody></table>
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("should render source block as plain text when language is unknown", func() {
+			source := `:source-highlighter: chroma
+
+[source,unknown]
+----
+<p>foo & bar</p>
+----`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre class="chroma highlight"><code data-lang="unknown">&lt;p&gt;foo &amp; bar&lt;/p&gt;</code></pre>
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
 	Context("passthrough blocks", func() {
 
 		It("with title", func() {
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	htmltemplate "html/template"
 	"strconv"
+	"strings"
 	texttemplate "text/template"
+	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -17,13 +19,13 @@ var documentAuthorDetailsTmpl texttemplate.Template
 func init() {
 	documentDetailsTmpl = newTextTemplate("document details", `<div class="details">{{ if .Authors }}
 {{ .Authors }}{{ end }}{{ if .RevNumber }}
-<span id="revnumber">version {{ .RevNumber }}{{ if .RevDate }},{{ end }}</span>{{ end }}{{ if .RevDate }}
+<span id="revnumber">{{ .VersionLabel }} {{ .RevNumber }}{{ if .RevDate }},{{ end }}</span>{{ end }}{{ if .RevDate }}
 <span id="revdate">{{ .RevDate }}</span>{{ end }}{{ if .RevRemark }}
-<br><span id="revremark">{{ .RevRemark }}</span>{{ end }}
+<br{{ .VoidTagEnd }}<span id="revremark">{{ .RevRemark }}</span>{{ end }}
 </div>`)
 
-	documentAuthorDetailsTmpl = newTextTemplate("author details", `{{ if .Name }}<span id="author{{ .Index }}" class="author">{{ .Name }}</span><br>{{ end }}{{ if .Email }}
-<span id="email{{ .Index }}" class="email"><a href="mailto:{{ .Email }}">{{ .Email }}</a></span><br>{{ end }}`)
+	documentAuthorDetailsTmpl = newTextTemplate("author details", `{{ if .Name }}<span id="author{{ .Index }}" class="author">{{ .Name }}</span><br{{ .VoidTagEnd }}{{ end }}{{ if .Email }}
+<span id="email{{ .Index }}" class="email"><a href="mailto:{{ .Email }}">{{ .Email }}</a></span><br{{ .VoidTagEnd }}{{ end }}`)
 }
 
 func renderDocumentDetails(ctx renderer.Context) (*htmltemplate.HTML, error) {
@@ -35,17 +37,24 @@ func renderDocumentDetails(ctx renderer.Context) (*htmltemplate.HTML, error) {
 		documentDetailsBuff := bytes.NewBuffer(nil)
 		revNumber, _ := ctx.Attributes.GetAsString("revnumber")
 		revDate, _ := ctx.Attributes.GetAsString("revdate")
+		if layout, found := ctx.Attributes.GetAsString(types.AttrDateFormat); found {
+			revDate = formatDate(revDate, layout)
+		}
 		revRemark, _ := ctx.Attributes.GetAsString("revremark")
 		err = documentDetailsTmpl.Execute(documentDetailsBuff, struct {
-			Authors   htmltemplate.HTML
-			RevNumber string
-			RevDate   string
-			RevRemark string
+			Authors      htmltemplate.HTML
+			VersionLabel string
+			RevNumber    string
+			RevDate      string
+			RevRemark    string
+			VoidTagEnd   string
 		}{
-			Authors:   *authors,
-			RevNumber: revNumber,
-			RevDate:   revDate,
-			RevRemark: revRemark,
+			Authors:      *authors,
+			VersionLabel: strings.ToLower(ctx.Attributes.GetLabel(types.AttrVersionLabel)),
+			RevNumber:    revNumber,
+			RevDate:      revDate,
+			RevRemark:    revRemark,
+			VoidTagEnd:   voidTagEnd(ctx),
 		})
 		if err != nil {
 			return nil, errors.Wrap(err, "error while rendering the document details")
@@ -77,13 +86,15 @@ func renderDocumentAuthorsDetails(ctx renderer.Context) (*htmltemplate.HTML, err
 			authorDetailsBuff := bytes.NewBuffer(nil)
 			email, _ := ctx.Attributes.GetAsString(emailKey)
 			err := documentAuthorDetailsTmpl.Execute(authorDetailsBuff, struct {
-				Index string
-				Name  string
-				Email string
+				Index      string
+				Name       string
+				Email      string
+				VoidTagEnd string
 			}{
-				Index: index,
-				Name:  author,
-				Email: email,
+				Index:      index,
+				Name:       author,
+				Email:      email,
+				VoidTagEnd: voidTagEnd(ctx),
 			})
 			if err != nil {
 				return nil, errors.Wrap(err, "error while rendering the document author")
@@ -101,3 +112,14 @@ func renderDocumentAuthorsDetails(ctx renderer.Context) (*htmltemplate.HTML, err
 	result := htmltemplate.HTML(authorsDetailsBuff.String()) //nolint: gosec
 	return &result, nil
 }
+
+// formatDate formats the given date with the given layout, if the date is in one of the ISO 8601 formats.
+// Otherwise, the date is returned as-is
+func formatDate(date, layout string) string {
+	for _, l := range []string{"2006-01-02", "2006-01-02 15:04:05 -0700", time.RFC3339} {
+		if t, err := time.Parse(l, date); err == nil {
+			return t.Format(layout)
+		}
+	}
+	return date
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -30,6 +31,8 @@ v1.0, March 22, 2020: Containment
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <meta name="author" content="Xavier">
+<style>
+` + html5.DefaultStylesheet + `</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -73,6 +76,8 @@ John Foo Doe <johndoe@example.com>; Jane Doe <janedoe@example.com>`
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
 <meta name="author" content="John Foo Doe; Jane Doe">
+<style>
+` + html5.DefaultStylesheet + `</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -116,6 +121,8 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+` + html5.DefaultStylesheet + `</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -152,6 +159,8 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+` + html5.DefaultStylesheet + `</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -185,6 +194,8 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+` + html5.DefaultStylesheet + `</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -220,6 +231,8 @@ a paragraph`
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
 <meta name="viewport" content="width=device-width, initial-scale=1.0">
 <meta name="generator" content="libasciidoc">
+<style>
+` + html5.DefaultStylesheet + `</style>
 <title>Document Title</title>
 </head>
 <body class="article">
@@ -241,4 +254,81 @@ a paragraph`
 		})
 
 	})
+
+	Context("dates", func() {
+
+		lastUpdated := time.Date(2020, time.March, 14, 9, 26, 53, 0, time.UTC)
+
+		It("should omit the last updated timestamp in a reproducible document", func() {
+			source := `= Document Title
+:reproducible:`
+			expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<style>
+` + html5.DefaultStylesheet + `</style>
+<title>Document Title</title>
+</head>
+<body class="article">
+<div id="header">
+<h1>Document Title</h1>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+</div>
+</div>
+</body>
+</html>`
+			Expect(RenderHTML(source, configuration.WithHeaderFooter(true), configuration.WithLastUpdated(lastUpdated))).To(Equal(expected))
+		})
+
+		It("should format the last updated timestamp and the revision date with the custom label and format", func() {
+			source := `= Document Title
+Xavier <xavier@example.org>
+v1.0, 2020-03-22
+:last-update-label: Updated on
+:date-format: January 2, 2006`
+			expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<meta name="author" content="Xavier">
+<style>
+` + html5.DefaultStylesheet + `</style>
+<title>Document Title</title>
+</head>
+<body class="article">
+<div id="header">
+<h1>Document Title</h1>
+<div class="details">
+<span id="author" class="author">Xavier</span><br>
+<span id="email" class="email"><a href="mailto:xavier@example.org">xavier@example.org</a></span><br>
+<span id="revnumber">version 1.0,</span>
+<span id="revdate">March 22, 2020</span>
+</div>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+Version 1.0<br>
+Updated on March 14, 2020
+</div>
+</div>
+</body>
+</html>`
+			Expect(RenderHTML(source, configuration.WithHeaderFooter(true), configuration.WithLastUpdated(lastUpdated))).To(Equal(expected))
+		})
+	})
 })
@@ -81,6 +81,10 @@ func renderElement(ctx renderer.Context, element interface{}) ([]byte, error) {
 	switch e := element.(type) {
 	case []interface{}:
 		return renderElements(ctx, e)
+	case types.AttributeDeclaration, types.AttributeReset:
+		// applied on the attributes used to render the elements which follow
+		ctx.ApplyAttributeEntry(e)
+		return []byte{}, nil
 	case types.TableOfContentsPlaceHolder:
 		return renderTableOfContents(ctx, ctx.TableOfContents)
 	case types.Section:
@@ -110,7 +114,7 @@ func renderElement(ctx renderer.Context, element interface{}) ([]byte, error) {
 	case types.ImageBlock:
 		return renderImageBlock(ctx, e)
 	case types.InlineImage:
-		return renderInlineImage(e)
+		return renderInlineImage(ctx, e)
 	case types.DelimitedBlock:
 		return renderDelimitedBlock(ctx, e)
 	case types.Table:
@@ -124,7 +128,7 @@ func renderElement(ctx renderer.Context, element interface{}) ([]byte, error) {
 	case types.FootnoteReference:
 		return renderFootnoteReference(e)
 	case types.LineBreak:
-		return renderLineBreak()
+		return renderLineBreak(ctx)
 	case types.UserMacro:
 		return renderUserMacro(ctx, e)
 	case types.IndexTerm:
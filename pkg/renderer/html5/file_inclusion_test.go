@@ -44,6 +44,7 @@ var _ = Describe("file inclusions", func() {
 					},
 				},
 			},
+			OutFileSuffix: ".html",
 		}))
 		// verify no error/warning in logs
 		Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
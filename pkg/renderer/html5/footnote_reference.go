@@ -36,9 +36,9 @@ func init() {
 	invalidFootnoteTmpl = newTextTemplate("invalid footnote", `<sup class="footnoteref red" title="Unresolved footnote reference.">[{{ .Ref }}]</sup>`)
 	footnotesTmpl = newTextTemplate("footnotes", `
 <div id="footnotes">
-<hr>{{ $ctx := .Context }}{{ with .Data }}{{ $footnotes := .Footnotes }}{{ range $index, $footnote := $footnotes }}
-<div class="footnote" id="_footnotedef_{{ renderIndex $index }}">
-<a href="#_footnoteref_{{ renderIndex $index }}">{{ renderIndex $index }}</a>. {{ renderFootnoteContent $ctx $footnote.Elements }}
+{{ $ctx := .Context }}<hr{{ voidTagEnd $ctx }}{{ with .Data }}{{ $footnotes := .Footnotes }}{{ range $footnote := $footnotes }}
+<div class="footnote" id="_footnotedef_{{ $footnote.ID }}">
+<a href="#_footnoteref_{{ $footnote.ID }}">{{ $footnote.ID }}</a>. {{ renderFootnoteContent $ctx $footnote.Elements }}
 </div>{{ end }}{{ end }}
 </div>`,
 		texttemplate.FuncMap{
@@ -50,6 +50,7 @@ func init() {
 				return strings.TrimSpace(string(result)), nil
 			},
 			"renderIndex": renderFootnoteIndex,
+			"voidTagEnd":  voidTagEnd,
 		})
 }
 
@@ -22,27 +22,32 @@ var manpageHeaderTmpl texttemplate.Template
 func init() {
 	articleTmpl = newTextTemplate("article",
 		`<!DOCTYPE html>
-<html lang="en">
+<html{{ if .XMLNamespace }} xmlns="http://www.w3.org/1999/xhtml"{{ end }} lang="{{ .Lang }}">
 <head>
-<meta charset="UTF-8">
-<meta http-equiv="X-UA-Compatible" content="IE=edge">
-<meta name="viewport" content="width=device-width, initial-scale=1.0">{{ if .Generator }}
-<meta name="generator" content="{{ .Generator }}">{{ end }}{{ if .Authors }}
-<meta name="author" content="{{ .Authors }}">{{ end }}{{ if .CSS}}
-<link type="text/css" rel="stylesheet" href="{{ .CSS }}">{{ end }}
-<title>{{ escape .Title }}</title>
+<meta charset="UTF-8"{{ .VoidTagEnd }}
+<meta http-equiv="X-UA-Compatible" content="IE=edge"{{ .VoidTagEnd }}
+<meta name="viewport" content="width=device-width, initial-scale=1.0"{{ .VoidTagEnd }}{{ if .Generator }}
+<meta name="generator" content="{{ .Generator }}"{{ .VoidTagEnd }}{{ end }}{{ if .Authors }}
+<meta name="author" content="{{ .Authors }}"{{ .VoidTagEnd }}{{ end }}{{ range .Stylesheets }}{{ if .Href }}
+<link type="text/css" rel="stylesheet" href="{{ .Href }}"{{ $.VoidTagEnd }}{{ else }}
+<style>
+{{ .Content }}
+</style>{{ end }}{{ end }}
+<title>{{ escape .Title }}</title>{{ if .DocInfoHead }}
+{{ .DocInfoHead }}{{ end }}
 </head>
-<body class="{{ .Doctype }}{{ if .Role }} {{ .Role }}{{ end }}">{{ if .IncludeHeader }}
+<body class="{{ .Doctype }}{{ if .TableOfContentsPlacement }} {{ .TableOfContentsClass }} toc-{{ .TableOfContentsPlacement }}{{ end }}{{ if .Role }} {{ .Role }}{{ end }}">{{ if .IncludeHeader }}
 {{ .Header }}{{ end }}
 <div id="content">
 {{ .Content }}
 </div>{{ if .IncludeFooter }}
 <div id="footer">
 <div id="footer-text">{{ if .RevNumber }}
-Version {{ .RevNumber }}<br>{{ end }}
-Last updated {{ .LastUpdated }}
+{{ .VersionLabel }} {{ .RevNumber }}<br{{ .VoidTagEnd }}{{ end }}{{ if .LastUpdated }}
+{{ .LastUpdateLabel }} {{ .LastUpdated }}{{ end }}
 </div>
-</div>{{ end }}
+</div>{{ end }}{{ if .DocInfoFooter }}
+{{ .DocInfoFooter }}{{ end }}
 </body>
 </html>`,
 		texttemplate.FuncMap{
@@ -51,11 +56,12 @@ Last updated {{ .LastUpdated }}
 
 	articleHeaderTmpl = newTextTemplate("article header", `<div id="header">
 <h1>{{ .Header }}</h1>{{ if .Details }}
-{{ .Details }}{{ end }}
+{{ .Details }}{{ end }}{{ if .TableOfContents }}
+{{ .TableOfContents }}{{ end }}
 </div>`)
 
 	manpageHeaderTmpl = newTextTemplate("manpage header", `{{ if .IncludeH1 }}<div id="header">
-<h1>{{ .Header }} Manual Page</h1>
+<h1>{{ .Header }} {{ .ManualPageLabel }}</h1>
 {{ end }}<h2 id="_name">{{ .Name }}</h2>
 <div class="sectionbody">
 {{ .Content }}
@@ -63,8 +69,30 @@ Last updated {{ .LastUpdated }}
 </div>{{ end }}`)
 }
 
-// Render renders the given document in HTML and writes the result in the given `writer`
+// registers the `html5` and `xhtml5` backends
+func init() {
+	renderer.Register("html5", renderer.RenderFunc(Render), map[string]string{
+		types.AttrBaseBackend:   "html",
+		types.AttrOutFileSuffix: ".html",
+		types.AttrFileType:      "html",
+		types.AttrHTMLSyntax:    "html",
+	})
+	renderer.Register("xhtml5", renderer.RenderFunc(Render), map[string]string{
+		types.AttrBaseBackend:   "html",
+		types.AttrOutFileSuffix: ".html",
+		types.AttrFileType:      "html",
+		types.AttrHTMLSyntax:    "xml",
+	})
+}
+
+// Render renders the given document in HTML and writes the result in the given `writer`.
+// If the `htmlsyntax` attribute is `xml` (eg: with the `xhtml5` backend), the void elements are self-closed (eg: `<br/>`)
 func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	templates, err := loadTemplates(ctx)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	ctx.Templates = templates
 	renderedTitle, err := renderDocumentTitle(ctx, doc)
 	if err != nil {
 		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
@@ -81,33 +109,60 @@ func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.M
 
 	if ctx.Config.IncludeHeaderFooter {
 		log.Debugf("Rendering full document...")
-		err = articleTmpl.Execute(output, struct {
-			Generator     string
-			Doctype       string
-			Title         string
-			Authors       string
-			Header        string
-			Role          string
-			Content       htmltemplate.HTML
-			RevNumber     string
-			LastUpdated   string
-			CSS           string
-			IncludeHeader bool
-			IncludeFooter bool
-		}{
-			Generator:     "libasciidoc", // TODO: externalize this value and include the lib version ?
-			Doctype:       doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article"),
-			Title:         string(renderedTitle),
-			Authors:       renderAuthors(doc),
-			Header:        string(renderedHeader),
-			Role:          documentRole(doc),
-			Content:       htmltemplate.HTML(string(renderedContent)), //nolint: gosec
-			RevNumber:     doc.Attributes.GetAsStringWithDefault("revnumber", ""),
-			LastUpdated:   ctx.Config.LastUpdated.Format(configuration.LastUpdatedFormat),
-			CSS:           ctx.Config.CSS,
-			IncludeHeader: !doc.Attributes.Has(types.AttrNoHeader),
-			IncludeFooter: !doc.Attributes.Has(types.AttrNoFooter),
-		})
+		stylesheets, err := renderStylesheets(ctx, doc)
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+		}
+		tocPlacement, _ := tableOfContentsSidePlacement(ctx)
+		if t, found := ctx.Templates.Lookup("document"); found {
+			err = renderDocumentWithTemplate(ctx, t, doc, output, string(renderedTitle), string(renderedHeader), string(renderedContent), stylesheets)
+		} else {
+			err = articleTmpl.Execute(output, struct {
+				Generator                string
+				Doctype                  string
+				Title                    string
+				Authors                  string
+				Header                   string
+				Role                     string
+				TableOfContentsPlacement string
+				TableOfContentsClass     string
+				Content                  htmltemplate.HTML
+				RevNumber                string
+				LastUpdateLabel          string
+				VersionLabel             string
+				Lang                     string
+				LastUpdated              string
+				Stylesheets              []stylesheet
+				IncludeHeader            bool
+				IncludeFooter            bool
+				DocInfoHead              string
+				DocInfoFooter            string
+				XMLNamespace             bool
+				VoidTagEnd               string
+			}{
+				Generator:                "libasciidoc", // TODO: externalize this value and include the lib version ?
+				Doctype:                  doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article"),
+				Title:                    string(renderedTitle),
+				Authors:                  renderAuthors(doc),
+				Header:                   string(renderedHeader),
+				Role:                     documentRole(doc),
+				TableOfContentsPlacement: tocPlacement,
+				TableOfContentsClass:     doc.Attributes.GetAsStringWithDefault(types.AttrTableOfContentsClass, "toc2"),
+				Content:                  htmltemplate.HTML(string(renderedContent)), //nolint: gosec
+				RevNumber:                doc.Attributes.GetAsStringWithDefault("revnumber", ""),
+				LastUpdateLabel:          doc.Attributes.GetLabel(types.AttrLastUpdateLabel),
+				VersionLabel:             doc.Attributes.GetLabel(types.AttrVersionLabel),
+				Lang:                     doc.Attributes.GetLang(),
+				LastUpdated:              renderLastUpdated(ctx, doc),
+				Stylesheets:              stylesheets,
+				IncludeHeader:            !doc.Attributes.Has(types.AttrNoHeader),
+				IncludeFooter:            !doc.Attributes.Has(types.AttrNoFooter),
+				DocInfoHead:              doc.DocInfo.Head,
+				DocInfoFooter:            doc.DocInfo.Footer,
+				XMLNamespace:             isXMLSyntax(ctx),
+				VoidTagEnd:               voidTagEnd(ctx),
+			})
+		}
 		if err != nil {
 			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
 		}
@@ -120,12 +175,42 @@ func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.M
 	// generate the metadata to be returned to the caller
 	metadata := types.Metadata{
 		Title:           string(renderedTitle),
-		LastUpdated:     ctx.Config.LastUpdated.Format(configuration.LastUpdatedFormat),
+		LastUpdated:     renderLastUpdated(ctx, doc),
 		TableOfContents: ctx.TableOfContents,
+		Warnings:        doc.Warnings,
 	}
 	return metadata, err
 }
 
+// isXMLSyntax returns true if the `htmlsyntax` attribute of the document (or of the backend) is `xml`
+func isXMLSyntax(ctx renderer.Context) bool {
+	if syntax, found := ctx.Attributes.GetAsString(types.AttrHTMLSyntax); found {
+		return syntax == "xml"
+	}
+	return ctx.Config.BackendAttributes[types.AttrHTMLSyntax] == "xml"
+}
+
+// voidTagEnd returns the end of the start tag of the void elements (eg: `<br>`), which are self-closed
+// if the `htmlsyntax` attribute is `xml` (eg: `<br/>`)
+func voidTagEnd(ctx renderer.Context) string {
+	if isXMLSyntax(ctx) {
+		return "/>"
+	}
+	return ">"
+}
+
+// renderLastUpdated returns the "last updated" timestamp, formatted with the `date-format` attribute (if set),
+// or an empty string if the document is `reproducible` or if the `last-update-label` attribute is empty or unset
+func renderLastUpdated(ctx renderer.Context, doc types.Document) string {
+	if doc.Attributes.Has(types.AttrReproducible) {
+		return ""
+	}
+	if doc.Attributes.GetLabel(types.AttrLastUpdateLabel) == "" {
+		return ""
+	}
+	return ctx.Config.LastUpdated.Format(doc.Attributes.GetAsStringWithDefault(types.AttrDateFormat, configuration.LastUpdatedFormat))
+}
+
 // splitAndRender the document with the header elements on one side
 // and all other elements (table of contents, with preamble, content) on the other side,
 // then renders the header and other elements
@@ -233,14 +318,20 @@ func renderArticleHeader(ctx renderer.Context, header types.Section) ([]byte, er
 	if err != nil {
 		return nil, err
 	}
+	renderedTableOfContents, err := renderHeaderTableOfContents(ctx, ctx.TableOfContents)
+	if err != nil {
+		return nil, err
+	}
 
 	output := bytes.NewBuffer(nil)
 	err = articleHeaderTmpl.Execute(output, struct {
-		Header  string
-		Details *htmltemplate.HTML
+		Header          string
+		Details         *htmltemplate.HTML
+		TableOfContents string
 	}{
-		Header:  string(renderedHeader),
-		Details: documentDetails,
+		Header:          string(renderedHeader),
+		Details:         documentDetails,
+		TableOfContents: string(renderedTableOfContents),
 	})
 	if err != nil {
 		return nil, err
@@ -268,15 +359,17 @@ func renderManpageHeader(ctx renderer.Context, header types.Section, nameSection
 	}
 	output := bytes.NewBuffer(nil)
 	err = manpageHeaderTmpl.Execute(output, struct {
-		Header    string
-		Name      string
-		Content   htmltemplate.HTML
-		IncludeH1 bool
+		Header          string
+		ManualPageLabel string
+		Name            string
+		Content         htmltemplate.HTML
+		IncludeH1       bool
 	}{
-		Header:    string(renderedHeader),
-		Name:      string(renderedName),
-		Content:   htmltemplate.HTML(string(renderedContent)), //nolint: gosec
-		IncludeH1: len(renderedHeader) > 0,
+		Header:          string(renderedHeader),
+		ManualPageLabel: ctx.Attributes.GetLabel(types.AttrManualPageLabel),
+		Name:            string(renderedName),
+		Content:         htmltemplate.HTML(string(renderedContent)), //nolint: gosec
+		IncludeH1:       len(renderedHeader) > 0,
 	})
 	if err != nil {
 		return nil, err
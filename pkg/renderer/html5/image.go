@@ -17,7 +17,7 @@ var inlineImageTmpl texttemplate.Template
 func init() {
 	blockImageTmpl = newTextTemplate("block image", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="imageblock{{ if .Role }} {{ .Role }}{{ end }}">
 <div class="content">
-{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}<img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>{{ if ne .Href "" }}</a>{{ end }}
+{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}<img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ .VoidTagEnd }}{{ if ne .Href "" }}</a>{{ end }}
 </div>{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>
 {{ else }}
@@ -25,7 +25,7 @@ func init() {
 		texttemplate.FuncMap{
 			"escape": EscapeString,
 		})
-	inlineImageTmpl = newTextTemplate("inline image", `<span class="image{{ if .Role }} {{ .Role }}{{ end }}"><img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Title }} title="{{ escape .Title }}"{{ end }}></span>`,
+	inlineImageTmpl = newTextTemplate("inline image", `<span class="image{{ if .Role }} {{ .Role }}{{ end }}"><img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Title }} title="{{ escape .Title }}"{{ end }}{{ .VoidTagEnd }}</span>`,
 		texttemplate.FuncMap{
 			"escape": EscapeString,
 		})
@@ -35,26 +35,41 @@ func renderImageBlock(ctx renderer.Context, img types.ImageBlock) ([]byte, error
 	result := bytes.NewBuffer(nil)
 	title := ""
 	if t, found := img.Attributes.GetAsString(types.AttrTitle); found {
-		title = "Figure " + strconv.Itoa(ctx.GetAndIncrementImageCounter()) + ". " + EscapeString(t)
+		title = EscapeString(t)
+		if caption := ctx.Attributes.GetLabel(types.AttrFigureCaption); caption != "" {
+			title = caption + " " + strconv.Itoa(ctx.GetAndIncrementImageCounter()) + ". " + title
+		}
+	}
+	if t, found := ctx.Templates.Lookup("image"); found {
+		return executeTemplate(t, ImageData{
+			Block:  newBlock(ctx, img.Attributes, title),
+			Path:   img.Location.String(),
+			Alt:    img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
+			Width:  img.Attributes.GetAsStringWithDefault(types.AttrImageWidth, ""),
+			Height: img.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
+			Link:   img.Attributes.GetAsStringWithDefault(types.AttrInlineLink, ""),
+		})
 	}
 	err := blockImageTmpl.Execute(result, struct {
-		ID     string
-		Title  string
-		Role   string
-		Href   string
-		Alt    string
-		Width  string
-		Height string
-		Path   string
+		ID         string
+		Title      string
+		Role       string
+		Href       string
+		Alt        string
+		Width      string
+		Height     string
+		Path       string
+		VoidTagEnd string
 	}{
-		ID:     img.Attributes.GetAsStringWithDefault(types.AttrID, ""),
-		Title:  title,
-		Role:   img.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
-		Href:   img.Attributes.GetAsStringWithDefault(types.AttrInlineLink, ""),
-		Alt:    img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
-		Width:  img.Attributes.GetAsStringWithDefault(types.AttrImageWidth, ""),
-		Height: img.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
-		Path:   img.Location.String(),
+		ID:         img.Attributes.GetAsStringWithDefault(types.AttrID, ""),
+		Title:      title,
+		Role:       img.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
+		Href:       img.Attributes.GetAsStringWithDefault(types.AttrInlineLink, ""),
+		Alt:        img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
+		Width:      img.Attributes.GetAsStringWithDefault(types.AttrImageWidth, ""),
+		Height:     img.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
+		Path:       img.Location.String(),
+		VoidTagEnd: voidTagEnd(ctx),
 	})
 
 	if err != nil {
@@ -64,23 +79,25 @@ func renderImageBlock(ctx renderer.Context, img types.ImageBlock) ([]byte, error
 	return result.Bytes(), nil
 }
 
-func renderInlineImage(img types.InlineImage) ([]byte, error) {
+func renderInlineImage(ctx renderer.Context, img types.InlineImage) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
 	err := inlineImageTmpl.Execute(result, struct {
-		Role   string
-		Title  string
-		Href   string
-		Alt    string
-		Width  string
-		Height string
-		Path   string
+		Role       string
+		Title      string
+		Href       string
+		Alt        string
+		Width      string
+		Height     string
+		Path       string
+		VoidTagEnd string
 	}{
-		Title:  renderElementTitle(img.Attributes),
-		Role:   img.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
-		Alt:    img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
-		Width:  img.Attributes.GetAsStringWithDefault(types.AttrImageWidth, ""),
-		Height: img.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
-		Path:   img.Location.String(),
+		Title:      renderElementTitle(img.Attributes),
+		Role:       img.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
+		Alt:        img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
+		Width:      img.Attributes.GetAsStringWithDefault(types.AttrImageWidth, ""),
+		Height:     img.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
+		Path:       img.Location.String(),
+		VoidTagEnd: voidTagEnd(ctx),
 	})
 
 	if err != nil {
@@ -44,7 +44,7 @@ func init() {
 {{ if includeNewline $ctx $itemIndex $items }}</td>
 </tr>
 <tr>
-<td class="hdlist1">{{ else }}</td>{{ end }}{{ else }}<br>{{ end }}{{ end }}
+<td class="hdlist1">{{ else }}</td>{{ end }}{{ else }}<br{{ voidTagEnd $ctx }}{{ end }}{{ end }}
 </tr>
 </table>
 </div>{{ end }}`,
@@ -52,6 +52,7 @@ func init() {
 			"renderInlineElements": renderInlineElements,
 			"renderElements":       renderListElements,
 			"includeNewline":       includeNewline,
+			"voidTagEnd":           voidTagEnd,
 			"escape":               EscapeString,
 		})
 
@@ -0,0 +1,126 @@
+package html5_test
+
+import (
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("labels", func() {
+
+	It("should render the custom captions", func() {
+		source := `:figure-caption: Fig.
+:example-caption:
+:note-caption: Nota bene
+
+.An image
+image::foo.png[]
+
+.An example
+====
+content
+====
+
+NOTE: a note`
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Fig. 1. An image</div>
+</div>
+<div class="exampleblock">
+<div class="title">An example</div>
+<div class="content">
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</div>
+<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Nota bene</div>
+</td>
+<td class="content">
+a note
+</td>
+</tr>
+</table>
+</div>`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("should not render the unset captions", func() {
+		source := `:figure-caption!:
+
+.An image
+image::foo.png[]
+
+.A table
+|===
+| foo
+|===`
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">An image</div>
+</div>
+<table class="tableblock frame-all grid-all stretch">
+<caption class="title">A table</caption>
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">foo</p></td>
+</tr>
+</tbody>
+</table>`
+		Expect(RenderHTML(source, configuration.WithAttribute("table-caption!", ""))).To(MatchHTML(expected))
+	})
+
+	It("should not render the last updated timestamp when its label is unset", func() {
+		source := `= Document Title
+John Doe
+v1.0
+:last-update-label!:`
+		lastUpdated := time.Date(2020, time.March, 14, 9, 26, 53, 0, time.UTC)
+		output, err := RenderHTML(source, configuration.WithHeaderFooter(true), configuration.WithLastUpdated(lastUpdated))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(ContainSubstring(`<div id="footer-text">
+Version 1.0<br>
+</div>`))
+	})
+
+	It("should render the german labels and lang", func() {
+		source := `= Dokumenttitel
+John Doe
+v1.0
+:lang: de
+:toc:
+
+.Eine Tabelle
+|===
+| foo
+|===
+
+== Abschnitt`
+		lastUpdated := time.Date(2020, time.March, 14, 9, 26, 53, 0, time.UTC)
+		output, err := RenderHTML(source, configuration.WithHeaderFooter(true), configuration.WithLastUpdated(lastUpdated))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(ContainSubstring(`<html lang="de">`))
+		Expect(output).To(ContainSubstring(`<span id="revnumber">version 1.0</span>`))
+		Expect(output).To(ContainSubstring(`<div id="toctitle">Inhaltsverzeichnis</div>`))
+		Expect(output).To(ContainSubstring(`<caption class="title">Tabelle 1. Eine Tabelle</caption>`))
+		Expect(output).To(ContainSubstring(`<div id="footer-text">
+Version 1.0<br>
+Zuletzt aktualisiert 2020-03-14 09:26:53 +0000
+</div>`))
+	})
+})
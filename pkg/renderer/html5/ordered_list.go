@@ -31,6 +31,9 @@ func init() {
 }
 
 func renderOrderedList(ctx renderer.Context, l types.OrderedList) ([]byte, error) {
+	if t, found := ctx.Templates.Lookup("olist"); found {
+		return renderOrderedListWithTemplate(ctx, t, l)
+	}
 	result := bytes.NewBuffer(nil)
 	err := orderedListTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
@@ -72,13 +72,14 @@ func init() {
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <pre class="content">{{ renderLines $ctx .Lines plainText | printf "%s" }}</pre>{{ if .Attribution.First }}
 <div class="attribution">
-&#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br>
+&#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br{{ voidTagEnd $ctx }}
 <cite>{{ .Attribution.Second }}</cite>{{ end }}
 </div>{{ end }}
 </div>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderLines": renderLines,
 			"plainText":   PlainText,
+			"voidTagEnd":  voidTagEnd,
 			"escape":      EscapeString,
 		})
 
@@ -88,13 +89,14 @@ func init() {
 {{ renderLines $ctx .Lines | printf "%s" }}
 </blockquote>{{ if .Attribution.First }}
 <div class="attribution">
-&#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br>
+&#8212; {{ .Attribution.First }}{{ if .Attribution.Second }}<br{{ voidTagEnd $ctx }}
 <cite>{{ .Attribution.Second }}</cite>{{ end }}
 </div>{{ end }}
 </div>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderLines": renderLines,
 			"plainText":   PlainText,
+			"voidTagEnd":  voidTagEnd,
 			"escape":      EscapeString,
 		})
 
@@ -123,6 +125,8 @@ func renderParagraph(ctx renderer.Context, p types.Paragraph) ([]byte, error) {
 		return renderQuoteParagraph(ctx, p)
 	} else if kind, ok := p.Attributes[types.AttrKind]; ok && kind == "manpage" {
 		return renderManpageNameParagraph(ctx, p)
+	} else if t, found := ctx.Templates.Lookup("paragraph"); found {
+		return renderParagraphWithTemplate(ctx, t, p)
 	} else if ctx.WithinDelimitedBlock || ctx.WithinList > 0 {
 		return renderDelimitedBlockParagraph(ctx, p)
 	} else {
@@ -158,6 +162,9 @@ func renderAdmonitionParagraph(ctx renderer.Context, p types.Paragraph) ([]byte,
 	if !ok {
 		return nil, errors.Errorf("failed to render admonition with unknown kind: %T", p.Attributes[types.AttrAdmonitionKind])
 	}
+	if t, found := ctx.Templates.Lookup("admonition"); found {
+		return renderAdmonitionWithTemplate(ctx, t, k, p)
+	}
 	err := admonitionParagraphTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
@@ -171,7 +178,7 @@ func renderAdmonitionParagraph(ctx renderer.Context, p types.Paragraph) ([]byte,
 			ID:        renderElementID(p.Attributes),
 			Title:     renderElementTitle(p.Attributes),
 			Class:     renderClass(k),
-			IconTitle: renderIconTitle(k),
+			IconTitle: renderIconTitle(ctx, k),
 			IconClass: renderIconClass(ctx, k),
 			Lines:     p.Lines,
 		},
@@ -309,18 +316,18 @@ func renderClass(kind types.AdmonitionKind) string {
 	}
 }
 
-func renderIconTitle(kind types.AdmonitionKind) string {
+func renderIconTitle(ctx renderer.Context, kind types.AdmonitionKind) string {
 	switch kind {
 	case types.Tip:
-		return "Tip"
+		return ctx.Attributes.GetLabel(types.AttrTipCaption)
 	case types.Note:
-		return "Note"
+		return ctx.Attributes.GetLabel(types.AttrNoteCaption)
 	case types.Important:
-		return "Important"
+		return ctx.Attributes.GetLabel(types.AttrImportantCaption)
 	case types.Warning:
-		return "Warning"
+		return ctx.Attributes.GetLabel(types.AttrWarningCaption)
 	case types.Caution:
-		return "Caution"
+		return ctx.Attributes.GetLabel(types.AttrCautionCaption)
 	default:
 		log.Errorf("unexpected kind of admonition: %v", kind)
 		return ""
@@ -393,7 +400,7 @@ func renderLines(ctx renderer.Context, lines [][]interface{}, options ...RenderL
 			// log.Debugf("rendered line is not the last one in the slice")
 			var err error
 			if linesRenderer.hardbreaks {
-				_, err = buf.WriteString("<br>\n")
+				_, err = buf.WriteString("<br" + voidTagEnd(ctx) + "\n")
 			} else {
 				_, err = buf.WriteString("\n")
 			}
@@ -127,6 +127,35 @@ baz</p>
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
 
+		It("with document attribute set and unset in the document body", func() {
+			source := `foo
+bar
+
+:hardbreaks:
+
+foo
+bar
+
+:hardbreaks!:
+
+foo
+bar`
+			// the attribute entries only apply to the paragraphs which follow them
+			expected := `<div class="paragraph">
+<p>foo
+bar</p>
+</div>
+<div class="paragraph">
+<p>foo<br>
+bar</p>
+</div>
+<div class="paragraph">
+<p>foo
+bar</p>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
 		It("paragraph with document attribute resets", func() {
 			source := `:author: Xavier
 						
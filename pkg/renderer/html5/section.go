@@ -75,6 +75,9 @@ func renderPreamble(ctx renderer.Context, p types.Preamble) ([]byte, error) {
 
 func renderSection(ctx renderer.Context, s types.Section) ([]byte, error) {
 	log.Debugf("rendering section level %d", s.Level)
+	if t, found := ctx.Templates.Lookup("section"); found {
+		return renderSectionWithTemplate(ctx, t, s)
+	}
 	renderedSectionTitle, err := renderSectionTitle(ctx, s)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering section")
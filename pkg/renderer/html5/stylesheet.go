@@ -0,0 +1,162 @@
+package html5
+
+import (
+	"bytes"
+	_ "embed" // for the default stylesheet
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/chroma/formatters/html"
+	"github.com/alecthomas/chroma/styles"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultStylesheet the default stylesheet, which is embedded in the output
+// (unless the `linkcss` attribute is set or another stylesheet is specified with the `stylesheet` attribute)
+//
+//go:embed libasciidoc.css
+var DefaultStylesheet string
+
+// DefaultStylesheetName the name of the default stylesheet, when it is linked (and copied) instead of being embedded
+const DefaultStylesheetName = "libasciidoc.css"
+
+// stylesheet a stylesheet to link to (if `Href` is set) or to embed in the output
+type stylesheet struct {
+	Href    string
+	Content string
+}
+
+// renderStylesheets returns the stylesheets of the document:
+// - the stylesheet set in the configuration (`--css` flag in the CLI), which is always linked
+// - otherwise, the stylesheet specified by the `stylesheet` attribute in the `stylesdir` directory (or the default stylesheet),
+// which is embedded unless the `linkcss` attribute is set (or in `secure` safe mode), and copied in the output directory
+// if the `copycss` attribute is set
+// - the chroma stylesheet for the `chroma-style` (or `pygments-style`) attribute when the `source-highlighter` is `chroma` (or `pygments`)
+// and `chroma-css` (or `pygments-css`) is `classes`
+func renderStylesheets(ctx renderer.Context, doc types.Document) ([]stylesheet, error) {
+	linkcss := doc.Attributes.Has(types.AttrLinkCSS) || ctx.Config.SafeMode >= configuration.Secure
+	copycss := doc.Attributes.Has(types.AttrCopyCSS)
+	stylesdir := doc.Attributes.GetAsStringWithDefault(types.AttrStylesDir, ".")
+	result := []stylesheet{}
+	if ctx.Config.CSS != "" {
+		result = append(result, stylesheet{
+			Href: ctx.Config.CSS,
+		})
+	} else {
+		name := doc.Attributes.GetAsStringWithDefault(types.AttrStylesheet, "")
+		var content string
+		if name == "" {
+			name = DefaultStylesheetName
+			content = DefaultStylesheet
+		} else if (!linkcss || copycss) && !isRemote(name) {
+			c, err := readStylesheet(ctx, path.Join(stylesdir, name))
+			if err != nil {
+				log.WithError(err).Warnf("unable to read stylesheet '%s'", name)
+			}
+			content = c
+		}
+		s, err := newStylesheet(ctx, stylesdir, name, content, linkcss, copycss)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	// stylesheet for the syntax highlighting
+	highlighter := doc.Attributes.GetAsStringWithDefault(types.AttrSyntaxHighlighter, "")
+	if h, supported := syntaxHighlighters[highlighter]; supported && doc.Attributes.GetAsStringWithDefault(h.css, "classes") == "classes" {
+		styleName := doc.Attributes.GetAsStringWithDefault(h.style, "")
+		style := styles.Fallback
+		if styleName != "" {
+			style = styles.Get(styleName)
+		} else {
+			styleName = "default"
+		}
+		buf := &bytes.Buffer{}
+		if err := html.New(html.WithClasses(true), html.ClassPrefix("tok-")).WriteCSS(buf, style); err != nil {
+			return nil, errors.Wrap(err, "unable to render the syntax highlighting stylesheet")
+		}
+		s, err := newStylesheet(ctx, stylesdir, highlighter+"-"+styleName+".css", strings.TrimSuffix(buf.String(), "\n"), linkcss, copycss)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+// newStylesheet returns a stylesheet with the given content, or a link to the given stylesheet in the `stylesdir`
+// (in which case the content is copied in the output directory if `copycss` is `true`)
+func newStylesheet(ctx renderer.Context, stylesdir, name, content string, linkcss, copycss bool) (stylesheet, error) {
+	if !linkcss && content != "" {
+		return stylesheet{
+			Content: strings.TrimSuffix(content, "\n"),
+		}, nil
+	}
+	if isRemote(name) {
+		return stylesheet{
+			Href: name,
+		}, nil
+	}
+	href := path.Join(stylesdir, name)
+	if stylesdir == "." {
+		href = "./" + href
+	}
+	if copycss && content != "" {
+		if err := copyStylesheet(ctx, href, content); err != nil {
+			return stylesheet{}, err
+		}
+	}
+	return stylesheet{
+		Href: href,
+	}, nil
+}
+
+// readStylesheet reads the stylesheet at the given location, relatively to the document
+func readStylesheet(ctx renderer.Context, location string) (string, error) {
+	p, err := ctx.Config.ResolvePath(location)
+	if err != nil {
+		return "", err
+	}
+	f, err := ctx.Config.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	content, err := ioutil.ReadAll(f)
+	return string(content), err
+}
+
+// copyStylesheet copies the given content at the given location in the output directory (if set in the configuration),
+// unless the location is outside of the output directory (absolute path, etc.)
+func copyStylesheet(ctx renderer.Context, href, content string) error {
+	if ctx.Config.OutputDir == "" {
+		log.Debugf("no output directory to copy the '%s' stylesheet", href)
+		return nil
+	}
+	if path.IsAbs(href) || strings.HasPrefix(path.Clean(href), "..") {
+		log.Warnf("not copying the '%s' stylesheet outside of the output directory", href)
+		return nil
+	}
+	p := filepath.Join(ctx.Config.OutputDir, filepath.FromSlash(href))
+	log.Debugf("copying stylesheet to '%s'", p)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return errors.Wrapf(err, "unable to copy the stylesheet to '%s'", p)
+	}
+	if err := ioutil.WriteFile(p, []byte(strings.TrimSuffix(content, "\n")+"\n"), 0644); err != nil { //nolint: gosec
+		return errors.Wrapf(err, "unable to copy the stylesheet to '%s'", p)
+	}
+	return nil
+}
+
+// isRemote returns true if the given location is a URL, including a protocol-relative URL (eg: `//cdn.example.com/site.css`)
+// which can only be linked to in the output
+func isRemote(location string) bool {
+	return configuration.IsRemote(location) || strings.HasPrefix(location, "//")
+}
@@ -0,0 +1,117 @@
+package html5_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing/fstest"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("stylesheets", func() {
+
+	lastUpdated := time.Date(2020, time.March, 14, 9, 26, 53, 0, time.UTC)
+
+	It("should link the default stylesheet", func() {
+		source := `= Document Title
+:linkcss:`
+		expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<link type="text/css" rel="stylesheet" href="./libasciidoc.css">
+<title>Document Title</title>
+</head>
+<body class="article">
+<div id="header">
+<h1>Document Title</h1>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated 2020-03-14 09:26:53 +0000
+</div>
+</div>
+</body>
+</html>`
+		Expect(RenderHTML(source, configuration.WithHeaderFooter(true), configuration.WithLastUpdated(lastUpdated))).To(Equal(expected))
+	})
+
+	It("should embed the custom stylesheet from the styles directory", func() {
+		source := `:stylesheet: custom.css
+:stylesdir: css`
+		fsys := fstest.MapFS{
+			"docs/index.adoc": &fstest.MapFile{
+				Data: []byte(source),
+			},
+			"docs/css/custom.css": &fstest.MapFile{
+				Data: []byte("body { color: red; }\n"),
+			},
+		}
+		Expect(RenderHTML(source,
+			configuration.WithFilename("docs/index.adoc"),
+			configuration.WithFilesystem(fsys),
+			configuration.WithHeaderFooter(true),
+		)).To(ContainSubstring(`<meta name="generator" content="libasciidoc">
+<style>
+body { color: red; }
+</style>
+<title>`))
+	})
+
+	It("should link the custom stylesheet from the styles directory", func() {
+		source := `:stylesheet: custom.css
+:stylesdir: css
+:linkcss:`
+		Expect(RenderHTML(source, configuration.WithHeaderFooter(true))).To(ContainSubstring(`<meta name="generator" content="libasciidoc">
+<link type="text/css" rel="stylesheet" href="css/custom.css">
+<title>`))
+	})
+
+	It("should link the stylesheet set in the configuration instead of the default one", func() {
+		Expect(RenderHTML("content", configuration.WithHeaderFooter(true), configuration.WithCSS("path/to/style.css"))).To(ContainSubstring(`<meta name="generator" content="libasciidoc">
+<link type="text/css" rel="stylesheet" href="path/to/style.css">
+<title>`))
+	})
+
+	It("should link and copy the default and syntax highlighting stylesheets in the output directory", func() {
+		outputDir, err := ioutil.TempDir("", "libasciidoc-stylesheets")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(outputDir)
+		source := `:linkcss:
+:copycss:
+:stylesdir: css
+:source-highlighter: pygments
+:pygments-style: monokai`
+		Expect(RenderHTML(source, configuration.WithHeaderFooter(true), configuration.WithOutputDir(outputDir))).To(ContainSubstring(`<meta name="generator" content="libasciidoc">
+<link type="text/css" rel="stylesheet" href="css/libasciidoc.css">
+<link type="text/css" rel="stylesheet" href="css/pygments-monokai.css">
+<title>`))
+		content, err := ioutil.ReadFile(filepath.Join(outputDir, "css", "libasciidoc.css"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal(html5.DefaultStylesheet))
+		content, err = ioutil.ReadFile(filepath.Join(outputDir, "css", "pygments-monokai.css"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(".tok-k {"))
+	})
+
+	It("should embed the syntax highlighting stylesheet", func() {
+		source := `:source-highlighter: pygments`
+		Expect(RenderHTML(source, configuration.WithHeaderFooter(true))).To(MatchRegexp(`(?s)</style>
+<style>
+.*\.tok-k \{.*</style>
+<title>`))
+	})
+})
@@ -0,0 +1,159 @@
+package html5
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/chroma"
+	"github.com/alecthomas/chroma/formatters/html"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/alecthomas/chroma/styles"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// syntaxHighlighter the document attributes which configure a syntax highlighter based on chroma
+type syntaxHighlighter struct {
+	style        string
+	css          string
+	linenumsMode string
+}
+
+// syntaxHighlighters the values of the `source-highlighter` attribute for which the source blocks are highlighted with chroma
+// (`pygments` is supported for compatibility with Asciidoctor)
+var syntaxHighlighters = map[string]syntaxHighlighter{
+	"chroma": {
+		style:        types.AttrChromaStyle,
+		css:          types.AttrChromaCSS,
+		linenumsMode: types.AttrChromaLineNumsMode,
+	},
+	"pygments": {
+		style:        types.AttrPygmentsStyle,
+		css:          types.AttrPygmentsCSS,
+		linenumsMode: types.AttrPygmentsLineNumsMode,
+	},
+}
+
+// highlightSourceLines highlights the given lines of a source block with chroma, using the given syntax highlighter attributes.
+// The callouts of the lines are appended after the highlighting, so that they are not escaped.
+// Also returns the line numbers to render in a separate column of the table layout, if applicable.
+func highlightSourceLines(ctx renderer.Context, b types.DelimitedBlock, h syntaxHighlighter, language string, lines []types.VerbatimLine) (string, string, error) {
+	source := &strings.Builder{}
+	for _, l := range lines {
+		if len(l.Callouts) > 0 {
+			source.WriteString(l.Content)
+		} else {
+			source.WriteString(strings.TrimRight(l.Content, " "))
+		}
+		// always end the lines with a newline, as some lexer rules expect it (eg: single-line comments)
+		source.WriteString("\n")
+	}
+	lexer := lexers.Get(language)
+	if lexer == nil {
+		log.Debugf("no lexer for language '%s', analysing the content instead", language)
+		lexer = lexers.Analyse(source.String())
+	}
+	if lexer == nil {
+		lexer = lexers.Fallback
+	}
+	lexer = chroma.Coalesce(lexer)
+	style := styles.Fallback
+	if s, found := ctx.Attributes.GetAsString(h.style); found {
+		style = styles.Get(s)
+	}
+	iterator, err := lexer.Tokenise(nil, source.String())
+	if err != nil {
+		return "", "", err
+	}
+	start := 1
+	if s, found := b.Attributes.GetAsString(types.AttrStart); found {
+		if start, err = strconv.Atoi(s); err != nil {
+			log.Warnf("invalid value for the 'start' attribute of the source block: '%s'", s)
+			start = 1
+		}
+	}
+	linenums := b.Attributes.Has(types.AttrLineNums)
+	inline := ctx.Attributes.GetAsStringWithDefault(h.linenumsMode, "table") == "inline"
+	options := []html.Option{
+		html.ClassPrefix("tok-"),
+		html.PreventSurroundingPre(true),
+		html.BaseLineNumber(start),
+		// inline CSS instead of classes
+		html.WithClasses(ctx.Attributes.GetAsStringWithDefault(h.css, "classes") != "style"),
+		// line numbers in the table are rendered separately
+		html.WithLineNumbers(linenums && inline),
+	}
+	if highlight, found := b.Attributes.GetAsString(types.AttrHighlight); found {
+		options = append(options, html.HighlightLines(parseHighlightedLines(highlight)))
+	}
+	result := &bytes.Buffer{}
+	if err := html.New(options...).Format(result, style, iterator); err != nil {
+		return "", "", err
+	}
+	content := appendCallouts(result.String(), lines)
+	if !linenums || inline {
+		return content, "", nil
+	}
+	numbers := make([]string, len(lines))
+	for i := range lines {
+		numbers[i] = strconv.Itoa(start + i)
+	}
+	return content, strings.Join(numbers, "\n"), nil
+}
+
+// appendCallouts appends the callouts of the given lines at the end of each line of the highlighted content.
+// Since chroma closes the spans of a token after its trailing newline, the closing tags at the beginning of a line
+// are moved at the end of the previous one, before appending the callouts.
+func appendCallouts(content string, lines []types.VerbatimLine) string {
+	highlightedLines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	for i := 1; i < len(highlightedLines); i++ {
+		for strings.HasPrefix(highlightedLines[i], "</span>") {
+			highlightedLines[i-1] += "</span>"
+			highlightedLines[i] = strings.TrimPrefix(highlightedLines[i], "</span>")
+		}
+	}
+	if last := len(highlightedLines) - 1; last > 0 && highlightedLines[last] == "" {
+		highlightedLines = highlightedLines[:last]
+	}
+	for i, l := range lines {
+		if i >= len(highlightedLines) {
+			break
+		}
+		for _, c := range l.Callouts {
+			highlightedLines[i] += fmt.Sprintf(`<b class="conum">(%d)</b>`, c.Ref)
+		}
+	}
+	return strings.Join(highlightedLines, "\n")
+}
+
+// parseHighlightedLines parses the value of the `highlight` attribute of a source block,
+// i.e., a list of line numbers or ranges separated by commas or semicolons (e.g. `2,4..6` or `2;4-6`)
+func parseHighlightedLines(value string) [][2]int {
+	result := [][2]int{}
+	for _, r := range strings.FieldsFunc(value, func(c rune) bool {
+		return c == ',' || c == ';'
+	}) {
+		r = strings.TrimSpace(r)
+		bounds := strings.SplitN(r, "..", 2)
+		if len(bounds) == 1 {
+			bounds = strings.SplitN(r, "-", 2)
+		}
+		from, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			log.Warnf("invalid line number in the 'highlight' attribute of the source block: '%s'", r)
+			continue
+		}
+		to := from
+		if len(bounds) == 2 {
+			if to, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+				log.Warnf("invalid line range in the 'highlight' attribute of the source block: '%s'", r)
+				continue
+			}
+		}
+		result = append(result, [2]int{from, to})
+	}
+	return result
+}
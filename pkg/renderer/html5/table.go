@@ -19,7 +19,7 @@ func init() {
 	tableTmpl = newTextTemplate("table", `{{ $ctx := .Context }}{{ with .Data }}<table class="tableblock frame-all grid-all stretch">{{ if .Lines }}
 {{ if .Title }}<caption class="title">{{ escape .Title }}</caption>
 {{ end }}<colgroup>
-{{ $cellWidths := .CellWidths }}{{ range $index, $width := $cellWidths }}<col style="width: {{ $width }}%;">{{ includeNewline $ctx $index $cellWidths }}{{ end }}
+{{ $cellWidths := .CellWidths }}{{ range $index, $width := $cellWidths }}<col style="width: {{ $width }}%;"{{ voidTagEnd $ctx }}{{ includeNewline $ctx $index $cellWidths }}{{ end }}
 </colgroup>
 {{ if .Header }}{{ if .Header.Cells }}<thead>
 <tr>
@@ -35,6 +35,7 @@ func init() {
 		texttemplate.FuncMap{
 			"renderElement":  renderInlineElements,
 			"includeNewline": includeNewline,
+			"voidTagEnd":     voidTagEnd,
 			"escape":         EscapeString,
 		})
 }
@@ -61,7 +62,13 @@ func renderTable(ctx renderer.Context, t types.Table) ([]byte, error) {
 	}
 	var title string
 	if titleAttr, ok := t.Attributes[types.AttrTitle].(string); ok {
-		title = fmt.Sprintf("Table %d. %s", ctx.GetAndIncrementTableCounter(), EscapeString(titleAttr))
+		title = EscapeString(titleAttr)
+		if caption := ctx.Attributes.GetLabel(types.AttrTableCaption); caption != "" {
+			title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementTableCounter(), title)
+		}
+	}
+	if tmpl, found := ctx.Templates.Lookup("table"); found {
+		return renderTableWithTemplate(ctx, tmpl, t, title, widths)
 	}
 	err := tableTmpl.Execute(result, ContextualPipeline{
 		Context: ctx,
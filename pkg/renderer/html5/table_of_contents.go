@@ -16,10 +16,13 @@ var tocRootTmpl texttemplate.Template
 var tocSectionTmpl texttemplate.Template
 
 func init() {
-	tocRootTmpl = newTextTemplate("toc", `<div id="toc" class="toc">
-<div id="toctitle">Table of Contents</div>
-{{ . }}
-</div>`)
+	tocRootTmpl = newTextTemplate("toc", `<div id="toc" class="{{ .Class }}">
+<div id="toctitle">{{ escape .Title }}</div>
+{{ .Sections }}
+</div>`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
 	tocSectionTmpl = newTextTemplate("toc section", `{{ $ctx := .Context }}{{ with .Data }}<ul class="sectlevel{{ .Level }}">
 {{ range .Sections }}<li><a href="#{{ .ID }}">{{ .Title }}</a>{{ if .Children }}
 {{ renderChildren $ctx .Children }}
@@ -51,6 +54,38 @@ type TableOfContentsSection struct {
 }
 
 func renderTableOfContents(ctx renderer.Context, toc types.TableOfContents) ([]byte, error) {
+	if _, ok := tableOfContentsSidePlacement(ctx); ok {
+		// the table of contents is rendered in the header of the document instead
+		return []byte{}, nil
+	}
+	return doRenderTableOfContents(ctx, toc, ctx.Attributes.GetAsStringWithDefault(types.AttrTableOfContentsClass, "toc"))
+}
+
+// renderHeaderTableOfContents renders the table of contents in the header of the document,
+// if its placement is `left` or `right`
+func renderHeaderTableOfContents(ctx renderer.Context, toc types.TableOfContents) ([]byte, error) {
+	if _, ok := tableOfContentsSidePlacement(ctx); !ok {
+		return []byte{}, nil
+	}
+	return doRenderTableOfContents(ctx, toc, ctx.Attributes.GetAsStringWithDefault(types.AttrTableOfContentsClass, "toc2"))
+}
+
+// tableOfContentsSidePlacement returns the placement of the table of contents (`left` or `right`)
+// and `true` if it is rendered in the header of a standalone document, `false` otherwise
+func tableOfContentsSidePlacement(ctx renderer.Context) (string, bool) {
+	if !ctx.Config.IncludeHeaderFooter || !ctx.HasHeader || ctx.Attributes.Has(types.AttrNoHeader) ||
+		ctx.Attributes.GetAsStringWithDefault(types.AttrDocType, "article") == "manpage" {
+		return "", false
+	}
+	switch placement, _ := ctx.Attributes.GetAsString(types.AttrTableOfContents); placement {
+	case "left", "right":
+		return placement, true
+	default:
+		return "", false
+	}
+}
+
+func doRenderTableOfContents(ctx renderer.Context, toc types.TableOfContents, class string) ([]byte, error) {
 	log.Debug("rendering table of contents...")
 	renderedSections, err := renderTableOfContentsSections(ctx, toc.Sections)
 	if err != nil {
@@ -61,7 +96,15 @@ func renderTableOfContents(ctx renderer.Context, toc types.TableOfContents) ([]b
 		return []byte{}, nil
 	}
 	result := bytes.NewBuffer(nil)
-	err = tocRootTmpl.Execute(result, renderedSections)
+	err = tocRootTmpl.Execute(result, struct {
+		Class    string
+		Title    string
+		Sections template.HTML
+	}{
+		Class:    class,
+		Title:    ctx.Attributes.GetLabel(types.AttrTableOfContentsTitle),
+		Sections: renderedSections,
+	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering table of contents")
 	}
@@ -130,6 +173,9 @@ func visitSection(ctx renderer.Context, section types.Section, currentLevel int)
 	if section.Level == 0 {
 		return children, nil // for the root section, immediately return its children)
 	}
+	if section.Attributes.Has(types.AttrDiscrete) {
+		return children, nil // discrete sections are excluded from the ToC, but not their children
+	}
 
 	renderedTitle, err := renderPlainText(ctx, section.Title)
 	if err != nil {
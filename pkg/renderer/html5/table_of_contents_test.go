@@ -1,6 +1,7 @@
 package html5_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -174,6 +175,130 @@ A preamble...
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
 
+		It("toc with custom title and class, without discrete sections", func() {
+			source := `= A title
+:toc:
+:toc-title: <Contents>
+:toc-class: custom-toc
+
+== Section A
+
+[discrete]
+== Section B
+
+=== Section B.a`
+
+			expected := `<div id="toc" class="custom-toc">
+<div id="toctitle">&lt;Contents&gt;</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+<li><a href="#_section_b_a">Section B.a</a></li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_b">Section B</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_section_b_a">Section B.a</h3>
+</div>
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("toc with macro placement", func() {
+			source := `= A title
+:toc: macro
+
+A preamble
+
+toc::[]
+
+== Section A`
+
+			expected := `<div id="preamble">
+<div class="sectionbody">
+<div class="paragraph">
+<p>A preamble</p>
+</div>
+<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("toc macro without macro placement", func() {
+			source := `= A title
+
+toc::[]
+
+== Section A`
+
+			expected := `<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("toc with left placement in standalone document", func() {
+			source := `= A title
+:toc: left
+
+== Section A`
+
+			output, err := RenderHTML(source, configuration.WithHeaderFooter(true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(ContainSubstring(`<body class="article toc2 toc-left">
+<div id="header">
+<h1>A title</h1>
+<div id="toc" class="toc2">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+</div>
+<div id="content">
+<div class="sect1">`))
+		})
+
+		It("toc with right placement in embedded document", func() {
+			source := `= A title
+:toc: right
+
+== Section A`
+
+			expected := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
 		It("document with no section", func() {
 			source := `= sect0
 :toc:
@@ -36,6 +36,9 @@ func renderUnorderedList(ctx renderer.Context, l types.UnorderedList) ([]byte, e
 			checkList = true
 		}
 	}
+	if t, found := ctx.Templates.Lookup("ulist"); found {
+		return renderUnorderedListWithTemplate(ctx, t, l)
+	}
 	result := bytes.NewBuffer(nil)
 	// here we must preserve the HTML tags
 	err := unorderedListTmpl.Execute(result, ContextualPipeline{
@@ -17,7 +17,7 @@ func renderUserMacro(ctx renderer.Context, um types.UserMacro) ([]byte, error) {
 				[]interface{}{
 					types.StringElement{Content: um.RawText},
 				},
-			}, nil)
+			}, nil, um.Position)
 			return renderParagraph(ctx, p)
 		}
 		// fallback to render raw text
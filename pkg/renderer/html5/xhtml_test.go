@@ -0,0 +1,122 @@
+package html5_test
+
+import (
+	"testing/fstest"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("xhtml5", func() {
+
+	It("block image", func() {
+		source := "image::foo.png[]"
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+</div>`
+		Expect(Render(source, configuration.WithBackend("xhtml5"))).To(MatchHTML(expected))
+	})
+
+	It("paragraph with hard breaks", func() {
+		source := `[%hardbreaks]
+a line
+another line`
+		expected := `<div class="paragraph">
+<p>a line<br/>
+another line</p>
+</div>`
+		Expect(Render(source, configuration.WithBackend("xhtml5"))).To(MatchHTML(expected))
+	})
+
+	It("table with columns", func() {
+		source := `|===
+| a | b
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;"/>
+<col style="width: 50%;"/>
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">a</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">b</p></td>
+</tr>
+</tbody>
+</table>`
+		Expect(Render(source, configuration.WithBackend("xhtml5"))).To(MatchHTML(expected))
+	})
+
+	It("character references", func() {
+		source := `AT&T &#169; &amp;`
+		expected := `<div class="paragraph">
+<p>AT&amp;T &#169; &amp;</p>
+</div>`
+		Expect(Render(source, configuration.WithBackend("xhtml5"))).To(MatchHTML(expected))
+	})
+
+	It("passthrough content as-is", func() {
+		source := `+++<br> &copy;+++ and pass:[<img src="foo.png">]`
+		expected := `<div class="paragraph">
+<p><br> &copy; and <img src="foo.png"></p>
+</div>`
+		Expect(Render(source, configuration.WithBackend("xhtml5"))).To(MatchHTML(expected))
+	})
+
+	It("docinfo content as-is", func() {
+		source := `= Title
+:docinfo: shared
+
+content`
+		fsys := fstest.MapFS{
+			"docinfo.html": &fstest.MapFile{
+				Data: []byte(`<meta name="description" content="&copy;">`),
+			},
+			"docinfo-footer.html": &fstest.MapFile{
+				Data: []byte(`<br>`),
+			},
+		}
+		result, err := Render(source, configuration.WithBackend("xhtml5"), configuration.WithHeaderFooter(true), configuration.WithFilename("doc.adoc"), configuration.WithFilesystem(fsys))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ContainSubstring(`<meta name="viewport" content="width=device-width, initial-scale=1.0"/>` + "\n"))
+		Expect(result).To(ContainSubstring(`<meta name="description" content="&copy;">` + "\n"))
+		Expect(result).To(ContainSubstring("\n<br>\n</body>"))
+	})
+
+	It("user-supplied template with self-closed void elements", func() {
+		source := "image::foo.png[]"
+		expected := `<img src="foo.png" alt="foo"/>`
+		fsys := fstest.MapFS{
+			"image.tmpl": &fstest.MapFile{
+				Data: []byte(`<img src="{{ .Path }}" alt="{{ .Alt }}"{{ .VoidTagEnd }}`),
+			},
+		}
+		Expect(Render(source, configuration.WithBackend("xhtml5"), configuration.WithTemplates(fsys))).To(Equal(expected))
+	})
+
+	It("document with namespace", func() {
+		source := `= Title`
+		result, err := Render(source, configuration.WithBackend("xhtml5"), configuration.WithHeaderFooter(true))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ContainSubstring(`<html xmlns="http://www.w3.org/1999/xhtml" lang="en">`))
+		Expect(result).To(ContainSubstring(`<meta charset="UTF-8"/>`))
+		Expect(result).NotTo(ContainSubstring(`<meta charset="UTF-8">`))
+	})
+
+	It("html5 backend with the htmlsyntax attribute", func() {
+		source := `:htmlsyntax: xml
+
+image::foo.png[]`
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+</div>`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+})
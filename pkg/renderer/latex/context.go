@@ -0,0 +1,21 @@
+package latex
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// context the rendering context of a LaTeX document.
+// Besides the common rendering context, it knows if the document is a book, in which the sections of level 0 and 1
+// are rendered as parts and chapters.
+type context struct {
+	renderer.Context
+	book bool
+}
+
+func newContext(ctx renderer.Context) *context {
+	return &context{
+		Context: ctx,
+		book:    ctx.Attributes.GetAsStringWithDefault(types.AttrDocType, "article") == "book",
+	}
+}
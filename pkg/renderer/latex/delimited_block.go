@@ -0,0 +1,241 @@
+package latex
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderDelimitedBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	switch b.Kind {
+	case types.Fenced, types.Source:
+		return renderListing(ctx, b.Attributes, verbatimLines(b.Elements)), nil
+	case types.Listing, types.Literal:
+		return renderVerbatim(b.Attributes, verbatimLines(b.Elements)), nil
+	case types.Example:
+		return renderExampleBlock(ctx, b)
+	case types.Quote, types.MarkdownQuote:
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render quote block")
+		}
+		return renderBlockQuote("quote", b.Attributes, content), nil
+	case types.Verse:
+		return renderVerseBlock(ctx, b)
+	case types.Sidebar:
+		return renderSidebarBlock(ctx, b)
+	case types.Passthrough:
+		lines := verbatimLines(b.Elements)
+		result := make([]string, len(lines))
+		for i, l := range lines {
+			result[i] = l.Content
+		}
+		for name := range b.Attributes {
+			if isSTEM(name) {
+				// STEM blocks are rendered in math mode
+				return []byte(`\[` + "\n" + strings.Join(result, "\n") + "\n" + `\]`), nil
+			}
+		}
+		if hasRole(b.Attributes, "latex") {
+			// passthrough which explicitly targets LaTeX
+			return []byte(strings.Join(result, "\n")), nil
+		}
+		// other passthroughs usually contain HTML, which is not valid in LaTeX
+		return []byte{}, nil
+	case types.Comment:
+		return []byte{}, nil
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+// listingLanguages the languages supported by the `listings` package, indexed by their (lowercase) name in Asciidoc
+var listingLanguages = map[string]string{
+	"awk":        "Awk",
+	"bash":       "bash",
+	"c":          "C",
+	"c++":        "C++",
+	"commonlisp": "Lisp",
+	"cpp":        "C++",
+	"delphi":     "Delphi",
+	"erlang":     "erlang",
+	"fortran":    "Fortran",
+	"haskell":    "Haskell",
+	"html":       "HTML",
+	"java":       "Java",
+	"latex":      "TeX",
+	"lisp":       "Lisp",
+	"lua":        "Lua",
+	"make":       "make",
+	"matlab":     "Matlab",
+	"ocaml":      "ML",
+	"pascal":     "Pascal",
+	"perl":       "Perl",
+	"php":        "PHP",
+	"prolog":     "Prolog",
+	"python":     "Python",
+	"r":          "R",
+	"ruby":       "Ruby",
+	"scala":      "Scala",
+	"sh":         "sh",
+	"shell":      "sh",
+	"sql":        "SQL",
+	"tex":        "TeX",
+	"xml":        "XML",
+}
+
+// renderListing renders the given lines in a `lstlisting` environment, with the language and the line numbering
+// set in the given attributes. The languages which are not supported by the `listings` package are ignored, since
+// they would break the compilation of the document.
+func renderListing(ctx *context, attrs types.Attributes, lines []types.VerbatimLine) []byte {
+	options := []string{}
+	if language, found := attrs.GetAsString(types.AttrLanguage); found && language != "" {
+		if l, supported := listingLanguages[strings.ToLower(language)]; supported {
+			options = append(options, "language="+l)
+		} else {
+			log.Debugf("language not supported by the listings package: '%s'", language)
+		}
+	}
+	if attrs.Has(types.AttrLineNums) {
+		options = append(options, "numbers=left")
+		if start, found := attrs.GetAsString(types.AttrStart); found {
+			options = append(options, "firstnumber="+start)
+		}
+	}
+	if title, found := attrs.GetAsString(types.AttrTitle); found && title != "" {
+		options = append(options, "caption={"+escape(title)+"}")
+	}
+	if id, found := attrs.GetAsString(types.AttrID); found && id != "" {
+		options = append(options, "label="+id)
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{lstlisting}`)
+	if len(options) > 0 {
+		result.WriteString("[" + strings.Join(options, ", ") + "]")
+	}
+	result.WriteString("\n")
+	writeVerbatimLines(result, lines)
+	result.WriteString(`\end{lstlisting}`)
+	return result.Bytes()
+}
+
+// renderVerbatim renders the given lines in a `verbatim` environment
+func renderVerbatim(attrs types.Attributes, lines []types.VerbatimLine) []byte {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{verbatim}` + "\n")
+	writeVerbatimLines(result, lines)
+	result.WriteString(`\end{verbatim}`)
+	return withTitle(attrs, result.Bytes())
+}
+
+// writeVerbatimLines writes the given lines "as-is" (i.e., without escaping), followed by their callouts (eg: ` (1)`)
+func writeVerbatimLines(result *bytes.Buffer, lines []types.VerbatimLine) {
+	for _, l := range lines {
+		result.WriteString(strings.TrimRight(l.Content, " "))
+		for _, c := range l.Callouts {
+			result.WriteString(fmt.Sprintf(" (%d)", c.Ref))
+		}
+		result.WriteString("\n")
+	}
+}
+
+func renderExampleBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	content, err := renderer.RenderElements(ctx, b.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render example block")
+	}
+	if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		return renderAdmonition(ctx, k, b.Attributes, content), nil
+	}
+	attrs := b.Attributes
+	if title, found := b.Attributes.GetAsString(types.AttrTitle); found && title != "" {
+		if caption := ctx.Attributes.GetLabel(types.AttrExampleCaption); caption != "" {
+			attrs = types.Attributes{}.Add(b.Attributes)
+			attrs[types.AttrTitle] = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementExampleBlockCounter(), title)
+		}
+	}
+	return withTitle(attrs, content), nil
+}
+
+func renderVerseBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	paragraphs := []string{}
+	for _, e := range b.Elements {
+		if p, ok := e.(types.Paragraph); ok {
+			content, err := renderParagraphContent(ctx, p, true)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render verse block")
+			}
+			paragraphs = append(paragraphs, string(content))
+		}
+	}
+	return renderBlockQuote("verse", b.Attributes, []byte(strings.Join(paragraphs, "\n\n"))), nil
+}
+
+// renderSidebarBlock renders the content of the sidebar in a framed box, with its optional title
+func renderSidebarBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	content, err := renderer.RenderElements(ctx, b.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render sidebar block")
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\noindent\fbox{\begin{minipage}{\dimexpr\linewidth-2\fboxsep-2\fboxrule}` + renderLabel(b.Attributes) + "\n")
+	if title, found := b.Attributes.GetAsString(types.AttrTitle); found && title != "" {
+		result.WriteString(`\textbf{` + escape(title) + "}\n\n")
+	}
+	result.Write(content)
+	result.WriteString("\n" + `\end{minipage}}`)
+	return result.Bytes(), nil
+}
+
+func renderLiteralBlock(b types.LiteralBlock) []byte {
+	lines := make([]types.VerbatimLine, len(b.Lines))
+	for i, l := range b.Lines {
+		lines[i] = types.VerbatimLine{
+			Content: l,
+		}
+	}
+	if t, found := b.Attributes.GetAsString(types.AttrLiteralBlockType); found && t == types.LiteralBlockWithSpacesOnFirstLine {
+		trimIndentation(lines)
+	}
+	return renderVerbatim(b.Attributes, lines)
+}
+
+// trimIndentation removes the common leading spaces of the given lines
+func trimIndentation(lines []types.VerbatimLine) {
+	indent := -1
+	for _, l := range lines {
+		if n := len(l.Content) - len(strings.TrimLeft(l.Content, " ")); indent == -1 || n < indent {
+			indent = n
+		}
+	}
+	for i := range lines {
+		lines[i].Content = lines[i].Content[indent:]
+	}
+}
+
+// verbatimLines returns the verbatim lines of a listing (blank lines are returned as empty verbatim lines),
+// without the trailing blank lines
+func verbatimLines(elements []interface{}) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, 0, len(elements))
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.VerbatimLine:
+			result = append(result, e)
+		case types.BlankLine:
+			result = append(result, types.VerbatimLine{})
+		default:
+			log.Warnf("unexpected element of type '%T' in listing", e)
+		}
+	}
+	for len(result) > 0 && result[len(result)-1].IsEmpty() {
+		result = result[:len(result)-1]
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+package latex
+
+import (
+	"html"
+	"strings"
+)
+
+// escape escapes the special characters of LaTeX in the given string.
+// The character references (eg: `&#8217;` for the `rsquo` attribute) are replaced with their actual character beforehand.
+func escape(s string) string {
+	return latexEscaper.Replace(html.UnescapeString(s))
+}
+
+var latexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`$`, `\$`,
+	`&`, `\&`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+	"\u00a0", `~`, // non-breaking space
+)
+
+// escapeURL escapes the characters of the given URL which cannot appear as-is in the argument of `\href` or `\url`
+func escapeURL(s string) string {
+	return urlEscaper.Replace(s)
+}
+
+var urlEscaper = strings.NewReplacer(
+	`#`, `\#`,
+	`%`, `\%`,
+	`{`, `\{`,
+	`}`, `\}`,
+)
+
+// replacements the textual replacements applied on the escaped strings (copyright, trademark, etc.)
+var replacements = strings.NewReplacer(
+	"...", `\ldots{}`,
+	"(C)", `\textcopyright{}`,
+	"(TM)", `\texttrademark{}`,
+	"(R)", `\textregistered{}`,
+)
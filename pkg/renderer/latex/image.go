@@ -0,0 +1,56 @@
+package latex
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// renderImageBlock renders the image in a `figure` environment, with its optional caption and label
+func renderImageBlock(ctx *context, img types.ImageBlock) []byte {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{figure}[htbp]` + "\n" + `\centering` + "\n")
+	result.WriteString(`\includegraphics` + imageOptions(img.Attributes) + `{` + img.Location.String() + `}` + "\n")
+	if title, found := img.Attributes.GetAsString(types.AttrTitle); found && title != "" {
+		// LaTeX numbers the figures, so the counter of the context is only incremented to stay consistent
+		// with the other backends
+		ctx.GetAndIncrementImageCounter()
+		result.WriteString(`\caption{` + escape(title) + `}` + "\n")
+	}
+	if label := renderLabel(img.Attributes); label != "" {
+		result.WriteString(label + "\n")
+	}
+	result.WriteString(`\end{figure}`)
+	return result.Bytes()
+}
+
+// imageOptions returns the optional width and height of the given image (eg: `[width=150bp]`).
+// The dimensions without unit are in pixels.
+func imageOptions(attrs types.Attributes) string {
+	options := []string{}
+	if width, found := attrs.GetAsString(types.AttrImageWidth); found && width != "" {
+		options = append(options, "width="+dimension(width, `\linewidth`))
+	}
+	if height, found := attrs.GetAsString(types.AttrImageHeight); found && height != "" {
+		options = append(options, "height="+dimension(height, `\textheight`))
+	}
+	if len(options) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(options, ", ") + "]"
+}
+
+// dimension returns the given dimension in a unit supported by TeX: the pixels (with or without the `px` unit)
+// are converted into big points (with 96 pixels per inch, as in CSS), the percentages into a fraction of the given
+// length (eg: `0.5\linewidth`), and the other dimensions (eg: `5cm`) are returned as-is
+func dimension(d, length string) string {
+	if v, err := strconv.ParseFloat(strings.TrimSuffix(d, "%"), 64); err == nil && strings.HasSuffix(d, "%") {
+		return strconv.FormatFloat(v/100, 'f', -1, 64) + length
+	}
+	if v, err := strconv.ParseFloat(strings.TrimSuffix(d, "px"), 64); err == nil {
+		return strconv.FormatFloat(v*0.75, 'f', -1, 64) + "bp"
+	}
+	return d
+}
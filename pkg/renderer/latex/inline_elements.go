@@ -0,0 +1,179 @@
+package latex
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderStringElement(s types.StringElement) []byte {
+	return []byte(replacements.Replace(escape(s.Content)))
+}
+
+func renderQuotedText(ctx *context, t types.QuotedText) ([]byte, error) {
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	var cmd string
+	switch t.Kind {
+	case types.Bold:
+		cmd = `\textbf`
+	case types.Italic:
+		cmd = `\emph`
+	case types.Monospace:
+		cmd = `\texttt`
+	case types.Subscript:
+		cmd = `\textsubscript`
+	case types.Superscript:
+		cmd = `\textsuperscript`
+	default:
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+	return []byte(cmd + "{" + string(content) + "}"), nil
+}
+
+// renderInlinePassthrough renders the content of the `+text+` passthrough, whose content is escaped.
+// The content of the other passthroughs (`+++text+++` and `pass:[text]`) is dropped, since it usually contains HTML,
+// which is not valid in LaTeX
+func renderInlinePassthrough(ctx *context, p types.InlinePassthrough) ([]byte, error) {
+	if p.Kind != types.SinglePlusPassthrough {
+		return []byte{}, nil
+	}
+	result := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch e := element.(type) {
+		case types.StringElement:
+			result.WriteString(escape(e.Content))
+		default:
+			renderedElement, err := renderInlineElement(ctx, e)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			result.Write(renderedElement)
+		}
+	}
+	return result.Bytes(), nil
+}
+
+// renderInternalCrossReference renders a reference to the number of the target (eg: the number of a section),
+// or a link to the target with the label of the cross reference
+func renderInternalCrossReference(ctx *context, xref types.InternalCrossReference) ([]byte, error) {
+	log.Debugf("rendering cross reference with ID: %s", xref.ID)
+	if xref.Label != "" {
+		return []byte(fmt.Sprintf(`\hyperref[%s]{%s}`, xref.ID, escape(xref.Label))), nil
+	}
+	return []byte(fmt.Sprintf(`\ref{%s}`, xref.ID)), nil
+}
+
+func renderExternalCrossReference(ctx *context, xref types.ExternalCrossReference) ([]byte, error) {
+	label, err := renderInlineElements(ctx, xref.Label)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render external cross reference")
+	}
+	// the target document is expected to be converted with the same backend
+	loc := xref.Location.String()
+	href := strings.TrimSuffix(loc, filepath.Ext(loc)) + ctx.Attributes.GetAsStringWithDefault(types.AttrOutFileSuffix, ".tex")
+	if len(label) == 0 {
+		label = []byte(escape(href))
+	}
+	return []byte(fmt.Sprintf(`\href{%s}{%s}`, escapeURL(href), label)), nil
+}
+
+// renderLink renders a link with its text, or the URL of the link if it has no text
+func renderLink(ctx *context, l types.InlineLink) ([]byte, error) {
+	href := l.Location.String()
+	t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{})
+	if !ok || len(t) == 0 {
+		if strings.HasPrefix(href, "mailto:") {
+			return []byte(fmt.Sprintf(`\href{%s}{%s}`, escapeURL(href), escape(strings.TrimPrefix(href, "mailto:")))), nil
+		}
+		return []byte(fmt.Sprintf(`\url{%s}`, escapeURL(href))), nil
+	}
+	text, err := renderInlineElements(ctx, t)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render link")
+	}
+	return []byte(fmt.Sprintf(`\href{%s}{%s}`, escapeURL(href), text)), nil
+}
+
+// renderFootnoteReference renders the footnote with its content in the text, or a mark referring to a footnote
+// which was already rendered (with the same reference)
+func renderFootnoteReference(ctx *context, note types.FootnoteReference) ([]byte, error) {
+	if note.ID == types.InvalidFootnoteReference {
+		log.Warnf("invalid footnote reference: '%s'", note.Ref)
+		return []byte("[" + escape(note.Ref) + "]"), nil
+	}
+	if note.Duplicate {
+		return []byte(fmt.Sprintf(`\footnotemark[%d]`, note.ID)), nil
+	}
+	for _, f := range ctx.Footnotes {
+		if f.ID != note.ID {
+			continue
+		}
+		content, err := renderInlineElements(ctx, f.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnote")
+		}
+		return []byte(fmt.Sprintf(`\footnote[%d]{%s}`, note.ID, strings.TrimSpace(string(content)))), nil
+	}
+	return nil, errors.Errorf("unable to render footnote: no footnote with ID %d", note.ID)
+}
+
+func renderIndexTerm(ctx *context, t types.IndexTerm) ([]byte, error) {
+	term, err := renderInlineElements(ctx, t.Term)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render index term")
+	}
+	return []byte(fmt.Sprintf(`%[1]s\index{%[1]s}`, term)), nil
+}
+
+// renderConcealedIndexTerm renders the terms of the index entry, separated by `!` (eg: `\index{cat!big}`)
+func renderConcealedIndexTerm(t types.ConcealedIndexTerm) []byte {
+	terms := []string{}
+	for _, term := range []interface{}{t.Term1, t.Term2, t.Term3} {
+		if term, ok := term.(string); ok {
+			terms = append(terms, escape(strings.TrimSpace(term)))
+		}
+	}
+	return []byte(`\index{` + strings.Join(terms, "!") + `}`)
+}
+
+// renderInlineMacro renders the content of the `stem`, `latexmath` and `asciimath` macros in math mode
+// (eg: `\(x^2\)`), and the other macros as-is
+func renderInlineMacro(m types.UserMacro) []byte {
+	if isSTEM(m.Name) {
+		return []byte(`\(` + macroContent(m) + `\)`)
+	}
+	return []byte(escape(m.RawText))
+}
+
+// isSTEM returns true if the given name is the name of a STEM (Science, Technology, Engineering and Math) macro or block
+func isSTEM(name string) bool {
+	switch name {
+	case "stem", "latexmath", "asciimath":
+		return true
+	default:
+		return false
+	}
+}
+
+// macroContent returns the content of the given macro, i.e., its text between the brackets
+func macroContent(m types.UserMacro) string {
+	start := strings.Index(m.RawText, "[")
+	end := strings.LastIndex(m.RawText, "]")
+	if start < 0 || end <= start {
+		return ""
+	}
+	return strings.TrimSpace(m.RawText[start+1 : end])
+}
+
+func renderInlineImage(img types.InlineImage) []byte {
+	return []byte(`\includegraphics` + imageOptions(img.Attributes) + `{` + img.Location.String() + `}`)
+}
@@ -0,0 +1,154 @@
+package latex
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var documentTmpl texttemplate.Template
+
+func init() {
+	documentTmpl = newTextTemplate("document", `\documentclass{{ "{" }}{{ .Class }}{{ "}" }}
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{amsmath}
+\usepackage{amssymb}
+\usepackage{array}
+\usepackage{enumitem}
+\usepackage{graphicx}
+\usepackage{listings}
+\usepackage{hyperref}{{ if .Title }}
+
+\title{{ "{" }}{{ .Title }}{{ "}" }}{{ if .Authors }}
+\author{{ "{" }}{{ .Authors }}{{ "}" }}{{ end }}
+\date{{ "{" }}{{ .Date }}{{ "}" }}{{ end }}
+
+\begin{document}{{ if or .Title .TableOfContents }}
+{{ end }}{{ if .Title }}
+\maketitle{{ end }}{{ if .TableOfContents }}
+\tableofcontents{{ end }}{{ if .Content }}
+
+{{ .Content }}{{ end }}
+
+\end{document}
+`)
+}
+
+// registers the `latex` backend
+func init() {
+	renderer.Register("latex", renderer.RenderFunc(Render), map[string]string{
+		types.AttrBaseBackend:   "latex",
+		types.AttrOutFileSuffix: ".tex",
+		types.AttrFileType:      "tex",
+	})
+}
+
+// Render renders the given document in LaTeX and writes the result in the given `writer`
+func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	c := newContext(ctx)
+	header, hasHeader := doc.Header()
+	title := []byte{}
+	elements := doc.Elements
+	if hasHeader {
+		var err error
+		if title, err = renderInlineElements(c, header.Title); err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+		}
+		// the title of the document is rendered with `\maketitle`, and the other sections (if any) are "parts" of a book
+		elements = append(append([]interface{}{}, header.Elements...), doc.Elements[1:]...)
+	}
+	renderedContent, err := renderer.RenderElements(c, elements)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	authors, _ := doc.Authors()
+	revision, _ := doc.Revision()
+	if ctx.Config.IncludeHeaderFooter {
+		log.Debugf("Rendering full document...")
+		err = documentTmpl.Execute(output, struct {
+			Class           string
+			Title           string
+			Authors         string
+			Date            string
+			TableOfContents bool
+			Content         string
+		}{
+			Class:           documentClass(c),
+			Title:           string(title),
+			Authors:         renderAuthors(authors),
+			Date:            escape(revision.Revdate),
+			TableOfContents: doc.Attributes.Has(types.AttrTableOfContents),
+			Content:         string(renderedContent),
+		})
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+		}
+	} else if _, err = output.Write(renderedContent); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	return types.Metadata{
+		Title:    string(plainText(header.Title)),
+		Authors:  authors,
+		Revision: revision,
+		Warnings: doc.Warnings,
+	}, nil
+}
+
+// documentClass returns the class of the document, based on its `doctype`
+func documentClass(ctx *context) string {
+	if ctx.book {
+		return "book"
+	}
+	return "article"
+}
+
+// renderAuthors returns the names of the authors, separated by `\and`
+func renderAuthors(authors []types.DocumentAuthor) string {
+	names := make([]string, 0, len(authors))
+	for _, a := range authors {
+		if name := strings.TrimSpace(a.FullName); name != "" {
+			names = append(names, escape(name))
+		}
+	}
+	return strings.Join(names, ` \and `)
+}
+
+func newTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttemplate.Template {
+	t := texttemplate.New(name)
+	for _, f := range funcs {
+		t.Funcs(f)
+	}
+	return *texttemplate.Must(t.Parse(src))
+}
+
+// plainText returns the text of the given inline elements, without any markup
+func plainText(elements []interface{}) []byte {
+	result := bytes.NewBuffer(nil)
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.QuotedText:
+			result.Write(plainText(e.Elements))
+		case types.InlinePassthrough:
+			result.Write(plainText(e.Elements))
+		case types.IndexTerm:
+			result.Write(plainText(e.Term))
+		case types.InlineLink:
+			if text, ok := e.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+				result.Write(plainText(text))
+			} else {
+				result.WriteString(e.Location.String())
+			}
+		}
+	}
+	return result.Bytes()
+}
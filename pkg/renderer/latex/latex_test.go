@@ -0,0 +1,333 @@
+package latex_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("latex documents", func() {
+
+	It("article with header, authors and table of contents", func() {
+		source := `= The Title
+John Doe <john@example.com>; Jane Roe
+v1.0, 2020-01-01
+:toc:
+
+== Section A
+
+some content`
+		expected := `\documentclass{article}
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{amsmath}
+\usepackage{amssymb}
+\usepackage{array}
+\usepackage{enumitem}
+\usepackage{graphicx}
+\usepackage{listings}
+\usepackage{hyperref}
+
+\title{The Title}
+\author{John Doe \and Jane Roe}
+\date{2020-01-01}
+
+\begin{document}
+
+\maketitle
+\tableofcontents
+
+\section{Section A}\label{_section_a}
+
+some content
+
+\end{document}
+`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("article without header", func() {
+		source := `some content`
+		expected := `\documentclass{article}
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{amsmath}
+\usepackage{amssymb}
+\usepackage{array}
+\usepackage{enumitem}
+\usepackage{graphicx}
+\usepackage{listings}
+\usepackage{hyperref}
+
+\begin{document}
+
+some content
+
+\end{document}
+`
+		Expect(RenderLaTeX(source)).To(Equal(expected))
+	})
+
+	It("sections in an article", func() {
+		source := `== Section A
+
+=== Section B
+
+==== Section C
+
+[discrete]
+===== Discrete`
+		expected := `\section{Section A}\label{_section_a}
+
+\subsection{Section B}\label{_section_b}
+
+\subsubsection{Section C}\label{_section_c}
+
+\paragraph*{Discrete}\label{_discrete}`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("parts and chapters in a book", func() {
+		source := `= The Book
+:doctype: book
+
+= Part One
+
+== Chapter One
+
+=== Section A`
+		expected := `\part{Part One}\label{_part_one}
+
+\chapter{Chapter One}\label{_chapter_one}
+
+\section{Section A}\label{_section_a}`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+		Expect(RenderLaTeX(source)).To(HavePrefix(`\documentclass{book}`))
+	})
+
+	It("escaped text and quoted text", func() {
+		source := `50% of $10 & #1 in a_b {set} with ~ and ^ or \ (C) ...
+
+*bold*, _italic_, ` + "`mono`" + `, H~2~O and E=mc^2^`
+		expected := `50\% of \$10 \& \#1 in a\_b \{set\} with \textasciitilde{} and \textasciicircum{} or \textbackslash{} \textcopyright{} \ldots{}
+
+\textbf{bold}, \emph{italic}, \texttt{mono}, H\textsubscript{2}O and E=mc\textsuperscript{2}`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("lists", func() {
+		source := `.Steps
+. one
+. two
+
+[loweralpha, start=3]
+. c
+. d
+
+//
+
+* [x] done
+* [ ] todo
+
+//
+
+Term:: definition`
+		expected := `\noindent\textbf{Steps}
+
+\begin{enumerate}[label=\arabic*.]
+\item one
+\item two
+\end{enumerate}
+
+\begin{enumerate}[label=\alph*., start=3]
+\item c
+\item d
+\end{enumerate}
+
+\begin{itemize}
+\item[$\boxtimes$] done
+\item[$\square$] todo
+\end{itemize}
+
+\begin{description}
+\item[Term] definition
+\end{description}`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("table with column specifications", func() {
+		source := `[[tbl]]
+.The table
+[cols="2*<,^.^3a", options="header"]
+|===
+|a |b |c
+
+|d |e |f
+|===`
+		expected := `\begin{table}[htbp]
+\centering
+\caption{The table}
+\label{tbl}
+\begin{tabular}{|l|l|>{\centering\arraybackslash}p{0.9\linewidth}|}
+\hline
+\textbf{a} & \textbf{b} & \textbf{c} \\
+\hline
+d & e & f \\
+\hline
+\end{tabular}
+\end{table}`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("table with relative column widths", func() {
+		source := `[cols="1,>3"]
+|===
+|a |b
+|===`
+		expected := `\begin{table}[htbp]
+\centering
+\begin{tabular}{|p{0.23\linewidth}|>{\raggedleft\arraybackslash}p{0.68\linewidth}|}
+\hline
+a & b \\
+\hline
+\end{tabular}
+\end{table}`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("source and listing blocks", func() {
+		source := `[source,python,linenums]
+----
+print("{hi}") <1>
+----
+<1> prints
+
+[source,go]
+----
+fmt.Println("hi")
+----
+
+----
+$ ls
+----`
+		expected := `\begin{lstlisting}[language=Python, numbers=left]
+print("{hi}") (1)
+\end{lstlisting}
+
+\begin{description}
+\item[(1)] prints
+\end{description}
+
+\begin{lstlisting}
+fmt.Println("hi")
+\end{lstlisting}
+
+\begin{verbatim}
+$ ls
+\end{verbatim}`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("footnotes and cross references", func() {
+		source := `[[first]]
+== First
+
+A note.footnote:ref[The content.] Again.footnote:ref[]
+See <<first>>, <<first,the first section>> and https://example.com[the site].`
+		expected := `\section{First}\label{first}
+
+A note.\footnote[1]{The content.} Again.\footnotemark[1]
+See \ref{first}, \hyperref[first]{the first section} and \href{https://example.com}{the site}.`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("image block and inline image", func() {
+		source := `[#img]
+.An image
+image::foo.png[Foo, 200]
+
+Look at image:bar.png[Bar].`
+		expected := `\begin{figure}[htbp]
+\centering
+\includegraphics[width=150bp]{foo.png}
+\caption{An image}
+\label{img}
+\end{figure}
+
+Look at \includegraphics{bar.png}.`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("stem passthroughs in math mode", func() {
+		source := `:stem:
+
+Inline stem:[x^2] and latexmath:[\alpha].
+
+[stem]
+++++
+\sum_{i=0}^n i
+++++`
+		expected := `Inline \(x^2\) and \(\alpha\).
+
+\[
+\sum_{i=0}^n i
+\]`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("image dimensions", func() {
+		source := `image::foo.png[Foo, 200px, 50%]
+
+image::bar.png[Bar, 5cm]`
+		expected := `\begin{figure}[htbp]
+\centering
+\includegraphics[width=150bp, height=0.5\textheight]{foo.png}
+\end{figure}
+
+\begin{figure}[htbp]
+\centering
+\includegraphics[width=5cm]{bar.png}
+\end{figure}`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("passthroughs which do not target LaTeX", func() {
+		source := `Some +<b>+ text +++<i>dropped</i>+++ and pass:[<u>dropped</u>].
+
+++++
+<div>dropped</div>
+++++
+
+[.latex]
+++++
+\LaTeX
+++++`
+		expected := `Some \textless{}b\textgreater{} text  and .
+
+\LaTeX`
+		Expect(RenderLaTeXContent(source)).To(Equal(expected))
+	})
+
+	It("admonition with a custom caption and quote", func() {
+		source := `NOTE: a note
+
+[quote, Someone, Somewhere]
+____
+quoted
+____`
+		expected := `\begin{quote}
+\textbf{Remark:} a note
+\end{quote}
+
+\begin{quote}
+quoted
+
+\hfill--- Someone, \emph{Somewhere}
+\end{quote}`
+		Expect(RenderLaTeXContent(source, configuration.WithAttributes(map[string]string{
+			"note-caption": "Remark",
+		}))).To(Equal(expected))
+	})
+})
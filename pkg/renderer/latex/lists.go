@@ -0,0 +1,113 @@
+package latex
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderUnorderedList(ctx *context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{itemize}` + "\n")
+	for _, item := range l.Items {
+		label := ""
+		switch item.CheckStyle {
+		case types.Checked:
+			label = `[$\boxtimes$]`
+		case types.Unchecked:
+			label = `[$\square$]`
+		}
+		if err := renderListItem(ctx, result, label, item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render unordered list")
+		}
+	}
+	result.WriteString(`\end{itemize}`)
+	return withTitle(l.Attributes, result.Bytes()), nil
+}
+
+func renderOrderedList(ctx *context, l types.OrderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	numbering := types.Arabic
+	if s, found := l.Attributes.GetAsString(types.AttrNumberingStyle); found {
+		numbering = types.NumberingStyle(s)
+	} else if len(l.Items) > 0 {
+		numbering = l.Items[0].NumberingStyle
+	}
+	options := []string{`label=` + enumerationLabel(numbering)}
+	if s, found := l.Attributes.GetAsString(types.AttrStart); found {
+		options = append(options, "start="+escape(s))
+	}
+	result.WriteString(`\begin{enumerate}[` + strings.Join(options, ", ") + "]\n")
+	for _, item := range l.Items {
+		if err := renderListItem(ctx, result, "", item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render ordered list")
+		}
+	}
+	result.WriteString(`\end{enumerate}`)
+	return withTitle(l.Attributes, result.Bytes()), nil
+}
+
+// enumerationLabel returns the label of the items of an `enumerate` environment with the given numbering style
+// (the greek numberings are not supported, so they fall back to arabic)
+func enumerationLabel(s types.NumberingStyle) string {
+	switch s {
+	case types.LowerAlpha:
+		return `\alph*.`
+	case types.UpperAlpha:
+		return `\Alph*.`
+	case types.LowerRoman:
+		return `\roman*)`
+	case types.UpperRoman:
+		return `\Roman*)`
+	default:
+		return `\arabic*.`
+	}
+}
+
+func renderLabeledList(ctx *context, l types.LabeledList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{description}` + "\n")
+	for _, item := range l.Items {
+		term, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+		if err := renderListItem(ctx, result, "["+strings.TrimSpace(string(term))+"]", item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+	}
+	result.WriteString(`\end{description}`)
+	return withTitle(l.Attributes, result.Bytes()), nil
+}
+
+func renderCalloutList(ctx *context, l types.CalloutList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{description}` + "\n")
+	for _, item := range l.Items {
+		if err := renderListItem(ctx, result, fmt.Sprintf("[(%d)]", item.Ref), item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render callout list")
+		}
+	}
+	result.WriteString(`\end{description}`)
+	return withTitle(l.Attributes, result.Bytes()), nil
+}
+
+// renderListItem renders an `\item` with the given (optional) label and elements
+func renderListItem(ctx *context, result *bytes.Buffer, label string, elements []interface{}) error {
+	content, err := renderer.RenderElements(ctx, elements)
+	if err != nil {
+		return err
+	}
+	result.WriteString(`\item` + label)
+	if len(content) > 0 {
+		result.WriteString(" ")
+		result.Write(content)
+	}
+	result.WriteString("\n")
+	return nil
+}
@@ -0,0 +1,133 @@
+package latex
+
+import (
+	"bytes"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderParagraph(ctx *context, p types.Paragraph) ([]byte, error) {
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		content, err := renderParagraphContent(ctx, p, false)
+		if err != nil {
+			return nil, err
+		}
+		return renderAdmonition(ctx, k, p.Attributes, content), nil
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		return renderListing(ctx, p.Attributes, paragraphLines(p)), nil
+	case types.Verse:
+		content, err := renderParagraphContent(ctx, p, true)
+		if err != nil {
+			return nil, err
+		}
+		return renderBlockQuote("verse", p.Attributes, content), nil
+	case types.Quote:
+		content, err := renderParagraphContent(ctx, p, false)
+		if err != nil {
+			return nil, err
+		}
+		return renderBlockQuote("quote", p.Attributes, content), nil
+	}
+	content, err := renderParagraphContent(ctx, p, false)
+	if err != nil {
+		return nil, err
+	}
+	return withTitle(p.Attributes, content), nil
+}
+
+// renderParagraphContent renders the lines of the given paragraph, separated by a newline
+// (and a line break if the `hardbreaks` option or attribute is set, or if the paragraph is a verse).
+// The check style of the paragraph (if any) is ignored, since it is rendered as the label of its list item.
+func renderParagraphContent(ctx *context, p types.Paragraph, hardbreaks bool) ([]byte, error) {
+	hardbreaks = hardbreaks || p.Attributes.Has(types.AttrHardBreaks) || ctx.Attributes.Has(types.DocumentAttrHardBreaks)
+	result := bytes.NewBuffer(nil)
+	for i, line := range p.Lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render paragraph")
+		}
+		result.Write(renderedLine)
+		if i < len(p.Lines)-1 {
+			if hardbreaks {
+				result.WriteString(` \\`)
+			}
+			result.WriteString("\n")
+		}
+	}
+	return result.Bytes(), nil
+}
+
+// paragraphLines returns the lines of the given paragraph as verbatim lines
+func paragraphLines(p types.Paragraph) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, len(p.Lines))
+	for i, line := range p.Lines {
+		result[i] = types.VerbatimLine{
+			Content: string(plainText(line)),
+		}
+	}
+	return result
+}
+
+// renderAdmonition renders the given content in a `quote` environment, starting with the label of the admonition
+// (eg: `\textbf{Note:}`)
+func renderAdmonition(ctx *context, kind types.AdmonitionKind, attrs types.Attributes, content []byte) []byte {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{quote}` + renderLabel(attrs) + "\n")
+	if title, found := attrs.GetAsString(types.AttrTitle); found && title != "" {
+		result.WriteString(`\textbf{` + escape(title) + "}\n\n")
+	}
+	result.WriteString(`\textbf{` + escape(admonitionLabel(ctx, kind)) + `:} `)
+	result.Write(content)
+	result.WriteString("\n" + `\end{quote}`)
+	return result.Bytes()
+}
+
+func admonitionLabel(ctx *context, kind types.AdmonitionKind) string {
+	switch kind {
+	case types.Tip:
+		return ctx.Attributes.GetLabel(types.AttrTipCaption)
+	case types.Note:
+		return ctx.Attributes.GetLabel(types.AttrNoteCaption)
+	case types.Important:
+		return ctx.Attributes.GetLabel(types.AttrImportantCaption)
+	case types.Warning:
+		return ctx.Attributes.GetLabel(types.AttrWarningCaption)
+	case types.Caution:
+		return ctx.Attributes.GetLabel(types.AttrCautionCaption)
+	default:
+		log.Errorf("unexpected kind of admonition: %v", kind)
+		return ""
+	}
+}
+
+// renderBlockQuote renders a quote or a verse in the environment with the given name,
+// followed by its optional attribution (eg: `\hfill--- author, \emph{title}`)
+func renderBlockQuote(env string, attrs types.Attributes, content []byte) []byte {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{` + env + `}` + renderLabel(attrs) + "\n")
+	result.Write(content)
+	author, hasAuthor := attrs.GetAsString(types.AttrQuoteAuthor)
+	title, hasTitle := attrs.GetAsString(types.AttrQuoteTitle)
+	if hasAuthor || hasTitle {
+		result.WriteString("\n\n" + `\hfill---`)
+		if hasAuthor {
+			result.WriteString(" " + escape(author))
+			if hasTitle {
+				result.WriteString(",")
+			}
+		}
+		if hasTitle {
+			result.WriteString(` \emph{` + escape(title) + `}`)
+		}
+	}
+	result.WriteString("\n" + `\end{` + env + `}`)
+	if title, found := attrs.GetAsString(types.AttrTitle); found && title != "" {
+		return []byte(`\noindent\textbf{` + escape(title) + "}\n\n" + result.String())
+	}
+	return result.Bytes()
+}
@@ -0,0 +1,44 @@
+package latex
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderSection(ctx *context, s types.Section) ([]byte, error) {
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section title")
+	}
+	content, err := renderer.RenderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section content")
+	}
+	cmd := sectionCommand(ctx, s)
+	if s.Attributes.Has(types.AttrDiscrete) {
+		// discrete headings are neither numbered nor part of the table of contents
+		cmd += "*"
+	}
+	result := fmt.Sprintf(`\%s{%s}%s`, cmd, title, renderLabel(s.Attributes))
+	if len(content) > 0 {
+		result += "\n\n" + string(content)
+	}
+	return []byte(result), nil
+}
+
+// sectionCommand returns the sectioning command of the given section:
+// in a book, the sections of level 0 and 1 are parts and chapters, and the other sections are "shifted" accordingly
+func sectionCommand(ctx *context, s types.Section) string {
+	commands := []string{"part", "section", "subsection", "subsubsection", "paragraph", "subparagraph"}
+	if ctx.book {
+		commands = []string{"part", "chapter", "section", "subsection", "subsubsection", "paragraph"}
+	}
+	if s.Level < len(commands) {
+		return commands[s.Level]
+	}
+	return commands[len(commands)-1]
+}
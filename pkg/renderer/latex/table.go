@@ -0,0 +1,144 @@
+package latex
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+func renderTable(ctx *context, t types.Table) ([]byte, error) {
+	count := len(t.Header.Cells)
+	if len(t.Lines) > 0 {
+		count = len(t.Lines[0].Cells)
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{table}[htbp]` + "\n" + `\centering` + "\n")
+	if title, found := t.Attributes.GetAsString(types.AttrTitle); found && title != "" {
+		// LaTeX numbers the tables, so the counter of the context is only incremented to stay consistent
+		// with the other backends
+		ctx.GetAndIncrementTableCounter()
+		result.WriteString(`\caption{` + escape(title) + `}` + "\n")
+	}
+	if label := renderLabel(t.Attributes); label != "" {
+		result.WriteString(label + "\n")
+	}
+	result.WriteString(`\begin{tabular}{|` + columnSpecs(t.Attributes.GetAsStringWithDefault("cols", ""), count) + "|}\n" + `\hline` + "\n")
+	if len(t.Header.Cells) > 0 {
+		if err := renderTableRow(ctx, result, t.Header, true); err != nil {
+			return nil, err
+		}
+		result.WriteString(`\hline` + "\n")
+	}
+	for _, l := range t.Lines {
+		if err := renderTableRow(ctx, result, l, false); err != nil {
+			return nil, err
+		}
+	}
+	result.WriteString(`\hline` + "\n" + `\end{tabular}` + "\n" + `\end{table}`)
+	return result.Bytes(), nil
+}
+
+// renderTableRow renders the cells of a row, separated by `&` (the content of the header cells is in bold)
+func renderTableRow(ctx *context, result *bytes.Buffer, l types.TableLine, header bool) error {
+	cells := make([]string, len(l.Cells))
+	for i, cell := range l.Cells {
+		content, err := renderInlineElements(ctx, cell)
+		if err != nil {
+			return errors.Wrapf(err, "unable to render table")
+		}
+		cells[i] = strings.TrimSpace(string(content))
+		if header {
+			cells[i] = `\textbf{` + cells[i] + `}`
+		}
+	}
+	result.WriteString(strings.Join(cells, " & ") + ` \\` + "\n")
+	return nil
+}
+
+// column the horizontal alignment and the (relative) width of a column, as specified in the `cols` attribute
+type column struct {
+	halign string
+	width  float64 // 0 if the width was not specified, or if the column has an automatic width (`~`)
+}
+
+// columnRegexp the specification of one or more columns, i.e.: an optional multiplier, an optional horizontal
+// alignment, an optional vertical alignment, an optional width and an optional style (eg: `2*<.^3a`)
+var columnRegexp = regexp.MustCompile(`^(?:(\d+)\*)?([<^>])?(?:\.[<^>])?(\d+%?|~)?[adehlmsv]?$`)
+
+// parseColumns parses the value of the `cols` attribute of a table, or returns `nil` if the value is empty or invalid
+func parseColumns(cols string) []column {
+	cols = strings.TrimSpace(cols)
+	if cols == "" {
+		return nil
+	}
+	// a single number is the number of columns
+	if n, err := strconv.Atoi(cols); err == nil {
+		return make([]column, n)
+	}
+	result := []column{}
+	for _, spec := range strings.FieldsFunc(cols, func(r rune) bool { return r == ',' || r == ';' }) {
+		m := columnRegexp.FindStringSubmatch(strings.TrimSpace(spec))
+		if m == nil {
+			return nil
+		}
+		c := column{
+			halign: m[2],
+		}
+		if w, err := strconv.ParseFloat(strings.TrimSuffix(m[3], "%"), 64); err == nil {
+			c.width = w
+		}
+		n := 1
+		if m[1] != "" {
+			n, _ = strconv.Atoi(m[1])
+		}
+		for i := 0; i < n; i++ {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// columnSpecs returns the column specifications of a `tabular` environment, based on the `cols` attribute of the table.
+// The columns with a width are rendered as paragraph columns (`p{...}`) which share 90% of the line width
+// (to leave some room for the padding and the rules of the columns),
+// and the other columns are rendered as `l`, `c` or `r` columns.
+// If the `cols` attribute is missing (or does not match the actual number of columns), all columns are left-aligned.
+func columnSpecs(cols string, count int) string {
+	columns := parseColumns(cols)
+	if len(columns) != count {
+		columns = make([]column, count)
+	}
+	total := 0.0
+	for _, c := range columns {
+		total += c.width
+	}
+	specs := make([]string, len(columns))
+	for i, c := range columns {
+		if c.width == 0 {
+			switch c.halign {
+			case "^":
+				specs[i] = "c"
+			case ">":
+				specs[i] = "r"
+			default:
+				specs[i] = "l"
+			}
+			continue
+		}
+		switch c.halign {
+		case "^":
+			specs[i] = `>{\centering\arraybackslash}`
+		case ">":
+			specs[i] = `>{\raggedleft\arraybackslash}`
+		}
+		specs[i] += fmt.Sprintf(`p{%s\linewidth}`, strconv.FormatFloat(math.Round(c.width/total*0.9*100)/100, 'f', -1, 64))
+	}
+	return strings.Join(specs, "|")
+}
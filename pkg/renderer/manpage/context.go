@@ -0,0 +1,19 @@
+package manpage
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+)
+
+// context the rendering context of a manpage.
+// Besides the common rendering context, it keeps track of the depth of the lists being rendered,
+// so that the nested labeled lists are indented.
+type context struct {
+	renderer.Context
+	lists int
+}
+
+func newContext(ctx renderer.Context) *context {
+	return &context{
+		Context: ctx,
+	}
+}
@@ -0,0 +1,153 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderDelimitedBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	title := b.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	switch b.Kind {
+	case types.Fenced, types.Source, types.Listing, types.Literal:
+		return renderVerbatimBlock(title, verbatimLines(b.Elements)), nil
+	case types.Example:
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render example block")
+		}
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			return renderAdmonition(ctx, k, title, content), nil
+		}
+		if title != "" {
+			if caption := ctx.Attributes.GetLabel(types.AttrExampleCaption); caption != "" {
+				title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementExampleBlockCounter(), title)
+			}
+		}
+		return renderIndentedBlock(title, content), nil
+	case types.Quote, types.MarkdownQuote:
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render quote block")
+		}
+		return renderBlockQuote(b.Attributes, string(content)), nil
+	case types.Verse:
+		return renderVerseBlock(ctx, b)
+	case types.Sidebar:
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render sidebar block")
+		}
+		return renderIndentedBlock(title, content), nil
+	case types.Passthrough:
+		lines := verbatimLines(b.Elements)
+		result := make([]string, len(lines))
+		for i, l := range lines {
+			result[i] = l.Content
+		}
+		return []byte(strings.Join(result, "\n")), nil
+	case types.Comment:
+		return []byte{}, nil
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+// renderIndentedBlock renders the given content, indented and preceded by its optional title
+func renderIndentedBlock(title string, content []byte) []byte {
+	return []byte(renderTitle(title) + ".RS 4\n" + string(content) + "\n.RE")
+}
+
+// renderVerbatimBlock renders the given lines "as-is" (i.e., without filling) with a monospace font,
+// in which the callouts are rendered in bold
+func renderVerbatimBlock(title string, lines []types.VerbatimLine) []byte {
+	result := bytes.NewBufferString(blockStart(title))
+	result.WriteString(".if n .RS 4\n.nf\n.fam C\n")
+	for _, l := range lines {
+		result.WriteString(escapeControlCharacter(escape(strings.TrimRight(l.Content, " "))))
+		for _, c := range l.Callouts {
+			result.WriteString(fmt.Sprintf(` \fB(%d)\fP`, c.Ref))
+		}
+		result.WriteString("\n")
+	}
+	result.WriteString(".fam\n.fi\n.if n .RE")
+	return result.Bytes()
+}
+
+func renderVerseBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	paragraphs := []string{}
+	for _, e := range b.Elements {
+		if p, ok := e.(types.Paragraph); ok {
+			content, err := renderParagraphContent(ctx, p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render verse block")
+			}
+			paragraphs = append(paragraphs, string(content))
+		}
+	}
+	return renderBlockQuote(b.Attributes, ".sp\n.nf\n"+strings.Join(paragraphs, "\n\n")+"\n.fi"), nil
+}
+
+func renderLiteralBlock(ctx *context, b types.LiteralBlock) []byte {
+	lines := make([]types.VerbatimLine, len(b.Lines))
+	for i, l := range b.Lines {
+		lines[i] = types.VerbatimLine{
+			Content: l,
+		}
+	}
+	if t, found := b.Attributes.GetAsString(types.AttrLiteralBlockType); found && t == types.LiteralBlockWithSpacesOnFirstLine {
+		trimIndentation(lines)
+	}
+	return renderVerbatimBlock(b.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), lines)
+}
+
+// trimIndentation removes the common leading spaces of the given lines
+func trimIndentation(lines []types.VerbatimLine) {
+	indent := -1
+	for _, l := range lines {
+		if n := len(l.Content) - len(strings.TrimLeft(l.Content, " ")); indent == -1 || n < indent {
+			indent = n
+		}
+	}
+	for i := range lines {
+		lines[i].Content = lines[i].Content[indent:]
+	}
+}
+
+// verbatimLines returns the verbatim lines of a listing (blank lines are returned as empty verbatim lines),
+// without the trailing blank lines
+func verbatimLines(elements []interface{}) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, 0, len(elements))
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.VerbatimLine:
+			result = append(result, e)
+		case types.BlankLine:
+			result = append(result, types.VerbatimLine{})
+		default:
+			log.Warnf("unexpected element of type '%T' in listing", e)
+		}
+	}
+	for len(result) > 0 && result[len(result)-1].IsEmpty() {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+func renderImageBlock(ctx *context, img types.ImageBlock) []byte {
+	title := img.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	if title != "" {
+		if caption := ctx.Attributes.GetLabel(types.AttrFigureCaption); caption != "" {
+			title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementImageCounter(), title)
+		}
+	}
+	alt := img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, img.Location.String())
+	return []byte(blockStart(title) + formatText("["+escape(alt)+"]"))
+}
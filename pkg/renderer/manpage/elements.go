@@ -0,0 +1,166 @@
+package manpage
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// the methods of the `renderer.BlockRenderer` interface, which render the blocks traversed by `renderer.RenderElements`
+
+// BlockSeparator returns a newline
+func (ctx *context) BlockSeparator() string {
+	return "\n"
+}
+
+func (ctx *context) RenderSection(s types.Section) ([]byte, error) {
+	return renderSection(ctx, s)
+}
+
+func (ctx *context) RenderPreamble(p types.Preamble) ([]byte, error) {
+	return renderer.RenderElements(ctx, p.Elements)
+}
+
+func (ctx *context) RenderLabeledList(l types.LabeledList) ([]byte, error) {
+	return renderLabeledList(ctx, l)
+}
+
+func (ctx *context) RenderOrderedList(l types.OrderedList) ([]byte, error) {
+	return renderOrderedList(ctx, l)
+}
+
+func (ctx *context) RenderUnorderedList(l types.UnorderedList) ([]byte, error) {
+	return renderUnorderedList(ctx, l)
+}
+
+func (ctx *context) RenderCalloutList(l types.CalloutList) ([]byte, error) {
+	return renderCalloutList(ctx, l)
+}
+
+func (ctx *context) RenderParagraph(p types.Paragraph) ([]byte, error) {
+	return renderParagraph(ctx, p)
+}
+
+func (ctx *context) RenderImageBlock(img types.ImageBlock) ([]byte, error) {
+	return renderImageBlock(ctx, img), nil
+}
+
+func (ctx *context) RenderDelimitedBlock(b types.DelimitedBlock) ([]byte, error) {
+	return renderDelimitedBlock(ctx, b)
+}
+
+func (ctx *context) RenderTable(t types.Table) ([]byte, error) {
+	return renderTable(ctx, t)
+}
+
+func (ctx *context) RenderLiteralBlock(b types.LiteralBlock) ([]byte, error) {
+	return renderLiteralBlock(ctx, b), nil
+}
+
+func (ctx *context) RenderBlockMacro(m types.UserMacro) ([]byte, error) {
+	return []byte(".sp\n" + formatText(escape(m.RawText))), nil
+}
+
+func (ctx *context) RenderInlineElement(element interface{}) ([]byte, error) {
+	return renderInlineElement(ctx, element)
+}
+
+// renderInlineElements renders the given inline elements, without any separator.
+// The punctuation which immediately follows a link is passed to the `URL` macro,
+// so that it is not separated from the link by a space.
+func renderInlineElements(ctx *context, elements []interface{}) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for i := 0; i < len(elements); i++ {
+		if l, ok := elements[i].(types.InlineLink); ok {
+			var next *types.StringElement
+			if i+1 < len(elements) {
+				if s, ok := elements[i+1].(types.StringElement); ok {
+					next = &s
+				}
+			}
+			trailing := ""
+			if next != nil {
+				rest := strings.TrimLeftFunc(next.Content, func(r rune) bool {
+					return !unicode.IsSpace(r)
+				})
+				trailing = next.Content[:len(next.Content)-len(rest)]
+				next.Content = rest
+			}
+			renderedLink, err := renderLink(ctx, l, trailing)
+			if err != nil {
+				return nil, err
+			}
+			buff.Write(renderedLink)
+			if next != nil {
+				buff.Write(renderStringElement(*next))
+				i++
+			}
+			continue
+		}
+		renderedElement, err := renderInlineElement(ctx, elements[i])
+		if err != nil {
+			return nil, err
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// nolint: gocyclo
+func renderInlineElement(ctx *context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderInlineElements(ctx, e)
+	case types.StringElement:
+		return renderStringElement(e), nil
+	case types.QuotedText:
+		return renderQuotedText(ctx, e)
+	case types.InlinePassthrough:
+		return renderInlinePassthrough(ctx, e)
+	case types.InternalCrossReference:
+		return renderInternalCrossReference(ctx, e)
+	case types.ExternalCrossReference:
+		return renderExternalCrossReference(ctx, e)
+	case types.InlineLink:
+		return renderLink(ctx, e, "")
+	case types.InlineImage:
+		return renderInlineImage(e), nil
+	case types.FootnoteReference:
+		return renderFootnoteReference(e), nil
+	case types.IndexTerm:
+		return renderInlineElements(ctx, e.Term)
+	case types.ConcealedIndexTerm:
+		// there is no index in a manpage
+		return []byte{}, nil
+	case types.LineBreak:
+		return []byte("\n" + macroMarker + ".br\n"), nil
+	case types.UserMacro:
+		return []byte(escape(e.RawText)), nil
+	case types.VerbatimLine:
+		return []byte(escape(e.Content)), nil
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// renderTitle returns the title of an element with a title (in bold, and followed by a line break),
+// or an empty string otherwise
+func renderTitle(title string) string {
+	if title == "" {
+		return ""
+	}
+	return ".sp\n.B \"" + macroArg(title) + "\"\n.br\n"
+}
+
+// blockStart returns the title of the block (if it has one), or a vertical space otherwise
+func blockStart(title string) string {
+	if title == "" {
+		return ".sp\n"
+	}
+	return renderTitle(title)
+}
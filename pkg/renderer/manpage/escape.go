@@ -0,0 +1,66 @@
+package manpage
+
+import (
+	"strings"
+)
+
+// macroMarker the prefix of the lines of macros (eg: `.URL` or `.br`) inserted while rendering inline elements,
+// which must not be escaped by `formatText`
+const macroMarker = "\x00"
+
+// escape escapes the backslashes and the hyphens (which would otherwise be rendered as a "minus" sign) in the given text
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+var escaper = strings.NewReplacer(
+	`\`, `\(rs`,
+	`-`, `\-`,
+)
+
+// replacements the textual symbols replaced by their roff glyph
+var replacements = strings.NewReplacer(
+	"(C)", `\(co`,
+	"(TM)", `\(tm`,
+	"(R)", `\(rg`,
+)
+
+// macroArg escapes the given text, so that it can be used as a quoted argument of a macro
+func macroArg(s string) string {
+	return quote(escape(s))
+}
+
+// quote escapes the double quotes (and removes the newlines) in the given, already escaped text,
+// so that it can be used as a quoted argument of a macro
+func quote(s string) string {
+	return strings.NewReplacer(`"`, `\(dq`, "\n", " ").Replace(s)
+}
+
+// formatText formats the given (already escaped) text as roff text lines: the leading spaces and the blank lines
+// are removed, and the lines starting with a dot or an apostrophe are escaped so that they are not interpreted
+// as control lines (except for the lines of the macros inserted while rendering the inline elements)
+func formatText(s string) string {
+	lines := strings.Split(s, "\n")
+	result := make([]string, 0, len(lines))
+	for _, l := range lines {
+		if strings.HasPrefix(l, macroMarker) {
+			result = append(result, strings.TrimPrefix(l, macroMarker))
+			continue
+		}
+		l = strings.TrimLeft(l, " \t")
+		if l == "" {
+			continue
+		}
+		result = append(result, escapeControlCharacter(l))
+	}
+	return strings.Join(result, "\n")
+}
+
+// escapeControlCharacter prefixes the given line with a zero-width character if it starts with a dot or an apostrophe,
+// so that it is not interpreted as a control line
+func escapeControlCharacter(l string) string {
+	if strings.HasPrefix(l, ".") || strings.HasPrefix(l, "'") {
+		return `\&` + l
+	}
+	return l
+}
@@ -0,0 +1,127 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderStringElement(s types.StringElement) []byte {
+	return []byte(replacements.Replace(escape(s.Content)))
+}
+
+func renderQuotedText(ctx *context, t types.QuotedText) ([]byte, error) {
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	switch t.Kind {
+	case types.Bold:
+		return []byte(`\fB` + string(content) + `\fP`), nil
+	case types.Italic:
+		return []byte(`\fI` + string(content) + `\fP`), nil
+	case types.Monospace:
+		return []byte(`\f(CR` + string(content) + `\fP`), nil
+	case types.Subscript, types.Superscript:
+		// there is no subscript or superscript in a terminal
+		return content, nil
+	default:
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+}
+
+func renderInlinePassthrough(ctx *context, p types.InlinePassthrough) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch e := element.(type) {
+		case types.StringElement:
+			if p.Kind == types.SinglePlusPassthrough {
+				result.WriteString(escape(e.Content))
+			} else {
+				// "string" elements must be rendered as-is, ie, without any escaping.
+				result.WriteString(e.Content)
+			}
+		default:
+			renderedElement, err := renderInlineElement(ctx, e)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			result.Write(renderedElement)
+		}
+	}
+	return result.Bytes(), nil
+}
+
+func renderInternalCrossReference(ctx *context, xref types.InternalCrossReference) ([]byte, error) {
+	log.Debugf("rendering cross reference with ID: %s", xref.ID)
+	if xref.Label != "" {
+		return []byte(escape(xref.Label)), nil
+	}
+	if target, found := ctx.ElementReferences[xref.ID]; found {
+		if t, ok := target.([]interface{}); ok {
+			return renderInlineElements(ctx, t)
+		}
+		return nil, errors.Errorf("unable to process internal cross reference to element of type %T", target)
+	}
+	return []byte("[" + escape(xref.ID) + "]"), nil
+}
+
+func renderExternalCrossReference(ctx *context, xref types.ExternalCrossReference) ([]byte, error) {
+	if len(xref.Label) > 0 {
+		return renderInlineElements(ctx, xref.Label)
+	}
+	return []byte(escape(xref.Location.String())), nil
+}
+
+// renderLink renders a link with the `URL` macro, on its own line (the `\c` escape at the end of the previous line
+// prevents the insertion of a space before the link), with the given trailing text (eg: punctuation)
+func renderLink(ctx *context, l types.InlineLink, trailing string) ([]byte, error) {
+	href := l.Location.String()
+	text := []byte{}
+	if t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+		var err error
+		if text, err = renderInlineElements(ctx, t); err != nil {
+			return nil, errors.Wrapf(err, "unable to render link")
+		}
+	}
+	macro := "URL"
+	if strings.HasPrefix(href, "mailto:") {
+		macro = "MTO"
+		href = strings.TrimPrefix(href, "mailto:")
+	}
+	return []byte(fmt.Sprintf("\\c\n%s.%s \"%s\" \"%s\" \"%s\"\n", macroMarker, macro, macroArg(href), quote(string(text)), macroArg(trailing))), nil
+}
+
+func renderInlineImage(img types.InlineImage) []byte {
+	return []byte("[" + escape(img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, img.Location.String())) + "]")
+}
+
+// renderFootnoteReference renders the number of the footnote, whose content is rendered in the `NOTES` section
+func renderFootnoteReference(note types.FootnoteReference) []byte {
+	if note.ID == types.InvalidFootnoteReference {
+		log.Warnf("invalid footnote reference: '%s'", note.Ref)
+		return []byte("[" + escape(note.Ref) + "]")
+	}
+	return []byte(fmt.Sprintf("[%d]", note.ID))
+}
+
+// renderNotes renders the `NOTES` section with the footnotes of the document
+func renderNotes(ctx *context) ([]byte, error) {
+	if len(ctx.Footnotes) == 0 {
+		return []byte{}, nil
+	}
+	result := bytes.NewBufferString(`.SH "NOTES"`)
+	for _, f := range ctx.Footnotes {
+		content, err := renderInlineElements(ctx, f.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnote")
+		}
+		result.WriteString(fmt.Sprintf("\n.IP \" %d.\" 4\n%s", f.ID, formatText(string(content))))
+	}
+	return result.Bytes(), nil
+}
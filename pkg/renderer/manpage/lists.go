@@ -0,0 +1,154 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// renderUnorderedList renders the items of the list as indented paragraphs, with a bullet
+func renderUnorderedList(ctx *context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBufferString(renderTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, "")))
+	result.WriteString(".RS 4")
+	for _, item := range l.Items {
+		if err := renderListItem(ctx, result, `.IP \(bu 2`, item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render unordered list")
+		}
+	}
+	result.WriteString("\n.RE")
+	return result.Bytes(), nil
+}
+
+// renderOrderedList renders the items of the list as indented paragraphs, with their number
+func renderOrderedList(ctx *context, l types.OrderedList) ([]byte, error) {
+	numbering := types.Arabic
+	if s, found := l.Attributes.GetAsString(types.AttrNumberingStyle); found {
+		numbering = types.NumberingStyle(s)
+	} else if len(l.Items) > 0 {
+		numbering = l.Items[0].NumberingStyle
+	}
+	start := 1
+	if s, found := l.Attributes.GetAsString(types.AttrStart); found {
+		if n, err := strconv.Atoi(s); err == nil {
+			start = n
+		}
+	}
+	result := bytes.NewBufferString(renderTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, "")))
+	result.WriteString(".RS 4")
+	for i, item := range l.Items {
+		if err := renderListItem(ctx, result, fmt.Sprintf(`.IP " %s." 4`, itemNumber(numbering, start+i)), item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render ordered list")
+		}
+	}
+	result.WriteString("\n.RE")
+	return result.Bytes(), nil
+}
+
+// itemNumber returns the number of an item in an ordered list with the given numbering style
+// (the greek numberings are not supported, so they fall back to arabic)
+func itemNumber(s types.NumberingStyle, n int) string {
+	switch s {
+	case types.LowerAlpha:
+		return alpha(n)
+	case types.UpperAlpha:
+		return strings.ToUpper(alpha(n))
+	case types.LowerRoman:
+		return strings.ToLower(roman(n))
+	case types.UpperRoman:
+		return roman(n)
+	default:
+		return strconv.Itoa(n)
+	}
+}
+
+// alpha returns the given (positive) number in the `a`, `b`, ..., `z`, `aa`, `ab`, ... sequence
+func alpha(n int) string {
+	if n <= 0 {
+		return strconv.Itoa(n)
+	}
+	result := ""
+	for ; n > 0; n = (n - 1) / 26 {
+		result = string(rune('a'+(n-1)%26)) + result
+	}
+	return result
+}
+
+// roman returns the given (positive) number in uppercase roman numerals
+func roman(n int) string {
+	if n <= 0 {
+		return strconv.Itoa(n)
+	}
+	values := []int{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	symbols := []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+	result := &strings.Builder{}
+	for i, v := range values {
+		for ; n >= v; n -= v {
+			result.WriteString(symbols[i])
+		}
+	}
+	return result.String()
+}
+
+// renderLabeledList renders the items of the list as tagged paragraphs, with the term in bold
+// (or in italic for the questions of a Q&A list).
+// The nested labeled lists are indented.
+func renderLabeledList(ctx *context, l types.LabeledList) ([]byte, error) {
+	term := ".B"
+	if l.Attributes.Has(types.AttrQandA) {
+		term = ".I"
+	}
+	indent := "0"
+	if ctx.lists > 0 {
+		indent = "4"
+	}
+	result := bytes.NewBufferString(renderTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, "")))
+	result.WriteString(".RS " + indent)
+	for _, item := range l.Items {
+		renderedTerm, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+		tag := fmt.Sprintf(".TP\n%s \"%s\"", term, quote(strings.TrimSpace(string(renderedTerm))))
+		if err := renderListItem(ctx, result, tag, item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+	}
+	result.WriteString("\n.RE")
+	return result.Bytes(), nil
+}
+
+// renderCalloutList renders the items of the list as indented paragraphs, with the number of the callout
+func renderCalloutList(ctx *context, l types.CalloutList) ([]byte, error) {
+	result := bytes.NewBufferString(renderTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, "")))
+	result.WriteString(".RS 4")
+	for _, item := range l.Items {
+		if err := renderListItem(ctx, result, fmt.Sprintf(`.IP "(%d)" 4`, item.Ref), item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render callout list")
+		}
+	}
+	result.WriteString("\n.RE")
+	return result.Bytes(), nil
+}
+
+// renderListItem renders the given macro (eg: `.IP`), followed by the content of the item
+func renderListItem(ctx *context, result *bytes.Buffer, macro string, elements []interface{}) error {
+	ctx.lists++
+	defer func() {
+		ctx.lists--
+	}()
+	content, err := renderCompactElements(ctx, elements)
+	if err != nil {
+		return err
+	}
+	result.WriteString("\n" + macro)
+	if len(content) > 0 {
+		result.WriteString("\n")
+		result.Write(content)
+	}
+	return nil
+}
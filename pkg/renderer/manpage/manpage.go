@@ -0,0 +1,288 @@
+package manpage
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var documentTmpl texttemplate.Template
+
+func init() {
+	documentTmpl = newTextTemplate("document", `'\" t
+.\"     Title: {{ .Name }}{{ if .Author }}
+.\"    Author: {{ .Author }}{{ end }}
+.\" Generator: libasciidoc{{ with .Version }} {{ . }}{{ end }}
+.\"      Date: {{ .Date }}
+.\"    Manual: {{ .Manual }}
+.\"    Source: {{ .Source }}
+.\"  Language: {{ .Lang }}
+.\"
+.TH "{{ .Title }}" "{{ .VolNum }}" "{{ macroArg .Date }}" "{{ .Source }}" "{{ .Manual }}"
+.ie \n(.g .ds Aq \(aq
+.el       .ds Aq '
+.ss \n[.ss] 0
+.nh
+.ad l
+.de URL
+\fI\\$2\fP <\\$1>\\$3
+..
+.als MTO URL
+.if \n[.g] \{\
+.  mso www.tmac
+.  am URL
+.    ad l
+.  .
+.  am MTO
+.    ad l
+.  .
+.  LINKSTYLE blue R < >
+.\}{{ if .Content }}
+{{ .Content }}{{ end }}
+`,
+		texttemplate.FuncMap{
+			"macroArg": macroArg,
+		})
+}
+
+// registers the `manpage` backend
+func init() {
+	renderer.Register("manpage", renderer.RenderFunc(Render), map[string]string{
+		types.AttrBaseBackend:   "manpage",
+		types.AttrOutFileSuffix: ".man",
+		types.AttrFileType:      "man",
+	})
+}
+
+// the default value of the `mansource` and `manmanual` attributes (i.e., a non-breaking, zero-width content)
+const defaultManInfo = `\ \&`
+
+// Render renders the given document as a manual page (i.e., in roff) and writes the result in the given `writer`.
+// The name of the output file is `<manname>.<manvolnum>` (eg: `git-commit.1`)
+func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	c := newContext(ctx)
+	header, hasHeader := doc.Header()
+	info := newManInfo(ctx, doc)
+	elements := doc.Elements
+	if hasHeader {
+		// the title of the document is rendered in the `.TH` macro
+		elements = append(append([]interface{}{}, header.Elements...), doc.Elements[1:]...)
+	}
+	renderedContent, err := renderer.RenderElements(c, elements)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	content := bytes.NewBuffer(renderedContent)
+	notes, err := renderNotes(c)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	appendBlock(content, notes)
+	authors, _ := doc.Authors()
+	if ctx.Config.IncludeHeaderFooter {
+		log.Debugf("Rendering full document...")
+		appendBlock(content, renderAuthors(authors))
+		author := ""
+		if len(authors) > 0 {
+			author = authors[0].FullName
+		}
+		err = documentTmpl.Execute(output, struct {
+			Name    string
+			Author  string
+			Version string
+			Date    string
+			Title   string
+			VolNum  string
+			Source  string
+			Manual  string
+			Lang    string
+			Content string
+		}{
+			Name:    info.name,
+			Author:  author,
+			Version: ctx.Config.GeneratorVersion,
+			Date:    documentDate(ctx, doc),
+			Title:   macroArg(strings.ToUpper(info.title)),
+			VolNum:  macroArg(info.volnum),
+			Source:  info.source,
+			Manual:  info.manual,
+			Lang:    doc.Attributes.GetLang(),
+			Content: content.String(),
+		})
+		if err != nil {
+			return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+		}
+	} else if _, err = output.Write(content.Bytes()); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	revision, _ := doc.Revision()
+	metadata := types.Metadata{
+		Title:    string(plainText(header.Title)),
+		Authors:  authors,
+		Revision: revision,
+		Warnings: doc.Warnings,
+	}
+	if info.name != "" {
+		metadata.OutFileName = info.name + "." + info.volnum
+	}
+	return metadata, nil
+}
+
+// appendBlock appends the given block (if not empty) to the given buffer, after a newline
+func appendBlock(result *bytes.Buffer, block []byte) {
+	if len(block) == 0 {
+		return
+	}
+	if result.Len() > 0 {
+		result.WriteString("\n")
+	}
+	result.Write(block)
+}
+
+// manInfo the information of the manual page, as set in the `.TH` macro and used to name the output file
+type manInfo struct {
+	title  string
+	volnum string
+	name   string
+	source string
+	manual string
+}
+
+// the title of a manpage, with the volume number in parentheses (eg: `git-commit(1)`)
+var manTitleRegexp = regexp.MustCompile(`^(.+)\((.+)\)$`)
+
+// newManInfo returns the information of the manual page, based on the title of the document, its `Name` section
+// and the `mantitle`, `manvolnum`, `manname`, `mansource` and `manmanual` attributes (which take precedence)
+func newManInfo(ctx renderer.Context, doc types.Document) manInfo {
+	info := manInfo{
+		volnum: "1",
+		source: defaultManInfo,
+		manual: defaultManInfo,
+	}
+	header, found := doc.Header()
+	if found {
+		info.title = strings.TrimSpace(string(plainText(header.Title)))
+		if m := manTitleRegexp.FindStringSubmatch(info.title); m != nil {
+			info.title = strings.TrimSpace(m[1])
+			info.volnum = strings.TrimSpace(m[2])
+		}
+		if len(header.Elements) > 0 {
+			if s, ok := header.Elements[0].(types.Section); ok && isNameSection(s) && len(s.Elements) > 0 {
+				if p, ok := s.Elements[0].(types.Paragraph); ok {
+					info.name = manName(p)
+				}
+			}
+		}
+	}
+	attrs := doc.Attributes
+	info.title = attrs.GetAsStringWithDefault(types.AttrManTitle, info.title)
+	info.volnum = attrs.GetAsStringWithDefault(types.AttrManVolNum, info.volnum)
+	info.name = attrs.GetAsStringWithDefault(types.AttrManName, info.name)
+	if source, found := attrs.GetAsString(types.AttrManSource); found && source != "" {
+		info.source = macroArg(source)
+	}
+	if manual, found := attrs.GetAsString(types.AttrManManual); found && manual != "" {
+		info.manual = macroArg(manual)
+	}
+	if info.name == "" {
+		// fallback to the name of the source file, or to the title of the document
+		if ctx.Config.Filename != "" {
+			base := filepath.Base(ctx.Config.Filename)
+			info.name = strings.TrimSuffix(base, filepath.Ext(base))
+		} else {
+			info.name = strings.ToLower(info.title)
+		}
+	}
+	return info
+}
+
+// isNameSection returns true if the given section is the `Name` section of a manpage
+func isNameSection(s types.Section) bool {
+	return s.Level == 1 && strings.EqualFold(strings.TrimSpace(string(plainText(s.Title))), "name")
+}
+
+// manName returns the name of the command described in the paragraph of the `Name` section
+// (eg: `git-commit` in `git-commit - Record changes to the repository`).
+// When the paragraph contains multiple names, only the first one is returned
+func manName(p types.Paragraph) string {
+	if len(p.Lines) == 0 {
+		return ""
+	}
+	content := string(plainText(p.Lines[0]))
+	if i := strings.Index(content, " - "); i >= 0 {
+		content = content[:i]
+	}
+	return strings.TrimSpace(strings.Split(content, ",")[0])
+}
+
+// documentDate returns the date of the document, i.e., the date of its revision (if available),
+// or the date of its last update otherwise (unless the document is `reproducible`)
+func documentDate(ctx renderer.Context, doc types.Document) string {
+	if r, found := doc.Revision(); found && r.Revdate != "" {
+		return r.Revdate
+	}
+	if doc.Attributes.Has(types.AttrReproducible) || ctx.Config.LastUpdated.IsZero() {
+		return ""
+	}
+	return ctx.Config.LastUpdated.Format("2006-01-02")
+}
+
+// renderAuthors renders the `AUTHOR` (or `AUTHORS`) section at the end of the manpage
+func renderAuthors(authors []types.DocumentAuthor) []byte {
+	if len(authors) == 0 {
+		return []byte{}
+	}
+	result := bytes.NewBufferString(`.SH "AUTHOR"`)
+	if len(authors) > 1 {
+		result = bytes.NewBufferString(`.SH "AUTHORS"`)
+	}
+	for _, a := range authors {
+		name := escape(a.FullName)
+		if a.Email != "" {
+			name += " <" + escape(a.Email) + ">"
+		}
+		result.WriteString("\n.sp\n" + formatText(name))
+	}
+	return result.Bytes()
+}
+
+func newTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttemplate.Template {
+	t := texttemplate.New(name)
+	for _, f := range funcs {
+		t.Funcs(f)
+	}
+	return *texttemplate.Must(t.Parse(src))
+}
+
+// plainText returns the text of the given inline elements, without any markup
+func plainText(elements []interface{}) []byte {
+	result := bytes.NewBuffer(nil)
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.QuotedText:
+			result.Write(plainText(e.Elements))
+		case types.InlinePassthrough:
+			result.Write(plainText(e.Elements))
+		case types.IndexTerm:
+			result.Write(plainText(e.Term))
+		case types.InlineLink:
+			if text, ok := e.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+				result.Write(plainText(text))
+			} else {
+				result.WriteString(e.Location.String())
+			}
+		}
+	}
+	return result.Bytes()
+}
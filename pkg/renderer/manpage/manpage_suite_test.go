@@ -0,0 +1,15 @@
+package manpage_test
+
+import (
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestManpage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Manpage Suite")
+}
@@ -0,0 +1,277 @@
+package manpage_test
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("manpages", func() {
+
+	lastUpdated := time.Date(2020, 4, 23, 12, 0, 0, 0, time.UTC)
+
+	It("full manpage with header and name section", func() {
+		source := `= eve(1)
+Andrew Stanton
+v1.0.0, 2021-01-02
+:mansource: Eve 1.0
+:manmanual: Eve Manual
+
+== Name
+
+eve - analyzes an image to determine if it's a picture of a life form
+
+== Synopsis
+
+*eve* [_OPTION_]... _FILE_...`
+		expected := `'\" t
+.\"     Title: eve
+.\"    Author: Andrew Stanton
+.\" Generator: libasciidoc
+.\"      Date: 2021-01-02
+.\"    Manual: Eve Manual
+.\"    Source: Eve 1.0
+.\"  Language: en
+.\"
+.TH "EVE" "1" "2021\-01\-02" "Eve 1.0" "Eve Manual"
+.ie \n(.g .ds Aq \(aq
+.el       .ds Aq '
+.ss \n[.ss] 0
+.nh
+.ad l
+.de URL
+\fI\\$2\fP <\\$1>\\$3
+..
+.als MTO URL
+.if \n[.g] \{\
+.  mso www.tmac
+.  am URL
+.    ad l
+.  .
+.  am MTO
+.    ad l
+.  .
+.  LINKSTYLE blue R < >
+.\}
+.SH "NAME"
+eve \- analyzes an image to determine if it's a picture of a life form
+.SH "SYNOPSIS"
+.sp
+\fBeve\fP [\fIOPTION\fP]... \fIFILE\fP...
+.SH "AUTHOR"
+.sp
+Andrew Stanton
+`
+		Expect(RenderManpage(source)).To(Equal(expected))
+	})
+
+	It("manpage with title and volume number attributes and default source and manual", func() {
+		source := `= The Eve Command
+:mantitle: eve
+:manvolnum: 8
+
+== Name
+
+eve - analyzes an image`
+		result, err := RenderManpage(source, configuration.WithLastUpdated(lastUpdated))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ContainSubstring(`.TH "EVE" "8" "2020\-04\-23" "\ \&" "\ \&"` + "\n"))
+	})
+
+	It("output file named after the name of the command and the volume number", func() {
+		source := `= eve(5)
+
+== Name
+
+eve, adam - analyzes an image
+
+== Synopsis
+
+*eve*`
+		output := bytes.NewBuffer(nil)
+		metadata, err := libasciidoc.Convert(strings.NewReader(source), output, configuration.NewConfiguration(configuration.WithBackend("manpage")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata.OutFileName).To(Equal("eve.5"))
+	})
+
+	It("sections and subsections", func() {
+		source := `== Options
+
+=== Sub-section
+
+some text`
+		expected := `.SH "OPTIONS"
+.SS "Sub\-section"
+.sp
+some text`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("escaped backslashes, hyphens and leading dots and apostrophes", func() {
+		source := `a line with a back\slash, a -hyphen and
+.period
+'apostrophe`
+		expected := `.sp
+a line with a back\(rsslash, a \-hyphen and
+\&.period
+\&'apostrophe`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("labeled list", func() {
+		source := `*-v, --verbose*::
+Show more _details_.
+` + "`--dry-run`" + `:: Do not
+change anything.`
+		expected := `.RS 0
+.TP
+.B "\fB\-v, \-\-verbose\fP"
+Show more \fIdetails\fP.
+.TP
+.B "\f(CR\-\-dry\-run\fP"
+Do not
+change anything.
+.RE`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("unordered list with nested ordered list", func() {
+		source := `* first item
+* second item
+. one
+. two`
+		expected := `.RS 4
+.IP \(bu 2
+first item
+.IP \(bu 2
+second item
+.RS 4
+.IP " 1." 4
+one
+.IP " 2." 4
+two
+.RE
+.RE`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("ordered list with roman numbering and start", func() {
+		source := `[lowerroman,start=3]
+. third
+. fourth`
+		expected := `.RS 4
+.IP " iii." 4
+third
+.IP " iv." 4
+fourth
+.RE`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("listing block with callouts", func() {
+		source := `----
+.start with a dot <1>
+  back\slash -x
+----
+<1> a callout`
+		expected := `.sp
+.if n .RS 4
+.nf
+.fam C
+\&.start with a dot \fB(1)\fP
+  back\(rsslash \-x
+.fam
+.fi
+.if n .RE
+.RS 4
+.IP "(1)" 4
+a callout
+.RE`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("links with trailing punctuation", func() {
+		source := `See https://example.com[the *site*], or
+mailto:john@example.com[John]`
+		expected := `.sp
+See \c
+.URL "https://example.com" "the \fBsite\fP" ","
+or
+\c
+.MTO "john@example.com" "John" ""`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("admonition with hard line break", func() {
+		source := `WARNING: don't do this +
+really.`
+		expected := `.sp
+.RS 4
+.B "Warning"
+.br
+don't do this
+.br
+really.
+.RE`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("quote block with attribution", func() {
+		source := `[quote, John Doe, The Book]
+____
+a quote
+____`
+		expected := `.RS 4
+.sp
+a quote
+.sp
+\(em John Doe, \fIThe Book\fP
+.RE`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("table with header and title", func() {
+		source := `.Options
+|===
+| Name | Value
+
+| a:b | c
+|===`
+		expected := `.sp
+.B "Table 1. Options"
+.br
+.TS
+allbox tab(:);
+ltB ltB
+lt lt.
+T{
+Name
+T}:T{
+Value
+T}
+T{
+a:b
+T}:T{
+c
+T}
+.TE`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+
+	It("footnotes in the notes section", func() {
+		source := `some text.footnote:[a note]`
+		expected := `.sp
+some text.[1]
+.SH "NOTES"
+.IP " 1." 4
+a note`
+		Expect(RenderManpageContent(source)).To(Equal(expected))
+	})
+})
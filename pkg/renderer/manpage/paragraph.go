@@ -0,0 +1,146 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderParagraph(ctx *context, p types.Paragraph) ([]byte, error) {
+	title := p.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		content, err := renderParagraphContent(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return renderAdmonition(ctx, k, title, content), nil
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		return renderVerbatimBlock(title, paragraphLines(p)), nil
+	case types.Verse:
+		content, err := renderParagraphContent(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return renderBlockQuote(p.Attributes, ".sp\n.nf\n"+string(content)+"\n.fi"), nil
+	case types.Quote:
+		content, err := renderParagraphContent(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return renderBlockQuote(p.Attributes, ".sp\n"+string(content)), nil
+	}
+	content, err := renderParagraphContent(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(blockStart(title) + string(content)), nil
+}
+
+// isRegularParagraph returns true if the given paragraph is neither an admonition, a listing, a verse nor a quote
+func isRegularParagraph(p types.Paragraph) bool {
+	if _, ok := p.Attributes[types.AttrAdmonitionKind]; ok {
+		return false
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source, types.Verse, types.Quote:
+		return false
+	}
+	return !p.Attributes.Has(types.AttrTitle)
+}
+
+// renderParagraphContent renders the lines of the given paragraph as roff text lines
+// (with a line break between them if the `hardbreaks` option or attribute is set)
+func renderParagraphContent(ctx *context, p types.Paragraph) ([]byte, error) {
+	hardbreaks := p.Attributes.Has(types.AttrHardBreaks) || ctx.Attributes.Has(types.DocumentAttrHardBreaks)
+	result := bytes.NewBuffer(nil)
+	switch p.Attributes[types.AttrCheckStyle] {
+	case types.Unchecked:
+		result.WriteString(`\(sq `)
+	case types.Checked:
+		result.WriteString(`\(OK `)
+	}
+	for i, line := range p.Lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render paragraph")
+		}
+		result.Write(renderedLine)
+		if i < len(p.Lines)-1 {
+			if hardbreaks {
+				result.WriteString("\n" + macroMarker + ".br")
+			}
+			result.WriteString("\n")
+		}
+	}
+	return []byte(formatText(result.String())), nil
+}
+
+// paragraphLines returns the lines of the given paragraph as verbatim lines
+func paragraphLines(p types.Paragraph) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, len(p.Lines))
+	for i, line := range p.Lines {
+		result[i] = types.VerbatimLine{
+			Content: string(plainText(line)),
+		}
+	}
+	return result
+}
+
+// renderAdmonition renders the label of the admonition (eg: `Note`) in bold, followed by its (indented) content
+func renderAdmonition(ctx *context, kind types.AdmonitionKind, title string, content []byte) []byte {
+	result := bytes.NewBufferString(".sp\n.RS 4\n")
+	result.WriteString(fmt.Sprintf(".B \"%s\"\n.br\n", macroArg(admonitionLabel(ctx, kind))))
+	if title != "" {
+		result.WriteString(fmt.Sprintf(".B \"%s\"\n.br\n", macroArg(title)))
+	}
+	result.Write(content)
+	result.WriteString("\n.RE")
+	return result.Bytes()
+}
+
+func admonitionLabel(ctx *context, kind types.AdmonitionKind) string {
+	switch kind {
+	case types.Tip:
+		return ctx.Attributes.GetLabel(types.AttrTipCaption)
+	case types.Note:
+		return ctx.Attributes.GetLabel(types.AttrNoteCaption)
+	case types.Important:
+		return ctx.Attributes.GetLabel(types.AttrImportantCaption)
+	case types.Warning:
+		return ctx.Attributes.GetLabel(types.AttrWarningCaption)
+	case types.Caution:
+		return ctx.Attributes.GetLabel(types.AttrCautionCaption)
+	default:
+		log.Errorf("unexpected kind of admonition: %v", kind)
+		return ""
+	}
+}
+
+// renderBlockQuote renders a quote or a verse (indented), with its optional attribution
+func renderBlockQuote(attrs types.Attributes, content string) []byte {
+	result := bytes.NewBufferString(renderTitle(attrs.GetAsStringWithDefault(types.AttrTitle, "")))
+	result.WriteString(".RS 4\n" + content)
+	author, hasAuthor := attrs.GetAsString(types.AttrQuoteAuthor)
+	title, hasTitle := attrs.GetAsString(types.AttrQuoteTitle)
+	if hasAuthor || hasTitle {
+		attribution := `\(em`
+		if hasAuthor {
+			attribution += " " + escape(author)
+		}
+		if hasTitle {
+			if hasAuthor {
+				attribution += ","
+			}
+			attribution += ` \fI` + escape(title) + `\fP`
+		}
+		result.WriteString("\n.sp\n" + attribution)
+	}
+	result.WriteString("\n.RE")
+	return result.Bytes()
+}
@@ -0,0 +1,66 @@
+package manpage
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// renderSection renders the sections of level 1 with the `.SH` macro (with an uppercase title),
+// and the sections of the lower levels with the `.SS` macro.
+// The paragraph of the `Name` section is rendered without any vertical space before.
+func renderSection(ctx *context, s types.Section) ([]byte, error) {
+	title := strings.TrimSpace(string(plainText(s.Title)))
+	var content []byte
+	var err error
+	if isNameSection(s) {
+		content, err = renderCompactElements(ctx, s.Elements)
+	} else {
+		content, err = renderer.RenderElements(ctx, s.Elements)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section content")
+	}
+	var result string
+	switch {
+	case s.Attributes.Has(types.AttrDiscrete):
+		// discrete headings are not part of the outline
+		result = renderTitle(title)
+		result = strings.TrimSuffix(result, "\n")
+	case s.Level <= 1:
+		result = `.SH "` + macroArg(strings.ToUpper(title)) + `"`
+	default:
+		result = `.SS "` + macroArg(title) + `"`
+	}
+	if len(content) > 0 {
+		result += "\n" + string(content)
+	}
+	return []byte(result), nil
+}
+
+// renderCompactElements renders the given elements, with the content of the first one rendered
+// without any vertical space before if it is a regular paragraph (eg: in a list item)
+func renderCompactElements(ctx *context, elements []interface{}) ([]byte, error) {
+	if len(elements) == 0 {
+		return []byte{}, nil
+	}
+	p, ok := elements[0].(types.Paragraph)
+	if !ok || !isRegularParagraph(p) {
+		return renderer.RenderElements(ctx, elements)
+	}
+	first, err := renderParagraphContent(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	rest, err := renderer.RenderElements(ctx, elements[1:])
+	if err != nil {
+		return nil, err
+	}
+	result := bytes.NewBuffer(first)
+	appendBlock(result, rest)
+	return result.Bytes(), nil
+}
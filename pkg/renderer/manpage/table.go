@@ -0,0 +1,61 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// renderTable renders the table with the `tbl` preprocessor, in which the content of each cell is a text block
+// (so that it can span multiple lines) and the cells of the header are in bold
+func renderTable(ctx *context, t types.Table) ([]byte, error) {
+	cols := len(t.Header.Cells)
+	if len(t.Lines) > 0 {
+		cols = len(t.Lines[0].Cells)
+	}
+	title := t.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	if title != "" {
+		if caption := ctx.Attributes.GetLabel(types.AttrTableCaption); caption != "" {
+			title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementTableCounter(), title)
+		}
+	}
+	result := bytes.NewBufferString(renderTitle(title))
+	result.WriteString(".TS\nallbox tab(:);\n")
+	if len(t.Header.Cells) > 0 {
+		result.WriteString(strings.TrimSpace(strings.Repeat("ltB ", cols)) + "\n")
+	}
+	result.WriteString(strings.TrimSpace(strings.Repeat("lt ", cols)) + ".\n")
+	if len(t.Header.Cells) > 0 {
+		if err := renderTableRow(ctx, result, t.Header); err != nil {
+			return nil, err
+		}
+	}
+	for _, l := range t.Lines {
+		if err := renderTableRow(ctx, result, l); err != nil {
+			return nil, err
+		}
+	}
+	result.WriteString(".TE")
+	return result.Bytes(), nil
+}
+
+func renderTableRow(ctx *context, result *bytes.Buffer, l types.TableLine) error {
+	cells := make([]string, len(l.Cells))
+	for i, cell := range l.Cells {
+		content, err := renderInlineElements(ctx, cell)
+		if err != nil {
+			return errors.Wrapf(err, "unable to render table")
+		}
+		c := formatText(strings.TrimSpace(string(content)))
+		if c != "" {
+			c += "\n"
+		}
+		cells[i] = "T{\n" + c + "T}"
+	}
+	result.WriteString(strings.Join(cells, ":") + "\n")
+	return nil
+}
@@ -0,0 +1,21 @@
+package markdown
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// context the rendering context of a Markdown document.
+// Besides the common rendering context, it indicates whether the blocks without Markdown equivalent
+// (eg: admonitions and sidebars) are rendered in raw HTML.
+type context struct {
+	renderer.Context
+	html bool
+}
+
+func newContext(ctx renderer.Context) *context {
+	return &context{
+		Context: ctx,
+		html:    ctx.Attributes.Has(types.AttrMarkdownHTML),
+	}
+}
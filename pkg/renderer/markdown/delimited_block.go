@@ -0,0 +1,165 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderDelimitedBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	title := b.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	switch b.Kind {
+	case types.Fenced, types.Source, types.Listing, types.Literal:
+		return renderFencedCodeBlock(b.Attributes, verbatimLines(b.Elements)), nil
+	case types.Example:
+		k, isAdmonition := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind)
+		if isAdmonition && ctx.html {
+			return renderHTML(ctx, b)
+		}
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render example block")
+		}
+		if isAdmonition {
+			return renderAdmonition(ctx, k, title, content, len(b.Elements) > 0 && isRegularParagraph(b.Elements[0])), nil
+		}
+		if title != "" {
+			if caption := ctx.Attributes.GetLabel(types.AttrExampleCaption); caption != "" {
+				title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementExampleBlockCounter(), title)
+			}
+		}
+		return withTitle(title, content), nil
+	case types.Quote, types.MarkdownQuote:
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render quote block")
+		}
+		return renderBlockQuote(b.Attributes, content), nil
+	case types.Verse:
+		return renderVerseBlock(ctx, b)
+	case types.Sidebar:
+		if ctx.html {
+			return renderHTML(ctx, b)
+		}
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render sidebar block")
+		}
+		// the title is part of the quote
+		return []byte(blockquote(string(withTitle(title, content)))), nil
+	case types.Passthrough:
+		lines := verbatimLines(b.Elements)
+		result := make([]string, len(lines))
+		for i, l := range lines {
+			result[i] = l.Content
+		}
+		return []byte(strings.Join(result, "\n")), nil
+	case types.Comment:
+		return []byte{}, nil
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+// renderFencedCodeBlock renders the given lines in a fenced code block, with the language set in the given attributes.
+// The callouts are rendered in parentheses at the end of their line (eg: `(1)`).
+func renderFencedCodeBlock(attrs types.Attributes, lines []types.VerbatimLine) []byte {
+	content := &strings.Builder{}
+	for i, l := range lines {
+		content.WriteString(strings.TrimRight(l.Content, " "))
+		for _, c := range l.Callouts {
+			content.WriteString(fmt.Sprintf(" (%d)", c.Ref))
+		}
+		if i < len(lines)-1 {
+			content.WriteString("\n")
+		}
+	}
+	// the fence must be longer than any sequence of backticks in the content
+	fence := "```"
+	for strings.Contains(content.String(), fence) {
+		fence += "`"
+	}
+	result := bytes.NewBufferString(fence + attrs.GetAsStringWithDefault(types.AttrLanguage, "") + "\n")
+	if content.Len() > 0 {
+		result.WriteString(content.String() + "\n")
+	}
+	result.WriteString(fence)
+	return withTitle(attrs.GetAsStringWithDefault(types.AttrTitle, ""), result.Bytes())
+}
+
+func renderVerseBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, e := range b.Elements {
+		if p, ok := e.(types.Paragraph); ok {
+			content, err := renderParagraphContent(ctx, p, true)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render verse block")
+			}
+			appendBlock(result, content)
+		}
+	}
+	return renderBlockQuote(b.Attributes, result.Bytes()), nil
+}
+
+func renderLiteralBlock(b types.LiteralBlock) []byte {
+	lines := make([]types.VerbatimLine, len(b.Lines))
+	for i, l := range b.Lines {
+		lines[i] = types.VerbatimLine{
+			Content: l,
+		}
+	}
+	if t, found := b.Attributes.GetAsString(types.AttrLiteralBlockType); found && t == types.LiteralBlockWithSpacesOnFirstLine {
+		trimIndentation(lines)
+	}
+	return renderFencedCodeBlock(b.Attributes, lines)
+}
+
+// trimIndentation removes the common leading spaces of the given lines
+func trimIndentation(lines []types.VerbatimLine) {
+	indent := -1
+	for _, l := range lines {
+		if n := len(l.Content) - len(strings.TrimLeft(l.Content, " ")); indent == -1 || n < indent {
+			indent = n
+		}
+	}
+	for i := range lines {
+		lines[i].Content = lines[i].Content[indent:]
+	}
+}
+
+// verbatimLines returns the verbatim lines of a listing (blank lines are returned as empty verbatim lines),
+// without the trailing blank lines
+func verbatimLines(elements []interface{}) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, 0, len(elements))
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.VerbatimLine:
+			result = append(result, e)
+		case types.BlankLine:
+			result = append(result, types.VerbatimLine{})
+		default:
+			log.Warnf("unexpected element of type '%T' in listing", e)
+		}
+	}
+	for len(result) > 0 && result[len(result)-1].IsEmpty() {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+func renderImageBlock(ctx *context, img types.ImageBlock) []byte {
+	title := img.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	if title != "" {
+		if caption := ctx.Attributes.GetLabel(types.AttrFigureCaption); caption != "" {
+			title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementImageCounter(), title)
+		}
+	}
+	return withTitle(title, []byte(renderImage(img.Location, img.Attributes)))
+}
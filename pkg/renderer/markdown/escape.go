@@ -0,0 +1,97 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+// escape escapes the characters which have a special meaning in Markdown
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+var escaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+)
+
+// replacements the textual symbols replaced by their Unicode character
+var replacements = strings.NewReplacer(
+	"(C)", "©",
+	"(TM)", "™",
+	"(R)", "®",
+)
+
+// an ordered list marker at the beginning of a line (eg: `1.` or `1)`)
+var orderedListMarker = regexp.MustCompile(`^(\d+)([.)])`)
+
+// formatText formats the given (already escaped) text as Markdown lines: the leading spaces (which could start
+// an indented code block) are removed, as well as the blank lines, and the characters at the beginning of a line
+// which would start a heading, a quote, a list or a thematic break are escaped
+func formatText(s string) string {
+	lines := strings.Split(s, "\n")
+	result := make([]string, 0, len(lines))
+	for _, l := range lines {
+		l = strings.TrimLeft(l, " \t")
+		if l == "" {
+			continue
+		}
+		if strings.ContainsAny(l[:1], "#>=+-~") {
+			l = `\` + l
+		} else if m := orderedListMarker.FindStringSubmatch(l); m != nil {
+			l = m[1] + `\` + l[len(m[1]):]
+		}
+		result = append(result, l)
+	}
+	return strings.Join(result, "\n")
+}
+
+// indent indents all the non-empty lines of the given text, except the first one
+func indent(s string, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = prefix + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// blockquote prefixes all the lines of the given text with the `>` marker
+func blockquote(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		if l == "" {
+			lines[i] = ">"
+		} else {
+			lines[i] = "> " + l
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// codeSpan returns the given text in a code span, delimited by enough backticks
+// if the text itself contains backticks
+func codeSpan(s string) string {
+	delimiter := "`"
+	for strings.Contains(s, delimiter) {
+		delimiter += "`"
+	}
+	if delimiter != "`" || strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		return delimiter + " " + s + " " + delimiter
+	}
+	return delimiter + s + delimiter
+}
+
+// destination returns the given URL as a link destination (between angle brackets if it contains spaces or parentheses)
+func destination(url string) string {
+	if strings.ContainsAny(url, " ()<>") {
+		return "<" + strings.NewReplacer("<", `\<`, ">", `\>`).Replace(url) + ">"
+	}
+	return url
+}
@@ -0,0 +1,124 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderQuotedText(ctx *context, t types.QuotedText) ([]byte, error) {
+	if t.Kind == types.Monospace {
+		// no markup in a code span
+		return []byte(codeSpan(string(plainText(t.Elements)))), nil
+	}
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	switch t.Kind {
+	case types.Bold:
+		return []byte("**" + string(content) + "**"), nil
+	case types.Italic:
+		return []byte("*" + string(content) + "*"), nil
+	case types.Subscript:
+		if ctx.html {
+			return []byte("<sub>" + string(content) + "</sub>"), nil
+		}
+		return content, nil
+	case types.Superscript:
+		if ctx.html {
+			return []byte("<sup>" + string(content) + "</sup>"), nil
+		}
+		return content, nil
+	default:
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+}
+
+func renderInlinePassthrough(ctx *context, p types.InlinePassthrough) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch e := element.(type) {
+		case types.StringElement:
+			if p.Kind == types.SinglePlusPassthrough {
+				result.WriteString(escape(e.Content))
+			} else {
+				// "string" elements must be rendered as-is, ie, without any escaping.
+				result.WriteString(e.Content)
+			}
+		default:
+			renderedElement, err := renderInlineElement(ctx, e)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			result.Write(renderedElement)
+		}
+	}
+	return result.Bytes(), nil
+}
+
+func renderInternalCrossReference(ctx *context, xref types.InternalCrossReference) ([]byte, error) {
+	log.Debugf("rendering cross reference with ID: %s", xref.ID)
+	label := []byte("[" + escape(xref.ID) + "]")
+	if xref.Label != "" {
+		label = []byte(escape(xref.Label))
+	} else if target, found := ctx.ElementReferences[xref.ID]; found {
+		t, ok := target.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("unable to process internal cross reference to element of type %T", target)
+		}
+		var err error
+		if label, err = renderInlineElements(ctx, t); err != nil {
+			return nil, errors.Wrapf(err, "unable to render internal cross reference")
+		}
+	}
+	return []byte(fmt.Sprintf("[%s](#%s)", label, destination(xref.ID))), nil
+}
+
+func renderExternalCrossReference(ctx *context, xref types.ExternalCrossReference) ([]byte, error) {
+	// the target document is expected to be converted with the same backend
+	loc := xref.Location.String()
+	href := strings.TrimSuffix(loc, filepath.Ext(loc)) + ctx.Attributes.GetAsStringWithDefault(types.AttrOutFileSuffix, ".md")
+	label := []byte(escape(href))
+	if len(xref.Label) > 0 {
+		var err error
+		if label, err = renderInlineElements(ctx, xref.Label); err != nil {
+			return nil, errors.Wrapf(err, "unable to render external cross reference")
+		}
+	}
+	return []byte(fmt.Sprintf("[%s](%s)", label, destination(href))), nil
+}
+
+// renderLink renders a link with its text, or an autolink if it has no text
+func renderLink(ctx *context, l types.InlineLink) ([]byte, error) {
+	href := l.Location.String()
+	t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{})
+	if !ok || len(t) == 0 {
+		return []byte("<" + href + ">"), nil
+	}
+	text, err := renderInlineElements(ctx, t)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render link")
+	}
+	return []byte(fmt.Sprintf("[%s](%s)", text, destination(href))), nil
+}
+
+// renderImage renders an image with its alternate text
+func renderImage(location types.Location, attrs types.Attributes) string {
+	return fmt.Sprintf("![%s](%s)", escape(attrs.GetAsStringWithDefault(types.AttrImageAlt, "")), destination(location.String()))
+}
+
+// renderFootnoteReference renders the reference to the footnote, whose content is rendered at the end of the document
+func renderFootnoteReference(note types.FootnoteReference) []byte {
+	if note.ID == types.InvalidFootnoteReference {
+		log.Warnf("invalid footnote reference: '%s'", note.Ref)
+		return []byte("[" + escape(note.Ref) + "]")
+	}
+	return []byte(fmt.Sprintf("[^%d]", note.ID))
+}
@@ -0,0 +1,124 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// renderUnorderedList renders the list with the `-` marker, and its checklist items as task list items
+func renderUnorderedList(ctx *context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, item := range l.Items {
+		prefix := ""
+		switch item.CheckStyle {
+		case types.Checked:
+			prefix = "[x] "
+		case types.Unchecked:
+			prefix = "[ ] "
+		}
+		if err := renderListItem(ctx, result, "- ", prefix, item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render unordered list")
+		}
+	}
+	return withTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), result.Bytes()), nil
+}
+
+// renderOrderedList renders the list with the `1.` markers (the other numbering styles are not supported in Markdown)
+func renderOrderedList(ctx *context, l types.OrderedList) ([]byte, error) {
+	start := 1
+	if s, found := l.Attributes.GetAsString(types.AttrStart); found {
+		if n, err := strconv.Atoi(s); err == nil {
+			start = n
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	for i, item := range l.Items {
+		if err := renderListItem(ctx, result, fmt.Sprintf("%d. ", start+i), "", item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render ordered list")
+		}
+	}
+	return withTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), result.Bytes()), nil
+}
+
+// renderLabeledList renders the list as an unordered list, in which each item starts with the term in bold,
+// followed by a colon and the description
+func renderLabeledList(ctx *context, l types.LabeledList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, item := range l.Items {
+		term, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+		t := strings.TrimSpace(string(term))
+		if !strings.HasPrefix(t, "**") || !strings.HasSuffix(t, "**") {
+			t = "**" + t + "**"
+		}
+		if elements := item.Elements; len(elements) > 0 && isRegularParagraph(elements[0]) {
+			t += ": "
+		} else if len(elements) > 0 {
+			// the other blocks of the description are rendered after a blank line
+			t += "\n\n"
+		}
+		if err := renderListItem(ctx, result, "- ", t, item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+	}
+	return withTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), result.Bytes()), nil
+}
+
+// renderCalloutList renders the list as an ordered list, numbered after the callouts
+func renderCalloutList(ctx *context, l types.CalloutList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, item := range l.Items {
+		if err := renderListItem(ctx, result, fmt.Sprintf("%d. ", item.Ref), "", item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render callout list")
+		}
+	}
+	return withTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), result.Bytes()), nil
+}
+
+// renderListItem renders the given marker (eg: `-`) and prefix (eg: `[x]`), followed by the content of the item,
+// in which the lines are indented after the marker.
+// A nested list immediately follows the text of the item, so that the list remains "tight".
+func renderListItem(ctx *context, result *bytes.Buffer, marker, prefix string, elements []interface{}) error {
+	content := bytes.NewBufferString(prefix)
+	for i, e := range elements {
+		renderedElement, err := renderer.RenderElement(ctx, e)
+		if err != nil {
+			return err
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if i > 0 {
+			if isList(e) && isRegularParagraph(elements[i-1]) {
+				content.WriteString("\n")
+			} else {
+				content.WriteString("\n\n")
+			}
+		}
+		content.Write(renderedElement)
+	}
+	if result.Len() > 0 {
+		result.WriteString("\n")
+	}
+	result.WriteString(marker + indent(content.String(), strings.Repeat(" ", len(marker))))
+	return nil
+}
+
+// isList returns true if the given element is a list
+func isList(element interface{}) bool {
+	switch element.(type) {
+	case types.UnorderedList, types.OrderedList, types.LabeledList, types.CalloutList:
+		return true
+	default:
+		return false
+	}
+}
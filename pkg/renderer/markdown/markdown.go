@@ -0,0 +1,139 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// registers the `markdown` backend
+func init() {
+	renderer.Register("markdown", renderer.RenderFunc(Render), map[string]string{
+		types.AttrBaseBackend:   "markdown",
+		types.AttrOutFileSuffix: ".md",
+		types.AttrFileType:      "markdown",
+	})
+}
+
+// Render renders the given document in Markdown (GitHub Flavored) and writes the result in the given `writer`
+func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	c := newContext(ctx)
+	header, hasHeader := doc.Header()
+	elements := doc.Elements
+	result := bytes.NewBuffer(nil)
+	if hasHeader {
+		if ctx.Config.IncludeHeaderFooter {
+			title, err := renderInlineElements(c, header.Title)
+			if err != nil {
+				return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+			}
+			result.WriteString("# " + string(title))
+		}
+		elements = append(append([]interface{}{}, header.Elements...), doc.Elements[1:]...)
+	}
+	renderedContent, err := renderer.RenderElements(c, elements)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	appendBlock(result, renderedContent)
+	footnotes, err := renderFootnotes(c)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	appendBlock(result, footnotes)
+	if ctx.Config.IncludeHeaderFooter {
+		log.Debugf("Rendering full document...")
+		result.WriteString("\n")
+	}
+	if _, err := output.Write(result.Bytes()); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	authors, _ := doc.Authors()
+	revision, _ := doc.Revision()
+	return types.Metadata{
+		Title:    string(plainText(header.Title)),
+		Authors:  authors,
+		Revision: revision,
+		Warnings: doc.Warnings,
+	}, nil
+}
+
+// appendBlock appends the given block (if not empty) to the given buffer, after a blank line
+func appendBlock(result *bytes.Buffer, block []byte) {
+	if len(block) == 0 {
+		return
+	}
+	if result.Len() > 0 {
+		result.WriteString("\n\n")
+	}
+	result.Write(block)
+}
+
+// renderFootnotes renders the definitions of the footnotes of the document
+func renderFootnotes(ctx *context) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, f := range ctx.Footnotes {
+		content, err := renderInlineElements(ctx, f.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnote")
+		}
+		if result.Len() > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString(fmt.Sprintf("[^%d]: %s", f.ID, strings.TrimSpace(string(content))))
+	}
+	return result.Bytes(), nil
+}
+
+// renderHTML renders the given element with the `html5` backend, for the blocks without Markdown equivalent
+// when the `markdown-html` attribute is set
+func renderHTML(ctx *context, element interface{}) ([]byte, error) {
+	c := ctx.Context
+	c.Config.IncludeHeaderFooter = false
+	attrs := types.Attributes{}
+	for k, v := range ctx.Attributes {
+		attrs[k] = v
+	}
+	// the element is rendered as part of a regular article
+	attrs[types.AttrDocType] = "article"
+	result := bytes.NewBuffer(nil)
+	if _, err := html5.Render(c, types.Document{
+		Attributes: attrs,
+		Elements:   []interface{}{element},
+	}, result); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(result.Bytes()), nil
+}
+
+// plainText returns the text of the given inline elements, without any markup
+func plainText(elements []interface{}) []byte {
+	result := bytes.NewBuffer(nil)
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.QuotedText:
+			result.Write(plainText(e.Elements))
+		case types.InlinePassthrough:
+			result.Write(plainText(e.Elements))
+		case types.IndexTerm:
+			result.Write(plainText(e.Term))
+		case types.InlineLink:
+			if text, ok := e.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+				result.Write(plainText(text))
+			} else {
+				result.WriteString(e.Location.String())
+			}
+		}
+	}
+	return result.Bytes()
+}
@@ -0,0 +1,196 @@
+package markdown_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("markdown documents", func() {
+
+	It("document with title, sections and footnotes", func() {
+		source := `= Document Title
+John Doe
+
+Intro with *bold*, _italic_, ` + "`code`" + ` and a snake_case name.footnote:[A note.]
+
+== Section A
+
+=== Section B
+
+See https://example.com[the site], https://example.org or <<_section_a>>.
+# not a heading`
+		expected := `# Document Title
+
+Intro with **bold**, *italic*, ` + "`code`" + ` and a snake\_case name.[^1]
+
+## Section A
+
+### Section B
+
+See [the site](https://example.com), <https://example.org> or [Section A](#_section_a).
+\# not a heading
+
+[^1]: A note.
+`
+		Expect(RenderMarkdown(source)).To(Equal(expected))
+	})
+
+	It("nested lists", func() {
+		source := `* first
+** nested
+* second
+. one
+. two`
+		expected := `- first
+  - nested
+- second
+  1. one
+  2. two`
+		Expect(RenderMarkdownContent(source)).To(Equal(expected))
+	})
+
+	It("task list", func() {
+		source := `* [x] done
+* [ ] todo`
+		expected := `- [x] done
+- [ ] todo`
+		Expect(RenderMarkdownContent(source)).To(Equal(expected))
+	})
+
+	It("ordered list with start", func() {
+		source := `[start=3]
+. three
+. four`
+		expected := `3. three
+4. four`
+		Expect(RenderMarkdownContent(source)).To(Equal(expected))
+	})
+
+	It("labeled list", func() {
+		source := `CPU:: The brain.
+RAM::
++
+----
+$ free -h
+----`
+		expected := "- **CPU**: The brain.\n" +
+			"- **RAM**\n" +
+			"\n" +
+			"  ```\n" +
+			"  $ free -h\n" +
+			"  ```"
+		Expect(RenderMarkdownContent(source)).To(Equal(expected))
+	})
+
+	It("source block with callouts", func() {
+		source := `.Main
+[source,go]
+----
+func main() { // <1>
+	fmt.Println("` + "```" + `")
+}
+----
+<1> entry point`
+		expected := "**Main**\n" +
+			"\n" +
+			"````go\n" +
+			"func main() { // (1)\n" +
+			"\tfmt.Println(\"```\")\n" +
+			"}\n" +
+			"````\n" +
+			"\n" +
+			"1. entry point"
+		Expect(RenderMarkdownContent(source)).To(Equal(expected))
+	})
+
+	It("table with header and title", func() {
+		source := `.Stats
+|===
+| Name | Value
+
+| a | ` + "`c|d`" + `
+|===`
+		expected := `**Table 1. Stats**
+
+| Name | Value |
+| --- | --- |
+| a | ` + "`c\\|d`" + ` |`
+		Expect(RenderMarkdownContent(source)).To(Equal(expected))
+	})
+
+	It("table without header", func() {
+		source := `|===
+| a | b
+|===`
+		expected := `|  |  |
+| --- | --- |
+| a | b |`
+		Expect(RenderMarkdownContent(source)).To(Equal(expected))
+	})
+
+	It("quote and verse blocks", func() {
+		source := `[quote, Jane Doe, The Book]
+____
+To be or not to be.
+____
+
+[verse, Poet]
+____
+line one
+line two
+____`
+		expected := `> To be or not to be.
+>
+> — Jane Doe, *The Book*
+
+> line one\
+> line two
+>
+> — Poet`
+		Expect(RenderMarkdownContent(source)).To(Equal(expected))
+	})
+
+	It("image block with title", func() {
+		source := `.A cookie
+image::cookie.png[Cookie]`
+		expected := `**Figure 1. A cookie**
+
+![Cookie](cookie.png)`
+		Expect(RenderMarkdownContent(source)).To(Equal(expected))
+	})
+
+	Context("blocks without markdown equivalent", func() {
+
+		source := `NOTE: Be careful.
+
+.Side
+****
+A sidebar
+****`
+
+		It("admonition and sidebar as quotes", func() {
+			expected := `> **Note:** Be careful.
+
+> **Side**
+>
+> A sidebar`
+			Expect(RenderMarkdownContent(source)).To(Equal(expected))
+		})
+
+		It("admonition and sidebar in raw HTML", func() {
+			result, err := RenderMarkdownContent(source, configuration.WithAttribute("markdown-html", ""))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ContainSubstring(`<div class="admonitionblock note">`))
+			Expect(result).To(ContainSubstring(`<div class="sidebarblock">`))
+			Expect(result).NotTo(ContainSubstring("**Note:**"))
+		})
+
+		It("subscript and superscript in raw HTML", func() {
+			Expect(RenderMarkdownContent("H~2~O and E=mc^2^")).To(Equal("H2O and E=mc2"))
+			Expect(RenderMarkdownContent("H~2~O and E=mc^2^", configuration.WithAttribute("markdown-html", ""))).To(Equal("H<sub>2</sub>O and E=mc<sup>2</sup>"))
+		})
+	})
+})
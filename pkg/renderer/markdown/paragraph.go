@@ -0,0 +1,165 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// renderSection renders the section with an ATX heading (the title of the document being the level-1 heading)
+func renderSection(ctx *context, s types.Section) ([]byte, error) {
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section title")
+	}
+	content, err := renderer.RenderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section content")
+	}
+	result := bytes.NewBufferString(strings.Repeat("#", s.Level+1) + " " + strings.TrimSpace(string(title)))
+	appendBlock(result, content)
+	return result.Bytes(), nil
+}
+
+func renderParagraph(ctx *context, p types.Paragraph) ([]byte, error) {
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		if ctx.html {
+			return renderHTML(ctx, p)
+		}
+		content, err := renderParagraphContent(ctx, p, false)
+		if err != nil {
+			return nil, err
+		}
+		return renderAdmonition(ctx, k, p.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), content, true), nil
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		return renderFencedCodeBlock(p.Attributes, paragraphLines(p)), nil
+	case types.Verse:
+		content, err := renderParagraphContent(ctx, p, true)
+		if err != nil {
+			return nil, err
+		}
+		return renderBlockQuote(p.Attributes, content), nil
+	case types.Quote:
+		content, err := renderParagraphContent(ctx, p, false)
+		if err != nil {
+			return nil, err
+		}
+		return renderBlockQuote(p.Attributes, content), nil
+	}
+	content, err := renderParagraphContent(ctx, p, false)
+	if err != nil {
+		return nil, err
+	}
+	return withTitle(p.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), content), nil
+}
+
+// isRegularParagraph returns true if the given paragraph is neither an admonition, a listing, a verse nor a quote,
+// and if it has no title
+func isRegularParagraph(element interface{}) bool {
+	p, ok := element.(types.Paragraph)
+	if !ok {
+		return false
+	}
+	if _, ok := p.Attributes[types.AttrAdmonitionKind]; ok {
+		return false
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source, types.Verse, types.Quote:
+		return false
+	}
+	return !p.Attributes.Has(types.AttrTitle)
+}
+
+// renderParagraphContent renders the lines of the given paragraph
+// (with a hard line break between them if the `hardbreaks` option or attribute is set, or if requested)
+func renderParagraphContent(ctx *context, p types.Paragraph, hardbreaks bool) ([]byte, error) {
+	hardbreaks = hardbreaks || p.Attributes.Has(types.AttrHardBreaks) || ctx.Attributes.Has(types.DocumentAttrHardBreaks)
+	result := bytes.NewBuffer(nil)
+	for i, line := range p.Lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render paragraph")
+		}
+		result.Write(renderedLine)
+		if i < len(p.Lines)-1 {
+			if hardbreaks {
+				result.WriteString(`\`)
+			}
+			result.WriteString("\n")
+		}
+	}
+	return []byte(formatText(result.String())), nil
+}
+
+// paragraphLines returns the lines of the given paragraph as verbatim lines
+func paragraphLines(p types.Paragraph) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, len(p.Lines))
+	for i, line := range p.Lines {
+		result[i] = types.VerbatimLine{
+			Content: string(plainText(line)),
+		}
+	}
+	return result
+}
+
+// renderAdmonition renders the admonition as a quote, starting with its label in bold (eg: `**Note:**`),
+// on the same line as the content if the latter starts with some text
+func renderAdmonition(ctx *context, kind types.AdmonitionKind, title string, content []byte, inline bool) []byte {
+	result := bytes.NewBufferString("**" + escape(admonitionLabel(ctx, kind)) + ":**")
+	if title != "" {
+		result.WriteString(" " + renderTitle(title))
+	}
+	if inline && title == "" {
+		result.WriteString(" ")
+		result.Write(content)
+	} else {
+		appendBlock(result, content)
+	}
+	return []byte(blockquote(result.String()))
+}
+
+func admonitionLabel(ctx *context, kind types.AdmonitionKind) string {
+	switch kind {
+	case types.Tip:
+		return ctx.Attributes.GetLabel(types.AttrTipCaption)
+	case types.Note:
+		return ctx.Attributes.GetLabel(types.AttrNoteCaption)
+	case types.Important:
+		return ctx.Attributes.GetLabel(types.AttrImportantCaption)
+	case types.Warning:
+		return ctx.Attributes.GetLabel(types.AttrWarningCaption)
+	case types.Caution:
+		return ctx.Attributes.GetLabel(types.AttrCautionCaption)
+	default:
+		log.Errorf("unexpected kind of admonition: %v", kind)
+		return ""
+	}
+}
+
+// renderBlockQuote renders a quote or a verse in a blockquote, with its optional title and attribution
+func renderBlockQuote(attrs types.Attributes, content []byte) []byte {
+	result := bytes.NewBuffer(content)
+	author, hasAuthor := attrs.GetAsString(types.AttrQuoteAuthor)
+	title, hasTitle := attrs.GetAsString(types.AttrQuoteTitle)
+	if hasAuthor || hasTitle {
+		attribution := "—"
+		if hasAuthor {
+			attribution += " " + escape(author)
+		}
+		if hasTitle {
+			if hasAuthor {
+				attribution += ","
+			}
+			attribution += " *" + escape(title) + "*"
+		}
+		appendBlock(result, []byte(attribution))
+	}
+	return withTitle(attrs.GetAsStringWithDefault(types.AttrTitle, ""), []byte(blockquote(result.String())))
+}
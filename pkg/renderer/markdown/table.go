@@ -0,0 +1,58 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// renderTable renders the table as a pipe table. Since the header row is mandatory,
+// a table without header has a header row with empty cells
+func renderTable(ctx *context, t types.Table) ([]byte, error) {
+	cols := len(t.Header.Cells)
+	if len(t.Lines) > 0 {
+		cols = len(t.Lines[0].Cells)
+	}
+	title := t.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	if title != "" {
+		if caption := ctx.Attributes.GetLabel(types.AttrTableCaption); caption != "" {
+			title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementTableCounter(), title)
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	header := t.Header
+	if len(header.Cells) == 0 {
+		header = types.TableLine{
+			Cells: make([][]interface{}, cols),
+		}
+	}
+	if err := renderTableRow(ctx, result, header); err != nil {
+		return nil, err
+	}
+	result.WriteString("\n|" + strings.Repeat(" --- |", cols))
+	for _, l := range t.Lines {
+		result.WriteString("\n")
+		if err := renderTableRow(ctx, result, l); err != nil {
+			return nil, err
+		}
+	}
+	return withTitle(title, result.Bytes()), nil
+}
+
+// renderTableRow renders the cells of the row on a single line, in which the pipes are escaped
+func renderTableRow(ctx *context, result *bytes.Buffer, l types.TableLine) error {
+	result.WriteString("|")
+	for _, cell := range l.Cells {
+		content, err := renderInlineElements(ctx, cell)
+		if err != nil {
+			return errors.Wrapf(err, "unable to render table")
+		}
+		c := strings.Join(strings.Fields(strings.ReplaceAll(string(content), "|", `\|`)), " ")
+		result.WriteString(" " + c + " |")
+	}
+	return nil
+}
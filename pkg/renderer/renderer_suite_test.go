@@ -0,0 +1,15 @@
+package renderer_test
+
+import (
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestRenderer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Renderer Suite")
+}
@@ -0,0 +1,69 @@
+package renderer
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// TemplateExtension the extension of the user-supplied templates (eg: `paragraph.tmpl`)
+const TemplateExtension = ".tmpl"
+
+// Templates the user-supplied templates which override the built-in templates of a backend,
+// indexed by the kind of element they render (eg: `paragraph`)
+type Templates map[string]*texttemplate.Template
+
+// LoadTemplates loads and parses the templates of the given kinds of elements in the root directory of the given filesystem,
+// i.e., the `<kind>.tmpl` files (eg: `paragraph.tmpl` for the `paragraph` kind), with the given functions.
+// The kinds without a template in the filesystem are ignored (the built-in templates apply),
+// as well as the files which do not match any kind (with a warning).
+func LoadTemplates(fsys fs.FS, kinds []string, funcs texttemplate.FuncMap) (Templates, error) {
+	result := Templates{}
+	if fsys == nil {
+		return result, nil
+	}
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load the templates")
+	}
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != TemplateExtension {
+			continue
+		}
+		kind := strings.TrimSuffix(e.Name(), TemplateExtension)
+		if !contains(kinds, kind) {
+			log.Warnf("ignoring template '%s': unsupported kind of element (expected one of %v)", e.Name(), kinds)
+			continue
+		}
+		src, err := fs.ReadFile(fsys, e.Name())
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load the '%s' template", e.Name())
+		}
+		t, err := texttemplate.New(e.Name()).Funcs(funcs).Parse(string(src))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse the '%s' template", e.Name())
+		}
+		log.Debugf("loaded the '%s' template", e.Name())
+		result[kind] = t
+	}
+	return result, nil
+}
+
+// Lookup returns the template of the given kind of element, if it exists
+func (t Templates) Lookup(kind string) (*texttemplate.Template, bool) {
+	tmpl, found := t[kind]
+	return tmpl, found
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package text
+
+import (
+	"strconv"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultWidth the default maximum width of the lines
+const defaultWidth = 80
+
+// context the rendering context of a plain text document.
+// Besides the common rendering context, it holds the width available for the lines of the current block,
+// which decreases as the blocks are indented (eg: in a list item).
+type context struct {
+	renderer.Context
+	width int
+}
+
+func newContext(ctx renderer.Context) *context {
+	width := defaultWidth
+	if w, found := ctx.Attributes.GetAsString(types.AttrTextWidth); found {
+		if n, err := strconv.Atoi(w); err == nil && n >= 0 {
+			width = n
+		} else {
+			log.Warnf("invalid value for the '%s' attribute: '%s'", types.AttrTextWidth, w)
+		}
+	}
+	return &context{
+		Context: ctx,
+		width:   width,
+	}
+}
+
+// indent reduces the width of the lines of the blocks that will be indented with the given number of spaces,
+// and returns the func to restore the initial width
+func (ctx *context) indent(n int) func() {
+	width := ctx.width
+	if width > 0 {
+		// the lines keep at least a single word
+		ctx.width = width - n
+		if ctx.width < 1 {
+			ctx.width = 1
+		}
+	}
+	return func() {
+		ctx.width = width
+	}
+}
@@ -0,0 +1,144 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderDelimitedBlock(ctx *context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	title := b.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	switch b.Kind {
+	case types.Fenced, types.Source, types.Listing, types.Literal:
+		return renderVerbatimBlock(b.Attributes, verbatimLines(b.Elements)), nil
+	case types.Example:
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			content, err := renderIndentedElements(ctx, b.Elements)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render admonition block")
+			}
+			return renderAdmonition(ctx, k, title, content), nil
+		}
+		content, err := renderer.RenderElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render example block")
+		}
+		if title != "" {
+			if caption := ctx.Attributes.GetLabel(types.AttrExampleCaption); caption != "" {
+				title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementExampleBlockCounter(), title)
+			}
+		}
+		return withTitle(title, content), nil
+	case types.Quote, types.MarkdownQuote:
+		return renderBlockQuote(ctx, b.Attributes, b.Elements, false)
+	case types.Verse:
+		return renderBlockQuote(ctx, b.Attributes, b.Elements, true)
+	case types.Sidebar:
+		content, err := renderIndentedElements(ctx, b.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render sidebar block")
+		}
+		return withTitle(title, []byte(indentBlock(string(content), 4))), nil
+	case types.Passthrough:
+		// the HTML tags are stripped, as well as the lines which only contained tags
+		lines := verbatimLines(b.Elements)
+		result := make([]string, 0, len(lines))
+		for _, l := range lines {
+			if c := strings.TrimRight(stripHTML(l.Content), " \t"); strings.TrimSpace(c) != "" {
+				result = append(result, c)
+			}
+		}
+		return []byte(strings.Join(result, "\n")), nil
+	case types.Comment:
+		return []byte{}, nil
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+// renderIndentedElements renders the given elements, whose lines will be indented with 4 spaces
+func renderIndentedElements(ctx *context, elements []interface{}) ([]byte, error) {
+	defer ctx.indent(4)()
+	return renderer.RenderElements(ctx, elements)
+}
+
+// renderVerbatimBlock renders the given lines indented with 4 spaces, and without wrapping.
+// The callouts are rendered in parentheses at the end of their line (eg: `(1)`).
+func renderVerbatimBlock(attrs types.Attributes, lines []types.VerbatimLine) []byte {
+	result := &strings.Builder{}
+	for i, l := range lines {
+		line := strings.TrimRight(l.Content, " ")
+		for _, c := range l.Callouts {
+			line += fmt.Sprintf(" (%d)", c.Ref)
+		}
+		if line != "" {
+			result.WriteString("    " + line)
+		}
+		if i < len(lines)-1 {
+			result.WriteString("\n")
+		}
+	}
+	return withTitle(attrs.GetAsStringWithDefault(types.AttrTitle, ""), []byte(result.String()))
+}
+
+func renderLiteralBlock(b types.LiteralBlock) []byte {
+	lines := make([]types.VerbatimLine, len(b.Lines))
+	for i, l := range b.Lines {
+		lines[i] = types.VerbatimLine{
+			Content: l,
+		}
+	}
+	if t, found := b.Attributes.GetAsString(types.AttrLiteralBlockType); found && t == types.LiteralBlockWithSpacesOnFirstLine {
+		trimIndentation(lines)
+	}
+	return renderVerbatimBlock(b.Attributes, lines)
+}
+
+// trimIndentation removes the common leading spaces of the given lines
+func trimIndentation(lines []types.VerbatimLine) {
+	indent := -1
+	for _, l := range lines {
+		if n := len(l.Content) - len(strings.TrimLeft(l.Content, " ")); indent == -1 || n < indent {
+			indent = n
+		}
+	}
+	for i := range lines {
+		lines[i].Content = lines[i].Content[indent:]
+	}
+}
+
+// verbatimLines returns the verbatim lines of a listing (blank lines are returned as empty verbatim lines),
+// without the trailing blank lines
+func verbatimLines(elements []interface{}) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, 0, len(elements))
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.VerbatimLine:
+			result = append(result, e)
+		case types.BlankLine:
+			result = append(result, types.VerbatimLine{})
+		default:
+			log.Warnf("unexpected element of type '%T' in listing", e)
+		}
+	}
+	for len(result) > 0 && result[len(result)-1].IsEmpty() {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+func renderImageBlock(ctx *context, img types.ImageBlock) []byte {
+	title := img.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	if title != "" {
+		if caption := ctx.Attributes.GetLabel(types.AttrFigureCaption); caption != "" {
+			title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementImageCounter(), title)
+		}
+	}
+	return withTitle(title, []byte(renderImage(img.Attributes)))
+}
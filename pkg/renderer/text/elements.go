@@ -0,0 +1,132 @@
+package text
+
+import (
+	"bytes"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// the methods of the `renderer.BlockRenderer` interface, which render the blocks traversed by `renderer.RenderElements`
+
+// BlockSeparator returns a blank line
+func (ctx *context) BlockSeparator() string {
+	return "\n\n"
+}
+
+func (ctx *context) RenderSection(s types.Section) ([]byte, error) {
+	return renderSection(ctx, s)
+}
+
+func (ctx *context) RenderPreamble(p types.Preamble) ([]byte, error) {
+	return renderer.RenderElements(ctx, p.Elements)
+}
+
+func (ctx *context) RenderLabeledList(l types.LabeledList) ([]byte, error) {
+	return renderLabeledList(ctx, l)
+}
+
+func (ctx *context) RenderOrderedList(l types.OrderedList) ([]byte, error) {
+	return renderOrderedList(ctx, l)
+}
+
+func (ctx *context) RenderUnorderedList(l types.UnorderedList) ([]byte, error) {
+	return renderUnorderedList(ctx, l)
+}
+
+func (ctx *context) RenderCalloutList(l types.CalloutList) ([]byte, error) {
+	return renderCalloutList(ctx, l)
+}
+
+func (ctx *context) RenderParagraph(p types.Paragraph) ([]byte, error) {
+	return renderParagraph(ctx, p)
+}
+
+func (ctx *context) RenderImageBlock(img types.ImageBlock) ([]byte, error) {
+	return renderImageBlock(ctx, img), nil
+}
+
+func (ctx *context) RenderDelimitedBlock(b types.DelimitedBlock) ([]byte, error) {
+	return renderDelimitedBlock(ctx, b)
+}
+
+func (ctx *context) RenderTable(t types.Table) ([]byte, error) {
+	return renderTable(ctx, t)
+}
+
+func (ctx *context) RenderLiteralBlock(b types.LiteralBlock) ([]byte, error) {
+	return renderLiteralBlock(b), nil
+}
+
+func (ctx *context) RenderBlockMacro(m types.UserMacro) ([]byte, error) {
+	return []byte(wrap(m.RawText, ctx.width)), nil
+}
+
+func (ctx *context) RenderInlineElement(element interface{}) ([]byte, error) {
+	return renderInlineElement(ctx, element)
+}
+
+// renderInlineElements renders the given inline elements, without any separator
+func renderInlineElements(ctx *context, elements []interface{}) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderInlineElement(ctx, element)
+		if err != nil {
+			return nil, err
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// nolint: gocyclo
+func renderInlineElement(ctx *context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderInlineElements(ctx, e)
+	case types.StringElement:
+		return []byte(replacements.Replace(e.Content)), nil
+	case types.QuotedText:
+		return renderInlineElements(ctx, e.Elements)
+	case types.InlinePassthrough:
+		return renderInlinePassthrough(ctx, e)
+	case types.InternalCrossReference:
+		return renderInternalCrossReference(ctx, e)
+	case types.ExternalCrossReference:
+		return renderExternalCrossReference(ctx, e)
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.InlineImage:
+		return []byte(renderImage(e.Attributes)), nil
+	case types.FootnoteReference:
+		return renderFootnoteReference(e), nil
+	case types.IndexTerm:
+		return renderInlineElements(ctx, e.Term)
+	case types.ConcealedIndexTerm, types.SingleLineComment:
+		// there is no index in a plain text document
+		return []byte{}, nil
+	case types.LineBreak:
+		return []byte("\n"), nil
+	case types.UserMacro:
+		return []byte(e.RawText), nil
+	case types.VerbatimLine:
+		return []byte(e.Content), nil
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// withTitle returns the given block, preceded by its title (if any) on its own line
+func withTitle(title string, block []byte) []byte {
+	if title == "" {
+		return block
+	}
+	result := bytes.NewBufferString(title)
+	if len(block) > 0 {
+		result.WriteString("\n")
+		result.Write(block)
+	}
+	return result.Bytes()
+}
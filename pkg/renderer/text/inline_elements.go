@@ -0,0 +1,107 @@
+package text
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// renderInlinePassthrough renders the content of the passthrough, in which the HTML tags are stripped
+// (except for the `+text+` passthrough, whose content is not meant to be HTML)
+func renderInlinePassthrough(ctx *context, p types.InlinePassthrough) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch e := element.(type) {
+		case types.StringElement:
+			if p.Kind == types.SinglePlusPassthrough {
+				result.WriteString(e.Content)
+			} else {
+				result.WriteString(stripHTML(e.Content))
+			}
+		default:
+			renderedElement, err := renderInlineElement(ctx, e)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			result.Write(renderedElement)
+		}
+	}
+	return result.Bytes(), nil
+}
+
+var htmlTagRegexp = regexp.MustCompile(`<[^<>]*>`)
+
+// stripHTML removes the HTML tags of the given content, and replaces the character references
+// with their actual character
+func stripHTML(s string) string {
+	return html.UnescapeString(htmlTagRegexp.ReplaceAllString(s, ""))
+}
+
+func renderInternalCrossReference(ctx *context, xref types.InternalCrossReference) ([]byte, error) {
+	log.Debugf("rendering cross reference with ID: %s", xref.ID)
+	if xref.Label != "" {
+		return []byte(xref.Label), nil
+	}
+	if target, found := ctx.ElementReferences[xref.ID]; found {
+		t, ok := target.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("unable to process internal cross reference to element of type %T", target)
+		}
+		label, err := renderInlineElements(ctx, t)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render internal cross reference")
+		}
+		return label, nil
+	}
+	return []byte("[" + xref.ID + "]"), nil
+}
+
+func renderExternalCrossReference(ctx *context, xref types.ExternalCrossReference) ([]byte, error) {
+	if len(xref.Label) == 0 {
+		return []byte(xref.Location.String()), nil
+	}
+	label, err := renderInlineElements(ctx, xref.Label)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render external cross reference")
+	}
+	return label, nil
+}
+
+// renderLink renders a link with its text, followed by its URL in angle brackets (eg: `the site <https://example.com>`),
+// or only its URL if it has no text
+func renderLink(ctx *context, l types.InlineLink) ([]byte, error) {
+	href := l.Location.String()
+	t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{})
+	if !ok || len(t) == 0 {
+		return []byte(strings.TrimPrefix(href, "mailto:")), nil
+	}
+	text, err := renderInlineElements(ctx, t)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render link")
+	}
+	if string(text) == href || "mailto:"+string(text) == href {
+		return text, nil
+	}
+	return []byte(fmt.Sprintf("%s <%s>", text, href)), nil
+}
+
+// renderImage renders the alternate text of an image, in brackets
+func renderImage(attrs types.Attributes) string {
+	return "[" + attrs.GetAsStringWithDefault(types.AttrImageAlt, "") + "]"
+}
+
+// renderFootnoteReference renders the number of the footnote in brackets (the footnote itself is rendered at the end of the document)
+func renderFootnoteReference(note types.FootnoteReference) []byte {
+	if note.ID == types.InvalidFootnoteReference {
+		log.Warnf("invalid footnote reference: '%s'", note.Ref)
+		return []byte("[" + note.Ref + "]")
+	}
+	return []byte(fmt.Sprintf("[%d]", note.ID))
+}
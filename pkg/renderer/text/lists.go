@@ -0,0 +1,202 @@
+package text
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// bullets the bullets of the unordered lists, by level of nesting
+var bullets = []string{"*", "-", "+"}
+
+// renderUnorderedList renders the items of the list after a bullet which depends on the level of nesting,
+// and the checklist items after a box (eg: `[x]`)
+func renderUnorderedList(ctx *context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, item := range l.Items {
+		prefix := ""
+		switch item.CheckStyle {
+		case types.Checked:
+			prefix = "[x] "
+		case types.Unchecked:
+			prefix = "[ ] "
+		}
+		if err := renderListItem(ctx, result, bullet(item.Level)+" "+prefix, item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render unordered list")
+		}
+	}
+	return withTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), result.Bytes()), nil
+}
+
+// bullet returns the bullet of the items at the given level
+func bullet(level int) string {
+	if level < 1 {
+		level = 1
+	}
+	return bullets[(level-1)%len(bullets)]
+}
+
+// renderOrderedList renders the items of the list after their number, in the numbering style of the list
+func renderOrderedList(ctx *context, l types.OrderedList) ([]byte, error) {
+	numbering := types.Arabic
+	if s, found := l.Attributes.GetAsString(types.AttrNumberingStyle); found {
+		numbering = types.NumberingStyle(s)
+	} else if len(l.Items) > 0 {
+		numbering = l.Items[0].NumberingStyle
+	}
+	start := 1
+	if s, found := l.Attributes.GetAsString(types.AttrStart); found {
+		if n, err := strconv.Atoi(s); err == nil {
+			start = n
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	for i, item := range l.Items {
+		if err := renderListItem(ctx, result, itemNumber(numbering, start+i)+". ", item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render ordered list")
+		}
+	}
+	return withTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), result.Bytes()), nil
+}
+
+// itemNumber returns the number of an item in an ordered list with the given numbering style
+// (the greek numberings are not supported, so they fall back to arabic)
+func itemNumber(s types.NumberingStyle, n int) string {
+	switch s {
+	case types.LowerAlpha:
+		return alpha(n)
+	case types.UpperAlpha:
+		return strings.ToUpper(alpha(n))
+	case types.LowerRoman:
+		return strings.ToLower(roman(n))
+	case types.UpperRoman:
+		return roman(n)
+	default:
+		return strconv.Itoa(n)
+	}
+}
+
+// alpha returns the given (positive) number in the `a`, `b`, ..., `z`, `aa`, `ab`, ... sequence
+func alpha(n int) string {
+	if n <= 0 {
+		return strconv.Itoa(n)
+	}
+	result := ""
+	for ; n > 0; n = (n - 1) / 26 {
+		result = string(rune('a'+(n-1)%26)) + result
+	}
+	return result
+}
+
+// roman returns the given (positive) number in uppercase roman numerals
+func roman(n int) string {
+	if n <= 0 {
+		return strconv.Itoa(n)
+	}
+	values := []int{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	symbols := []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+	result := &strings.Builder{}
+	for i, v := range values {
+		for ; n >= v; n -= v {
+			result.WriteString(symbols[i])
+		}
+	}
+	return result.String()
+}
+
+// renderLabeledList renders the term of each item on its own line, followed by the description indented below
+func renderLabeledList(ctx *context, l types.LabeledList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, item := range l.Items {
+		term, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+		description, err := renderIndentedElements(ctx, item.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render labeled list")
+		}
+		if result.Len() > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString(strings.Join(strings.Fields(string(term)), " "))
+		if len(description) > 0 {
+			result.WriteString("\n" + indentBlock(string(description), 4))
+		}
+	}
+	return withTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), result.Bytes()), nil
+}
+
+// renderCalloutList renders the items of the list after the number of the callout in parentheses
+func renderCalloutList(ctx *context, l types.CalloutList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, item := range l.Items {
+		if err := renderListItem(ctx, result, fmt.Sprintf("(%d) ", item.Ref), item.Elements); err != nil {
+			return nil, errors.Wrapf(err, "unable to render callout list")
+		}
+	}
+	return withTitle(l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""), result.Bytes()), nil
+}
+
+// renderListItem renders the given marker (eg: `*` or `1.`), followed by the content of the item,
+// in which the lines are indented after the marker.
+// A nested list immediately follows the text of the item, so that the list remains compact.
+func renderListItem(ctx *context, result *bytes.Buffer, marker string, elements []interface{}) error {
+	defer ctx.indent(len(marker))()
+	content := &strings.Builder{}
+	for i, e := range elements {
+		renderedElement, err := renderer.RenderElement(ctx, e)
+		if err != nil {
+			return err
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if content.Len() > 0 {
+			if isList(e) && isRegularParagraph(elements[i-1]) {
+				content.WriteString("\n")
+			} else {
+				content.WriteString("\n\n")
+			}
+		}
+		content.Write(renderedElement)
+	}
+	if result.Len() > 0 {
+		result.WriteString("\n")
+	}
+	result.WriteString(marker + indent(content.String(), len(marker)))
+	return nil
+}
+
+// isList returns true if the given element is a list
+func isList(element interface{}) bool {
+	switch element.(type) {
+	case types.UnorderedList, types.OrderedList, types.LabeledList, types.CalloutList:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRegularParagraph returns true if the given paragraph is neither an admonition, a listing, a verse nor a quote,
+// and if it has no title
+func isRegularParagraph(element interface{}) bool {
+	p, ok := element.(types.Paragraph)
+	if !ok {
+		return false
+	}
+	if _, ok := p.Attributes[types.AttrAdmonitionKind]; ok {
+		return false
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source, types.Verse, types.Quote:
+		return false
+	}
+	return !p.Attributes.Has(types.AttrTitle)
+}
@@ -0,0 +1,173 @@
+package text
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// renderSection renders the title of the section, underlined with the character corresponding to its level
+func renderSection(ctx *context, s types.Section) ([]byte, error) {
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section title")
+	}
+	content, err := renderer.RenderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render section content")
+	}
+	result := bytes.NewBufferString(underline(strings.Join(strings.Fields(string(title)), " "), s.Level))
+	appendBlock(result, content)
+	return result.Bytes(), nil
+}
+
+func renderParagraph(ctx *context, p types.Paragraph) ([]byte, error) {
+	title := p.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		if title != "" {
+			restore := ctx.indent(4)
+			content, err := renderParagraphContent(ctx, p, false, 0)
+			restore()
+			if err != nil {
+				return nil, err
+			}
+			return renderAdmonition(ctx, k, title, content), nil
+		}
+		label := admonitionLabel(ctx, k) + ": "
+		content, err := renderParagraphContent(ctx, p, false, utf8.RuneCountInString(label))
+		if err != nil {
+			return nil, err
+		}
+		return []byte(label + string(content)), nil
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		return renderVerbatimBlock(p.Attributes, paragraphLines(p)), nil
+	case types.Verse:
+		return renderBlockQuote(ctx, p.Attributes, []interface{}{p}, true)
+	case types.Quote:
+		return renderBlockQuote(ctx, p.Attributes, []interface{}{p}, false)
+	}
+	content, err := renderParagraphContent(ctx, p, false, 0)
+	if err != nil {
+		return nil, err
+	}
+	return withTitle(title, content), nil
+}
+
+// renderParagraphContent renders the lines of the given paragraph, in which the words are wrapped
+// (the lines are kept if the `hardbreaks` option or attribute is set, or if requested).
+// The width of the first line is reduced by the given offset (eg: for a label at the beginning of the paragraph).
+func renderParagraphContent(ctx *context, p types.Paragraph, hardbreaks bool, offset int) ([]byte, error) {
+	hardbreaks = hardbreaks || p.Attributes.Has(types.AttrHardBreaks) || ctx.Attributes.Has(types.DocumentAttrHardBreaks)
+	result := &strings.Builder{}
+	result.WriteString(strings.Repeat("\x00", offset)) // placeholder for the offset, removed after the wrapping
+	for i, line := range p.Lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render paragraph")
+		}
+		result.Write(renderedLine)
+		if i < len(p.Lines)-1 {
+			if hardbreaks {
+				result.WriteString("\n")
+			} else {
+				result.WriteString(" ")
+			}
+		}
+	}
+	return []byte(strings.TrimLeft(wrap(result.String(), ctx.width), "\x00")), nil
+}
+
+// paragraphLines returns the lines of the given paragraph as verbatim lines
+func paragraphLines(p types.Paragraph) []types.VerbatimLine {
+	result := make([]types.VerbatimLine, len(p.Lines))
+	for i, line := range p.Lines {
+		result[i] = types.VerbatimLine{
+			Content: string(plainText(line)),
+		}
+	}
+	return result
+}
+
+// renderAdmonition renders the label of the admonition (followed by its title, if any) on its own line,
+// and the given content indented below
+func renderAdmonition(ctx *context, kind types.AdmonitionKind, title string, content []byte) []byte {
+	label := admonitionLabel(ctx, kind)
+	if title != "" {
+		label += ": " + title
+	}
+	return withTitle(label, []byte(indentBlock(string(content), 4)))
+}
+
+func admonitionLabel(ctx *context, kind types.AdmonitionKind) string {
+	switch kind {
+	case types.Tip:
+		return ctx.Attributes.GetLabel(types.AttrTipCaption)
+	case types.Note:
+		return ctx.Attributes.GetLabel(types.AttrNoteCaption)
+	case types.Important:
+		return ctx.Attributes.GetLabel(types.AttrImportantCaption)
+	case types.Warning:
+		return ctx.Attributes.GetLabel(types.AttrWarningCaption)
+	case types.Caution:
+		return ctx.Attributes.GetLabel(types.AttrCautionCaption)
+	default:
+		log.Errorf("unexpected kind of admonition: %v", kind)
+		return ""
+	}
+}
+
+// renderBlockQuote renders the given elements of a quote or a verse indented, followed by the attribution (if any)
+func renderBlockQuote(ctx *context, attrs types.Attributes, elements []interface{}, verse bool) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	content, err := renderQuoteContent(ctx, elements, verse)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quote")
+	}
+	result.Write(content)
+	author, hasAuthor := attrs.GetAsString(types.AttrQuoteAuthor)
+	title, hasTitle := attrs.GetAsString(types.AttrQuoteTitle)
+	if hasAuthor || hasTitle {
+		attribution := "—"
+		if hasAuthor {
+			attribution += " " + author
+		}
+		if hasTitle {
+			if hasAuthor {
+				attribution += ","
+			}
+			attribution += " " + title
+		}
+		appendBlock(result, []byte(attribution))
+	}
+	return withTitle(attrs.GetAsStringWithDefault(types.AttrTitle, ""), []byte(indentBlock(result.String(), 4))), nil
+}
+
+// renderQuoteContent renders the elements of a quote or a verse, in which the lines of the paragraphs
+// are kept in the case of a verse
+func renderQuoteContent(ctx *context, elements []interface{}, verse bool) ([]byte, error) {
+	defer ctx.indent(4)()
+	result := bytes.NewBuffer(nil)
+	for _, e := range elements {
+		var content []byte
+		var err error
+		if p, ok := e.(types.Paragraph); ok {
+			// the paragraph itself is neither a verse nor a quote
+			content, err = renderParagraphContent(ctx, p, verse, 0)
+		} else {
+			content, err = renderer.RenderElement(ctx, e)
+		}
+		if err != nil {
+			return nil, err
+		}
+		appendBlock(result, content)
+	}
+	return result.Bytes(), nil
+}
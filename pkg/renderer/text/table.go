@@ -0,0 +1,82 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// renderTable renders the table as aligned columns of text, separated by 2 spaces.
+// The header row is underlined with dashes.
+// The cells are rendered on a single line, so that the lines of the table are not wrapped.
+func renderTable(ctx *context, t types.Table) ([]byte, error) {
+	title := t.Attributes.GetAsStringWithDefault(types.AttrTitle, "")
+	if title != "" {
+		if caption := ctx.Attributes.GetLabel(types.AttrTableCaption); caption != "" {
+			title = fmt.Sprintf("%s %d. %s", caption, ctx.GetAndIncrementTableCounter(), title)
+		}
+	}
+	rows := make([][]string, 0, len(t.Lines)+2)
+	if len(t.Header.Cells) > 0 {
+		header, err := renderTableRow(ctx, t.Header)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, header)
+	}
+	for _, l := range t.Lines {
+		row, err := renderTableRow(ctx, l)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	// the width of each column is the width of its largest cell
+	widths := []int{}
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			if n := utf8.RuneCountInString(cell); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	if len(t.Header.Cells) > 0 {
+		rule := make([]string, len(widths))
+		for i, w := range widths {
+			rule[i] = strings.Repeat("-", w)
+		}
+		rows = append(rows[:1], append([][]string{rule}, rows[1:]...)...)
+	}
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		line := &strings.Builder{}
+		for j, cell := range row {
+			if j > 0 {
+				line.WriteString("  ")
+			}
+			line.WriteString(cell + strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell)))
+		}
+		lines[i] = strings.TrimRight(line.String(), " ")
+	}
+	return withTitle(title, []byte(strings.Join(lines, "\n"))), nil
+}
+
+// renderTableRow renders the content of each cell of the given row on a single line
+func renderTableRow(ctx *context, l types.TableLine) ([]string, error) {
+	result := make([]string, len(l.Cells))
+	for i, cell := range l.Cells {
+		content, err := renderInlineElements(ctx, cell)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render table")
+		}
+		result[i] = strings.Join(strings.Fields(string(content)), " ")
+	}
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package text
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// registers the `text` backend
+func init() {
+	renderer.Register("text", renderer.RenderFunc(Render), map[string]string{
+		types.AttrBaseBackend:   "text",
+		types.AttrOutFileSuffix: ".txt",
+		types.AttrFileType:      "text",
+	})
+}
+
+// Render renders the given document in plain text and writes the result in the given `writer`
+func Render(ctx renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+	c := newContext(ctx)
+	header, hasHeader := doc.Header()
+	elements := doc.Elements
+	result := bytes.NewBuffer(nil)
+	if hasHeader {
+		if ctx.Config.IncludeHeaderFooter {
+			title, err := renderInlineElements(c, header.Title)
+			if err != nil {
+				return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+			}
+			result.WriteString(underline(strings.TrimSpace(string(title)), 0))
+		}
+		elements = append(append([]interface{}{}, header.Elements...), doc.Elements[1:]...)
+	}
+	renderedContent, err := renderer.RenderElements(c, elements)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	appendBlock(result, renderedContent)
+	footnotes, err := renderFootnotes(c)
+	if err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	appendBlock(result, footnotes)
+	if ctx.Config.IncludeHeaderFooter {
+		log.Debugf("Rendering full document...")
+		result.WriteString("\n")
+	}
+	if _, err := output.Write(result.Bytes()); err != nil {
+		return types.Metadata{}, errors.Wrapf(err, "unable to render full document")
+	}
+	authors, _ := doc.Authors()
+	revision, _ := doc.Revision()
+	return types.Metadata{
+		Title:    string(plainText(header.Title)),
+		Authors:  authors,
+		Revision: revision,
+		Warnings: doc.Warnings,
+	}, nil
+}
+
+// appendBlock appends the given block (if not empty) to the given buffer, after a blank line
+func appendBlock(result *bytes.Buffer, block []byte) {
+	if len(block) == 0 {
+		return
+	}
+	if result.Len() > 0 {
+		result.WriteString("\n\n")
+	}
+	result.Write(block)
+}
+
+// renderFootnotes renders the content of the footnotes of the document, each one after its number in brackets
+func renderFootnotes(ctx *context) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, f := range ctx.Footnotes {
+		content, err := renderInlineElements(ctx, f.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnote")
+		}
+		if result.Len() > 0 {
+			result.WriteString("\n")
+		}
+		marker := fmt.Sprintf("[%d] ", f.ID)
+		result.WriteString(marker + indent(wrap(string(content), ctx.width-len(marker)), len(marker)))
+	}
+	return result.Bytes(), nil
+}
+
+// plainText returns the text of the given inline elements, without any markup
+func plainText(elements []interface{}) []byte {
+	result := bytes.NewBuffer(nil)
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.QuotedText:
+			result.Write(plainText(e.Elements))
+		case types.InlinePassthrough:
+			result.Write(plainText(e.Elements))
+		case types.IndexTerm:
+			result.Write(plainText(e.Term))
+		case types.InlineLink:
+			if text, ok := e.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+				result.Write(plainText(text))
+			} else {
+				result.WriteString(e.Location.String())
+			}
+		}
+	}
+	return result.Bytes()
+}
@@ -0,0 +1,15 @@
+package text_test
+
+import (
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestText(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Text Suite")
+}
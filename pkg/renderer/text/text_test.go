@@ -0,0 +1,196 @@
+package text_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("text documents", func() {
+
+	It("document with title, sections and footnotes", func() {
+		source := `= Document Title
+John Doe
+
+Intro with *bold*, _italic_ and ` + "`code`" + `.footnote:[A note.]
+
+== Section A
+
+=== Section B
+
+See https://example.com[the site], https://example.org or <<_section_a>>.`
+		expected := `Document Title
+==============
+
+Intro with bold, italic and code.[1]
+
+Section A
+---------
+
+Section B
+~~~~~~~~~
+
+See the site <https://example.com>, https://example.org or Section A.
+
+[1] A note.
+`
+		Expect(RenderText(source)).To(Equal(expected))
+	})
+
+	It("paragraph wrapped with the default width", func() {
+		source := `Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt
+ut labore et dolore magna aliqua.`
+		expected := `Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor
+incididunt ut labore et dolore magna aliqua.`
+		Expect(RenderTextContent(source)).To(Equal(expected))
+	})
+
+	It("paragraph wrapped with a custom width", func() {
+		source := `Lorem ipsum dolor sit amet, consectetur adipiscing elit.`
+		expected := `Lorem ipsum dolor
+sit amet,
+consectetur
+adipiscing elit.`
+		Expect(RenderTextContent(source, configuration.WithAttribute("text-width", "20"))).To(Equal(expected))
+	})
+
+	It("paragraph not wrapped", func() {
+		source := `Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt
+ut labore et dolore magna aliqua.`
+		expected := `Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua.`
+		Expect(RenderTextContent(source, configuration.WithAttribute("text-width", "0"))).To(Equal(expected))
+	})
+
+	It("nested lists", func() {
+		source := `* first item, with a text which is wrapped
+** nested
+* [x] done
+* [ ] todo
+
+[upperroman]
+. one
+. two`
+		expected := `* first item, with a text
+  which is wrapped
+  - nested
+* [x] done
+* [ ] todo
+
+I. one
+II. two`
+		Expect(RenderTextContent(source, configuration.WithAttribute("text-width", "30"))).To(Equal(expected))
+	})
+
+	It("labeled list", func() {
+		source := `CPU:: The brain of the computer.
+RAM::
++
+----
+$ free -h
+----`
+		expected := `CPU
+    The brain of the
+    computer.
+RAM
+        $ free -h`
+		Expect(RenderTextContent(source, configuration.WithAttribute("text-width", "20"))).To(Equal(expected))
+	})
+
+	It("table as aligned columns", func() {
+		source := `.Stats
+|===
+| Name | Value
+
+| a | 1
+| longer name | 10
+|===`
+		expected := `Table 1. Stats
+Name         Value
+-----------  -----
+a            1
+longer name  10`
+		Expect(RenderTextContent(source)).To(Equal(expected))
+	})
+
+	It("source block with callouts", func() {
+		source := `[source,go]
+----
+func main() { // <1>
+}
+----
+<1> entry point`
+		expected := `    func main() { // (1)
+    }
+
+(1) entry point`
+		Expect(RenderTextContent(source)).To(Equal(expected))
+	})
+
+	It("admonitions", func() {
+		source := `NOTE: Be careful with this paragraph.
+
+[WARNING]
+.Beware
+====
+Watch out.
+====`
+		expected := `Note: Be careful with
+this paragraph.
+
+Warning: Beware
+    Watch out.`
+		Expect(RenderTextContent(source, configuration.WithAttribute("text-width", "25"))).To(Equal(expected))
+	})
+
+	It("quote and verse blocks", func() {
+		source := `[quote, Jane Doe, The Book]
+____
+To be or not to be.
+____
+
+[verse, Poet]
+____
+line one
+line two
+____`
+		expected := `    To be or not to be.
+
+    — Jane Doe, The Book
+
+    line one
+    line two
+
+    — Poet`
+		Expect(RenderTextContent(source)).To(Equal(expected))
+	})
+
+	It("comments and passthrough HTML stripped", func() {
+		source := `// a comment
+
+////
+a comment block
+////
+
+++++
+<div class="raw">
+<p>Raw &amp; HTML</p>
+</div>
+++++
+
+Some +++<b>bold</b>+++ text.`
+		expected := `Raw & HTML
+
+Some bold text.`
+		Expect(RenderTextContent(source)).To(Equal(expected))
+	})
+
+	It("image block with title", func() {
+		source := `.A cookie
+image::cookie.png[Cookie]`
+		expected := `Figure 1. A cookie
+[Cookie]`
+		Expect(RenderTextContent(source)).To(Equal(expected))
+	})
+})
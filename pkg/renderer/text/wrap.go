@@ -0,0 +1,76 @@
+package text
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// wrap wraps the words of each line of the given text, so that the lines are not longer than the given width
+// (unless they contain a longer word). If the width is not positive, the words are only separated by a single space
+func wrap(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	result := make([]string, 0, len(lines))
+	for _, l := range lines {
+		words := strings.Fields(l)
+		if width <= 0 {
+			result = append(result, strings.Join(words, " "))
+			continue
+		}
+		line := &strings.Builder{}
+		length := 0
+		for _, w := range words {
+			n := utf8.RuneCountInString(w)
+			if length > 0 && length+1+n > width {
+				result = append(result, line.String())
+				line.Reset()
+				length = 0
+			}
+			if length > 0 {
+				line.WriteString(" ")
+				length++
+			}
+			line.WriteString(w)
+			length += n
+		}
+		result = append(result, line.String())
+	}
+	return strings.Join(result, "\n")
+}
+
+// indent indents all the non-empty lines of the given text with the given number of spaces, except the first one
+func indent(s string, n int) string {
+	lines := strings.Split(s, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = strings.Repeat(" ", n) + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// indentBlock indents all the non-empty lines of the given text with the given number of spaces
+func indentBlock(s string, n int) string {
+	if s == "" {
+		return s
+	}
+	return strings.Repeat(" ", n) + indent(s, n)
+}
+
+// underlines the characters used to underline the titles, by level
+var underlines = []string{"=", "-", "~", "^", "+"}
+
+// underline returns the given title, followed by a line of the same length
+// with the character corresponding to the given level
+func underline(title string, level int) string {
+	if level >= len(underlines) {
+		level = len(underlines) - 1
+	}
+	return title + "\n" + strings.Repeat(underlines[level], utf8.RuneCountInString(title))
+}
+
+// replacements the textual symbols replaced by their Unicode character
+var replacements = strings.NewReplacer(
+	"(C)", "©",
+	"(TM)", "™",
+	"(R)", "®",
+)
@@ -19,6 +19,26 @@ const (
 	AttrDocType string = "doctype"
 	// AttrSyntaxHighlighter the attribute to define the syntax highlighter on code source blocks
 	AttrSyntaxHighlighter string = "source-highlighter"
+	// AttrPygmentsStyle the `pygments-style` attribute, i.e., the style of the syntax highlighting
+	AttrPygmentsStyle string = "pygments-style"
+	// AttrPygmentsCSS the `pygments-css` attribute, to use CSS classes (`classes`, default) or inline styles (`style`) in the syntax highlighting
+	AttrPygmentsCSS string = "pygments-css"
+	// AttrPygmentsLineNumsMode the `pygments-linenums-mode` attribute, to render the line numbers in a table (`table`, default) or inline (`inline`)
+	AttrPygmentsLineNumsMode string = "pygments-linenums-mode"
+	// AttrChromaStyle the `chroma-style` attribute, i.e., the style of the syntax highlighting
+	AttrChromaStyle string = "chroma-style"
+	// AttrChromaCSS the `chroma-css` attribute, to use CSS classes (`classes`, default) or inline styles (`style`) in the syntax highlighting
+	AttrChromaCSS string = "chroma-css"
+	// AttrChromaLineNumsMode the `chroma-linenums-mode` attribute, to render the line numbers in a table (`table`, default) or inline (`inline`)
+	AttrChromaLineNumsMode string = "chroma-linenums-mode"
+	// AttrStylesheet the `stylesheet` attribute, i.e., the name of the stylesheet to use instead of the default one
+	AttrStylesheet string = "stylesheet"
+	// AttrStylesDir the `stylesdir` attribute, i.e., the directory of the stylesheet
+	AttrStylesDir string = "stylesdir"
+	// AttrLinkCSS the `linkcss` attribute, to link the stylesheet instead of embedding it
+	AttrLinkCSS string = "linkcss"
+	// AttrCopyCSS the `copycss` attribute, to copy the linked stylesheet in the output directory
+	AttrCopyCSS string = "copycss"
 	// AttrIDPrefix the key to retrieve the ID Prefix
 	AttrIDPrefix string = "idprefix"
 	// DefaultIDPrefix the default ID Prefix
@@ -27,6 +47,54 @@ const (
 	AttrTableOfContents string = "toc"
 	// AttrTableOfContentsLevels the document attribute which specifies the number of levels to display in the ToC
 	AttrTableOfContentsLevels string = "toclevels"
+	// AttrTableOfContentsTitle the `toc-title` attribute, i.e., the title of the ToC
+	AttrTableOfContentsTitle string = "toc-title"
+	// AttrTableOfContentsClass the `toc-class` attribute, i.e., the CSS class of the ToC
+	AttrTableOfContentsClass string = "toc-class"
+	// AttrLang the `lang` attribute, i.e., the language of the document, which also selects the built-in labels
+	AttrLang string = "lang"
+	// AttrFigureCaption the `figure-caption` attribute, i.e., the label of the image block titles
+	AttrFigureCaption string = "figure-caption"
+	// AttrTableCaption the `table-caption` attribute, i.e., the label of the table titles
+	AttrTableCaption string = "table-caption"
+	// AttrExampleCaption the `example-caption` attribute, i.e., the label of the example block titles
+	AttrExampleCaption string = "example-caption"
+	// AttrNoteCaption the `note-caption` attribute, i.e., the title of the `NOTE` admonitions
+	AttrNoteCaption string = "note-caption"
+	// AttrTipCaption the `tip-caption` attribute, i.e., the title of the `TIP` admonitions
+	AttrTipCaption string = "tip-caption"
+	// AttrImportantCaption the `important-caption` attribute, i.e., the title of the `IMPORTANT` admonitions
+	AttrImportantCaption string = "important-caption"
+	// AttrWarningCaption the `warning-caption` attribute, i.e., the title of the `WARNING` admonitions
+	AttrWarningCaption string = "warning-caption"
+	// AttrCautionCaption the `caution-caption` attribute, i.e., the title of the `CAUTION` admonitions
+	AttrCautionCaption string = "caution-caption"
+	// AttrVersionLabel the `version-label` attribute, i.e., the label of the revision number
+	AttrVersionLabel string = "version-label"
+	// AttrManualPageLabel the `manual-page-label` attribute, i.e., the suffix of the title of a manpage
+	AttrManualPageLabel string = "manual-page-label"
+	// AttrManTitle the `mantitle` attribute, i.e., the title of a manpage (eg: `git-commit`)
+	AttrManTitle string = "mantitle"
+	// AttrManVolNum the `manvolnum` attribute, i.e., the volume (section) number of a manpage (eg: `1`)
+	AttrManVolNum string = "manvolnum"
+	// AttrManName the `manname` attribute, i.e., the name of the command described in a manpage
+	AttrManName string = "manname"
+	// AttrManSource the `mansource` attribute, i.e., the source (eg: the name and version of the software) of a manpage
+	AttrManSource string = "mansource"
+	// AttrManManual the `manmanual` attribute, i.e., the name of the manual to which a manpage belongs
+	AttrManManual string = "manmanual"
+	// AttrMarkdownHTML the `markdown-html` attribute, i.e., the blocks without Markdown equivalent are rendered in raw HTML by the `markdown` backend
+	AttrMarkdownHTML string = "markdown-html"
+	// AttrTextWidth the `text-width` attribute, i.e., the maximum width of the lines rendered by the `text` backend (`0` to disable the wrapping)
+	AttrTextWidth string = "text-width"
+	// AttrFrontCoverImage the `front-cover-image` attribute, i.e., the cover image of an e-book
+	AttrFrontCoverImage string = "front-cover-image"
+	// AttrUUID the `uuid` attribute, i.e., the unique identifier of an e-book
+	AttrUUID string = "uuid"
+	// AttrHTMLSyntax the `htmlsyntax` attribute, i.e., the syntax of the HTML output (`html` or `xml`)
+	AttrHTMLSyntax string = "htmlsyntax"
+	// AttrDiscrete the `discrete` attribute on a section, which excludes it from the ToC
+	AttrDiscrete string = "discrete"
 	// AttrNoHeader attribute to disable the rendering of document footer
 	AttrNoHeader string = "noheader"
 	// AttrNoFooter attribute to disable the rendering of document footer
@@ -57,6 +125,8 @@ const (
 	AttrLanguage string = "language"
 	// AttrLineNums the `linenums` attribute for a source block or a source paragraph
 	AttrLineNums string = "linenums"
+	// AttrHighlight the `highlight` attribute for a source block, i.e., the lines to emphasize (e.g. `2,4..6`)
+	AttrHighlight string = "highlight"
 	// AttrCheckStyle the attribute to mark the first element of an unordered list itemd as a checked or not
 	AttrCheckStyle string = "checkstyle"
 	// AttrStart the `start` attribute in an ordered list
@@ -71,6 +141,69 @@ const (
 	AttrLineRanges string = "lines"
 	// AttrTagRanges the `tag`/`tags` attribute used in file inclusions
 	AttrTagRanges string = "tags"
+	// AttrIndent the `indent` attribute used in file inclusions
+	AttrIndent string = "indent"
+	// AttrTabSize the `tabsize` attribute, i.e., the number of columns of a tab in the indentation of the included files
+	AttrTabSize string = "tabsize"
+	// AttrEncoding the `encoding` attribute used in file inclusions
+	AttrEncoding string = "encoding"
+	// AttrOptions the `opts` attribute used in file inclusions
+	AttrOptions string = "opts"
+	// AttrAllowURIRead the `allow-uri-read` attribute to allow the inclusion of remote content (can only be set via the API or the CLI)
+	AttrAllowURIRead string = "allow-uri-read"
+	// AttrAttributeMissing the `attribute-missing` attribute which controls how references to missing attributes are handled
+	AttrAttributeMissing string = "attribute-missing"
+	// AttrAttributeUndefined the `attribute-undefined` attribute which controls how inline attribute entries which unset an attribute are handled
+	AttrAttributeUndefined string = "attribute-undefined"
+	// AttrDocName the `docname` intrinsic attribute, i.e., the name of the document file without its extension
+	AttrDocName string = "docname"
+	// AttrDocFile the `docfile` intrinsic attribute, i.e., the path of the document file
+	AttrDocFile string = "docfile"
+	// AttrDocFileSuffix the `docfilesuffix` intrinsic attribute, i.e., the extension of the document file
+	AttrDocFileSuffix string = "docfilesuffix"
+	// AttrDocDir the `docdir` intrinsic attribute, i.e., the path of the directory of the document file
+	AttrDocDir string = "docdir"
+	// AttrDocDate the `docdate` intrinsic attribute, i.e., the last modification date of the document
+	AttrDocDate string = "docdate"
+	// AttrDocTime the `doctime` intrinsic attribute, i.e., the last modification time of the document
+	AttrDocTime string = "doctime"
+	// AttrDocYear the `docyear` intrinsic attribute, i.e., the last modification year of the document
+	AttrDocYear string = "docyear"
+	// AttrDocDateTime the `docdatetime` intrinsic attribute, i.e., the last modification date and time of the document
+	AttrDocDateTime string = "docdatetime"
+	// AttrLocalDate the `localdate` intrinsic attribute, i.e., the date of the conversion
+	AttrLocalDate string = "localdate"
+	// AttrLocalTime the `localtime` intrinsic attribute, i.e., the time of the conversion
+	AttrLocalTime string = "localtime"
+	// AttrLocalYear the `localyear` intrinsic attribute, i.e., the year of the conversion
+	AttrLocalYear string = "localyear"
+	// AttrLocalDateTime the `localdatetime` intrinsic attribute, i.e., the date and time of the conversion
+	AttrLocalDateTime string = "localdatetime"
+	// AttrDocTitle the `doctitle` intrinsic attribute, i.e., the title of the document
+	AttrDocTitle string = "doctitle"
+	// AttrBackend the `backend` intrinsic attribute
+	AttrBackend string = "backend"
+	// AttrBaseBackend the `basebackend` intrinsic attribute
+	AttrBaseBackend string = "basebackend"
+	// AttrOutFileSuffix the `outfilesuffix` intrinsic attribute, i.e., the extension of the output file
+	AttrOutFileSuffix string = "outfilesuffix"
+	// AttrFileType the `filetype` intrinsic attribute, i.e., the type of the output file
+	AttrFileType string = "filetype"
+	// AttrGeneratorVersion the `libasciidoc-version` intrinsic attribute
+	AttrGeneratorVersion string = "libasciidoc-version"
+	// AttrReproducible the `reproducible` attribute, to omit the "last updated" timestamp in the footer
+	AttrReproducible string = "reproducible"
+	// AttrLastUpdateLabel the `last-update-label` attribute, i.e., the label of the "last updated" timestamp in the footer
+	AttrLastUpdateLabel string = "last-update-label"
+	// AttrDateFormat the `date-format` attribute, i.e., the Go layout of the "last updated" timestamp and of the revision date
+	// (eg: `January 2, 2006`, but not a `strftime` pattern such as `%B %d, %Y`)
+	AttrDateFormat string = "date-format"
+	// AttrDocInfo the `docinfo` attribute, i.e., the locations of the docinfo files to inject in the output
+	AttrDocInfo string = "docinfo"
+	// AttrDocInfoDir the `docinfodir` attribute, i.e., the directory of the docinfo files
+	AttrDocInfoDir string = "docinfodir"
+	// AttrDocInfoSubs the `docinfosubs` attribute, i.e., the substitutions to apply on the content of the docinfo files
+	AttrDocInfoSubs string = "docinfosubs"
 	// AttrLastUpdated the "last updated" data in the document, i.e., the output/generation time
 	AttrLastUpdated string = "LastUpdated"
 	// AttrImageAlt the image `alt` attribute
@@ -83,6 +216,21 @@ const (
 	AttrImageTitle string = "title"
 )
 
+const (
+	// AttributeMissingSkip leaves the reference to the missing attribute as-is (default)
+	AttributeMissingSkip string = "skip"
+	// AttributeMissingDrop drops the reference to the missing attribute
+	AttributeMissingDrop string = "drop"
+	// AttributeMissingDropLine drops the line containing the reference to the missing attribute
+	AttributeMissingDropLine string = "drop-line"
+	// AttributeMissingWarn leaves the reference to the missing attribute as-is, and reports a warning
+	AttributeMissingWarn string = "warn"
+	// AttributeUndefinedDrop substitutes the inline attribute entry which unsets an attribute with an empty string
+	AttributeUndefinedDrop string = "drop"
+	// AttributeUndefinedDropLine drops the line containing the inline attribute entry which unsets an attribute (default)
+	AttributeUndefinedDropLine string = "drop-line"
+)
+
 // NewElementID initializes a new attribute map with a single entry for the ID using the given value
 func NewElementID(id string) (Attributes, error) {
 	// log.Debugf("initializing a new ElementID with ID=%s", id)
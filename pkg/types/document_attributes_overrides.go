@@ -1,75 +1,166 @@
 package types
 
+import "strings"
+
 // AttributesWithOverrides the document attributes with some overrides provided by the CLI (for example)
+//
+// The overrides follow the same conventions as Asciidoctor:
+// - `name: value`: the attribute is set and locked, i.e., it cannot be redefined or unset in the document (hard set)
+// - `name: value@`: the attribute is set, but it can be redefined or unset in the document (soft set)
+// - `!name: ""` or `name!: ""`: the attribute is unset and locked, i.e., it cannot be set in the document (hard unset)
+// - `!name: @` or `name!: @`: the attribute is unset, but it can be set in the document (soft unset)
 type AttributesWithOverrides struct {
 	Content   map[string]interface{}
 	Overrides map[string]string
+	Intrinsic map[string]string // the intrinsic attributes (`docname`, `docdate`, etc.), which are not reported in the document attributes
+}
+
+// NewAttributesWithOverrides returns a new set of attributes with the given overrides,
+// in which the soft-set overrides are already defined (and can be redefined or unset by the document)
+func NewAttributesWithOverrides(overrides map[string]string) AttributesWithOverrides {
+	result := AttributesWithOverrides{
+		Content:   map[string]interface{}{},
+		Overrides: overrides,
+	}
+	for k, v := range overrides {
+		if !isUnsetOverride(k) && strings.HasSuffix(v, "@") {
+			result.Content[k] = strings.TrimSuffix(v, "@")
+		} else if name := strings.Trim(k, "!"); isUnsetOverride(k) && strings.HasSuffix(v, "@") && IsLabel(name) {
+			// the label is disabled (unless it is set in the document)
+			result.Content[name] = ""
+		}
+	}
+	return result
+}
+
+// ParseAttributeOverride parses the given attribute override as specified in the CLI, in the form of
+// `name`, `name=value`, `name@`, `name=value@`, `name!`, `!name`, `name!@` or `!name@`
+// and returns the corresponding key and value to use in the overrides
+// (the value may contain some `=` characters)
+func ParseAttributeOverride(attr string) (string, string) {
+	key, value := attr, ""
+	if i := strings.Index(attr, "="); i >= 0 {
+		key, value = attr[:i], attr[i+1:]
+	}
+	// soft set or unset
+	if strings.HasSuffix(key, "@") {
+		key = strings.TrimSuffix(key, "@")
+		value += "@"
+	}
+	// unset
+	if strings.HasPrefix(key, "!") || strings.HasSuffix(key, "!") {
+		key = "!" + strings.Trim(key, "!")
+		if strings.HasSuffix(value, "@") {
+			return key, "@"
+		}
+		return key, ""
+	}
+	return key, value
+}
+
+func isUnsetOverride(key string) bool {
+	return strings.HasPrefix(key, "!") || strings.HasSuffix(key, "!")
+}
+
+// override returns the override for the given attribute (if found), along with a flag indicating
+// if the attribute is unset, and another flag indicating if the override is soft
+func (a AttributesWithOverrides) override(key string) (value string, unset bool, soft bool, found bool) {
+	if value, found := a.Overrides[key]; found {
+		return strings.TrimSuffix(value, "@"), false, strings.HasSuffix(value, "@"), true
+	}
+	for _, k := range []string{"!" + key, key + "!"} {
+		if value, found := a.Overrides[k]; found {
+			return "", true, strings.HasSuffix(value, "@"), true
+		}
+	}
+	return "", false, false, false
+}
+
+// Locked returns `true` if the given attribute was hard set or hard unset by an override,
+// in which case it cannot be redefined or unset in the document
+func (a AttributesWithOverrides) Locked(key string) bool {
+	_, _, soft, found := a.override(key)
+	return found && !soft
 }
 
 // All returns all attributes
 func (a AttributesWithOverrides) All() Attributes {
 	result := Attributes{}
 	for k, v := range a.Content {
-		result[k] = v
+		if !a.Locked(k) {
+			result[k] = v
+		}
 	}
 	for k, v := range a.Overrides {
+		if name := strings.Trim(k, "!"); isUnsetOverride(k) && !strings.HasSuffix(v, "@") && IsLabel(name) {
+			// the label is disabled, rather than replaced with its built-in value
+			result[name] = ""
+			continue
+		}
+		if isUnsetOverride(k) || strings.HasSuffix(v, "@") {
+			continue
+		}
 		result[k] = v
 	}
 	return result
 }
 
-// Set sets the given attribute
+// Set sets the given attribute (unless it is locked by an override)
 func (a AttributesWithOverrides) Set(key string, value interface{}) {
+	if a.Locked(key) {
+		return
+	}
 	a.Content[key] = value
 }
 
-// Add adds the given attributes
+// Add adds the given attributes (except those which are locked by an override)
 func (a AttributesWithOverrides) Add(attrs map[string]interface{}) {
 	for k, v := range attrs {
-		a.Content[k] = v
+		a.Set(k, v)
 	}
 }
 
-// Delete deletes the given attribute
+// Delete deletes the given attribute (unless it is locked by an override).
+// The label attributes (eg: `figure-caption`) are set with an empty value instead, so that their built-in value is not used
 func (a AttributesWithOverrides) Delete(key string) {
+	if a.Locked(key) {
+		return
+	}
+	if IsLabel(key) {
+		// the label is disabled, rather than replaced with its built-in value
+		a.Content[key] = ""
+		return
+	}
 	delete(a.Content, key)
 }
 
 // GetAsString gets the string value for the given key (+ `true`),
 // or empty string (+ `false`) if none was found
 func (a AttributesWithOverrides) GetAsString(key string) (string, bool) {
-	// if value is overridden
-	if value, found := a.Overrides[key]; found {
-		return value, true
+	// if value is hard set or hard unset
+	if value, unset, soft, found := a.override(key); found && !soft {
+		return value, !unset
 	}
-	// check in predefined attributes
-	if value, found := Predefined[key]; found {
+	// check in the document attributes (which include the soft set values)
+	if value, found := a.Content[key].(string); found {
 		return value, true
 	}
-	// if value is reset
-	if _, found := a.Overrides["!"+key]; found {
-		return "", false
+	// check in intrinsic attributes
+	if value, found := a.Intrinsic[key]; found {
+		return value, true
 	}
-	if value, found := a.Content[key].(string); found {
+	// check in predefined attributes
+	if value, found := Predefined[key]; found {
 		return value, true
 	}
-	// TODO: raise a warning if there was no entry found
 	return "", false
 }
 
 // GetAsStringWithDefault gets the string value for the given key,
 // or returns the given default value
 func (a AttributesWithOverrides) GetAsStringWithDefault(key, defaultValue string) string {
-	if value, found := a.Overrides[key]; found {
-		return value
-	}
-	// check in predefined attributes
-	if value, found := Predefined[key]; found {
-		return value
-	}
-	if value, found := a.Content[key].(string); found {
+	if value, found := a.GetAsString(key); found {
 		return value
 	}
-	// TODO: raise a warning if there was no entry found
 	return defaultValue
 }
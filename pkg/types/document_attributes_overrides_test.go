@@ -61,3 +61,157 @@ var _ = DescribeTable("document attribute overrides with default",
 	Entry("!bar", "bar", "default"), // entry is reset, default is returned
 	Entry("baz", "baz", ""),         // entry exists but its value is empty
 )
+
+var _ = DescribeTable("document attribute overrides precedence",
+	func(overrides map[string]string, declare func(attrs types.AttributesWithOverrides), expectedValue string, expectedFound bool) {
+		// given
+		attributes := types.NewAttributesWithOverrides(overrides)
+		// when
+		declare(attributes)
+		value, found := attributes.GetAsString("foo")
+		// then
+		Expect(found).To(Equal(expectedFound))
+		Expect(value).To(Equal(expectedValue))
+	},
+	Entry("hard set is locked against redefinition",
+		map[string]string{"foo": "api"},
+		func(attrs types.AttributesWithOverrides) { attrs.Set("foo", "doc") },
+		"api", true),
+	Entry("hard set is locked against unset",
+		map[string]string{"foo": "api"},
+		func(attrs types.AttributesWithOverrides) { attrs.Delete("foo") },
+		"api", true),
+	Entry("soft set is a default value",
+		map[string]string{"foo": "api@"},
+		func(attrs types.AttributesWithOverrides) {},
+		"api", true),
+	Entry("soft set can be redefined",
+		map[string]string{"foo": "api@"},
+		func(attrs types.AttributesWithOverrides) { attrs.Set("foo", "doc") },
+		"doc", true),
+	Entry("soft set can be unset",
+		map[string]string{"foo": "api@"},
+		func(attrs types.AttributesWithOverrides) { attrs.Delete("foo") },
+		"", false),
+	Entry("hard unset with leading '!' is locked against definition",
+		map[string]string{"!foo": ""},
+		func(attrs types.AttributesWithOverrides) { attrs.Set("foo", "doc") },
+		"", false),
+	Entry("hard unset with trailing '!' is locked against definition",
+		map[string]string{"foo!": ""},
+		func(attrs types.AttributesWithOverrides) { attrs.Add(map[string]interface{}{"foo": "doc"}) },
+		"", false),
+	Entry("soft unset",
+		map[string]string{"!foo": "@"},
+		func(attrs types.AttributesWithOverrides) {},
+		"", false),
+	Entry("soft unset can be defined",
+		map[string]string{"!foo": "@"},
+		func(attrs types.AttributesWithOverrides) { attrs.Set("foo", "doc") },
+		"doc", true),
+)
+
+var _ = DescribeTable("document attribute overrides with predefined attributes",
+	func(overrides map[string]string, expectedValue string, expectedFound bool) {
+		// given
+		attributes := types.NewAttributesWithOverrides(overrides)
+		// when
+		value, found := attributes.GetAsString("nbsp")
+		// then
+		Expect(found).To(Equal(expectedFound))
+		Expect(value).To(Equal(expectedValue))
+	},
+	Entry("predefined", map[string]string{}, "&#160;", true),
+	Entry("hard set", map[string]string{"nbsp": "custom"}, "custom", true),
+	Entry("soft set", map[string]string{"nbsp": "custom@"}, "custom", true),
+	Entry("hard unset", map[string]string{"!nbsp": ""}, "", false),
+)
+
+var _ = DescribeTable("all document attributes with overrides",
+	func(overrides map[string]string, content map[string]interface{}, expected types.Attributes) {
+		// given
+		attributes := types.NewAttributesWithOverrides(overrides)
+		attributes.Add(content)
+		// when/then
+		Expect(attributes.All()).To(Equal(expected))
+	},
+	Entry("hard and soft overrides",
+		map[string]string{
+			"hard":       "api",
+			"soft":       "api@",
+			"!hardunset": "",
+			"!softunset": "@",
+		},
+		map[string]interface{}{
+			"hard":      "doc",
+			"soft":      "doc",
+			"hardunset": "doc",
+			"softunset": "doc",
+			"other":     "doc",
+		},
+		types.Attributes{
+			"hard":      "api",
+			"soft":      "doc",
+			"softunset": "doc",
+			"other":     "doc",
+		}),
+)
+
+var _ = DescribeTable("parse attribute overrides",
+	func(attr, expectedKey, expectedValue string) {
+		key, value := types.ParseAttributeOverride(attr)
+		Expect(key).To(Equal(expectedKey))
+		Expect(value).To(Equal(expectedValue))
+	},
+	Entry("name", "foo", "foo", ""),
+	Entry("name=value", "foo=bar", "foo", "bar"),
+	Entry("name=value with '='", "url=https://example.com?a=b&c=d", "url", "https://example.com?a=b&c=d"),
+	Entry("name@", "foo@", "foo", "@"),
+	Entry("name=value@", "foo=bar@", "foo", "bar@"),
+	Entry("name!", "foo!", "!foo", ""),
+	Entry("!name", "!foo", "!foo", ""),
+	Entry("name!@", "foo!@", "!foo", "@"),
+	Entry("!name@", "!foo@", "!foo", "@"),
+)
+
+var _ = DescribeTable("document attribute overrides with labels",
+	func(overrides map[string]string, declare func(attrs types.AttributesWithOverrides), expected string) {
+		// given
+		attributes := types.NewAttributesWithOverrides(overrides)
+		// when
+		declare(attributes)
+		// then
+		Expect(attributes.All().GetLabel(types.AttrFigureCaption)).To(Equal(expected))
+	},
+	Entry("never set",
+		map[string]string{},
+		func(attrs types.AttributesWithOverrides) {},
+		"Figure"),
+	Entry("set",
+		map[string]string{},
+		func(attrs types.AttributesWithOverrides) { attrs.Set(types.AttrFigureCaption, "Fig.") },
+		"Fig."),
+	Entry("unset",
+		map[string]string{},
+		func(attrs types.AttributesWithOverrides) { attrs.Delete(types.AttrFigureCaption) },
+		""),
+	Entry("set then unset",
+		map[string]string{},
+		func(attrs types.AttributesWithOverrides) {
+			attrs.Set(types.AttrFigureCaption, "Fig.")
+			attrs.Delete(types.AttrFigureCaption)
+		},
+		""),
+	Entry("hard unset",
+		map[string]string{"!figure-caption": ""},
+		func(attrs types.AttributesWithOverrides) { attrs.Set(types.AttrFigureCaption, "Fig.") },
+		""),
+	Entry("soft unset",
+		map[string]string{"!figure-caption": "@"},
+		func(attrs types.AttributesWithOverrides) {},
+		""),
+	Entry("soft unset then set",
+		map[string]string{"!figure-caption": "@"},
+		func(attrs types.AttributesWithOverrides) { attrs.Set(types.AttrFigureCaption, "Fig.") },
+		"Fig."),
+)
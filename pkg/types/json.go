@@ -0,0 +1,221 @@
+package types
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// JSONSchemaVersion the version of the JSON representation of the documents.
+// It is incremented when the representation of an existing node changes in an incompatible way
+// (adding a new type of node or a new field does not change the version)
+const JSONSchemaVersion = 1
+
+// EncodeJSON writes the given document (a `Document` or a `DraftDocument`) in the JSON format in the given `output`:
+//
+//	{
+//	  "schemaVersion": 1,
+//	  "document": {
+//	    "type": "Document",
+//	    "attributes": { ... },
+//	    "elements": [ ... ]
+//	  }
+//	}
+//
+// Each node (i.e., a struct of this package) is represented by an object whose `type` field is the name of the struct,
+// followed by the fields which have a `json` tag, in the declaration order and with the name set in the tag
+// (eg: `Position` becomes `position`, with its `file`, `line`, `column`, `endLine` and `endColumn` fields).
+// The fields without a `json` tag or with the `json:"-"` tag are internal to the processing of the document
+// (eg: the content of the docinfo files or the warnings) and are never written.
+// The fields with the `omitempty` option are omitted when they have no value (empty strings, slices and maps,
+// `false` and unset positions).
+// The maps (eg: the attributes) are represented by objects with sorted keys, the slices by arrays,
+// the kinds of quoted text and passthrough by their name (eg: `bold`)
+// and the other values (eg: the kinds of blocks) by their JSON equivalent.
+// The JSON schema of this representation is written by `EncodeJSONSchema`.
+func EncodeJSON(output io.Writer, doc interface{}) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonObject{
+		{key: "schemaVersion", value: JSONSchemaVersion},
+		{key: "document", value: toJSONValue(reflect.ValueOf(doc))},
+	})
+}
+
+// jsonObject a JSON object whose fields are encoded in order
+type jsonObject []jsonField
+
+type jsonField struct {
+	key   string
+	value interface{}
+}
+
+// MarshalJSON returns the JSON encoding of the object, with its fields in order
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	result := bytes.NewBufferString("{")
+	// the characters such as `<` are not escaped in the values (eg: in the content of a passthrough)
+	encoder := json.NewEncoder(result)
+	encoder.SetEscapeHTML(false)
+	for i, f := range o {
+		if i > 0 {
+			result.WriteString(",")
+		}
+		if err := encoder.Encode(f.key); err != nil {
+			return nil, err
+		}
+		result.Truncate(result.Len() - 1) // remove the newline added by the encoder
+		result.WriteString(":")
+		if err := encoder.Encode(f.value); err != nil {
+			return nil, err
+		}
+		result.Truncate(result.Len() - 1)
+	}
+	result.WriteString("}")
+	return result.Bytes(), nil
+}
+
+var typesPkgPath = reflect.TypeOf(Document{}).PkgPath()
+
+// toJSONValue converts the given value into a value which can be encoded in JSON
+func toJSONValue(v reflect.Value) interface{} {
+	if v.IsValid() && v.Kind() != reflect.Struct && v.CanInterface() {
+		if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+			if text, err := m.MarshalText(); err == nil {
+				return string(text)
+			}
+		}
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return toJSONValue(v.Elem())
+	case reflect.Struct:
+		if v.Type().PkgPath() != typesPkgPath {
+			// not a node of the document (eg: a `time.Time`)
+			return v.Interface()
+		}
+		result := jsonObject{
+			{key: "type", value: v.Type().Name()},
+		}
+		for i := 0; i < v.NumField(); i++ {
+			key, omitEmpty, found := jsonFieldTag(v.Type().Field(i))
+			if !found || (omitEmpty && isEmpty(v.Field(i))) {
+				// internal field, or field without value
+				continue
+			}
+			result = append(result, jsonField{
+				key:   key,
+				value: toJSONValue(v.Field(i)),
+			})
+		}
+		return result
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes())
+		}
+		result := make([]interface{}, v.Len())
+		for i := range result {
+			result[i] = toJSONValue(v.Index(i))
+		}
+		return result
+	case reflect.Map:
+		keys := make([]string, 0, v.Len())
+		values := make(map[string]reflect.Value, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			k := fmt.Sprintf("%v", iter.Key().Interface())
+			keys = append(keys, k)
+			values[k] = iter.Value()
+		}
+		sort.Strings(keys)
+		result := make(jsonObject, len(keys))
+		for i, k := range keys {
+			result[i] = jsonField{
+				key:   k,
+				value: toJSONValue(values[k]),
+			}
+		}
+		return result
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	default:
+		// eg: funcs and channels, which are not part of a document
+		return nil
+	}
+}
+
+// jsonFieldTag returns the key and the `omitempty` option of the given field, as set in its `json` tag,
+// or `false` if the field is not part of the JSON representation
+func jsonFieldTag(f reflect.StructField) (string, bool, bool) {
+	tag, found := f.Tag.Lookup("json")
+	if !found || tag == "-" || f.PkgPath != "" {
+		return "", false, false
+	}
+	options := strings.Split(tag, ",")
+	for _, o := range options[1:] {
+		if o == "omitempty" {
+			return options[0], true, true
+		}
+	}
+	return options[0], false, true
+}
+
+// isEmpty returns true if the given value has no value
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// MarshalText returns the name of the kind of quoted text (eg: `bold`)
+func (k QuotedTextKind) MarshalText() ([]byte, error) {
+	switch k {
+	case Bold:
+		return []byte("bold"), nil
+	case Italic:
+		return []byte("italic"), nil
+	case Monospace:
+		return []byte("monospace"), nil
+	case Subscript:
+		return []byte("subscript"), nil
+	case Superscript:
+		return []byte("superscript"), nil
+	default:
+		return nil, fmt.Errorf("unknown kind of quoted text: %d", k)
+	}
+}
+
+// MarshalText returns the name of the kind of passthrough (eg: `tripleplus`)
+func (k PassthroughKind) MarshalText() ([]byte, error) {
+	switch k {
+	case SinglePlusPassthrough:
+		return []byte("singleplus"), nil
+	case TriplePlusPassthrough:
+		return []byte("tripleplus"), nil
+	case PassthroughMacro:
+		return []byte("macro"), nil
+	default:
+		return nil, fmt.Errorf("unknown kind of passthrough: %d", k)
+	}
+}
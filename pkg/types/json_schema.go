@@ -0,0 +1,205 @@
+package types
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+)
+
+// jsonNodeTypes the types of the nodes which can be written by `EncodeJSON`,
+// i.e., the types of the elements of the documents and draft documents, and of the values of their attributes
+var jsonNodeTypes = []interface{}{
+	AttributeDeclaration{},
+	AttributeReset{},
+	AttributeSubstitution{},
+	BlankLine{},
+	Callout{},
+	CalloutList{},
+	CalloutListItem{},
+	ConcealedIndexTerm{},
+	ContinuedListItemElement{},
+	DelimitedBlock{},
+	Document{},
+	DocumentAuthor{},
+	DocumentRevision{},
+	DraftDocument{},
+	ExternalCrossReference{},
+	FileInclusion{},
+	Footnote{},
+	FootnoteReference{},
+	FrontMatter{},
+	ImageBlock{},
+	IncludedFileEndTag{},
+	IncludedFileStartTag{},
+	IndexTerm{},
+	InlineAttributeEntry{},
+	InlineImage{},
+	InlineLink{},
+	InlinePassthrough{},
+	InternalCrossReference{},
+	LabeledList{},
+	LabeledListItem{},
+	LineBreak{},
+	LineRange{},
+	LiteralBlock{},
+	Location{},
+	OrderedList{},
+	OrderedListItem{},
+	OrderedListItemPrefix{},
+	Paragraph{},
+	Position{},
+	Preamble{},
+	QuotedText{},
+	Section{},
+	SingleLineComment{},
+	StringElement{},
+	Table{},
+	TableLine{},
+	TableOfContentsPlaceHolder{},
+	TagRange{},
+	UnorderedList{},
+	UnorderedListItem{},
+	UnorderedListItemPrefix{},
+	UserMacro{},
+	VerbatimLine{},
+}
+
+// EncodeJSONSchema writes the JSON schema (draft 07) of the representation of the documents written by `EncodeJSON`
+// in the given `output`. Each node is described in the `definitions` of the schema, by the name of its type,
+// with its fields as set in their `json` tags. The fields without the `omitempty` option are required.
+// Returns an error if an exported field of a node has no `json` tag, since all fields must be explicitly mapped
+// (or explicitly excluded with the `json:"-"` tag).
+func EncodeJSONSchema(output io.Writer) error {
+	definitions := jsonObject{}
+	nodes := make([]interface{}, len(jsonNodeTypes))
+	for i, n := range jsonNodeTypes {
+		t := reflect.TypeOf(n)
+		definition, err := jsonSchemaOfNode(t)
+		if err != nil {
+			return err
+		}
+		definitions = append(definitions, jsonField{key: t.Name(), value: definition})
+		nodes[i] = jsonSchemaRef(t.Name())
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].key < definitions[j].key
+	})
+	definitions = append(jsonObject{
+		{key: "node", value: jsonObject{
+			{key: "oneOf", value: nodes},
+		}},
+	}, definitions...)
+	encoder := json.NewEncoder(output)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonObject{
+		{key: "$schema", value: "http://json-schema.org/draft-07/schema#"},
+		{key: "title", value: "libasciidoc document"},
+		{key: "type", value: "object"},
+		{key: "required", value: []string{"schemaVersion", "document"}},
+		{key: "properties", value: jsonObject{
+			{key: "schemaVersion", value: jsonObject{
+				{key: "const", value: JSONSchemaVersion},
+			}},
+			{key: "document", value: jsonObject{
+				{key: "oneOf", value: []interface{}{
+					jsonSchemaRef(reflect.TypeOf(Document{}).Name()),
+					jsonSchemaRef(reflect.TypeOf(DraftDocument{}).Name()),
+				}},
+			}},
+		}},
+		{key: "additionalProperties", value: false},
+		{key: "definitions", value: definitions},
+	})
+}
+
+// jsonSchemaOfNode returns the schema of the given type of node
+func jsonSchemaOfNode(t reflect.Type) (jsonObject, error) {
+	required := []string{"type"}
+	properties := jsonObject{
+		{key: "type", value: jsonObject{
+			{key: "const", value: t.Name()},
+		}},
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// unexported field
+			continue
+		}
+		if _, found := f.Tag.Lookup("json"); !found {
+			return nil, fmt.Errorf("missing JSON mapping of the '%s.%s' field", t.Name(), f.Name)
+		}
+		key, omitEmpty, found := jsonFieldTag(f)
+		if !found {
+			// internal field
+			continue
+		}
+		if !omitEmpty {
+			required = append(required, key)
+		}
+		properties = append(properties, jsonField{
+			key:   key,
+			value: jsonSchemaOfValue(f.Type),
+		})
+	}
+	return jsonObject{
+		{key: "type", value: "object"},
+		{key: "required", value: required},
+		{key: "properties", value: properties},
+		{key: "additionalProperties", value: false},
+	}, nil
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// jsonSchemaOfValue returns the schema of the values of the given type
+func jsonSchemaOfValue(t reflect.Type) jsonObject {
+	if t.Implements(textMarshalerType) {
+		return jsonSchemaType("string")
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return jsonSchemaOfValue(t.Elem())
+	case reflect.Struct:
+		return jsonSchemaRef(t.Name())
+	case reflect.Interface:
+		// a node, or a plain value (eg: the terms of a concealed index term)
+		return jsonObject{}
+	case reflect.Slice, reflect.Array:
+		items := jsonSchemaOfValue(t.Elem())
+		if t.Elem().Kind() == reflect.Interface {
+			items = jsonSchemaRef("node")
+		}
+		return append(jsonSchemaType("array"), jsonField{key: "items", value: items})
+	case reflect.Map:
+		// eg: the attributes, whose values can be plain values, nodes or arrays
+		return jsonSchemaType("object")
+	case reflect.String:
+		return jsonSchemaType("string")
+	case reflect.Bool:
+		return jsonSchemaType("boolean")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return jsonSchemaType("integer")
+	case reflect.Float32, reflect.Float64:
+		return jsonSchemaType("number")
+	default:
+		return jsonObject{}
+	}
+}
+
+func jsonSchemaType(name string) jsonObject {
+	return jsonObject{
+		{key: "type", value: name},
+	}
+}
+
+func jsonSchemaRef(name string) jsonObject {
+	return jsonObject{
+		{key: "$ref", value: "#/definitions/" + name},
+	}
+}
@@ -0,0 +1,225 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("json encoding", func() {
+
+	It("document with a section and a paragraph", func() {
+		doc := types.Document{
+			Attributes: types.Attributes{
+				types.AttrDocType: "article",
+			},
+			Elements: []interface{}{
+				types.Section{
+					Level: 0,
+					Attributes: types.Attributes{
+						types.AttrID: "_title",
+					},
+					Title: []interface{}{
+						types.StringElement{
+							Content: "Title",
+						},
+					},
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.QuotedText{
+										Kind: types.Bold,
+										Elements: []interface{}{
+											types.StringElement{
+												Content: "<bold>",
+											},
+										},
+									},
+								},
+							},
+							Position: types.Position{
+								File:      "test.adoc",
+								Line:      3,
+								Column:    1,
+								EndLine:   3,
+								EndColumn: 11,
+							},
+						},
+					},
+				},
+			},
+		}
+		expected := `{
+  "schemaVersion": 1,
+  "document": {
+    "type": "Document",
+    "attributes": {
+      "doctype": "article"
+    },
+    "elements": [
+      {
+        "type": "Section",
+        "level": 0,
+        "attributes": {
+          "id": "_title"
+        },
+        "title": [
+          {
+            "type": "StringElement",
+            "content": "Title"
+          }
+        ],
+        "elements": [
+          {
+            "type": "Paragraph",
+            "lines": [
+              [
+                {
+                  "type": "QuotedText",
+                  "kind": "bold",
+                  "elements": [
+                    {
+                      "type": "StringElement",
+                      "content": "<bold>"
+                    }
+                  ]
+                }
+              ]
+            ],
+            "position": {
+              "type": "Position",
+              "file": "test.adoc",
+              "line": 3,
+              "column": 1,
+              "endLine": 3,
+              "endColumn": 11
+            }
+          }
+        ]
+      }
+    ]
+  }
+}
+`
+		result := &bytes.Buffer{}
+		err := types.EncodeJSON(result, doc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.String()).To(Equal(expected))
+	})
+
+	It("draft document with a passthrough", func() {
+		doc := types.DraftDocument{
+			Blocks: []interface{}{
+				types.InlinePassthrough{
+					Kind: types.TriplePlusPassthrough,
+				},
+				types.BlankLine{},
+			},
+		}
+		expected := `{
+  "schemaVersion": 1,
+  "document": {
+    "type": "DraftDocument",
+    "blocks": [
+      {
+        "type": "InlinePassthrough",
+        "kind": "tripleplus"
+      },
+      {
+        "type": "BlankLine"
+      }
+    ]
+  }
+}
+`
+		result := &bytes.Buffer{}
+		err := types.EncodeJSON(result, doc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.String()).To(Equal(expected))
+	})
+
+	It("document without its internal fields", func() {
+		doc := types.Document{
+			Elements: []interface{}{
+				types.BlankLine{},
+			},
+			ElementReferences: types.ElementReferences{
+				"_section": "section",
+			},
+			Warnings: []types.ProcessingWarning{
+				{
+					Message: "warning",
+				},
+			},
+			DocInfo: types.DocInfo{
+				Head: "<meta>",
+			},
+		}
+		expected := `{
+  "schemaVersion": 1,
+  "document": {
+    "type": "Document",
+    "elements": [
+      {
+        "type": "BlankLine"
+      }
+    ]
+  }
+}
+`
+		result := &bytes.Buffer{}
+		err := types.EncodeJSON(result, doc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.String()).To(Equal(expected))
+	})
+})
+
+var _ = Describe("json schema", func() {
+
+	It("should match the schema description shipped in the docs", func() {
+		// the shipped schema can be regenerated from the root of the repository with
+		// `libasciidoc --dump schema -o docs/json-schema.json`
+		expected, err := ioutil.ReadFile("../../docs/json-schema.json")
+		Expect(err).NotTo(HaveOccurred())
+		result := &bytes.Buffer{}
+		err = types.EncodeJSONSchema(result)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.String()).To(Equal(string(expected)))
+	})
+
+	It("should describe the fields of the nodes", func() {
+		result := &bytes.Buffer{}
+		err := types.EncodeJSONSchema(result)
+		Expect(err).NotTo(HaveOccurred())
+		schema := struct {
+			Definitions map[string]struct {
+				Required   []string                   `json:"required"`
+				Properties map[string]json.RawMessage `json:"properties"`
+			} `json:"definitions"`
+		}{}
+		err = json.Unmarshal(result.Bytes(), &schema)
+		Expect(err).NotTo(HaveOccurred())
+		// the internal fields of the document are not part of the schema
+		Expect(schema.Definitions).To(HaveKey("Document"))
+		Expect(schema.Definitions["Document"].Properties).To(HaveLen(4))
+		Expect(schema.Definitions["Document"].Properties).To(HaveKey("type"))
+		Expect(schema.Definitions["Document"].Properties).To(HaveKey("attributes"))
+		Expect(schema.Definitions["Document"].Properties).To(HaveKey("elements"))
+		Expect(schema.Definitions["Document"].Properties).To(HaveKey("footnotes"))
+		Expect(schema.Definitions["Document"].Required).To(Equal([]string{"type"}))
+		// the fields without the `omitempty` option are required
+		Expect(schema.Definitions).To(HaveKey("Section"))
+		Expect(schema.Definitions["Section"].Required).To(Equal([]string{"type", "level"}))
+		Expect(schema.Definitions).To(HaveKey("Position"))
+		Expect(schema.Definitions["Position"].Required).To(Equal([]string{"type", "line", "column", "endLine", "endColumn"}))
+		// the internal types are not part of the schema
+		Expect(schema.Definitions).NotTo(HaveKey("DocInfo"))
+		Expect(schema.Definitions).NotTo(HaveKey("ProcessingWarning"))
+	})
+})
@@ -0,0 +1,131 @@
+package types
+
+import "strings"
+
+// DefaultLang the default language of the documents
+const DefaultLang = "en"
+
+// labels the built-in labels (i.e., the default values of the label attributes), by language
+var labels = map[string]map[string]string{
+	"en": {
+		AttrTableOfContentsTitle: "Table of Contents",
+		AttrFigureCaption:        "Figure",
+		AttrTableCaption:         "Table",
+		AttrExampleCaption:       "Example",
+		AttrNoteCaption:          "Note",
+		AttrTipCaption:           "Tip",
+		AttrImportantCaption:     "Important",
+		AttrWarningCaption:       "Warning",
+		AttrCautionCaption:       "Caution",
+		AttrVersionLabel:         "Version",
+		AttrLastUpdateLabel:      "Last updated",
+		AttrManualPageLabel:      "Manual Page",
+	},
+	"de": {
+		AttrTableOfContentsTitle: "Inhaltsverzeichnis",
+		AttrFigureCaption:        "Abbildung",
+		AttrTableCaption:         "Tabelle",
+		AttrExampleCaption:       "Beispiel",
+		AttrNoteCaption:          "Anmerkung",
+		AttrTipCaption:           "Hinweis",
+		AttrImportantCaption:     "Wichtig",
+		AttrWarningCaption:       "Warnung",
+		AttrCautionCaption:       "Achtung",
+		AttrVersionLabel:         "Version",
+		AttrLastUpdateLabel:      "Zuletzt aktualisiert",
+		AttrManualPageLabel:      "Handbuchseite",
+	},
+	"es": {
+		AttrTableOfContentsTitle: "Tabla de Contenido",
+		AttrFigureCaption:        "Figura",
+		AttrTableCaption:         "Tabla",
+		AttrExampleCaption:       "Ejemplo",
+		AttrNoteCaption:          "Nota",
+		AttrTipCaption:           "Sugerencia",
+		AttrImportantCaption:     "Importante",
+		AttrWarningCaption:       "Aviso",
+		AttrCautionCaption:       "Precaución",
+		AttrVersionLabel:         "Versión",
+		AttrLastUpdateLabel:      "Última actualización",
+		AttrManualPageLabel:      "Página de manual",
+	},
+	"fr": {
+		AttrTableOfContentsTitle: "Table des matières",
+		AttrFigureCaption:        "Figure",
+		AttrTableCaption:         "Tableau",
+		AttrExampleCaption:       "Exemple",
+		AttrNoteCaption:          "Note",
+		AttrTipCaption:           "Astuce",
+		AttrImportantCaption:     "Important",
+		AttrWarningCaption:       "Attention",
+		AttrCautionCaption:       "Avertissement",
+		AttrVersionLabel:         "Version",
+		AttrLastUpdateLabel:      "Dernière mise à jour",
+		AttrManualPageLabel:      "Page de manuel",
+	},
+	"it": {
+		AttrTableOfContentsTitle: "Indice",
+		AttrFigureCaption:        "Figura",
+		AttrTableCaption:         "Tabella",
+		AttrExampleCaption:       "Esempio",
+		AttrNoteCaption:          "Nota",
+		AttrTipCaption:           "Suggerimento",
+		AttrImportantCaption:     "Importante",
+		AttrWarningCaption:       "Avvertenza",
+		AttrCautionCaption:       "Attenzione",
+		AttrVersionLabel:         "Versione",
+		AttrLastUpdateLabel:      "Ultimo aggiornamento",
+		AttrManualPageLabel:      "Pagina di manuale",
+	},
+	"nl": {
+		AttrTableOfContentsTitle: "Inhoudsopgave",
+		AttrFigureCaption:        "Figuur",
+		AttrTableCaption:         "Tabel",
+		AttrExampleCaption:       "Voorbeeld",
+		AttrNoteCaption:          "Noot",
+		AttrTipCaption:           "Tip",
+		AttrImportantCaption:     "Belangrijk",
+		AttrWarningCaption:       "Waarschuwing",
+		AttrCautionCaption:       "Opgelet",
+		AttrVersionLabel:         "Versie",
+		AttrLastUpdateLabel:      "Laatste aanpassing",
+		AttrManualPageLabel:      "Handleidingpagina",
+	},
+}
+
+// GetLang returns the language of the document, i.e., the value of the `lang` attribute,
+// or `en` if it is not set
+func (a Attributes) GetLang() string {
+	if lang := a.GetAsStringWithDefault(AttrLang, ""); lang != "" {
+		return lang
+	}
+	return DefaultLang
+}
+
+// IsLabel returns true if the given attribute is a label attribute (eg: `figure-caption`), which has a built-in value
+func IsLabel(name string) bool {
+	_, found := labels[DefaultLang][name]
+	return found
+}
+
+// GetLabel returns the value of the given label attribute (eg: `figure-caption`) if it is set,
+// otherwise the built-in label in the language of the document (or in English if there is no such label).
+// An empty string means that the label is disabled, which is also the case when the label attribute was unset
+// (eg: `:figure-caption!:` in the document, or `figure-caption!` in the overrides).
+func (a Attributes) GetLabel(name string) string {
+	if value, found := a.GetAsString(name); found {
+		return value
+	}
+	lang := a.GetLang()
+	// also lookup the labels for the primary language (eg: `de` for `de-AT`)
+	primary := lang
+	if i := strings.IndexAny(lang, "-_"); i > 0 {
+		primary = lang[:i]
+	}
+	for _, l := range []string{lang, primary, DefaultLang} {
+		if value, found := labels[l][name]; found {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,19 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = DescribeTable("labels",
+	func(attrs types.Attributes, name, expected string) {
+		Expect(attrs.GetLabel(name)).To(Equal(expected))
+	},
+	Entry("default language", types.Attributes{}, types.AttrFigureCaption, "Figure"),
+	Entry("german", types.Attributes{types.AttrLang: "de"}, types.AttrFigureCaption, "Abbildung"),
+	Entry("german in austria", types.Attributes{types.AttrLang: "de-AT"}, types.AttrTableOfContentsTitle, "Inhaltsverzeichnis"),
+	Entry("unknown language", types.Attributes{types.AttrLang: "xx"}, types.AttrNoteCaption, "Note"),
+	Entry("custom label", types.Attributes{types.AttrLang: "de", types.AttrNoteCaption: "Notiz"}, types.AttrNoteCaption, "Notiz"),
+	Entry("disabled label", types.Attributes{types.AttrTableCaption: ""}, types.AttrTableCaption, ""),
+)
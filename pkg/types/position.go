@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ------------------------------------------
+// Positions
+// ------------------------------------------
+
+// Position the position of an element in the source document.
+// For the elements that were found in an included file, the position is in the included file.
+// Note: the plain text elements (`StringElement`) do not carry any position, since they are
+// merged and split during the processing of the document.
+type Position struct {
+	File      string `json:"file,omitempty"` // the document in which the element was found (which can be an included file)
+	Line      int    `json:"line"`           // the line at which the element starts (1-based)
+	Column    int    `json:"column"`         // the column at which the element starts (1-based)
+	EndLine   int    `json:"endLine"`        // the line at which the element ends
+	EndColumn int    `json:"endColumn"`      // the column after the last character of the element
+}
+
+// IsSet returns true if the position was set
+func (p Position) IsSet() bool {
+	return p.Line > 0
+}
+
+// String returns the position in the `file:line:column` form
+func (p Position) String() string {
+	if !p.IsSet() {
+		return ""
+	}
+	if p.File == "" {
+		return fmt.Sprintf("%d:%d", p.Line, p.Column)
+	}
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// Fields returns the log fields to report this position in a log message
+// (or no field if the position is not set)
+func (p Position) Fields() log.Fields {
+	if !p.IsSet() {
+		return log.Fields{}
+	}
+	return log.Fields{
+		"position": p.String(),
+	}
+}
+
+// Extend returns a new position which starts at this position and which
+// ends at the end of the given position (if it is in the same file and after this one)
+func (p Position) Extend(other Position) Position {
+	if !p.IsSet() || !other.IsSet() || other.File != p.File {
+		return p
+	}
+	if other.EndLine > p.EndLine || (other.EndLine == p.EndLine && other.EndColumn > p.EndColumn) {
+		p.EndLine = other.EndLine
+		p.EndColumn = other.EndColumn
+	}
+	return p
+}
+
+var positionType = reflect.TypeOf(Position{})
+
+// PositionOf returns the position of the given element, or an empty position
+// if the element does not carry one
+func PositionOf(element interface{}) Position {
+	v := reflect.ValueOf(element)
+	if v.Kind() != reflect.Struct {
+		return Position{}
+	}
+	if f := v.FieldByName("Position"); f.IsValid() && f.Type() == positionType {
+		return f.Interface().(Position)
+	}
+	return Position{}
+}
@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = DescribeTable("position",
+	func(p types.Position, expected string) {
+		Expect(p.String()).To(Equal(expected))
+	},
+	Entry("unset", types.Position{}, ""),
+	Entry("without file", types.Position{Line: 1, Column: 2}, "1:2"),
+	Entry("with file", types.Position{File: "foo.adoc", Line: 1, Column: 2}, "foo.adoc:1:2"),
+)
+
+var _ = DescribeTable("extend position",
+	func(p, other, expected types.Position) {
+		Expect(p.Extend(other)).To(Equal(expected))
+	},
+	Entry("with later position in same file",
+		types.Position{File: "foo.adoc", Line: 1, Column: 1, EndLine: 1, EndColumn: 5},
+		types.Position{File: "foo.adoc", Line: 3, Column: 1, EndLine: 4, EndColumn: 2},
+		types.Position{File: "foo.adoc", Line: 1, Column: 1, EndLine: 4, EndColumn: 2}),
+	Entry("with earlier position in same file",
+		types.Position{File: "foo.adoc", Line: 3, Column: 1, EndLine: 4, EndColumn: 2},
+		types.Position{File: "foo.adoc", Line: 1, Column: 1, EndLine: 1, EndColumn: 5},
+		types.Position{File: "foo.adoc", Line: 3, Column: 1, EndLine: 4, EndColumn: 2}),
+	Entry("with position in other file",
+		types.Position{File: "foo.adoc", Line: 1, Column: 1, EndLine: 1, EndColumn: 5},
+		types.Position{File: "bar.adoc", Line: 3, Column: 1, EndLine: 4, EndColumn: 2},
+		types.Position{File: "foo.adoc", Line: 1, Column: 1, EndLine: 1, EndColumn: 5}),
+	Entry("with unset position",
+		types.Position{File: "foo.adoc", Line: 1, Column: 1, EndLine: 1, EndColumn: 5},
+		types.Position{},
+		types.Position{File: "foo.adoc", Line: 1, Column: 1, EndLine: 1, EndColumn: 5}),
+)
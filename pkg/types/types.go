@@ -22,8 +22,8 @@ import (
 
 // DraftDocument the linear-level structure for a document
 type DraftDocument struct {
-	FrontMatter FrontMatter
-	Blocks      []interface{}
+	FrontMatter FrontMatter   `json:"frontMatter,omitempty"`
+	Blocks      []interface{} `json:"blocks,omitempty"`
 }
 
 // NewDraftDocument initializes a new Draft`Document` from the given lines
@@ -38,12 +38,49 @@ func NewDraftDocument(frontMatter interface{}, blocks []interface{}) (DraftDocum
 	return result, nil
 }
 
+// Header returns the header, i.e., the section with level 0 if it found as the first block of the document
+// (after the blank lines and comments)
+func (d DraftDocument) Header() (Section, bool) {
+	for _, b := range d.Blocks {
+		switch b := b.(type) {
+		case BlankLine, SingleLineComment:
+			continue
+		case Section:
+			return b, b.Level == 0
+		default:
+			return Section{}, false
+		}
+	}
+	return Section{}, false
+}
+
 // Attributes returns the document attributes on the top-level section
-// and all the document attribute declarations at the top of the document only.
+// and all the document attribute declarations at the top of the document only
+// (ie, until the first blank line which follows the document header, or the first block which is not a header element).
 func (d DraftDocument) Attributes() Attributes {
-	result := Attributes{}
-blocks:
-	for _, b := range d.Blocks {
+	result := NewAttributesWithOverrides(map[string]string{})
+	d.ApplyHeaderAttributes(result)
+	log.Debugf("document attributes: %+v", result.Content)
+	return result.Content
+}
+
+// ApplyHeaderAttributes sets the document attributes on the top-level section and applies the document attribute
+// declarations and resets at the top of the document on the given attributes.
+// Returns the number of blocks at the top of the document which belong to the header
+// (the attribute declarations and resets which follow them are part of the document body)
+func (d DraftDocument) ApplyHeaderAttributes(result AttributesWithOverrides) int {
+	inHeader := false
+	for i, b := range d.Blocks {
+		switch b.(type) {
+		case BlankLine:
+			if inHeader {
+				return i
+			}
+			continue // skip the blank lines at the top of the document
+		case SingleLineComment:
+			continue
+		}
+		inHeader = true
 		switch b := b.(type) {
 		case Section:
 			if b.Level == 0 {
@@ -61,15 +98,16 @@ blocks:
 				}
 				continue // allow to continue if the section is level 0
 			}
-			break blocks // otherwise, just stop
+			return i // otherwise, just stop
 		case AttributeDeclaration:
 			result.Set(b.Name, b.Value)
+		case AttributeReset:
+			result.Delete(b.Name)
 		default:
-			break blocks
+			return i
 		}
 	}
-	log.Debugf("document attributes: %+v", result)
-	return result
+	return len(d.Blocks)
 }
 
 // ------------------------------------------
@@ -78,10 +116,24 @@ blocks:
 
 // Document the top-level structure for a document
 type Document struct {
-	Attributes        Attributes
-	Elements          []interface{} // TODO: rename to `Blocks`?
-	ElementReferences ElementReferences
-	Footnotes         []Footnote
+	Attributes        Attributes          `json:"attributes,omitempty"`
+	Elements          []interface{}       `json:"elements,omitempty"` // TODO: rename to `Blocks`?
+	ElementReferences ElementReferences   `json:"-"`
+	Footnotes         []Footnote          `json:"footnotes,omitempty"`
+	Warnings          []ProcessingWarning `json:"-"`
+	DocInfo           DocInfo             `json:"-"`
+}
+
+// DocInfo the content of the docinfo files, to inject in the head and at the end of the body of the output
+type DocInfo struct {
+	Head   string
+	Footer string
+}
+
+// ProcessingWarning a problem which was detected while processing the document, but which did not prevent its rendering
+type ProcessingWarning struct {
+	Message  string
+	Position Position
 }
 
 // Authors retrieves the document authors from the document header, or empty array if no author was found
@@ -123,6 +175,9 @@ type Metadata struct {
 	TableOfContents TableOfContents
 	Authors         []DocumentAuthor
 	Revision        DocumentRevision
+	Warnings        []ProcessingWarning
+	OutFileName     string // the name of the output file, if set by the backend (eg: `<manname>.<manvolnum>` for a manpage)
+	OutFileSuffix   string // the suffix of the output file, as set by the backend used to render the document (or by the document itself)
 }
 
 // TableOfContents the table of contents
@@ -153,8 +208,8 @@ type DocumentElement interface {
 
 // DocumentAuthor a document author
 type DocumentAuthor struct {
-	FullName string
-	Email    string
+	FullName string `json:"fullName,omitempty"`
+	Email    string `json:"email,omitempty"`
 }
 
 // NewDocumentAuthors converts the given authors into an array of `DocumentAuthor`
@@ -190,9 +245,9 @@ func NewDocumentAuthor(fullName, email interface{}) (DocumentAuthor, error) {
 
 // DocumentRevision a document revision
 type DocumentRevision struct {
-	Revnumber string
-	Revdate   string
-	Revremark string
+	Revnumber string `json:"revnumber,omitempty"`
+	Revdate   string `json:"revdate,omitempty"`
+	Revremark string `json:"revremark,omitempty"`
 }
 
 // NewDocumentRevision intializes a new DocumentRevision
@@ -240,12 +295,13 @@ func NewDocumentRevision(revnumber, revdate, revremark interface{}) (DocumentRev
 
 // AttributeDeclaration the type for Document Attribute Declarations
 type AttributeDeclaration struct {
-	Name  string
-	Value string
+	Name     string   `json:"name,omitempty"`
+	Value    string   `json:"value,omitempty"`
+	Position Position `json:"position,omitempty"`
 }
 
 // NewAttributeDeclaration initializes a new AttributeDeclaration with the given name and optional value
-func NewAttributeDeclaration(name string, value interface{}) (AttributeDeclaration, error) {
+func NewAttributeDeclaration(name string, value interface{}, position Position) (AttributeDeclaration, error) {
 	var attrName, attrValue string
 	attrName = Apply(name,
 		func(s string) string {
@@ -259,31 +315,61 @@ func NewAttributeDeclaration(name string, value interface{}) (AttributeDeclarati
 	}
 	log.Debugf("initialized a new AttributeDeclaration: '%s' -> '%s'", attrName, attrValue)
 	return AttributeDeclaration{
-		Name:  attrName,
-		Value: attrValue,
+		Name:     attrName,
+		Value:    attrValue,
+		Position: position,
 	}, nil
 }
 
 // AttributeReset the type for AttributeReset
 type AttributeReset struct {
-	Name string
+	Name     string   `json:"name,omitempty"`
+	Position Position `json:"position,omitempty"`
 }
 
 // NewAttributeReset initializes a new Document Attribute Resets.
-func NewAttributeReset(attrName string) (AttributeReset, error) {
+func NewAttributeReset(attrName string, position Position) (AttributeReset, error) {
 	log.Debugf("initialized a new AttributeReset: '%s'", attrName)
-	return AttributeReset{Name: attrName}, nil
+	return AttributeReset{Name: attrName, Position: position}, nil
 }
 
 // AttributeSubstitution the type for AttributeSubstitution
 type AttributeSubstitution struct {
-	Name string
+	Name     string   `json:"name,omitempty"`
+	Position Position `json:"position,omitempty"`
 }
 
 // NewAttributeSubstitution initializes a new Document Attribute Substitutions
-func NewAttributeSubstitution(attrName string) (AttributeSubstitution, error) {
+func NewAttributeSubstitution(attrName string, position Position) (AttributeSubstitution, error) {
 	log.Debugf("initialized a new AttributeSubstitution: '%s'", attrName)
-	return AttributeSubstitution{Name: attrName}, nil
+	return AttributeSubstitution{Name: attrName, Position: position}, nil
+}
+
+// String returns the attribute substitution in its `{name}` form
+func (s AttributeSubstitution) String() string {
+	return "{" + s.Name + "}"
+}
+
+// InlineAttributeEntry the type for the inline attribute entries, i.e., `{set:name}`, `{set:name:value}` or `{set:name!}`
+type InlineAttributeEntry struct {
+	Name     string   `json:"name,omitempty"`
+	Value    string   `json:"value,omitempty"`
+	Unset    bool     `json:"unset,omitempty"`
+	Position Position `json:"position,omitempty"`
+}
+
+// NewInlineAttributeEntry initializes a new inline attribute entry
+func NewInlineAttributeEntry(attrName string, value interface{}, unset bool, position Position) (InlineAttributeEntry, error) {
+	log.Debugf("initialized a new InlineAttributeEntry: '%s'", attrName)
+	result := InlineAttributeEntry{
+		Name:  attrName,
+		Unset: unset,
+	}
+	if value, ok := value.(string); ok {
+		result.Value = value
+	}
+	result.Position = position
+	return result, nil
 }
 
 // ------------------------------------------
@@ -327,6 +413,7 @@ const (
 // TableOfContentsPlaceHolder a place holder for Table of Contents, so
 // the renderer knows when to render it.
 type TableOfContentsPlaceHolder struct {
+	Position Position `json:"position,omitempty"`
 }
 
 // ------------------------------------------
@@ -345,15 +432,16 @@ type MacroKind string
 
 // UserMacro the structure for User Macro
 type UserMacro struct {
-	Kind       MacroKind
-	Name       string
-	Value      string
-	Attributes Attributes
-	RawText    string
+	Kind       MacroKind  `json:"kind,omitempty"`
+	Name       string     `json:"name,omitempty"`
+	Value      string     `json:"value,omitempty"`
+	Attributes Attributes `json:"attributes,omitempty"`
+	RawText    string     `json:"rawText,omitempty"`
+	Position   Position   `json:"position,omitempty"`
 }
 
 // NewUserMacroBlock returns an UserMacro
-func NewUserMacroBlock(name string, value string, attributes interface{}, raw string) (UserMacro, error) {
+func NewUserMacroBlock(name string, value string, attributes interface{}, raw string, position Position) (UserMacro, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return UserMacro{}, errors.Wrap(err, "failed to initialize a UserMacro element")
@@ -364,11 +452,12 @@ func NewUserMacroBlock(name string, value string, attributes interface{}, raw st
 		Value:      value,
 		Attributes: attrs,
 		RawText:    raw,
+		Position:   position,
 	}, nil
 }
 
 // NewInlineUserMacro returns an UserMacro
-func NewInlineUserMacro(name, value string, attributes interface{}, raw string) (UserMacro, error) {
+func NewInlineUserMacro(name, value string, attributes interface{}, raw string, position Position) (UserMacro, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return UserMacro{}, errors.Wrap(err, "failed to initialize a UserMacro element")
@@ -379,6 +468,7 @@ func NewInlineUserMacro(name, value string, attributes interface{}, raw string)
 		Value:      value,
 		Attributes: attrs,
 		RawText:    raw,
+		Position:   position,
 	}, nil
 }
 
@@ -388,7 +478,7 @@ func NewInlineUserMacro(name, value string, attributes interface{}, raw string)
 
 // Preamble the structure for document Preamble
 type Preamble struct {
-	Elements []interface{}
+	Elements []interface{} `json:"elements,omitempty"`
 }
 
 // HasContent returns `true` if this Preamble has at least one element which is neither a
@@ -411,7 +501,7 @@ func (p Preamble) HasContent() bool {
 
 // FrontMatter the structure for document front-matter
 type FrontMatter struct {
-	Content map[string]interface{}
+	Content map[string]interface{} `json:"content,omitempty"`
 }
 
 // NewYamlFrontMatter initializes a new FrontMatter from the given `content`
@@ -431,14 +521,15 @@ func NewYamlFrontMatter(content string) (FrontMatter, error) {
 
 // Section the structure for a section
 type Section struct {
-	Level      int
-	Attributes Attributes
-	Title      []interface{}
-	Elements   []interface{}
+	Level      int           `json:"level"`
+	Attributes Attributes    `json:"attributes,omitempty"`
+	Title      []interface{} `json:"title,omitempty"`
+	Elements   []interface{} `json:"elements,omitempty"`
+	Position   Position      `json:"position,omitempty"`
 }
 
 // NewSection initializes a new `Section` from the given section title and elements
-func NewSection(level int, title []interface{}, ids []interface{}, attributes interface{}) (Section, error) {
+func NewSection(level int, title []interface{}, ids []interface{}, attributes interface{}, position Position) (Section, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return Section{}, errors.Wrapf(err, "failed to initialize a Section element")
@@ -453,6 +544,7 @@ func NewSection(level int, title []interface{}, ids []interface{}, attributes in
 		Attributes: attrs,
 		Title:      title,
 		Elements:   []interface{}{},
+		Position:   position,
 	}, nil
 }
 
@@ -473,6 +565,7 @@ func (s Section) ResolveID(docAttributes AttributesWithOverrides) (Section, erro
 // AddElement adds the given child element to this section
 func (s *Section) AddElement(e interface{}) {
 	s.Elements = append(s.Elements, e)
+	s.Position = s.Position.Extend(PositionOf(e))
 }
 
 var _ FootnotesContainer = Section{}
@@ -489,9 +582,9 @@ func (s Section) ReplaceFootnotes(notes *Footnotes) interface{} {
 }
 
 // NewDocumentHeader initializes a new Section with level 0 which can have authors and a revision, among other attributes
-func NewDocumentHeader(title []interface{}, authors interface{}, revision interface{}) (Section, error) {
+func NewDocumentHeader(title []interface{}, authors interface{}, revision interface{}, position Position) (Section, error) {
 	// log.Debugf("initializing a new Section level 0 with authors '%v' and revision '%v'", authors, revision)
-	section, err := NewSection(0, title, nil, nil)
+	section, err := NewSection(0, title, nil, nil, position)
 	if err != nil {
 		return Section{}, err
 	}
@@ -619,16 +712,18 @@ type ListItem interface { // TODO: convert to struct and use as composant in Ord
 
 // ContinuedListItemElement a wrapper for an element which should be attached to a list item (same level or an ancestor)
 type ContinuedListItemElement struct {
-	Offset  int // the relative ancestor. Should be a negative number
-	Element interface{}
+	Offset   int         `json:"offset"` // the relative ancestor. Should be a negative number
+	Element  interface{} `json:"element,omitempty"`
+	Position Position    `json:"position,omitempty"`
 }
 
 // NewContinuedListItemElement returns a wrapper for an element which should be attached to a list item (same level or an ancestor)
-func NewContinuedListItemElement(element interface{}) (ContinuedListItemElement, error) {
+func NewContinuedListItemElement(element interface{}, position Position) (ContinuedListItemElement, error) {
 	// log.Debugf("initializing a new continued list element for element of type %T", element)
 	return ContinuedListItemElement{
-		Offset:  0,
-		Element: element,
+		Offset:   0,
+		Element:  element,
+		Position: position,
 	}, nil
 }
 
@@ -638,8 +733,9 @@ func NewContinuedListItemElement(element interface{}) (ContinuedListItemElement,
 
 // OrderedList the structure for the Ordered Lists
 type OrderedList struct {
-	Attributes Attributes
-	Items      []OrderedListItem
+	Attributes Attributes        `json:"attributes,omitempty"`
+	Items      []OrderedListItem `json:"items,omitempty"`
+	Position   Position          `json:"position,omitempty"`
 }
 
 var _ List = &OrderedList{}
@@ -677,6 +773,7 @@ func NewOrderedList(item *OrderedListItem) *OrderedList {
 		Items: []OrderedListItem{
 			*item,
 		},
+		Position: item.Position,
 	}
 }
 
@@ -708,6 +805,7 @@ func rearrangeListAttributes(attributes Attributes) Attributes {
 // AddItem adds the given item
 func (l *OrderedList) AddItem(item OrderedListItem) {
 	l.Items = append(l.Items, item)
+	l.Position = l.Position.Extend(item.Position)
 }
 
 // LastItem returns the last item in this list
@@ -717,17 +815,18 @@ func (l *OrderedList) LastItem() ListItem {
 
 // OrderedListItem the structure for the ordered list items
 type OrderedListItem struct {
-	Attributes     Attributes
-	Level          int
-	NumberingStyle NumberingStyle
-	Elements       []interface{} // TODO: rename to `Blocks`?
+	Attributes     Attributes     `json:"attributes,omitempty"`
+	Level          int            `json:"level"`
+	NumberingStyle NumberingStyle `json:"numberingStyle,omitempty"`
+	Elements       []interface{}  `json:"elements,omitempty"` // TODO: rename to `Blocks`?
+	Position       Position       `json:"position,omitempty"`
 }
 
 // making sure that the `ListItem` interface is implemented by `OrderedListItem`
 var _ ListItem = &OrderedListItem{}
 
 // NewOrderedListItem initializes a new `orderedListItem` from the given content
-func NewOrderedListItem(prefix OrderedListItemPrefix, elements []interface{}, attributes interface{}) (OrderedListItem, error) {
+func NewOrderedListItem(prefix OrderedListItemPrefix, elements []interface{}, attributes interface{}, position Position) (OrderedListItem, error) {
 	log.Debugf("initializing a new OrderedListItem")
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
@@ -738,6 +837,7 @@ func NewOrderedListItem(prefix OrderedListItemPrefix, elements []interface{}, at
 		NumberingStyle: prefix.NumberingStyle,
 		Level:          prefix.Level,
 		Elements:       elements,
+		Position:       position,
 	}, nil
 }
 
@@ -749,12 +849,13 @@ func (i OrderedListItem) GetAttributes() Attributes {
 // AddElement add an element to this OrderedListItem
 func (i *OrderedListItem) AddElement(element interface{}) {
 	i.Elements = append(i.Elements, element)
+	i.Position = i.Position.Extend(PositionOf(element))
 }
 
 // OrderedListItemPrefix the prefix used to construct an OrderedListItem
 type OrderedListItemPrefix struct {
-	NumberingStyle NumberingStyle
-	Level          int
+	NumberingStyle NumberingStyle `json:"numberingStyle,omitempty"`
+	Level          int            `json:"level"`
 }
 
 // NewOrderedListItemPrefix initializes a new OrderedListItemPrefix
@@ -771,8 +872,9 @@ func NewOrderedListItemPrefix(s NumberingStyle, l int) (OrderedListItemPrefix, e
 
 // UnorderedList the structure for the Unordered Lists
 type UnorderedList struct {
-	Attributes Attributes
-	Items      []UnorderedListItem
+	Attributes Attributes          `json:"attributes,omitempty"`
+	Items      []UnorderedListItem `json:"items,omitempty"`
+	Position   Position            `json:"position,omitempty"`
 }
 
 var _ List = &UnorderedList{}
@@ -787,6 +889,7 @@ func NewUnorderedList(item *UnorderedListItem) *UnorderedList {
 		Items: []UnorderedListItem{
 			*item,
 		},
+		Position: item.Position,
 	}
 	return list
 }
@@ -794,6 +897,7 @@ func NewUnorderedList(item *UnorderedListItem) *UnorderedList {
 // AddItem adds the given item
 func (l *UnorderedList) AddItem(item UnorderedListItem) {
 	l.Items = append(l.Items, item)
+	l.Position = l.Position.Extend(item.Position)
 }
 
 // LastItem returns the last item in this list
@@ -803,15 +907,16 @@ func (l *UnorderedList) LastItem() ListItem {
 
 // UnorderedListItem the structure for the unordered list items
 type UnorderedListItem struct {
-	Level       int
-	BulletStyle BulletStyle
-	CheckStyle  UnorderedListItemCheckStyle
-	Attributes  Attributes
-	Elements    []interface{} // TODO: rename to `Blocks`?
+	Level       int                         `json:"level"`
+	BulletStyle BulletStyle                 `json:"bulletStyle,omitempty"`
+	CheckStyle  UnorderedListItemCheckStyle `json:"checkStyle,omitempty"`
+	Attributes  Attributes                  `json:"attributes,omitempty"`
+	Elements    []interface{}               `json:"elements,omitempty"` // TODO: rename to `Blocks`?
+	Position    Position                    `json:"position,omitempty"`
 }
 
 // NewUnorderedListItem initializes a new `UnorderedListItem` from the given content
-func NewUnorderedListItem(prefix UnorderedListItemPrefix, checkstyle interface{}, elements []interface{}, attributes interface{}) (UnorderedListItem, error) {
+func NewUnorderedListItem(prefix UnorderedListItemPrefix, checkstyle interface{}, elements []interface{}, attributes interface{}, position Position) (UnorderedListItem, error) {
 	log.Debugf("initializing a new UnorderedListItem with %d elements", len(elements))
 	// log.Debugf("initializing a new UnorderedListItem with '%d' lines (%T) and input level '%d'", len(elements), elements, lvl.Len())
 	attrs, err := NewAttributes(attributes)
@@ -834,6 +939,7 @@ func NewUnorderedListItem(prefix UnorderedListItemPrefix, checkstyle interface{}
 		BulletStyle: prefix.BulletStyle,
 		CheckStyle:  cs,
 		Elements:    elements,
+		Position:    position,
 	}, nil
 }
 
@@ -845,6 +951,7 @@ func (i UnorderedListItem) GetAttributes() Attributes {
 // AddElement add an element to this UnorderedListItem
 func (i *UnorderedListItem) AddElement(element interface{}) {
 	i.Elements = append(i.Elements, element)
+	i.Position = i.Position.Extend(PositionOf(element))
 }
 
 // UnorderedListItemCheckStyle the check style that applies on an unordered list item
@@ -914,8 +1021,8 @@ func (b BulletStyle) NextLevel(p BulletStyle) BulletStyle {
 
 // UnorderedListItemPrefix the prefix used to construct an UnorderedListItem
 type UnorderedListItemPrefix struct {
-	BulletStyle BulletStyle
-	Level       int
+	BulletStyle BulletStyle `json:"bulletStyle,omitempty"`
+	Level       int         `json:"level"`
 }
 
 // NewUnorderedListItemPrefix initializes a new UnorderedListItemPrefix
@@ -953,8 +1060,9 @@ func NewListItemContent(content []interface{}) ([]interface{}, error) {
 
 // LabeledList the structure for the Labeled Lists
 type LabeledList struct {
-	Attributes Attributes
-	Items      []LabeledListItem
+	Attributes Attributes        `json:"attributes,omitempty"`
+	Items      []LabeledListItem `json:"items,omitempty"`
+	Position   Position          `json:"position,omitempty"`
 }
 
 var _ List = &LabeledList{}
@@ -969,6 +1077,7 @@ func NewLabeledList(item LabeledListItem) *LabeledList {
 		Items: []LabeledListItem{
 			item,
 		},
+		Position: item.Position,
 	}
 	return &result
 }
@@ -976,6 +1085,7 @@ func NewLabeledList(item LabeledListItem) *LabeledList {
 // AddItem adds the given item
 func (l *LabeledList) AddItem(item LabeledListItem) {
 	l.Items = append(l.Items, item)
+	l.Position = l.Position.Extend(item.Position)
 }
 
 // LastItem returns the last item in this list
@@ -985,17 +1095,18 @@ func (l *LabeledList) LastItem() ListItem {
 
 // LabeledListItem an item in a labeled
 type LabeledListItem struct {
-	Term       []interface{}
-	Level      int
-	Attributes Attributes
-	Elements   []interface{} // TODO: rename to `Blocks`?
+	Term       []interface{} `json:"term,omitempty"`
+	Level      int           `json:"level"`
+	Attributes Attributes    `json:"attributes,omitempty"`
+	Elements   []interface{} `json:"elements,omitempty"` // TODO: rename to `Blocks`?
+	Position   Position      `json:"position,omitempty"`
 }
 
 // making sure that the `ListItem` interface is implemented by `LabeledListItem`
 var _ ListItem = &LabeledListItem{}
 
 // NewLabeledListItem initializes a new LabeledListItem
-func NewLabeledListItem(level int, term []interface{}, description interface{}, attributes interface{}) (LabeledListItem, error) {
+func NewLabeledListItem(level int, term []interface{}, description interface{}, attributes interface{}, position Position) (LabeledListItem, error) {
 	log.Debugf("initializing a new LabeledListItem")
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
@@ -1012,6 +1123,7 @@ func NewLabeledListItem(level int, term []interface{}, description interface{},
 		Term:       term,
 		Level:      level,
 		Elements:   elements,
+		Position:   position,
 	}, nil
 }
 
@@ -1023,6 +1135,7 @@ func (i LabeledListItem) GetAttributes() Attributes {
 // AddElement add an element to this LabeledListItem
 func (i *LabeledListItem) AddElement(element interface{}) {
 	i.Elements = append(i.Elements, element)
+	i.Position = i.Position.Extend(PositionOf(element))
 }
 
 // ------------------------------------------
@@ -1031,8 +1144,9 @@ func (i *LabeledListItem) AddElement(element interface{}) {
 
 // Paragraph the structure for the paragraphs
 type Paragraph struct {
-	Attributes Attributes
-	Lines      [][]interface{}
+	Attributes Attributes      `json:"attributes,omitempty"`
+	Lines      [][]interface{} `json:"lines,omitempty"`
+	Position   Position        `json:"position,omitempty"`
 }
 
 // AttrHardBreaks the attribute to set on a paragraph to render with hard breaks on each line
@@ -1042,7 +1156,7 @@ const AttrHardBreaks = "%hardbreaks"
 const DocumentAttrHardBreaks = "hardbreaks"
 
 // NewParagraph initializes a new `Paragraph`
-func NewParagraph(lines []interface{}, attributes interface{}) (Paragraph, error) {
+func NewParagraph(lines []interface{}, attributes interface{}, position Position) (Paragraph, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return Paragraph{}, errors.Wrapf(err, "failed to initialize a Paragraph element")
@@ -1064,6 +1178,7 @@ func NewParagraph(lines []interface{}, attributes interface{}) (Paragraph, error
 	return Paragraph{
 		Attributes: attrs,
 		Lines:      elements,
+		Position:   position,
 	}, nil
 }
 
@@ -1083,13 +1198,13 @@ func (p Paragraph) ReplaceFootnotes(notes *Footnotes) interface{} {
 }
 
 // NewAdmonitionParagraph returns a new Paragraph with an extra admonition attribute
-func NewAdmonitionParagraph(lines []interface{}, admonitionKind AdmonitionKind, attributes interface{}) (Paragraph, error) {
+func NewAdmonitionParagraph(lines []interface{}, admonitionKind AdmonitionKind, attributes interface{}, position Position) (Paragraph, error) {
 	log.Debugf("new admonition paragraph")
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return Paragraph{}, errors.Wrapf(err, "failed to initialize an Admonition Paragraph element")
 	}
-	p, err := NewParagraph(lines, attrs)
+	p, err := NewParagraph(lines, attrs, position)
 	if err != nil {
 		return Paragraph{}, err
 	}
@@ -1130,31 +1245,34 @@ func NewInlineElements(elements ...interface{}) ([]interface{}, error) {
 
 // InternalCrossReference the struct for Cross References
 type InternalCrossReference struct {
-	ID    string
-	Label string
+	ID       string   `json:"id,omitempty"`
+	Label    string   `json:"label,omitempty"`
+	Position Position `json:"position,omitempty"`
 }
 
 // NewInternalCrossReference initializes a new `InternalCrossReference` from the given ID
-func NewInternalCrossReference(id string, label interface{}) (InternalCrossReference, error) {
+func NewInternalCrossReference(id string, label interface{}, position Position) (InternalCrossReference, error) {
 	log.Debugf("initializing a new InternalCrossReference with ID=%s", id)
 	var l string
 	if label, ok := label.(string); ok {
 		l = Apply(label, strings.TrimSpace)
 	}
 	return InternalCrossReference{
-		ID:    id,
-		Label: l,
+		ID:       id,
+		Label:    l,
+		Position: position,
 	}, nil
 }
 
 // ExternalCrossReference the struct for Cross References
 type ExternalCrossReference struct {
-	Location Location
-	Label    []interface{}
+	Location Location      `json:"location,omitempty"`
+	Label    []interface{} `json:"label,omitempty"`
+	Position Position      `json:"position,omitempty"`
 }
 
 // NewExternalCrossReference initializes a new `InternalCrossReference` from the given ID
-func NewExternalCrossReference(location Location, attributes Attributes) (ExternalCrossReference, error) {
+func NewExternalCrossReference(location Location, attributes Attributes, position Position) (ExternalCrossReference, error) {
 	var label []interface{}
 	if l, ok := attributes["positional-1"].([]interface{}); ok {
 		label = l
@@ -1163,6 +1281,7 @@ func NewExternalCrossReference(location Location, attributes Attributes) (Extern
 	return ExternalCrossReference{
 		Location: location,
 		Label:    label,
+		Position: position,
 	}, nil
 }
 
@@ -1179,12 +1298,13 @@ func (r ExternalCrossReference) ResolveLocation(attrs AttributesWithOverrides) E
 
 // ImageBlock the structure for the block images
 type ImageBlock struct {
-	Location   Location
-	Attributes Attributes
+	Location   Location   `json:"location,omitempty"`
+	Attributes Attributes `json:"attributes,omitempty"`
+	Position   Position   `json:"position,omitempty"`
 }
 
 // NewImageBlock initializes a new `ImageBlock`
-func NewImageBlock(path Location, inlineAttributes Attributes, attributes interface{}) (ImageBlock, error) {
+func NewImageBlock(path Location, inlineAttributes Attributes, attributes interface{}, position Position) (ImageBlock, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return ImageBlock{}, errors.Wrapf(err, "failed to initialize an ImageBlock element")
@@ -1197,6 +1317,7 @@ func NewImageBlock(path Location, inlineAttributes Attributes, attributes interf
 	return ImageBlock{
 		Location:   path,
 		Attributes: attrs,
+		Position:   position,
 	}, nil
 }
 
@@ -1212,15 +1333,17 @@ func (b ImageBlock) ResolveLocation(attrs AttributesWithOverrides) ImageBlock {
 
 // InlineImage the structure for the inline image macros
 type InlineImage struct {
-	Location   Location
-	Attributes Attributes
+	Location   Location   `json:"location,omitempty"`
+	Attributes Attributes `json:"attributes,omitempty"`
+	Position   Position   `json:"position,omitempty"`
 }
 
 // NewInlineImage initializes a new `InlineImage` (similar to ImageBlock, but without attributes)
-func NewInlineImage(path Location, attributes Attributes) (InlineImage, error) {
+func NewInlineImage(path Location, attributes Attributes, position Position) (InlineImage, error) {
 	return InlineImage{
 		Location:   path,
 		Attributes: attributes,
+		Position:   position,
 	}, nil
 }
 
@@ -1274,35 +1397,39 @@ func NewImageAttributes(alt, width, height interface{}, otherattrs []interface{}
 // multiple times to the same footnote across the document)
 type Footnote struct {
 	// ID is only set during document processing
-	ID int
+	ID int `json:"id"`
 	// Ref the optional reference
-	Ref string
+	Ref string `json:"ref,omitempty"`
 	// the footnote content (can be "rich")
-	Elements []interface{}
+	Elements []interface{} `json:"elements,omitempty"`
+	Position Position      `json:"position,omitempty"`
 }
 
 // NewFootnote returns a new Footnote with the given content
-func NewFootnote(ref string, elements interface{}) (Footnote, error) {
+func NewFootnote(ref string, elements interface{}, position Position) (Footnote, error) {
 	// footnote with content get an ID
 	if elements, ok := elements.([]interface{}); ok {
 		return Footnote{
 			// ID is only set during document processing
 			Ref:      ref,
 			Elements: elements,
+			Position: position,
 		}, nil
 	} // footnote which are just references don't get an ID, so we don't increment the sequence
 	return Footnote{
 		Ref:      ref,
 		Elements: []interface{}{},
+		Position: position,
 	}, nil
 }
 
 // FootnoteReference a footnote reference. Replaces the actual footnote in the document,
 // and only contains a generated, sequential ID (which will be displayed)
 type FootnoteReference struct {
-	ID        int
-	Ref       string // the user-specified reference (optional)
-	Duplicate bool   // indicates if this reference targets an already-existing footnote // TODO: find a better name?
+	ID        int      `json:"id"`
+	Ref       string   `json:"ref,omitempty"`       // the user-specified reference (optional)
+	Duplicate bool     `json:"duplicate,omitempty"` // indicates if this reference targets an already-existing footnote // TODO: find a better name?
+	Position  Position `json:"position,omitempty"`
 }
 
 // FootnotesContainer interface for all types which may contain footnotes
@@ -1343,7 +1470,9 @@ const (
 
 // Reference adds the given footnote and returns a FootnoteReference in replacement
 func (f *Footnotes) Reference(note Footnote) FootnoteReference {
-	ref := FootnoteReference{}
+	ref := FootnoteReference{
+		Position: note.Position,
+	}
 	if len(note.Elements) > 0 {
 		note.ID = f.sequence.nextVal()
 		f.notes = append(f.notes, note)
@@ -1353,7 +1482,7 @@ func (f *Footnotes) Reference(note Footnote) FootnoteReference {
 		ref.Duplicate = true
 	} else {
 		ref.ID = InvalidFootnoteReference
-		logrus.Errorf("no footnote with reference '%s'", note.Ref)
+		logrus.WithFields(note.Position.Fields()).Errorf("no footnote with reference '%s'", note.Ref)
 	}
 	ref.Ref = note.Ref
 	return ref
@@ -1382,13 +1511,14 @@ func (s *sequence) nextVal() int {
 
 // DelimitedBlock the structure for the delimited blocks
 type DelimitedBlock struct {
-	Kind       BlockKind
-	Attributes Attributes
-	Elements   []interface{} // TODO: rename to `Blocks`?
+	Kind       BlockKind     `json:"kind,omitempty"`
+	Attributes Attributes    `json:"attributes,omitempty"`
+	Elements   []interface{} `json:"elements,omitempty"` // TODO: rename to `Blocks`?
+	Position   Position      `json:"position,omitempty"`
 }
 
 // NewDelimitedBlock initializes a new `DelimitedBlock` of the given kind with the given elements
-func NewDelimitedBlock(kind BlockKind, elements []interface{}, attributes interface{}) (DelimitedBlock, error) {
+func NewDelimitedBlock(kind BlockKind, elements []interface{}, attributes interface{}, position Position) (DelimitedBlock, error) {
 	log.Debugf("initializing a new DelimitedBlock of kind '%v' with %d elements", kind, len(elements))
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
@@ -1402,6 +1532,7 @@ func NewDelimitedBlock(kind BlockKind, elements []interface{}, attributes interf
 		Attributes: attrs,
 		Kind:       kind,
 		Elements:   elements,
+		Position:   position,
 	}, nil
 }
 
@@ -1411,21 +1542,24 @@ func NewDelimitedBlock(kind BlockKind, elements []interface{}, attributes interf
 
 // Callout a reference at the end of a line in a delimited block with verbatim content (eg: listing, source code)
 type Callout struct {
-	Ref int
+	Ref      int      `json:"ref"`
+	Position Position `json:"position,omitempty"`
 }
 
 // NewCallout returns a new Callout with the given reference
-func NewCallout(ref int) (Callout, error) {
+func NewCallout(ref int, position Position) (Callout, error) {
 	return Callout{
-		Ref: ref,
+		Ref:      ref,
+		Position: position,
 	}, nil
 }
 
 // CalloutListItem the description of a call out which will appear as an ordered list item after the delimited block
 type CalloutListItem struct {
-	Attributes Attributes
-	Ref        int
-	Elements   []interface{}
+	Attributes Attributes    `json:"attributes,omitempty"`
+	Ref        int           `json:"ref"`
+	Elements   []interface{} `json:"elements,omitempty"`
+	Position   Position      `json:"position,omitempty"`
 }
 
 var _ ListItem = &CalloutListItem{}
@@ -1440,21 +1574,24 @@ func (i CalloutListItem) GetAttributes() Attributes {
 // AddElement add an element to this CalloutListItem
 func (i *CalloutListItem) AddElement(element interface{}) {
 	i.Elements = append(i.Elements, element)
+	i.Position = i.Position.Extend(PositionOf(element))
 }
 
 // NewCalloutListItem returns a new CalloutListItem
-func NewCalloutListItem(ref int, description []interface{}) (CalloutListItem, error) {
+func NewCalloutListItem(ref int, description []interface{}, position Position) (CalloutListItem, error) {
 	return CalloutListItem{
 		Attributes: nil,
 		Ref:        ref,
 		Elements:   description,
+		Position:   position,
 	}, nil
 }
 
 // CalloutList the structure for the Callout Lists
 type CalloutList struct {
-	Attributes Attributes
-	Items      []CalloutListItem
+	Attributes Attributes        `json:"attributes,omitempty"`
+	Items      []CalloutListItem `json:"items,omitempty"`
+	Position   Position          `json:"position,omitempty"`
 }
 
 var _ List = &CalloutList{}
@@ -1468,12 +1605,14 @@ func NewCalloutList(item CalloutListItem) *CalloutList {
 		Items: []CalloutListItem{
 			item,
 		},
+		Position: item.Position,
 	}
 }
 
 // AddItem adds the given item to the list
 func (l *CalloutList) AddItem(item CalloutListItem) {
 	l.Items = append(l.Items, item)
+	l.Position = l.Position.Extend(item.Position)
 }
 
 // LastItem returns the last item in the list
@@ -1487,13 +1626,14 @@ func (l *CalloutList) LastItem() ListItem {
 
 // Table the structure for the tables
 type Table struct {
-	Attributes Attributes
-	Header     TableLine
-	Lines      []TableLine
+	Attributes Attributes  `json:"attributes,omitempty"`
+	Header     TableLine   `json:"header,omitempty"`
+	Lines      []TableLine `json:"lines,omitempty"`
+	Position   Position    `json:"position,omitempty"`
 }
 
 // NewTable initializes a new table with the given lines and attributes
-func NewTable(header interface{}, lines []interface{}, attributes interface{}) (Table, error) {
+func NewTable(header interface{}, lines []interface{}, attributes interface{}, position Position) (Table, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return Table{}, errors.Wrapf(err, "failed to initialize a Table element")
@@ -1536,12 +1676,13 @@ func NewTable(header interface{}, lines []interface{}, attributes interface{}) (
 		}
 	}
 	// log.Debugf("initialized a new table with %d line(s)", len(lines))
+	t.Position = position
 	return t, nil
 }
 
 // TableLine a table line is made of columns, each column being a group of []interface{} (to support quoted text, etc.)
 type TableLine struct {
-	Cells [][]interface{}
+	Cells [][]interface{} `json:"cells,omitempty"`
 }
 
 // NewTableLine initializes a new TableLine with the given columns
@@ -1566,8 +1707,9 @@ func NewTableLine(columns []interface{}) (TableLine, error) {
 
 // LiteralBlock the structure for the literal blocks
 type LiteralBlock struct {
-	Attributes Attributes
-	Lines      []string
+	Attributes Attributes `json:"attributes,omitempty"`
+	Lines      []string   `json:"lines,omitempty"`
+	Position   Position   `json:"position,omitempty"`
 }
 
 const (
@@ -1583,7 +1725,7 @@ const (
 
 // NewLiteralBlock initializes a new `DelimitedBlock` of the given kind with the given content,
 // along with the given sectionTitle spaces
-func NewLiteralBlock(origin string, lines []interface{}, attributes interface{}) (LiteralBlock, error) {
+func NewLiteralBlock(origin string, lines []interface{}, attributes interface{}, position Position) (LiteralBlock, error) {
 	l, err := toString(lines)
 	if err != nil {
 		return LiteralBlock{}, errors.Wrapf(err, "failed to initialize a new LiteralBlock")
@@ -1600,6 +1742,7 @@ func NewLiteralBlock(origin string, lines []interface{}, attributes interface{})
 	return LiteralBlock{
 		Attributes: attrs,
 		Lines:      l,
+		Position:   position,
 	}, nil
 }
 
@@ -1609,12 +1752,13 @@ func NewLiteralBlock(origin string, lines []interface{}, attributes interface{})
 
 // BlankLine the structure for the empty lines, which are used to separate logical blocks
 type BlankLine struct {
+	Position Position `json:"position,omitempty"`
 }
 
 // NewBlankLine initializes a new `BlankLine`
-func NewBlankLine() (BlankLine, error) {
+func NewBlankLine(position Position) (BlankLine, error) {
 	// log.Debug("initializing a new BlankLine")
-	return BlankLine{}, nil
+	return BlankLine{Position: position}, nil
 }
 
 // ------------------------------------------
@@ -1623,14 +1767,16 @@ func NewBlankLine() (BlankLine, error) {
 
 // SingleLineComment a single line comment
 type SingleLineComment struct {
-	Content string
+	Content  string   `json:"content,omitempty"`
+	Position Position `json:"position,omitempty"`
 }
 
 // NewSingleLineComment initializes a new single line content
-func NewSingleLineComment(content string) (SingleLineComment, error) {
+func NewSingleLineComment(content string, position Position) (SingleLineComment, error) {
 	log.Debugf("initializing a single line comment with content: '%s'", content)
 	return SingleLineComment{
-		Content: content,
+		Content:  content,
+		Position: position,
 	}, nil
 }
 
@@ -1640,7 +1786,7 @@ func NewSingleLineComment(content string) (SingleLineComment, error) {
 
 // StringElement the structure for strings
 type StringElement struct {
-	Content string
+	Content string `json:"content,omitempty"`
 }
 
 // NewStringElement initializes a new `StringElement` from the given content
@@ -1659,12 +1805,13 @@ func (s StringElement) String() string {
 
 // VerbatimLine the structure for verbatim line, ie, read "as-is" from a given text document.
 type VerbatimLine struct {
-	Content  string
-	Callouts []Callout
+	Content  string    `json:"content,omitempty"`
+	Callouts []Callout `json:"callouts,omitempty"`
+	Position Position  `json:"position,omitempty"`
 }
 
 // NewVerbatimLine initializes a new `VerbatimLine` from the given content
-func NewVerbatimLine(content string, callouts interface{}) (VerbatimLine, error) {
+func NewVerbatimLine(content string, callouts interface{}, position Position) (VerbatimLine, error) {
 	var cos []Callout
 	if callouts, ok := callouts.([]interface{}); ok {
 		for _, c := range callouts {
@@ -1674,13 +1821,15 @@ func NewVerbatimLine(content string, callouts interface{}) (VerbatimLine, error)
 	return VerbatimLine{
 		Content:  content,
 		Callouts: cos,
+		Position: position,
 	}, nil
 }
 
 // NewVerbatimFileLine initializes a new `VerbatimLine` from the given content in a file
-func NewVerbatimFileLine(content string) (VerbatimLine, error) {
+func NewVerbatimFileLine(content string, position Position) (VerbatimLine, error) {
 	return VerbatimLine{
-		Content: content,
+		Content:  content,
+		Position: position,
 	}, nil
 }
 
@@ -1701,11 +1850,13 @@ func (s VerbatimLine) String() string {
 // ------------------------------------------
 
 // LineBreak an explicit line break in a paragraph
-type LineBreak struct{}
+type LineBreak struct {
+	Position Position `json:"position,omitempty"`
+}
 
 // NewLineBreak returns a new line break, that's all.
-func NewLineBreak() (LineBreak, error) {
-	return LineBreak{}, nil
+func NewLineBreak(position Position) (LineBreak, error) {
+	return LineBreak{Position: position}, nil
 }
 
 // ------------------------------------------
@@ -1714,8 +1865,9 @@ func NewLineBreak() (LineBreak, error) {
 
 // QuotedText the structure for quoted text
 type QuotedText struct {
-	Kind     QuotedTextKind
-	Elements []interface{}
+	Kind     QuotedTextKind `json:"kind"`
+	Elements []interface{}  `json:"elements,omitempty"`
+	Position Position       `json:"position,omitempty"`
 }
 
 // QuotedTextKind the type for
@@ -1735,10 +1887,11 @@ const (
 )
 
 // NewQuotedText initializes a new `QuotedText` from the given kind and content
-func NewQuotedText(kind QuotedTextKind, elements ...interface{}) (QuotedText, error) {
+func NewQuotedText(kind QuotedTextKind, elements []interface{}, position Position) (QuotedText, error) {
 	return QuotedText{
 		Kind:     kind,
 		Elements: Merge(elements),
+		Position: position,
 	}, nil
 }
 
@@ -1777,8 +1930,9 @@ func NewEscapedQuotedText(backslashes string, punctuation string, content interf
 
 // InlinePassthrough the structure for Passthroughs
 type InlinePassthrough struct {
-	Kind     PassthroughKind
-	Elements []interface{}
+	Kind     PassthroughKind `json:"kind"`
+	Elements []interface{}   `json:"elements,omitempty"`
+	Position Position        `json:"position,omitempty"`
 }
 
 // PassthroughKind the kind of passthrough
@@ -1794,10 +1948,11 @@ const (
 )
 
 // NewInlinePassthrough returns a new passthrough
-func NewInlinePassthrough(kind PassthroughKind, elements []interface{}) (InlinePassthrough, error) {
+func NewInlinePassthrough(kind PassthroughKind, elements []interface{}, position Position) (InlinePassthrough, error) {
 	return InlinePassthrough{
 		Kind:     kind,
 		Elements: Merge(elements...),
+		Position: position,
 	}, nil
 
 }
@@ -1808,18 +1963,20 @@ func NewInlinePassthrough(kind PassthroughKind, elements []interface{}) (InlineP
 
 // InlineLink the structure for the external links
 type InlineLink struct {
-	Location   Location
-	Attributes Attributes
+	Location   Location   `json:"location,omitempty"`
+	Attributes Attributes `json:"attributes,omitempty"`
+	Position   Position   `json:"position,omitempty"`
 }
 
 // NewInlineLink initializes a new inline `InlineLink`
-func NewInlineLink(url Location, attrs interface{}) (InlineLink, error) {
+func NewInlineLink(url Location, attrs interface{}, position Position) (InlineLink, error) {
 	result := InlineLink{
 		Location: url,
 	}
 	if attrs, ok := attrs.(Attributes); ok && len(attrs) > 0 {
 		result.Attributes = attrs
 	}
+	result.Position = position
 	return result, nil
 }
 
@@ -1854,21 +2011,37 @@ func NewInlineLinkAttributes(attributes []interface{}) (Attributes, error) {
 
 // FileInclusion the structure for the file inclusions
 type FileInclusion struct {
-	Attributes Attributes
-	Location   Location
-	RawText    string
+	Attributes Attributes `json:"attributes,omitempty"`
+	Location   Location   `json:"location,omitempty"`
+	RawText    string     `json:"rawText,omitempty"`
+	Position   Position   `json:"position,omitempty"`
 }
 
 // NewFileInclusion initializes a new inline `InlineLink`
-func NewFileInclusion(location Location, attributes interface{}, rawtext string) (FileInclusion, error) {
+func NewFileInclusion(location Location, attributes interface{}, rawtext string, position Position) (FileInclusion, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return FileInclusion{}, errors.Wrap(err, "failed to initialize a FileInclusion element")
 	}
+	for _, key := range []string{AttrIndent, AttrEncoding, AttrOptions} {
+		if v, ok := attrs[key].(string); ok {
+			attrs[key] = strings.Trim(v, `"'`)
+		}
+	}
+	if v, ok := attrs[AttrIndent].(string); ok {
+		indent, err := strconv.Atoi(v)
+		if err != nil || indent < 0 {
+			log.Warnf("invalid 'indent' value in '%s'", rawtext)
+			delete(attrs, AttrIndent)
+		} else {
+			attrs[AttrIndent] = indent
+		}
+	}
 	return FileInclusion{
 		Attributes: attrs,
 		Location:   location,
 		RawText:    rawtext,
+		Position:   position,
 	}, nil
 }
 
@@ -1893,6 +2066,31 @@ func (f *FileInclusion) TagRanges() (TagRanges, bool) {
 	return TagRanges{}, false // default tag ranges: include all content
 }
 
+// Indent returns the number of spaces to use to indent the content of the file to include.
+func (f *FileInclusion) Indent() (int, bool) {
+	indent, ok := f.Attributes[AttrIndent].(int)
+	return indent, ok
+}
+
+// Encoding returns the name of the encoding of the file to include.
+func (f *FileInclusion) Encoding() (string, bool) {
+	encoding, ok := f.Attributes[AttrEncoding].(string)
+	return encoding, ok && encoding != ""
+}
+
+// IsOptional returns true if the file to include is optional (`opts=optional`),
+// i.e., if it can be silently skipped when it is missing.
+func (f *FileInclusion) IsOptional() bool {
+	if opts, ok := f.Attributes[AttrOptions].(string); ok {
+		for _, opt := range strings.Split(opts, ",") {
+			if strings.TrimSpace(opt) == "optional" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // -------------------------------------------------------------------------------------
 // LineRanges: one or more ranges of lines to limit the content of a file to include
 // -------------------------------------------------------------------------------------
@@ -1920,8 +2118,8 @@ func NewLineRangesAttribute(ranges interface{}) (Attributes, error) {
 // - if there's a single line to include, then `End = Start`
 // - if there is all remaining content after a given line (included), then `End = -1`
 type LineRange struct {
-	StartLine int
-	EndLine   int
+	StartLine int `json:"startLine"`
+	EndLine   int `json:"endLine"`
 }
 
 // NewLineRange returns a new line range
@@ -2035,8 +2233,8 @@ func (tr TagRanges) Match(line int, currentRanges CurrentRanges) bool {
 // - '*' means that all tag ranges are included (except the lines having the start and end ranges)
 // - '**' means that all content is included, regardless of whether it is in a tag or not (except the lines having the start and end ranges)
 type TagRange struct {
-	Name     string
-	Included bool
+	Name     string `json:"name,omitempty"`
+	Included bool   `json:"included,omitempty"`
 }
 
 // NewTagRange returns a new TagRange
@@ -2105,7 +2303,7 @@ func (l IncludedFileLine) GetEndTag() (IncludedFileEndTag, bool) {
 
 // IncludedFileStartTag the type for the `tag::` macro
 type IncludedFileStartTag struct {
-	Value string
+	Value string `json:"value,omitempty"`
 }
 
 // NewIncludedFileStartTag returns a new IncludedFileStartTag
@@ -2115,7 +2313,7 @@ func NewIncludedFileStartTag(tag string) (IncludedFileStartTag, error) {
 
 // IncludedFileEndTag the type for the `end::` macro
 type IncludedFileEndTag struct {
-	Value string
+	Value string `json:"value,omitempty"`
 }
 
 // NewIncludedFileEndTag returns a new IncludedFileEndTag
@@ -2129,8 +2327,8 @@ func NewIncludedFileEndTag(tag string) (IncludedFileEndTag, error) {
 
 // Location a Location contains characters and optionaly, document attributes
 type Location struct {
-	Scheme string
-	Path   []interface{}
+	Scheme string        `json:"scheme,omitempty"`
+	Path   []interface{} `json:"path,omitempty"`
 }
 
 // NewLocation return a new location with the given elements
@@ -2216,28 +2414,32 @@ func (l *Location) Resolve(attrs AttributesWithOverrides) Location {
 
 // IndexTerm a index term, with a single term
 type IndexTerm struct {
-	Term []interface{}
+	Term     []interface{} `json:"term,omitempty"`
+	Position Position      `json:"position,omitempty"`
 }
 
 // NewIndexTerm returns a new IndexTerm
-func NewIndexTerm(term []interface{}) (IndexTerm, error) {
+func NewIndexTerm(term []interface{}, position Position) (IndexTerm, error) {
 	return IndexTerm{
-		Term: term,
+		Term:     term,
+		Position: position,
 	}, nil
 }
 
 // ConcealedIndexTerm a concealed index term, with 1 required and 2 optional terms
 type ConcealedIndexTerm struct {
-	Term1 interface{}
-	Term2 interface{}
-	Term3 interface{}
+	Term1    interface{} `json:"term1,omitempty"`
+	Term2    interface{} `json:"term2,omitempty"`
+	Term3    interface{} `json:"term3,omitempty"`
+	Position Position    `json:"position,omitempty"`
 }
 
 // NewConcealedIndexTerm returns a new ConcealedIndexTerm
-func NewConcealedIndexTerm(term1, term2, term3 interface{}) (ConcealedIndexTerm, error) {
+func NewConcealedIndexTerm(term1, term2, term3 interface{}, position Position) (ConcealedIndexTerm, error) {
 	return ConcealedIndexTerm{
-		Term1: term1,
-		Term2: term2,
-		Term3: term3,
+		Term1:    term1,
+		Term2:    term2,
+		Term3:    term3,
+		Position: position,
 	}, nil
 }
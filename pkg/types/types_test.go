@@ -614,6 +614,34 @@ var _ = DescribeTable("draft document attributes",
 			"foo2": "bar2",
 		},
 	),
+	Entry("should skip blank lines and comments at top of document and apply resets",
+		types.DraftDocument{
+			Blocks: []interface{}{
+				types.BlankLine{},
+				types.SingleLineComment{
+					Content: "a comment",
+				},
+				types.AttributeDeclaration{
+					Name:  "foo1",
+					Value: "bar1",
+				},
+				types.AttributeDeclaration{
+					Name:  "foo2",
+					Value: "bar2",
+				},
+				types.AttributeReset{
+					Name: "foo1",
+				},
+				types.BlankLine{},
+				types.AttributeReset{
+					Name: "foo2",
+				},
+			},
+		},
+		types.Attributes{
+			"foo2": "bar2",
+		},
+	),
 	Entry("should use attribute declarations right after section 0 only",
 		types.DraftDocument{
 			Blocks: []interface{}{
@@ -17,11 +17,12 @@ func Validate(doc *types.Document) []Problem {
 }
 
 // Problem a problem detected during validation
-// Must have a severity and an associated message
-// TODO: include element position once available in the AST.
+// Must have a severity and an associated message, and may have
+// the position of the element in the source document
 type Problem struct {
 	Severity Severity
 	Message  string
+	Position types.Position
 }
 
 // Severity the problem severity
@@ -47,21 +48,25 @@ func validateManpage(doc *types.Document) []Problem {
 		problems = append(problems, Problem{
 			Severity: Error,
 			Message:  "manpage document is missing a header",
+			Position: types.PositionOf(doc.Elements[0]),
 		})
 	} else if nameSection, ok := assertThatElement(header.Elements[0]).isSection(withLevel(1), withTitle("name")); !ok {
 		problems = append(problems, Problem{
 			Severity: Error,
 			Message:  "manpage document is missing the 'Name' section'",
+			Position: types.PositionOf(header.Elements[0]),
 		})
 	} else if ok := assertThatElements(nameSection.Elements).haveCount(1); !ok {
 		problems = append(problems, Problem{
 			Severity: Error,
 			Message:  "'Name' section' should contain a single paragraph",
+			Position: nameSection.Position,
 		})
 	} else if _, ok := assertThatElement(header.Elements[1]).isSection(withLevel(1), withTitle("synopsis")); !ok {
 		problems = append(problems, Problem{
 			Severity: Error,
 			Message:  "manpage document is missing the 'Synopsis' section'",
+			Position: types.PositionOf(header.Elements[1]),
 		})
 	}
 	// if any problem found, change the doctype to render the document as a regular article
@@ -234,6 +234,60 @@ var _ = Describe("document validator", func() {
 				Expect(doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "")).To(Equal("article")) // changed
 			})
 
+			It("missing name section - with position", func() {
+				// given
+				doc := types.Document{
+					Attributes: types.Attributes{
+						types.AttrDocType: "manpage",
+					},
+					Elements: []interface{}{
+						types.Section{
+							Attributes: types.Attributes{},
+							Level:      0,
+							Title: []interface{}{
+								types.StringElement{
+									Content: "foo",
+								},
+							},
+							Elements: []interface{}{
+								types.Section{
+									Attributes: types.Attributes{},
+									Level:      2, // invalid level
+									Title: []interface{}{
+										types.StringElement{
+											Content: "Name",
+										},
+									},
+									Position: types.Position{
+										File:      "foo.adoc",
+										Line:      3,
+										Column:    1,
+										EndLine:   5,
+										EndColumn: 10,
+									},
+								},
+							},
+						},
+					},
+				}
+
+				// when
+				problems := Validate(&doc)
+
+				// then
+				Expect(problems).To(ContainElement(Problem{
+					Severity: Error,
+					Message:  "manpage document is missing the 'Name' section'",
+					Position: types.Position{
+						File:      "foo.adoc",
+						Line:      3,
+						Column:    1,
+						EndLine:   5,
+						EndColumn: 10,
+					},
+				}))
+			})
+
 			It("missing name section - invalid title", func() {
 				// given
 				doc := types.Document{
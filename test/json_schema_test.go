@@ -0,0 +1,108 @@
+package test_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+// validateJSON verifies that the given JSON content is valid against the schema shipped in the `docs` folder.
+// Only the subset of the JSON schema keywords which are used in this schema is supported.
+func validateJSON(content []byte) {
+	s, err := ioutil.ReadFile("../docs/json-schema.json")
+	Expect(err).ShouldNot(HaveOccurred())
+	var schema map[string]interface{}
+	err = json.Unmarshal(s, &schema)
+	Expect(err).ShouldNot(HaveOccurred())
+	var value interface{}
+	err = json.Unmarshal(content, &value)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(validateJSONValue(schema, schema, value, "")).To(Succeed())
+}
+
+func validateJSONValue(root, schema map[string]interface{}, value interface{}, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/definitions/")
+		definition, found := root["definitions"].(map[string]interface{})[name]
+		if !found {
+			return fmt.Errorf("unknown definition at %s: '%s'", path, ref)
+		}
+		return validateJSONValue(root, definition.(map[string]interface{}), value, path)
+	}
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		matches := 0
+		for _, s := range oneOf {
+			if validateJSONValue(root, s.(map[string]interface{}), value, path) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return fmt.Errorf("value at %s matches %d schemas instead of 1: %v", path, matches, value)
+		}
+		return nil
+	}
+	if c, ok := schema["const"]; ok && c != value {
+		return fmt.Errorf("unexpected value at %s: '%v' (expected '%v')", path, value, c)
+	}
+	switch schema["type"] {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected an object at %s", path)
+		}
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, k := range required {
+				if _, found := object[k.(string)]; !found {
+					return fmt.Errorf("missing '%s' field at %s", k, path)
+				}
+			}
+		}
+		properties, _ := schema["properties"].(map[string]interface{})
+		keys := make([]string, 0, len(object))
+		for k := range object {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p, found := properties[k]
+			if !found {
+				if schema["additionalProperties"] == false {
+					return fmt.Errorf("unexpected '%s' field at %s", k, path)
+				}
+				continue
+			}
+			if err := validateJSONValue(root, p.(map[string]interface{}), object[k], path+"/"+k); err != nil {
+				return err
+			}
+		}
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected an array at %s", path)
+		}
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, v := range array {
+				if err := validateJSONValue(root, items, v, fmt.Sprintf("%s/%d", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("expected a string at %s", path)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("expected a boolean at %s", path)
+		}
+	case "integer":
+		if n, ok := value.(float64); !ok || n != float64(int64(n)) {
+			return fmt.Errorf("expected an integer at %s", path)
+		}
+	}
+	return nil
+}
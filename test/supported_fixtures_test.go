@@ -2,7 +2,10 @@ package test_test
 
 import (
 	"bytes"
+	"encoding/xml"
+	"io"
 	"io/ioutil"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
@@ -39,8 +42,43 @@ var _ = Describe("fixtures", func() {
 
 	// verifies that all files in the `supported` subfolder match their sibling golden file
 	DescribeTable("supported", compare, entries("fixtures/supported/*.adoc")...)
+
+	// verifies that all files in the `supported` subfolder are rendered as well-formed XML with the `xhtml5` backend
+	DescribeTable("supported in XHTML", wellFormed, entries("fixtures/supported/*.adoc")...)
+
+	// verifies that the JSON export of the final and draft documents of all files in the `supported` subfolder
+	// match their sibling `.json` and `-draft.json` golden files (which can be regenerated from this directory with
+	// `libasciidoc --dump final -o fixtures/supported/<name>.json fixtures/supported/<name>.adoc`, and with `--dump draft`
+	// for the draft documents, once the changes in the JSON export have been reviewed)
+	DescribeTable("supported in JSON", compareJSON(libasciidoc.ParseToJSON, ".json"), entries("fixtures/supported/*.adoc")...)
+	DescribeTable("supported in JSON (draft)", compareJSON(libasciidoc.ParseDraftToJSON, "-draft.json"), entries("fixtures/supported/*.adoc")...)
+
+	// verifies that the JSON export of the final and draft documents of all files in the `supported` subfolder
+	// are valid against the JSON schema shipped in the `docs` folder
+	DescribeTable("supported in JSON (schema)", conformJSON, entries("fixtures/supported/*.adoc")...)
 })
 
+func wellFormed(file string) {
+	buff := bytes.NewBuffer(nil)
+	config := configuration.NewConfiguration(
+		configuration.WithFilename(file),
+		configuration.WithBackend("xhtml5"),
+		configuration.WithHeaderFooter(true),
+	)
+	_, err := libasciidoc.ConvertFile(buff, config)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(buff.String()).To(ContainSubstring(`<html xmlns="http://www.w3.org/1999/xhtml"`))
+	// decode all tokens, which fails at the first syntax error
+	decoder := xml.NewDecoder(buff)
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+}
+
 func compare(file string) {
 	// set logger to a minimal verbose level, then restore at its initial level afterwards
 	// unless the logger was at `DEBUG` level, in which case, it should remain as-is
@@ -65,6 +103,38 @@ func compare(file string) {
 	Expect(actual).To(Equal(expected))
 }
 
+func compareJSON(export func(io.Reader, io.Writer, configuration.Configuration) error, goldenSuffix string) func(string) {
+	return func(file string) {
+		source, err := os.Open(file)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer source.Close()
+		actual := bytes.NewBuffer(nil)
+		err = export(source, actual, configuration.NewConfiguration(configuration.WithFilename(file)))
+		Expect(err).ShouldNot(HaveOccurred())
+		expected, err := ioutil.ReadFile(file[:len(file)-len(adocExt)] + goldenSuffix)
+		Expect(err).ShouldNot(HaveOccurred())
+		if runtime.GOOS == "windows" && autocrlf == "true" {
+			expected = bytes.Replace(expected, []byte("\r"), nil, -1)
+		}
+		Expect(actual.String()).To(Equal(string(expected)))
+	}
+}
+
+func conformJSON(file string) {
+	for _, export := range []func(io.Reader, io.Writer, configuration.Configuration) error{
+		libasciidoc.ParseToJSON,
+		libasciidoc.ParseDraftToJSON,
+	} {
+		source, err := os.Open(file)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer source.Close()
+		actual := bytes.NewBuffer(nil)
+		err = export(source, actual, configuration.NewConfiguration(configuration.WithFilename(file)))
+		Expect(err).ShouldNot(HaveOccurred())
+		validateJSON(actual.Bytes())
+	}
+}
+
 const adocExt = ".adoc"
 
 func entries(pattern string) []TableEntry {
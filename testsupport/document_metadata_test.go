@@ -26,6 +26,7 @@ var _ = Describe("document metadata", func() {
 				},
 			},
 		},
+		OutFileSuffix: ".html",
 	}
 
 	It("should match", func() {
@@ -15,9 +15,13 @@ import (
 func ParseDocument(actual string, settings ...configuration.Setting) (types.Document, error) {
 	r := strings.NewReader(actual)
 	doc, err := parser.ParseDocument(r, configuration.NewConfiguration(settings...))
-	if err == nil && logrus.IsLevelEnabled(logrus.DebugLevel) {
+	if err != nil {
+		return doc, err
+	}
+	doc = WithoutPositions(doc).(types.Document)
+	if logrus.IsLevelEnabled(logrus.DebugLevel) {
 		log.Debug("final document:")
 		spew.Dump(doc)
 	}
-	return doc, err
+	return doc, nil
 }
@@ -13,10 +13,12 @@ import (
 func ParseDocumentBlock(actual string, opts ...parser.Option) (interface{}, error) {
 	r := strings.NewReader(actual)
 	result, err := parser.ParseReader("", r, append(opts, parser.Entrypoint("DocumentBlock"))...)
-	if err == nil {
-		if log.IsLevelEnabled(log.DebugLevel) {
-			spew.Dump(result)
-		}
+	if err != nil {
+		return nil, err
 	}
-	return result, err
+	result = WithoutPositions(result)
+	if log.IsLevelEnabled(log.DebugLevel) {
+		spew.Dump(result)
+	}
+	return result, nil
 }
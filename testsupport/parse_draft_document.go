@@ -27,10 +27,18 @@ func ParseDraftDocument(actual string, options ...interface{}) (interface{}, err
 	}
 
 	if !c.preprocessing {
-		return parser.ParseReader(c.filename, r, append(parserOptions, parser.Entrypoint("AsciidocDocument"))...)
+		doc, err := parser.ParseReader(c.filename, r, append(parserOptions, parser.Entrypoint("AsciidocDocument"))...)
+		if err != nil {
+			return nil, err
+		}
+		return WithoutPositions(doc), nil
 	}
 	config := configuration.NewConfiguration(configuration.WithFilename(c.filename))
-	return parser.ParseDraftDocument(r, config, parserOptions...)
+	doc, err := parser.ParseDraftDocument(r, config, parserOptions...)
+	if err != nil {
+		return nil, err
+	}
+	return WithoutPositions(doc), nil
 }
 
 type drafDocumentParserConfig struct {
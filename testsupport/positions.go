@@ -0,0 +1,66 @@
+package testsupport
+
+import (
+	"reflect"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+var positionType = reflect.TypeOf(types.Position{})
+
+// WithoutPositions returns a copy of the given element (and of all its nested elements)
+// in which the source positions are reset, so that it can be compared with an expected
+// element in which positions are not relevant
+func WithoutPositions(element interface{}) interface{} {
+	if element == nil {
+		return nil
+	}
+	return withoutPositions(reflect.ValueOf(element)).Interface()
+}
+
+func withoutPositions(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Struct:
+		result := reflect.New(v.Type()).Elem()
+		result.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			f := result.Field(i)
+			if !f.CanSet() {
+				continue
+			}
+			if f.Type() == positionType {
+				f.Set(reflect.Zero(positionType))
+				continue
+			}
+			f.Set(withoutPositions(v.Field(i)))
+		}
+		return result
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			result.Index(i).Set(withoutPositions(v.Index(i)))
+		}
+		return result
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			result.SetMapIndex(k, withoutPositions(v.MapIndex(k)))
+		}
+		return result
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.New(v.Type()).Elem()
+		result.Set(withoutPositions(v.Elem()))
+		return result
+	default:
+		return v
+	}
+}
@@ -0,0 +1,85 @@
+package testsupport
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the given source with the backend set in the settings (or the default backend)
+func Render(actual string, settings ...configuration.Setting) (string, error) {
+	config := configuration.NewConfiguration(settings...)
+	contentReader := strings.NewReader(actual)
+	resultWriter := bytes.NewBuffer(nil)
+	_, err := libasciidoc.Convert(contentReader, resultWriter, config)
+	if err != nil {
+		return "", err
+	}
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.Debug(resultWriter.String())
+	}
+	return resultWriter.String(), nil
+}
+
+// RenderDocBook5 renders the given source as a full DocBook 5 document
+func RenderDocBook5(source string, settings ...configuration.Setting) (string, error) {
+	return renderDocument("docbook5", source, settings...)
+}
+
+// RenderDocBook5Content renders the given source as DocBook 5, without the root and `info` elements
+func RenderDocBook5Content(source string, settings ...configuration.Setting) (string, error) {
+	return renderContent("docbook5", source, settings...)
+}
+
+// RenderLaTeX renders the given source as a full LaTeX document (i.e., with its preamble)
+func RenderLaTeX(source string, settings ...configuration.Setting) (string, error) {
+	return renderDocument("latex", source, settings...)
+}
+
+// RenderLaTeXContent renders the given source in LaTeX, without the preamble of the document
+func RenderLaTeXContent(source string, settings ...configuration.Setting) (string, error) {
+	return renderContent("latex", source, settings...)
+}
+
+// RenderManpage renders the given source as a full manpage
+func RenderManpage(source string, settings ...configuration.Setting) (string, error) {
+	return renderDocument("manpage", source, settings...)
+}
+
+// RenderManpageContent renders the given source as a manpage, without the preamble (`.TH` macro, etc.)
+func RenderManpageContent(source string, settings ...configuration.Setting) (string, error) {
+	return renderContent("manpage", source, settings...)
+}
+
+// RenderMarkdown renders the given source as a full Markdown document (i.e., with its title)
+func RenderMarkdown(source string, settings ...configuration.Setting) (string, error) {
+	return renderDocument("markdown", source, settings...)
+}
+
+// RenderMarkdownContent renders the given source as Markdown, without the title of the document
+func RenderMarkdownContent(source string, settings ...configuration.Setting) (string, error) {
+	return renderContent("markdown", source, settings...)
+}
+
+// RenderText renders the given source as a full plain text document (i.e., with its title)
+func RenderText(source string, settings ...configuration.Setting) (string, error) {
+	return renderDocument("text", source, settings...)
+}
+
+// RenderTextContent renders the given source as plain text, without the title of the document
+func RenderTextContent(source string, settings ...configuration.Setting) (string, error) {
+	return renderContent("text", source, settings...)
+}
+
+// renderDocument renders the given source as a full document (i.e., with its header and footer) with the given backend
+func renderDocument(backend, source string, settings ...configuration.Setting) (string, error) {
+	return Render(source, append(settings, configuration.WithBackend(backend), configuration.WithHeaderFooter(true))...)
+}
+
+// renderContent renders the given source with the given backend, without the header and footer of the document
+func renderContent(backend, source string, settings ...configuration.Setting) (string, error) {
+	return Render(source, append(settings, configuration.WithBackend(backend))...)
+}
@@ -0,0 +1,31 @@
+package testsupport_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("backend renderers", func() {
+
+	source := `= Title
+
+hello, world!`
+
+	It("should render the full document", func() {
+		// when
+		result, err := testsupport.RenderText(source)
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("Title\n=====\n\nhello, world!\n"))
+	})
+
+	It("should render the content of the document", func() {
+		// when
+		result, err := testsupport.RenderTextContent(source)
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal("hello, world!"))
+	})
+})